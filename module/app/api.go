@@ -0,0 +1,192 @@
+package app
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
+	"github.com/spf13/cast"
+)
+
+// GravityAPIConfig configures the CORS and the rate limiting of the routes of the API server which bridge frontends
+// query directly, so that validators can expose them publicly. It is read from the gravity-api section of app.toml
+type GravityAPIConfig struct {
+	// Routes are the path prefixes the configuration applies to
+	Routes []string `mapstructure:"routes"`
+	// CORSAllowedOrigins are the origins browsers may query the routes from, "*" allows any origin. Without origins
+	// the CORS headers are left to the api section
+	CORSAllowedOrigins []string `mapstructure:"cors-allowed-origins"`
+	// RateLimit is the number of requests per second a client IP may send to each route, zero disables the limit
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst is the number of requests a client IP may send at once to each route
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+}
+
+// DefaultGravityAPIConfig applies neither CORS nor rate limiting to the gravity routes
+func DefaultGravityAPIConfig() GravityAPIConfig {
+	return GravityAPIConfig{
+		Routes:             []string{"/gravity/"},
+		CORSAllowedOrigins: []string{},
+		RateLimit:          0,
+		RateLimitBurst:     0,
+	}
+}
+
+// GravityAPIConfigTemplate is the app.toml section of GravityAPIConfig, for a config embedding it as GravityAPI
+const GravityAPIConfigTemplate = `
+###############################################################################
+###                         Gravity API Configuration                       ###
+###############################################################################
+
+[gravity-api]
+
+# Path prefixes of the API server routes the settings below apply to, the gravity REST and gRPC gateway routes.
+routes = [{{ range .GravityAPI.Routes }}{{ printf "%q, " . }}{{end}}]
+
+# Origins browsers may query the routes from, "*" allows any origin. Empty leaves CORS to the api section.
+cors-allowed-origins = [{{ range .GravityAPI.CORSAllowedOrigins }}{{ printf "%q, " . }}{{end}}]
+
+# Requests per second a client IP may send to each route, 0 disables the rate limiting.
+rate-limit = {{ .GravityAPI.RateLimit }}
+
+# Requests a client IP may send at once to each route, at least the rate rounded up.
+rate-limit-burst = {{ .GravityAPI.RateLimitBurst }}
+`
+
+// ReadGravityAPIConfig reads the gravity-api section of app.toml
+func ReadGravityAPIConfig(appOpts servertypes.AppOptions) GravityAPIConfig {
+	config := DefaultGravityAPIConfig()
+	if routes := cast.ToStringSlice(appOpts.Get("gravity-api.routes")); len(routes) > 0 {
+		config.Routes = routes
+	}
+	config.CORSAllowedOrigins = cast.ToStringSlice(appOpts.Get("gravity-api.cors-allowed-origins"))
+	config.RateLimit = cast.ToFloat64(appOpts.Get("gravity-api.rate-limit"))
+	config.RateLimitBurst = cast.ToInt(appOpts.Get("gravity-api.rate-limit-burst"))
+	return config
+}
+
+// Middleware returns the middleware applying the CORS and the rate limiting to the configured routes, the other
+// requests are passed through
+func (c GravityAPIConfig) Middleware() mux.MiddlewareFunc {
+	limiter := newRateLimiter(c.RateLimit, c.RateLimitBurst, time.Now)
+	var corsHandler *cors.Cors
+	if len(c.CORSAllowedOrigins) > 0 {
+		//nolint: exhaustivestruct
+		corsHandler = cors.New(cors.Options{
+			AllowedOrigins: c.CORSAllowedOrigins,
+			AllowedMethods: []string{http.MethodGet, http.MethodPost},
+			AllowedHeaders: []string{"*"},
+		})
+	}
+
+	return func(next http.Handler) http.Handler {
+		var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if limiter != nil && !limiter.allow(clientIP(r)+" "+c.route(r.URL.Path)) {
+				w.Header().Set("Retry-After", "1")
+				rest.WriteErrorResponse(w, http.StatusTooManyRequests, "rate limit exceeded")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+		// the CORS headers are also set on rejected requests, so that browsers let frontends read the rejection
+		if corsHandler != nil {
+			handler = corsHandler.Handler(handler)
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if c.route(r.URL.Path) == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// route returns the longest configured prefix of path, or an empty string if the configuration does not apply to it
+func (c GravityAPIConfig) route(path string) string {
+	route := ""
+	for _, prefix := range c.Routes {
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(route) {
+			route = prefix
+		}
+	}
+	return route
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// rateLimiter is a token bucket per key, the buckets refilled to the burst are dropped every minute
+type rateLimiter struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns nil for a rate which is not positive, the burst is at least the rate rounded up
+func newRateLimiter(rate float64, burst int, now func() time.Time) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		rate:      rate,
+		burst:     math.Max(float64(burst), math.Ceil(rate)),
+		now:       now,
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: now(),
+	}
+}
+
+// allow takes a token from the bucket of key if there is one
+func (l *rateLimiter) allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > time.Minute {
+		for k, b := range l.buckets {
+			if l.refill(b, now) >= l.burst {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *rateLimiter) refill(b *tokenBucket, now time.Time) float64 {
+	return math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Tests that the gravity routes are rate limited per client and route and get the configured CORS headers, while
+// the other routes are passed through
+func TestGravityAPIMiddleware(t *testing.T) {
+	config := GravityAPIConfig{
+		Routes:             []string{"/gravity/", "/gravity/v1beta/"},
+		CORSAllowedOrigins: []string{"https://bridge.example"},
+		RateLimit:          1,
+		RateLimitBurst:     2,
+	}
+	handler := config.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(path, client string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = client + ":1234"
+		req.Header.Set("Origin", "https://bridge.example")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i < 2; i++ {
+		rec := serve("/gravity/valset_requests", "10.0.0.1")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "https://bridge.example", rec.Header().Get("Access-Control-Allow-Origin"))
+	}
+	rec := serve("/gravity/valset_requests", "10.0.0.1")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "https://bridge.example", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	// other clients and other routes have their own buckets, the routes outside of the config are not limited
+	require.Equal(t, http.StatusOK, serve("/gravity/valset_requests", "10.0.0.2").Code)
+	require.Equal(t, http.StatusOK, serve("/gravity/v1beta/params", "10.0.0.1").Code)
+	for i := 0; i < 3; i++ {
+		rec := serve("/cosmos/bank/v1beta1/balances/x", "10.0.0.1")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	}
+}
+
+// Tests that the buckets refill at the rate up to the burst and that the full buckets are dropped
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := newRateLimiter(2, 0, func() time.Time { return now })
+	require.Nil(t, newRateLimiter(0, 10, time.Now))
+
+	// the burst is at least the rate
+	require.True(t, limiter.allow("a"))
+	require.True(t, limiter.allow("a"))
+	require.False(t, limiter.allow("a"))
+
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, limiter.allow("a"))
+	require.False(t, limiter.allow("a"))
+
+	now = now.Add(time.Hour)
+	require.True(t, limiter.allow("b"))
+	require.Len(t, limiter.buckets, 1)
+}
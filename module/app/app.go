@@ -57,6 +57,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -96,6 +97,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
 
 	gravityparams "github.com/onomyprotocol/arc/module/eth/app/params"
+	"github.com/onomyprotocol/arc/module/eth/chain"
 	"github.com/onomyprotocol/arc/module/eth/x/gravity"
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
 	gravitytypes "github.com/onomyprotocol/arc/module/eth/x/gravity/types"
@@ -141,14 +143,18 @@ var (
 	// module account permissions
 	// NOTE: We believe that this is giving various modules access to functions of the supply module? We will probably need to use this.
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:            nil,
+		distrtypes.ModuleName:                 nil,
+		minttypes.ModuleName:                  {authtypes.Minter},
+		stakingtypes.BondedPoolName:           {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:        {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                   {authtypes.Burner},
+		ibctransfertypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+		gravitytypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
+		gravitytypes.UnbatchedPoolAccountName: nil,
+		gravitytypes.BatchesAccountName:       nil,
+		gravitytypes.FeesAccountName:          nil,
+		gravitytypes.HeldDepositsAccountName:  nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -206,16 +212,26 @@ type Gravity struct {
 	ibcTransferKeeper *ibctransferkeeper.Keeper
 	gravityKeeper     *keeper.Keeper
 
+	// optional keepers, nil unless their module is wired with an AppOption
+	feegrantKeeper *feegrantkeeper.Keeper
+
 	// make scoped keepers public for test purposes
 	// NOTE: If you add anything to this struct, add a nil check to ValidateMembers below!
 	ScopedIBCKeeper      *capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper *capabilitykeeper.ScopedKeeper
 
+	// the optional modules wired into the app and the module account permissions with theirs
+	optionalModules []OptionalModule
+	maccPerms       map[string][]string
+
 	// Module Manager
 	mm *module.Manager
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// apiConfig is the CORS and rate limiting of the gravity API routes
+	apiConfig GravityAPIConfig
 }
 
 // ValidateMembers checks for nil members
@@ -303,11 +319,26 @@ func init() {
 	sdkgovtypes.DefaultMinDepositTokens = sdk.NewIntWithDecimal(1, 18)
 }
 
+// NewGravityApp returns the gravity app with the optional modules of the DefaultAppOptions
 func NewGravityApp(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, skipUpgradeHeights map[int64]bool,
 	homePath string, invCheckPeriod uint, encodingConfig gravityparams.EncodingConfig,
 	appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp),
 ) *Gravity {
+	return NewGravityAppWithOptions(
+		logger, db, traceStore, loadLatest, skipUpgradeHeights, homePath, invCheckPeriod, encodingConfig, appOpts,
+		DefaultAppOptions(), baseAppOptions...,
+	)
+}
+
+// NewGravityAppWithOptions returns the gravity app with the optional modules of options, which lets the binary of a
+// target chain choose the heavy modules it runs without a copy of this constructor
+func NewGravityAppWithOptions(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, skipUpgradeHeights map[int64]bool,
+	homePath string, invCheckPeriod uint, encodingConfig gravityparams.EncodingConfig,
+	appOpts servertypes.AppOptions, options []AppOption, baseAppOptions ...func(*baseapp.BaseApp),
+) *Gravity {
+	opts := newAppOptions(options)
 	appCodec := encodingConfig.Marshaler
 	legacyAmino := encodingConfig.Amino
 	interfaceRegistry := encodingConfig.InterfaceRegistry
@@ -327,6 +358,12 @@ func NewGravityApp(
 	)
 	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+	for _, optional := range opts.modules {
+		optional.Basic.RegisterInterfaces(interfaceRegistry)
+		for _, storeKey := range optional.StoreKeys {
+			keys[storeKey] = sdk.NewKVStoreKey(storeKey)
+		}
+	}
 
 	//nolint: exhaustivestruct
 	var app = &Gravity{
@@ -338,6 +375,9 @@ func NewGravityApp(
 		keys:              keys,
 		tKeys:             tKeys,
 		memKeys:           memKeys,
+		optionalModules:   opts.modules,
+		maccPerms:         opts.maccPerms(),
+		apiConfig:         ReadGravityAPIConfig(appOpts),
 	}
 
 	paramsKeeper := initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tKeys[paramstypes.TStoreKey])
@@ -367,7 +407,7 @@ func NewGravityApp(
 		keys[authtypes.StoreKey],
 		app.GetSubspace(authtypes.ModuleName),
 		authtypes.ProtoBaseAccount,
-		maccPerms,
+		app.maccPerms,
 	)
 	app.accountKeeper = &accountKeeper
 
@@ -427,6 +467,7 @@ func NewGravityApp(
 		&distrKeeper,
 		&accountKeeper,
 	)
+	gravityKeeper.SetStoreMetricsTelemetry(cast.ToBool(appOpts.Get("telemetry.enabled")))
 	app.gravityKeeper = &gravityKeeper
 
 	// Add the staking hooks from distribution, slashing, and gravity to staking
@@ -478,9 +519,9 @@ func NewGravityApp(
 
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(paramsKeeper)).
+		AddRoute(paramsproposal.RouterKey, keeper.NewCriticalParamChangeProposalHandler(gravityKeeper, params.NewParamChangeProposalHandler(paramsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(distrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(upgradeKeeper)).
+		AddRoute(upgradetypes.RouterKey, keeper.NewUpgradeGuardProposalHandler(gravityKeeper, upgrade.NewSoftwareUpgradeProposalHandler(upgradeKeeper))).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(ibcKeeper.ClientKeeper)).
 		AddRoute(gravitytypes.RouterKey, keeper.NewGravityProposalHandler(gravityKeeper))
 
@@ -494,6 +535,10 @@ func NewGravityApp(
 		govRouter,
 	)
 	app.govKeeper = &govKeeper
+	gravityKeeper.SetGovKeeper(&govKeeper)
+	// the binary versions are read from the module manager, which is only created below
+	gravityKeeper.SetModuleVersions(upgradeKeeper, func() module.VersionMap { return app.mm.GetVersionMap() })
+	gravityKeeper.SetMountedStores(keys, tKeys, memKeys)
 
 	ibctransferKeeper := ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
@@ -501,6 +546,8 @@ func NewGravityApp(
 		accountKeeper, bankKeeper, scopedTransferKeeper,
 	)
 	app.ibcTransferKeeper = &ibctransferKeeper
+	gravityKeeper.SetDenomTraceSource(ibctransferKeeper)
+	gravityKeeper.SetIbcTransferKeeper(ibctransferKeeper)
 
 	ibcTransferModule := transfer.NewAppModule(ibctransferKeeper)
 
@@ -594,10 +641,13 @@ func NewGravityApp(
 			bankKeeper,
 		),
 	)
+	for _, optional := range opts.modules {
+		mm.Modules[optional.Name] = optional.NewModule(app, keys)
+	}
 	app.mm = &mm
 
 	// NOTE: capability module's BeginBlocker must come before any modules using capabilities (e.g. IBC)
-	mm.SetOrderBeginBlockers(
+	mm.SetOrderBeginBlockers(opts.withOptionalModules(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -616,8 +666,8 @@ func NewGravityApp(
 		crisistypes.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
-	)
-	mm.SetOrderEndBlockers(
+	)...)
+	mm.SetOrderEndBlockers(opts.withOptionalModules(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -636,8 +686,8 @@ func NewGravityApp(
 		crisistypes.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
-	)
-	mm.SetOrderInitGenesis(
+	)...)
+	mm.SetOrderInitGenesis(opts.withOptionalModules(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -656,7 +706,7 @@ func NewGravityApp(
 		crisistypes.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
-	)
+	)...)
 
 	mm.RegisterInvariants(&crisisKeeper)
 	mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
@@ -686,20 +736,35 @@ func NewGravityApp(
 
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	options := ante.HandlerOptions{
+	anteOptions := ante.HandlerOptions{
 		AccountKeeper:   accountKeeper,
 		BankKeeper:      bankKeeper,
 		FeegrantKeeper:  nil,
 		SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 	}
-	ah, err := ante.NewAnteHandler(options)
+	if app.feegrantKeeper != nil {
+		anteOptions.FeegrantKeeper = app.feegrantKeeper
+	}
+	ah, err := ante.NewAnteHandler(anteOptions)
 	if err != nil {
 		panic("invalid antehandler created")
 	}
-	app.SetAnteHandler(ah)
+	// validators jailed for missing bridge signatures must unjail through the gravity module
+	unjailDecorator := gravity.NewUnjailDecorator(*app.gravityKeeper)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return unjailDecorator.AnteHandle(ctx, tx, simulate, ah)
+	})
 	app.SetEndBlocker(app.EndBlocker)
 
+	// the webhooks only read the events of the blocks, the node posts them without affecting consensus
+	webhookSink := newWebhookSink(ReadGravityWebhookConfig(appOpts), func(ctx sdk.Context) bool {
+		return app.gravityKeeper.IsBridgeActive(ctx)
+	}, logger)
+	if webhookSink != nil {
+		app.SetStreamingService(webhookSink)
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -740,6 +805,13 @@ func (app *Gravity) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	// a node whose chain config file is for another deployment must not start from the genesis
+	if c := chain.Current(); c.Name != "" {
+		if err := c.ValidateGenesis(app.appCodec, genesisState); err != nil {
+			panic(err)
+		}
+	}
+	app.upgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -751,7 +823,7 @@ func (app *Gravity) LoadHeight(height int64) error {
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *Gravity) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
-	for acc := range maccPerms {
+	for acc := range app.maccPerms {
 		modAccAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
 
@@ -762,7 +834,7 @@ func (app *Gravity) ModuleAccountAddrs() map[string]bool {
 // allowed to receive external tokens.
 func (app *Gravity) BlockedAddrs() map[string]bool {
 	blockedAddrs := make(map[string]bool)
-	for acc := range maccPerms {
+	for acc := range app.maccPerms {
 		blockedAddrs[authtypes.NewModuleAddress(acc).String()] = !allowedReceivingModAcc[acc]
 	}
 
@@ -826,11 +898,20 @@ func (app *Gravity) SimulationManager() *module.SimulationManager {
 // API server.
 func (app *Gravity) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	clientCtx := apiSvr.ClientCtx
+	// the middleware also covers the gRPC gateway, which the API server mounts on the router when it starts
+	apiSvr.Router.Use(app.apiConfig.Middleware())
 	rpc.RegisterRoutes(clientCtx, apiSvr.Router)
 	authrest.RegisterTxRoutes(clientCtx, apiSvr.Router)
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// the optional modules registered by a build tag are in the ModuleBasics already
+	for _, optional := range app.optionalModules {
+		if _, ok := ModuleBasics[optional.Name]; !ok {
+			optional.Basic.RegisterRESTRoutes(clientCtx, apiSvr.Router)
+			optional.Basic.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+		}
+	}
 	// TODO: build the custom gravity swagger files and add here?
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
@@ -859,7 +940,8 @@ func (app *Gravity) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
 }
 
-// GetMaccPerms returns a mapping of the application's module account permissions.
+// GetMaccPerms returns a mapping of the application's module account permissions, without those of the optional
+// modules.
 func GetMaccPerms() map[string][]string {
 	modAccPerms := make(map[string][]string)
 	for k, v := range maccPerms {
@@ -0,0 +1,28 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+)
+
+// feegrantModule lets an account pay the fees of the txs of another, the ante handler deducts the fees of a tx with a
+// fee granter from the allowance of the granter
+var feegrantModule = OptionalModule{
+	Name:      feegrant.ModuleName,
+	StoreKeys: []string{feegrant.StoreKey},
+	MaccPerms: nil,
+	Basic:     feegrantmodule.AppModuleBasic{},
+	NewModule: func(app *Gravity, keys map[string]*sdk.KVStoreKey) module.AppModule {
+		feegrantKeeper := feegrantkeeper.NewKeeper(app.appCodec, keys[feegrant.StoreKey], app.accountKeeper)
+		app.feegrantKeeper = &feegrantKeeper
+		return feegrantmodule.NewAppModule(app.appCodec, app.accountKeeper, app.bankKeeper, feegrantKeeper, app.interfaceRegistry)
+	},
+}
+
+// WithFeegrant wires the feegrant module into the app, binaries built with the feegrant tag include it by default
+func WithFeegrant() AppOption {
+	return WithModule(feegrantModule)
+}
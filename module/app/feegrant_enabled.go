@@ -0,0 +1,7 @@
+//go:build feegrant
+
+package app
+
+func init() {
+	RegisterOptionalModule(feegrantModule)
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// OptionalModule is a module not every binary built from this app runs, e.g. a heavy module only some of the target
+// chains of the bridge need. The module is created once the keepers of the app are, so that it can use them
+type OptionalModule struct {
+	// Name is the module name, the module begins and ends blocks and inits genesis right before crisis
+	Name string
+	// StoreKeys are the names of the KV stores mounted for the module
+	StoreKeys []string
+	// MaccPerms are the permissions of the module accounts of the module
+	MaccPerms map[string][]string
+	// Basic registers the interfaces of the module
+	Basic module.AppModuleBasic
+	// NewModule creates the module from the app and its KV store keys
+	NewModule func(app *Gravity, keys map[string]*sdk.KVStoreKey) module.AppModule
+}
+
+// AppOption configures the optional modules NewGravityAppWithOptions wires into the app
+type AppOption func(*appOptions)
+
+// appOptions are the optional modules of an app, in the order of their options
+type appOptions struct {
+	modules []OptionalModule
+}
+
+// WithModule wires m into the app, it replaces a module of an earlier option with the same name. A chain which enables
+// a module after its genesis has to add the stores of the module with the upgrade that enables it
+func WithModule(m OptionalModule) AppOption {
+	return func(opts *appOptions) {
+		WithoutModule(m.Name)(opts)
+		opts.modules = append(opts.modules, m)
+	}
+}
+
+// WithoutModule removes the module with the name from the app, e.g. one of the DefaultAppOptions
+func WithoutModule(name string) AppOption {
+	return func(opts *appOptions) {
+		modules := opts.modules[:0]
+		for _, m := range opts.modules {
+			if m.Name != name {
+				modules = append(modules, m)
+			}
+		}
+		opts.modules = modules
+	}
+}
+
+// defaultAppOptions are the options of the optional modules registered by the build tags of this binary
+var defaultAppOptions []AppOption
+
+// DefaultAppOptions returns the options NewGravityApp wires the app with, one for each optional module registered by
+// the build tags of this binary
+func DefaultAppOptions() []AppOption {
+	return append([]AppOption(nil), defaultAppOptions...)
+}
+
+// RegisterOptionalModule adds m to the ModuleBasics and the DefaultAppOptions, so that the genesis, the commands and
+// the codecs of the binary include it. It is called from the init of the file behind the build tag of the module
+func RegisterOptionalModule(m OptionalModule) {
+	ModuleBasics[m.Name] = m.Basic
+	defaultAppOptions = append(defaultAppOptions, WithModule(m))
+}
+
+// newAppOptions applies the options in order
+func newAppOptions(options []AppOption) appOptions {
+	var opts appOptions
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
+
+// moduleNames returns the names of the optional modules
+func (opts appOptions) moduleNames() []string {
+	names := make([]string, len(opts.modules))
+	for i, m := range opts.modules {
+		names[i] = m.Name
+	}
+	return names
+}
+
+// maccPerms returns the module account permissions of the app, those of the optional modules included
+func (opts appOptions) maccPerms() map[string][]string {
+	perms := GetMaccPerms()
+	for _, m := range opts.modules {
+		for acc, accPerms := range m.MaccPerms {
+			perms[acc] = accPerms
+		}
+	}
+	return perms
+}
+
+// withOptionalModules places the optional modules right before crisis in a module order, crisis asserts the
+// invariants once the genesis of every other module is initialized
+func (opts appOptions) withOptionalModules(order ...string) []string {
+	names := opts.moduleNames()
+	withOptional := make([]string, 0, len(order)+len(names))
+	for _, name := range order {
+		if name == crisistypes.ModuleName {
+			withOptional = append(withOptional, names...)
+		}
+		withOptional = append(withOptional, name)
+	}
+	return withOptional
+}
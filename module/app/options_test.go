@@ -0,0 +1,42 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Tests that an optional module wired with its option gets its store, module and ante handler keeper and runs right
+// before crisis, and that a later WithoutModule removes it again
+func TestAppOptions(t *testing.T) {
+	newApp := func(options ...AppOption) *Gravity {
+		return NewGravityAppWithOptions(
+			log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(),
+			simapp.EmptyAppOptions{}, options,
+		)
+	}
+
+	app := newApp(WithFeegrant())
+	assert.NotNil(t, app.GetKey(feegrant.StoreKey))
+	assert.NotNil(t, app.feegrantKeeper)
+	require.Contains(t, app.mm.Modules, feegrant.ModuleName)
+	for _, order := range [][]string{app.mm.OrderBeginBlockers, app.mm.OrderEndBlockers, app.mm.OrderInitGenesis} {
+		for i, name := range order {
+			if name == crisistypes.ModuleName {
+				assert.Equal(t, feegrant.ModuleName, order[i-1])
+			}
+		}
+	}
+
+	app = newApp(WithFeegrant(), WithoutModule(feegrant.ModuleName))
+	assert.Nil(t, app.GetKey(feegrant.StoreKey))
+	assert.Nil(t, app.feegrantKeeper)
+	assert.NotContains(t, app.mm.Modules, feegrant.ModuleName)
+	assert.Equal(t, GetMaccPerms(), app.maccPerms)
+}
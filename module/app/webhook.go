@@ -0,0 +1,250 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// The types of the events posted to the webhooks
+const (
+	WebhookEventDepositObserved = "deposit_observed"
+	WebhookEventBatchExecuted   = "batch_executed"
+	WebhookEventBridgeHalted    = "bridge_halted"
+)
+
+// webhookQueueSize is the number of events waiting to be posted past which new events are dropped, so that a slow
+// webhook never holds up the blocks
+const webhookQueueSize = 256
+
+// GravityWebhookConfig configures the webhooks the node posts the gravity events to, so that validators can alert on
+// the bridge without running an indexer. The events are posted by the node alone and have no effect on consensus. It
+// is read from the gravity-webhook section of app.toml
+type GravityWebhookConfig struct {
+	// URLs are the webhooks every event is posted to, no URL disables the webhooks
+	URLs []string `mapstructure:"urls"`
+	// Events are the types of the events posted, all of them if empty
+	Events []string `mapstructure:"events"`
+	// Timeout is how long a webhook has to answer a post
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultGravityWebhookConfig posts no events
+func DefaultGravityWebhookConfig() GravityWebhookConfig {
+	return GravityWebhookConfig{
+		URLs:    []string{},
+		Events:  []string{},
+		Timeout: 5 * time.Second,
+	}
+}
+
+// GravityWebhookConfigTemplate is the app.toml section of GravityWebhookConfig, for a config embedding it as
+// GravityWebhook
+const GravityWebhookConfigTemplate = `
+###############################################################################
+###                       Gravity Webhook Configuration                     ###
+###############################################################################
+
+[gravity-webhook]
+
+# URLs the gravity events are posted to as JSON, empty disables the webhooks.
+urls = [{{ range .GravityWebhook.URLs }}{{ printf "%q, " . }}{{end}}]
+
+# Types of the events posted: deposit_observed, batch_executed and bridge_halted. Empty posts all of them.
+events = [{{ range .GravityWebhook.Events }}{{ printf "%q, " . }}{{end}}]
+
+# How long a webhook has to answer a post.
+timeout = "{{ .GravityWebhook.Timeout }}"
+`
+
+// ReadGravityWebhookConfig reads the gravity-webhook section of app.toml
+func ReadGravityWebhookConfig(appOpts servertypes.AppOptions) GravityWebhookConfig {
+	config := DefaultGravityWebhookConfig()
+	config.URLs = cast.ToStringSlice(appOpts.Get("gravity-webhook.urls"))
+	config.Events = cast.ToStringSlice(appOpts.Get("gravity-webhook.events"))
+	if timeout := cast.ToDuration(appOpts.Get("gravity-webhook.timeout")); timeout > 0 {
+		config.Timeout = timeout
+	}
+	return config
+}
+
+// WebhookEvent is the JSON body posted to the webhooks
+type WebhookEvent struct {
+	Type       string            `json:"type"`
+	ChainID    string            `json:"chain_id"`
+	Height     int64             `json:"height"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// webhookSink is a streaming service reading the gravity events off the ABCI responses of the blocks and posting them
+// to the webhooks from a goroutine
+type webhookSink struct {
+	config       GravityWebhookConfig
+	bridgeActive func(ctx sdk.Context) bool
+	logger       log.Logger
+	client       *http.Client
+
+	events    chan WebhookEvent
+	done      chan struct{}
+	closeOnce sync.Once
+	// wasActive is whether the bridge was active at the end of the last block, nil before the first block
+	wasActive *bool
+}
+
+var _ baseapp.StreamingService = &webhookSink{}
+
+// newWebhookSink returns nil if the config has no URL, bridgeActive tells whether the bridge is active at the end of
+// a block
+func newWebhookSink(config GravityWebhookConfig, bridgeActive func(ctx sdk.Context) bool, logger log.Logger) *webhookSink {
+	if len(config.URLs) == 0 {
+		return nil
+	}
+	s := &webhookSink{
+		config:       config,
+		bridgeActive: bridgeActive,
+		logger:       logger.With("module", "gravity-webhook"),
+		client:       &http.Client{Timeout: config.Timeout},
+		events:       make(chan WebhookEvent, webhookQueueSize),
+		done:         make(chan struct{}),
+	}
+	go s.post()
+	return s
+}
+
+// Stream implements baseapp.StreamingService, the events are posted from the goroutine started by newWebhookSink
+func (s *webhookSink) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService, the sink does not listen to the stores
+func (s *webhookSink) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener
+func (s *webhookSink) ListenBeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.enqueueEvents(ctx, res.Events)
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener
+func (s *webhookSink) ListenDeliverTx(ctx sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	s.enqueueEvents(ctx, res.Events)
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener, the bridge halting is read off the state at the end of the block
+// since governance, the param changes and the fork detection can all halt it
+func (s *webhookSink) ListenEndBlock(ctx sdk.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	s.enqueueEvents(ctx, res.Events)
+	active := s.bridgeActive(ctx)
+	if s.wasActive != nil && *s.wasActive && !active {
+		s.enqueue(ctx, WebhookEventBridgeHalted, map[string]string{})
+	}
+	s.wasActive = &active
+	return nil
+}
+
+// Close implements baseapp.StreamingService, the events still queued are dropped
+func (s *webhookSink) Close() error {
+	s.closeOnce.Do(func() { close(s.done) })
+	return nil
+}
+
+// enqueueEvents queues the observations of deposits and batch executions among events
+func (s *webhookSink) enqueueEvents(ctx sdk.Context, events []abci.Event) {
+	for _, event := range events {
+		if event.Type != types.EventTypeObservation {
+			continue
+		}
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		// the attestation id is a binary store key, the nonce identifies the attestation
+		delete(attributes, types.AttributeKeyAttestationID)
+		switch attributes[types.AttributeKeyAttestationType] {
+		case types.CLAIM_TYPE_SEND_TO_COSMOS.String():
+			s.enqueue(ctx, WebhookEventDepositObserved, attributes)
+		case types.CLAIM_TYPE_BATCH_SEND_TO_ETH.String():
+			s.enqueue(ctx, WebhookEventBatchExecuted, attributes)
+		}
+	}
+}
+
+// enqueue queues an event of a configured type, dropping it if the queue is full
+func (s *webhookSink) enqueue(ctx sdk.Context, eventType string, attributes map[string]string) {
+	if !s.posts(eventType) {
+		return
+	}
+	event := WebhookEvent{
+		Type:       eventType,
+		ChainID:    ctx.ChainID(),
+		Height:     ctx.BlockHeight(),
+		Attributes: attributes,
+	}
+	select {
+	case s.events <- event:
+	default:
+		s.logger.Error("webhook queue full, dropping event", "type", eventType, "height", ctx.BlockHeight())
+	}
+}
+
+// posts returns whether events of eventType are posted
+func (s *webhookSink) posts(eventType string) bool {
+	if len(s.config.Events) == 0 {
+		return true
+	}
+	for _, configured := range s.config.Events {
+		if configured == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// post posts the queued events to every webhook in order until the sink is closed
+func (s *webhookSink) post() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case event := <-s.events:
+			body, err := json.Marshal(event)
+			if err != nil {
+				s.logger.Error("unable to encode webhook event", "type", event.Type, "err", err)
+				continue
+			}
+			for _, url := range s.config.URLs {
+				if err := s.postTo(url, body); err != nil {
+					s.logger.Error("webhook post failed", "url", url, "type", event.Type, "height", event.Height, "err", err)
+				}
+			}
+		}
+	}
+}
+
+func (s *webhookSink) postTo(url string, body []byte) error {
+	resp, err := s.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the deposit and batch observations and the halting of the bridge are posted to the webhooks, filtered by
+// the configured types, and that nothing is posted without a URL
+func TestWebhookSink(t *testing.T) {
+	posted := make(chan WebhookEvent, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event WebhookEvent
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		posted <- event
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	require.Nil(t, newWebhookSink(DefaultGravityWebhookConfig(), nil, log.NewNopLogger()))
+
+	active := true
+	sink := newWebhookSink(GravityWebhookConfig{
+		URLs:    []string{server.URL},
+		Events:  []string{WebhookEventDepositObserved, WebhookEventBridgeHalted},
+		Timeout: time.Second,
+	}, func(sdk.Context) bool { return active }, log.NewNopLogger())
+	require.NotNil(t, sink)
+	defer sink.Close()
+
+	observation := func(claimType types.ClaimType, nonce string) abci.Event {
+		return abci.Event(sdk.NewEvent(types.EventTypeObservation,
+			sdk.NewAttribute(types.AttributeKeyAttestationType, claimType.String()),
+			sdk.NewAttribute(types.AttributeKeyAttestationID, "\x00\x01"),
+			sdk.NewAttribute(types.AttributeKeyNonce, nonce),
+		))
+	}
+	ctx := sdk.Context{}.WithChainID("gravity-test").WithBlockHeader(tmproto.Header{Height: 7})
+	require.NoError(t, sink.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{Events: []abci.Event{
+		observation(types.CLAIM_TYPE_BATCH_SEND_TO_ETH, "4"),
+		observation(types.CLAIM_TYPE_SEND_TO_COSMOS, "5"),
+		abci.Event(sdk.NewEvent(types.EventTypeOutgoingBatch)),
+	}}))
+	active = false
+	require.NoError(t, sink.ListenEndBlock(ctx.WithBlockHeight(8), abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	require.NoError(t, sink.ListenEndBlock(ctx.WithBlockHeight(9), abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+
+	receive := func() WebhookEvent {
+		select {
+		case event := <-posted:
+			return event
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no event posted")
+			return WebhookEvent{}
+		}
+	}
+	// the batch execution is filtered out and the halting is only posted once
+	require.Equal(t, WebhookEvent{
+		Type:    WebhookEventDepositObserved,
+		ChainID: "gravity-test",
+		Height:  7,
+		Attributes: map[string]string{
+			types.AttributeKeyAttestationType: types.CLAIM_TYPE_SEND_TO_COSMOS.String(),
+			types.AttributeKeyNonce:           "5",
+		},
+	}, receive())
+	require.Equal(t, WebhookEvent{
+		Type:       WebhookEventBridgeHalted,
+		ChainID:    "gravity-test",
+		Height:     8,
+		Attributes: map[string]string{},
+	}, receive())
+	select {
+	case event := <-posted:
+		require.FailNow(t, "unexpected event posted", event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,131 @@
+// Package chain holds the constants which differ between the ports of the bridge to the EVM chains and between their
+// deployments. The ports run the same app and gravity module, each one configured with its own Chain before anything
+// else reads the constants, and a deployment overrides them with the chain config file of the node
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/viper"
+)
+
+// ConfigFileName is the name of the chain config file in the config directory of the node home
+const ConfigFileName = "chain.toml"
+
+// Chain are the constants of a port of the bridge
+type Chain struct {
+	// Name is the name of the EVM chain the port bridges to
+	Name string `mapstructure:"name"`
+	// Bech32Prefix is the bech32 prefix of the account addresses, those of the public keys, validators and consensus
+	// nodes are derived from it
+	Bech32Prefix string `mapstructure:"bech32-prefix"`
+	// BondDenom is the staking denom of the testnets of the port and of the genesis the node starts from, the sdk
+	// modules keep their own default denom in the default genesis
+	BondDenom string `mapstructure:"bond-denom"`
+	// PowerReductionExponent is the exponent of the base 10 power reduction, the bond denom tokens of one unit of
+	// consensus power
+	PowerReductionExponent uint `mapstructure:"power-reduction-exponent"`
+	// GravityID is the gravity id of the default genesis, it has to differ from the ids of the other ports
+	GravityID string `mapstructure:"gravity-id"`
+}
+
+// ValidateBasic checks that the constants are set and the bond denom is valid
+func (c Chain) ValidateBasic() error {
+	switch {
+	case c.Name == "":
+		return fmt.Errorf("empty chain name")
+	case c.Bech32Prefix == "":
+		return fmt.Errorf("empty bech32 prefix of %s", c.Name)
+	case c.GravityID == "":
+		return fmt.Errorf("empty gravity id of %s", c.Name)
+	}
+	if err := sdk.ValidateDenom(c.BondDenom); err != nil {
+		return fmt.Errorf("bond denom of %s: %w", c.Name, err)
+	}
+	return nil
+}
+
+// PowerReduction returns the bond denom tokens of one unit of consensus power
+func (c Chain) PowerReduction() sdk.Int {
+	return sdk.NewIntWithDecimal(1, int(c.PowerReductionExponent))
+}
+
+// ValidateGenesis checks that the app state of a genesis was built for c, a node configured for another deployment
+// would otherwise only fail on it somewhere in InitChain
+func (c Chain) ValidateGenesis(cdc codec.JSONCodec, genState map[string]json.RawMessage) error {
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, genState)
+	if stakingGenState.Params.BondDenom != c.BondDenom {
+		return fmt.Errorf("genesis bond denom %s is not the bond denom %s of %s", stakingGenState.Params.BondDenom, c.BondDenom, c.Name)
+	}
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, genState)
+	for _, balance := range bankGenState.Balances {
+		prefix, _, err := bech32.DecodeAndConvert(balance.Address)
+		if err != nil {
+			return fmt.Errorf("genesis balance address %s: %w", balance.Address, err)
+		}
+		if prefix != c.Bech32Prefix {
+			return fmt.Errorf("genesis balance address %s does not have the bech32 prefix %s of %s", balance.Address, c.Bech32Prefix, c.Name)
+		}
+	}
+	return nil
+}
+
+// current is the chain the port is configured with
+var current Chain
+
+// Current returns the chain the port is configured with, it is empty before Configure
+func Current() Chain {
+	return current
+}
+
+// Configure sets the bech32 prefixes of the sdk config and the chain the default genesis is built for. It is called
+// from the init of the port and again with the chain of LoadConfigFile, the node then sets the power reduction and
+// seals the sdk config. It panics if c is invalid or the sdk config is sealed
+func Configure(c Chain) {
+	if err := c.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(c.Bech32Prefix, c.Bech32Prefix+sdk.PrefixPublic)
+	config.SetBech32PrefixForValidator(
+		c.Bech32Prefix+sdk.PrefixValidator+sdk.PrefixOperator,
+		c.Bech32Prefix+sdk.PrefixValidator+sdk.PrefixOperator+sdk.PrefixPublic,
+	)
+	config.SetBech32PrefixForConsensusNode(
+		c.Bech32Prefix+sdk.PrefixValidator+sdk.PrefixConsensus,
+		c.Bech32Prefix+sdk.PrefixValidator+sdk.PrefixConsensus+sdk.PrefixPublic,
+	)
+
+	current = c
+}
+
+// LoadConfigFile returns the current chain with the constants set by the chain config file in the config directory
+// of home, or the current chain if home has no chain config file
+func LoadConfigFile(home string) (Chain, error) {
+	c := Current()
+	path := filepath.Join(home, "config", ConfigFileName)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return c, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return Chain{}, fmt.Errorf("failed to read chain config file %s: %w", path, err)
+	}
+	if err := v.Unmarshal(&c); err != nil {
+		return Chain{}, fmt.Errorf("failed to parse chain config file %s: %w", path, err)
+	}
+	if err := c.ValidateBasic(); err != nil {
+		return Chain{}, fmt.Errorf("invalid chain config file %s: %w", path, err)
+	}
+	return c, nil
+}
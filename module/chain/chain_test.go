@@ -0,0 +1,88 @@
+package chain_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/app"
+	"github.com/onomyprotocol/arc/module/eth/chain"
+	"github.com/onomyprotocol/arc/module/eth/config"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the Ethereum port derives the bech32 prefixes it had before they were derived from its chain, and that a
+// chain with a missing constant is rejected
+func TestConfigure(t *testing.T) {
+	sdkConfig := sdk.GetConfig()
+	assert.Equal(t, "onomy", sdkConfig.GetBech32AccountAddrPrefix())
+	assert.Equal(t, "onomypub", sdkConfig.GetBech32AccountPubPrefix())
+	assert.Equal(t, "onomyvaloper", sdkConfig.GetBech32ValidatorAddrPrefix())
+	assert.Equal(t, "onomyvaloperpub", sdkConfig.GetBech32ValidatorPubPrefix())
+	assert.Equal(t, "onomyvalcons", sdkConfig.GetBech32ConsensusAddrPrefix())
+	assert.Equal(t, "onomyvalconspub", sdkConfig.GetBech32ConsensusPubPrefix())
+	assert.Equal(t, config.Ethereum, chain.Current())
+	assert.Equal(t, sdk.NewIntWithDecimal(1, 18), config.Ethereum.PowerReduction())
+	assert.Equal(t, config.Ethereum.GravityID, types.DefaultParams().GravityId)
+
+	assert.NoError(t, config.Ethereum.ValidateBasic())
+	for _, invalid := range []func(c *chain.Chain){
+		func(c *chain.Chain) { c.Name = "" },
+		func(c *chain.Chain) { c.Bech32Prefix = "" },
+		func(c *chain.Chain) { c.BondDenom = "1" },
+		func(c *chain.Chain) { c.GravityID = "" },
+	} {
+		c := config.Ethereum
+		invalid(&c)
+		assert.Error(t, c.ValidateBasic())
+		assert.Panics(t, func() { chain.Configure(c) })
+	}
+}
+
+// Tests that the chain config file overrides the constants it sets and keeps the others, and that a node without one
+// keeps the chain of its port
+func TestLoadConfigFile(t *testing.T) {
+	home := t.TempDir()
+	c, err := chain.LoadConfigFile(home)
+	require.NoError(t, err)
+	assert.Equal(t, config.Ethereum, c)
+
+	require.NoError(t, os.Mkdir(filepath.Join(home, "config"), 0o755))
+	path := filepath.Join(home, "config", chain.ConfigFileName)
+	require.NoError(t, os.WriteFile(path, []byte("bech32-prefix = \"onomytest\"\nbond-denom = \"anom\"\n"), 0o600))
+	c, err = chain.LoadConfigFile(home)
+	require.NoError(t, err)
+	expected := config.Ethereum
+	expected.Bech32Prefix = "onomytest"
+	expected.BondDenom = "anom"
+	assert.Equal(t, expected, c)
+
+	require.NoError(t, os.WriteFile(path, []byte("bond-denom = \"1\"\n"), 0o600))
+	_, err = chain.LoadConfigFile(home)
+	assert.Error(t, err)
+}
+
+// Tests that a genesis with another bond denom or with balances of addresses of another prefix is rejected
+func TestValidateGenesis(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	genState := app.NewDefaultGenesisState()
+	require.NoError(t, config.Ethereum.ValidateGenesis(encCfg.Marshaler, genState))
+
+	other := config.Ethereum
+	other.BondDenom = "anom"
+	assert.Error(t, other.ValidateGenesis(encCfg.Marshaler, genState))
+
+	addr := sdk.AccAddress(make([]byte, 20)).String()
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = []banktypes.Balance{{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}}
+	genState[banktypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(bankGenState)
+	require.NoError(t, config.Ethereum.ValidateGenesis(encCfg.Marshaler, genState))
+	other = config.Ethereum
+	other.Bech32Prefix = "gravity"
+	assert.Error(t, other.ValidateGenesis(encCfg.Marshaler, genState))
+}
@@ -1,22 +1,32 @@
 package cmd
 
 import (
+	"bufio"
 	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/client/ethkeys"
 )
 
-const flagPassphrase = "passphrase"
+const (
+	flagPassphrase       = "passphrase"
+	flagRecover          = "recover"
+	flagImportPrivateKey = "import-private-key"
+)
 
 // Commands registers a sub-tree of commands to interact with
 // local private key storage.
@@ -30,6 +40,9 @@ func Commands(defaultNodeHome string) *cobra.Command {
 The keyring supports the following backends:
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+
+Use "keys add-eth" to store an ethereum delegate key in the cosmos keyring instead, where the os,
+file and kwallet backends protect it and the orchestrator and relayer can load it by name.
 `,
 	}
 
@@ -130,3 +143,122 @@ func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
 
 	return nil
 }
+
+// AddEthKeyCommand defines a keys command storing an ethereum delegate key in the cosmos keyring,
+// it is protected by the keyring backend like the cosmos keys
+func AddEthKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "add-eth [name]",
+		Short: "Add an ethereum delegate key to the keyring",
+		Long: `Derive a new ethereum private key from a generated mnemonic and store it in the keyring.
+
+The key is derived with the ethereum HD path m/44'/60'/0'/0/0, the same mnemonic gives the same
+address in any ethereum wallet. Use --recover to derive the key from an existing mnemonic instead,
+or --import-private-key to store an existing hex encoded private key.
+
+The orchestrator and relayer load the key with --ethereum-key-name instead of a raw hex key.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runAddEthKeyCmd,
+	}
+
+	cmd.Flags().Bool(flagRecover, false, "Provide the mnemonic to derive the key from instead of generating one")
+	cmd.Flags().Bool(flagImportPrivateKey, false, "Provide an existing hex encoded private key to store instead of generating one")
+
+	return cmd
+}
+
+// EthereumKeyringOutput is the output of add-eth, the mnemonic is only set when it was generated
+type EthereumKeyringOutput struct {
+	Name     string `json:"name"`
+	Address  string `json:"address"`
+	Mnemonic string `json:"mnemonic,omitempty"`
+}
+
+func runAddEthKeyCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	kr := clientCtx.Keyring
+	name := args[0]
+	if _, err := kr.Key(name); err == nil {
+		return fmt.Errorf("key %s already exists", name)
+	}
+
+	recoverKey, _ := cmd.Flags().GetBool(flagRecover)
+	importKey, _ := cmd.Flags().GetBool(flagImportPrivateKey)
+	if recoverKey && importKey {
+		return fmt.Errorf("--%s and --%s are exclusive", flagRecover, flagImportPrivateKey)
+	}
+
+	var (
+		info     keyring.Info
+		mnemonic string
+	)
+	buf := bufio.NewReader(cmd.InOrStdin())
+	switch {
+	case recoverKey:
+		mnemonic, err = input.GetString("Enter your bip39 mnemonic", buf)
+		if err != nil {
+			return err
+		}
+		info, err = ethkeys.Add(kr, name, mnemonic, keyring.DefaultBIP39Passphrase)
+		// the mnemonic is already known to the user
+		mnemonic = ""
+
+	case importKey:
+		var hexKey string
+		hexKey, err = input.GetString("Enter the hex encoded ethereum private key", buf)
+		if err != nil {
+			return err
+		}
+		var privateKey *ecdsa.PrivateKey
+		if privateKey, err = crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x")); err != nil {
+			return errors.New("invalid ethereum private key")
+		}
+		info, err = ethkeys.Import(kr, name, privateKey)
+
+	default:
+		info, mnemonic, err = ethkeys.New(kr, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	address, err := ethkeys.Address(info)
+	if err != nil {
+		return err
+	}
+	keyOutput := EthereumKeyringOutput{
+		Name:     name,
+		Address:  address.Hex(),
+		Mnemonic: mnemonic,
+	}
+
+	output, _ := cmd.Flags().GetString(cli.OutputFlag)
+	switch output {
+	case keys.OutputFormatText:
+		cmd.PrintErrln()
+		cmd.Printf("name: %s\naddress: %s\n", keyOutput.Name, keyOutput.Address)
+		if keyOutput.Mnemonic != "" {
+			cmd.PrintErrln("\n**Important** write this mnemonic phrase in a safe place.")
+			cmd.PrintErrln("It is the only way to recover your ethereum key if you ever forget your keyring password.")
+			cmd.PrintErrln()
+			cmd.PrintErrln(keyOutput.Mnemonic)
+		}
+
+	case keys.OutputFormatJSON:
+		outputBytes, err := json.Marshal(keyOutput)
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/onomyprotocol/arc/module/eth/cmd/gravity/cmd"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/client/ethkeys"
+)
+
+//nolint: exhaustivestruct
+func TestAddEthKeyCmd(t *testing.T) {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	address := gethcrypto.PubkeyToAddress(key.PublicKey).Hex()
+
+	tests := []struct {
+		name      string
+		flags     []string
+		input     string
+		address   string
+		mnemonic  bool
+		expectErr bool
+	}{
+		{
+			name:     "generated",
+			mnemonic: true,
+		},
+		{
+			name:    "recovered",
+			flags:   []string{"--recover"},
+			input:   "test test test test test test test test test test test junk\n",
+			address: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		},
+		{
+			name:    "imported",
+			flags:   []string{"--import-private-key"},
+			input:   fmt.Sprintf("0x%x\n", gethcrypto.FromECDSA(key)),
+			address: address,
+		},
+		{
+			name:      "invalid private key",
+			flags:     []string{"--import-private-key"},
+			input:     "0x1234\n",
+			expectErr: true,
+		},
+		{
+			name:      "exclusive flags",
+			flags:     []string{"--recover", "--import-private-key"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, dir, nil)
+			require.NoError(t, err)
+			clientCtx := client.Context{}.WithKeyringDir(dir).WithKeyring(kr)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			keysCmd := keys.Commands(dir)
+			keysCmd.AddCommand(cmd.AddEthKeyCommand())
+			out := &bytes.Buffer{}
+			keysCmd.SetOut(out)
+			keysCmd.SetErr(&bytes.Buffer{})
+			keysCmd.SetIn(strings.NewReader(tc.input))
+			keysCmd.SetArgs(append([]string{
+				"add-eth", "orchestrator",
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, dir),
+				fmt.Sprintf("--%s=json", cli.OutputFlag),
+			}, tc.flags...))
+
+			if tc.expectErr {
+				require.Error(t, keysCmd.ExecuteContext(ctx))
+				return
+			}
+			require.NoError(t, keysCmd.ExecuteContext(ctx))
+
+			var output cmd.EthereumKeyringOutput
+			require.NoError(t, json.Unmarshal(out.Bytes(), &output))
+			require.Equal(t, "orchestrator", output.Name)
+			require.Equal(t, tc.mnemonic, output.Mnemonic != "")
+			if tc.address != "" {
+				require.Equal(t, tc.address, output.Address)
+			}
+
+			stored, err := ethkeys.PrivateKey(kr, "orchestrator")
+			require.NoError(t, err)
+			require.Equal(t, output.Address, gethcrypto.PubkeyToAddress(stored.PublicKey).Hex())
+
+			// keys are never overwritten
+			keysCmd.SetArgs([]string{"add-eth", "orchestrator", fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)})
+			require.Error(t, keysCmd.ExecuteContext(ctx))
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/onomyprotocol/arc/module/eth/app"
 	"github.com/onomyprotocol/arc/module/eth/app/params"
+	"github.com/onomyprotocol/arc/module/eth/chain"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -61,7 +62,11 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 
 			gravityAppTemplate, gravityAppConfig := initAppConfig()
 
-			return server.InterceptConfigsPreRunHandler(cmd, gravityAppTemplate, gravityAppConfig)
+			if err := server.InterceptConfigsPreRunHandler(cmd, gravityAppTemplate, gravityAppConfig); err != nil {
+				return err
+			}
+
+			return configureChain(client.GetClientContextFromCmd(cmd).HomeDir)
 		},
 	}
 
@@ -70,11 +75,27 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 	return rootCmd, encodingConfig
 }
 
+// configureChain configures the chain of the chain config file in home, if there is one, with its power reduction and
+// seals the sdk config
+func configureChain(home string) error {
+	c, err := chain.LoadConfigFile(home)
+	if err != nil {
+		return err
+	}
+	chain.Configure(c)
+	sdk.DefaultPowerReduction = c.PowerReduction()
+	sdk.GetConfig().Seal()
+	return nil
+}
+
 // initAppConfig defines the default configuration for a gravity instance. These defaults can be overridden via an
 // app.toml file or with flags provided on the command line
 func initAppConfig() (string, interface{}) {
 	type GravityAppConfig struct {
 		serverconfig.Config
+
+		GravityAPI     app.GravityAPIConfig     `mapstructure:"gravity-api"`
+		GravityWebhook app.GravityWebhookConfig `mapstructure:"gravity-webhook"`
 	}
 
 	// DEFAULT SERVER CONFIGURATIONS
@@ -83,12 +104,14 @@ func initAppConfig() (string, interface{}) {
 	// CUSTOM APP CONFIG - add members to this struct to add gravity-specific configuration options
 	// NOTE: Make sure config options are explained with their default values in gravityAppTemplate
 	gravityAppConfig := GravityAppConfig{
-		Config: *srvConfig,
+		Config:         *srvConfig,
+		GravityAPI:     app.DefaultGravityAPIConfig(),
+		GravityWebhook: app.DefaultGravityWebhookConfig(),
 	}
 
 	// CUSTOM CONFIG TEMPLATE - add to this string when adding gravity-specific configurations have been added to
 	// GravityAppConfig above, an example can be seen at https://github.com/cosmos/cosmos-sdk/blob/master/simapp/simd/cmd/root.go
-	gravityAppTemplate := serverconfig.DefaultConfigTemplate
+	gravityAppTemplate := serverconfig.DefaultConfigTemplate + app.GravityAPIConfigTemplate + app.GravityWebhookConfigTemplate
 
 	return gravityAppTemplate, gravityAppConfig
 }
@@ -120,7 +143,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.MigrateGenesisCmd(),
 		GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
-		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
+		ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
@@ -130,12 +153,23 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createSimappAndExport, addModuleInitFlags)
 
+	// the version command of the sdk is replaced by one which also prints the bridge the binary was built for
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == "version" {
+			rootCmd.RemoveCommand(c)
+		}
+	}
+	rootCmd.AddCommand(VersionCmd())
+
+	keysCmd := keys.Commands(app.DefaultNodeHome)
+	keysCmd.AddCommand(AddEthKeyCommand())
+
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
-		keys.Commands(app.DefaultNodeHome),
+		keysCmd,
 		Commands(app.DefaultNodeHome),
 	)
 }
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/onomyprotocol/arc/module/eth/chain"
+	gravitytypes "github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+const flagDelegateKeysOptOut = "delegate-keys-opt-out"
+
+// ValidateGenesisCmd takes a genesis file, and makes sure that it is valid. On top of the
+// module level validation it makes sure every genesis validator has registered its gravity
+// delegate keys, otherwise the bridge would halt on the first valset.
+func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "validates the genesis file at the default location or at the location passed as an arg",
+		Long: `validates the genesis file at the default location or at the location passed as an arg.
+Every validator created by a gentx or present in the staking genesis must have set its delegate keys,
+either through a MsgSetOrchestratorAddress inside its gentx or in the gravity genesis delegate_keys.
+Validators which intentionally start without delegate keys must be listed with --delegate-keys-opt-out.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			cdc := clientCtx.Codec
+
+			// Load default if passed no args, otherwise load passed file
+			var genesis string
+			if len(args) == 0 {
+				genesis = serverCtx.Config.GenesisFile()
+			} else {
+				genesis = args[0]
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(genesis)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read genesis doc file %s", genesis)
+			}
+
+			var genState map[string]json.RawMessage
+			if err = json.Unmarshal(genDoc.AppState, &genState); err != nil {
+				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
+			}
+
+			if err = mbm.ValidateGenesis(cdc, clientCtx.TxConfig, genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
+			if err = chain.Current().ValidateGenesis(cdc, genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
+			optOut, err := cmd.Flags().GetStringSlice(flagDelegateKeysOptOut)
+			if err != nil {
+				return err
+			}
+
+			if err = ValidateGenesisDelegateKeys(cdc, clientCtx.TxConfig.TxJSONDecoder(), genState, optOut); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
+			fmt.Printf("File at %s is a valid genesis file\n", genesis)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagDelegateKeysOptOut, []string{}, "Comma separated validator operator addresses allowed to start without gravity delegate keys")
+
+	return cmd
+}
+
+// ValidateGenesisDelegateKeys checks that every validator declared in the genesis, either by a gentx
+// MsgCreateValidator or in the staking genesis, has registered its gravity delegate keys through a gentx
+// MsgSetOrchestratorAddress or the gravity genesis delegate_keys. Validators listed in optOut are skipped.
+func ValidateGenesisDelegateKeys(
+	cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, genState map[string]json.RawMessage, optOut []string,
+) error {
+	skipped := make(map[string]bool)
+	for _, addr := range optOut {
+		val, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid opt out validator address %s", addr)
+		}
+		skipped[val.String()] = true
+	}
+
+	registered := make(map[string]bool)
+	validators := make(map[string]bool)
+
+	var gravityGenState gravitytypes.GenesisState
+	if genState[gravitytypes.ModuleName] != nil {
+		if err := cdc.UnmarshalJSON(genState[gravitytypes.ModuleName], &gravityGenState); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal %s genesis state", gravitytypes.ModuleName)
+		}
+	}
+	for _, keys := range gravityGenState.DelegateKeys {
+		registered[keys.Validator] = true
+	}
+
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, genState)
+	for _, val := range stakingGenState.Validators {
+		validators[val.OperatorAddress] = true
+	}
+
+	genutilGenState := genutiltypes.GetGenesisStateFromAppState(cdc, genState)
+	for i, jsonTx := range genutilGenState.GenTxs {
+		genTx, err := txJSONDecoder(jsonTx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode gentx %d", i)
+		}
+		for _, msg := range genTx.GetMsgs() {
+			switch msg := msg.(type) {
+			case *stakingtypes.MsgCreateValidator:
+				validators[msg.ValidatorAddress] = true
+			case *gravitytypes.MsgSetOrchestratorAddress:
+				registered[msg.Validator] = true
+			}
+		}
+	}
+
+	var missing []string
+	for val := range validators {
+		if !registered[val] && !skipped[val] {
+			missing = append(missing, val)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf(
+			"genesis validators without gravity delegate keys: %s, register them with a MsgSetOrchestratorAddress or pass --%s",
+			strings.Join(missing, ", "), flagDelegateKeysOptOut,
+		)
+	}
+
+	return nil
+}
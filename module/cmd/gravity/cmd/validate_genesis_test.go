@@ -0,0 +1,95 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/onomyprotocol/arc/module/eth/app"
+	"github.com/onomyprotocol/arc/module/eth/cmd/gravity/cmd"
+	gravitytypes "github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestValidateGenesisDelegateKeys(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	cdc := encCfg.Marshaler
+
+	gentxVal := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	stakingVal := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	orchestrator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	createValidator, err := stakingtypes.NewMsgCreateValidator(
+		gentxVal,
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		stakingtypes.Description{Moniker: "gentx"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	ethAddress, err := gravitytypes.NewEthAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	require.NoError(t, err)
+	setOrchestrator := gravitytypes.NewMsgSetOrchestratorAddress(gentxVal, orchestrator, *ethAddress)
+
+	genTx := func(msgs ...sdk.Msg) json.RawMessage {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		bz, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	genState := func(delegateKeys []gravitytypes.MsgSetOrchestratorAddress, genTxs ...json.RawMessage) map[string]json.RawMessage {
+		state := app.NewDefaultGenesisState()
+
+		gravityGenState := gravitytypes.DefaultGenesisState()
+		gravityGenState.DelegateKeys = delegateKeys
+		state[gravitytypes.ModuleName] = cdc.MustMarshalJSON(gravityGenState)
+
+		stakingGenState := stakingtypes.DefaultGenesisState()
+		stakingGenState.Validators = []stakingtypes.Validator{{OperatorAddress: stakingVal.String()}}
+		state[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenState)
+
+		state[genutiltypes.ModuleName] = cdc.MustMarshalJSON(genutiltypes.NewGenesisState(genTxs))
+		return state
+	}
+
+	stakingValKeys := []gravitytypes.MsgSetOrchestratorAddress{{
+		Validator:    stakingVal.String(),
+		Orchestrator: orchestrator.String(),
+		EthAddress:   ethAddress.GetAddress(),
+	}}
+
+	decoder := encCfg.TxConfig.TxJSONDecoder()
+
+	// all validators registered, through the gentx and the gravity genesis
+	err = cmd.ValidateGenesisDelegateKeys(cdc, decoder, genState(stakingValKeys, genTx(createValidator, setOrchestrator)), nil)
+	require.NoError(t, err)
+
+	// the gentx validator has no delegate keys
+	err = cmd.ValidateGenesisDelegateKeys(cdc, decoder, genState(stakingValKeys, genTx(createValidator)), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), gentxVal.String())
+
+	// the staking genesis validator has no delegate keys
+	err = cmd.ValidateGenesisDelegateKeys(cdc, decoder, genState(nil, genTx(createValidator, setOrchestrator)), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), stakingVal.String())
+
+	// explicit opt out
+	err = cmd.ValidateGenesisDelegateKeys(
+		cdc, decoder, genState(nil, genTx(createValidator)), []string{gentxVal.String(), stakingVal.String()},
+	)
+	require.NoError(t, err)
+
+	// invalid opt out address
+	err = cmd.ValidateGenesisDelegateKeys(cdc, decoder, genState(nil), []string{"invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
+)
+
+const flagLong = "long"
+
+// versionInfo is the long version information of the sdk with the bridge the binary was built for
+type versionInfo struct {
+	version.Info `yaml:",inline"`
+
+	BridgeTarget         string `json:"bridge_target" yaml:"bridge_target"`
+	ContractAbiHash      string `json:"contract_abi_hash" yaml:"contract_abi_hash"`
+	ProtoPackage         string `json:"proto_package" yaml:"proto_package"`
+	ClaimEncodingVersion uint64 `json:"claim_encoding_version" yaml:"claim_encoding_version"`
+}
+
+// VersionCmd replaces the version command of the sdk, its long version information also tells which EVM chain,
+// Gravity.sol ABI, gravity protos and claim encoding the binary was built for, which the BuildInfo query returns for
+// a running node
+func VersionCmd() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Print the application binary version information and the bridge it was built for",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			buildInfo := keeper.GetBuildInfo()
+			info := versionInfo{
+				Info:                 version.NewInfo(),
+				BridgeTarget:         buildInfo.BridgeTarget,
+				ContractAbiHash:      buildInfo.ContractAbiHash,
+				ProtoPackage:         buildInfo.ProtoPackage,
+				ClaimEncodingVersion: buildInfo.ClaimEncodingVersion,
+			}
+
+			if long, _ := cmd.Flags().GetBool(flagLong); !long {
+				cmd.Println(info.Version)
+				return nil
+			}
+
+			var (
+				bz  []byte
+				err error
+			)
+			output, _ := cmd.Flags().GetString(cli.OutputFlag)
+			switch strings.ToLower(output) {
+			case "json":
+				bz, err = json.Marshal(info)
+			default:
+				bz, err = yaml.Marshal(&info)
+			}
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagLong, false, "Print long version information")
+	cmd.Flags().StringP(cli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
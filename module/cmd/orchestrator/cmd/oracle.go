@@ -0,0 +1,297 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/contracts"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// blocksToSearch bounds the block range of a single log query, public nodes reject larger ranges
+const blocksToSearch = 5000
+
+// claim is a message attesting to a Gravity.sol event
+type claim interface {
+	sdk.Msg
+	GetEventNonce() uint64
+}
+
+// observeEvents submits claims for the contract events after the last event nonce the validator
+// attested to, scanning the blocks after the last scanned one up to the block delay
+func (o *orchestrator) observeEvents(ctx context.Context) error {
+	res, err := o.gravityQuery.LastEventNonceByAddr(ctx, &types.QueryLastEventNonceByAddrRequest{Address: o.address.String()})
+	if err != nil {
+		return errors.Wrap(err, "failed to query the last event nonce")
+	}
+	lastEventNonce := res.EventNonce
+
+	header, err := o.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to query the latest ethereum block")
+	}
+	if header.Number.Uint64() < o.cfg.blockDelay {
+		return nil
+	}
+	latest := header.Number.Uint64() - o.cfg.blockDelay
+
+	if err := o.checkFork(ctx); err != nil {
+		return err
+	}
+
+	for o.nextBlock <= latest {
+		end := o.nextBlock + blocksToSearch - 1
+		if end > latest {
+			end = latest
+		}
+		// the hash of the last block of the range is read first, a reorg replacing it while the range is
+		// scanned is then detected by the next check
+		endHeader, err := o.eth.HeaderByNumber(ctx, new(big.Int).SetUint64(end))
+		if err != nil {
+			return errors.Wrapf(err, "failed to query ethereum block %d", end)
+		}
+
+		claims, err := o.claims(ctx, o.nextBlock, end)
+		if err != nil {
+			return err
+		}
+		claims = pendingClaims(claims, lastEventNonce)
+		if len(claims) > 0 {
+			msgs := make([]sdk.Msg, len(claims))
+			for i, c := range claims {
+				msgs[i] = c
+			}
+			if err := o.broadcast(ctx, msgs...); err != nil {
+				return errors.Wrapf(err, "failed to submit the claims of blocks %d to %d", o.nextBlock, end)
+			}
+			lastEventNonce = claims[len(claims)-1].GetEventNonce()
+			o.logger.Info("submitted claims", "count", len(claims), "last-event-nonce", lastEventNonce)
+		}
+
+		o.nextBlock = end + 1
+		o.scannedHash = endHeader.Hash()
+	}
+
+	return nil
+}
+
+// checkFork compares the hash of the last scanned block with the one the Ethereum node returns for its height now. A
+// different hash means a reorg deeper than the block delay replaced a block whose events may already be attested to,
+// the fork is reported once and no more events are observed since the scanned ones may no longer exist
+func (o *orchestrator) checkFork(ctx context.Context) error {
+	if o.fork != nil {
+		return fmt.Errorf("ethereum fork detected at block %d, not observing events", o.fork.EthereumHeight)
+	}
+	if o.scannedHash == (common.Hash{}) {
+		return nil
+	}
+	height := o.nextBlock - 1
+	header, err := o.eth.HeaderByNumber(ctx, new(big.Int).SetUint64(height))
+	if err != nil {
+		return errors.Wrapf(err, "failed to query ethereum block %d", height)
+	}
+	if header.Hash() == o.scannedHash {
+		return nil
+	}
+
+	fork := forkDetectedClaim(height, o.scannedHash, header.Hash(), o.address.String())
+	if err := o.broadcast(ctx, fork); err != nil {
+		return errors.Wrapf(err, "failed to report the fork at ethereum block %d", height)
+	}
+	o.fork = fork
+	return fmt.Errorf("ethereum fork detected at block %d: scanned %s, now %s", height, o.scannedHash.Hex(), header.Hash().Hex())
+}
+
+// pendingClaims orders claims by event nonce and drops the ones already attested to, the module
+// only accepts claims in event nonce order
+func pendingClaims(claims []claim, lastEventNonce uint64) []claim {
+	pending := make([]claim, 0, len(claims))
+	for _, c := range claims {
+		if c.GetEventNonce() > lastEventNonce {
+			pending = append(pending, c)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].GetEventNonce() < pending[j].GetEventNonce()
+	})
+	return pending
+}
+
+// claims returns the claims for every contract event emitted between the start and end blocks
+func (o *orchestrator) claims(ctx context.Context, start, end uint64) ([]claim, error) {
+	//nolint: exhaustivestruct
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+	orch := o.address.String()
+	var claims []claim
+
+	deposits, err := o.gravity.FilterSendToCosmosEvent(opts, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query the deposits")
+	}
+	for deposits.Next() {
+		claims = append(claims, sendToCosmosClaim(deposits.Event, orch))
+	}
+	if err := closeIterator(deposits.Error(), deposits.Close()); err != nil {
+		return nil, errors.Wrap(err, "failed to read the deposits")
+	}
+
+	batches, err := o.gravity.FilterTransactionBatchExecutedEvent(opts, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query the executed batches")
+	}
+	for batches.Next() {
+		relayer, err := o.transactionSender(ctx, batches.Event.Raw.TxHash)
+		if err != nil {
+			batches.Close()
+			return nil, err
+		}
+		claims = append(claims, batchSendToEthClaim(batches.Event, relayer, orch))
+	}
+	if err := closeIterator(batches.Error(), batches.Close()); err != nil {
+		return nil, errors.Wrap(err, "failed to read the executed batches")
+	}
+
+	deployments, err := o.gravity.FilterERC20DeployedEvent(opts, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query the erc20 deployments")
+	}
+	for deployments.Next() {
+		claims = append(claims, erc20DeployedClaim(deployments.Event, orch))
+	}
+	if err := closeIterator(deployments.Error(), deployments.Close()); err != nil {
+		return nil, errors.Wrap(err, "failed to read the erc20 deployments")
+	}
+
+	logicCalls, err := o.gravity.FilterLogicCallEvent(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query the logic calls")
+	}
+	for logicCalls.Next() {
+		claims = append(claims, logicCallExecutedClaim(logicCalls.Event, orch))
+	}
+	if err := closeIterator(logicCalls.Error(), logicCalls.Close()); err != nil {
+		return nil, errors.Wrap(err, "failed to read the logic calls")
+	}
+
+	valsets, err := o.gravity.FilterValsetUpdatedEvent(opts, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query the valset updates")
+	}
+	for valsets.Next() {
+		claims = append(claims, valsetUpdatedClaim(valsets.Event, orch))
+	}
+	if err := closeIterator(valsets.Error(), valsets.Close()); err != nil {
+		return nil, errors.Wrap(err, "failed to read the valset updates")
+	}
+
+	return claims, nil
+}
+
+// transactionSender returns the address that sent the transaction with the given hash, it is the
+// relayer of the batch executed by the transaction
+func (o *orchestrator) transactionSender(ctx context.Context, hash common.Hash) (common.Address, error) {
+	tx, _, err := o.eth.TransactionByHash(ctx, hash)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "failed to query transaction %s", hash.Hex())
+	}
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(o.ethChainID), tx)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "failed to recover the sender of transaction %s", hash.Hex())
+	}
+	return sender, nil
+}
+
+func closeIterator(iterErr, closeErr error) error {
+	if iterErr != nil {
+		return iterErr
+	}
+	return closeErr
+}
+
+func sendToCosmosClaim(e *contracts.GravitySendToCosmosEvent, orch string) *types.MsgSendToCosmosClaim {
+	return &types.MsgSendToCosmosClaim{
+		EventNonce:     e.EventNonce.Uint64(),
+		BlockHeight:    e.Raw.BlockNumber,
+		BlockHash:      e.Raw.BlockHash.Hex(),
+		TokenContract:  e.TokenContract.Hex(),
+		Amount:         sdk.NewIntFromBigInt(e.Amount),
+		EthereumSender: e.Sender.Hex(),
+		// invalid destinations are attested to as they are, the module handles them by its invalid receiver policy
+		CosmosReceiver: e.Destination,
+		Orchestrator:   orch,
+	}
+}
+
+func batchSendToEthClaim(e *contracts.GravityTransactionBatchExecutedEvent, relayer common.Address, orch string) *types.MsgBatchSendToEthClaim {
+	return &types.MsgBatchSendToEthClaim{
+		EventNonce:    e.EventNonce.Uint64(),
+		BlockHeight:   e.Raw.BlockNumber,
+		BlockHash:     e.Raw.BlockHash.Hex(),
+		BatchNonce:    e.BatchNonce.Uint64(),
+		TokenContract: e.Token.Hex(),
+		Orchestrator:  orch,
+		Relayer:       relayer.Hex(),
+	}
+}
+
+func erc20DeployedClaim(e *contracts.GravityERC20DeployedEvent, orch string) *types.MsgERC20DeployedClaim {
+	return &types.MsgERC20DeployedClaim{
+		EventNonce:    e.EventNonce.Uint64(),
+		BlockHeight:   e.Raw.BlockNumber,
+		BlockHash:     e.Raw.BlockHash.Hex(),
+		CosmosDenom:   e.CosmosDenom,
+		TokenContract: e.TokenContract.Hex(),
+		Name:          e.Name,
+		Symbol:        e.Symbol,
+		Decimals:      uint64(e.Decimals),
+		Orchestrator:  orch,
+	}
+}
+
+func logicCallExecutedClaim(e *contracts.GravityLogicCallEvent, orch string) *types.MsgLogicCallExecutedClaim {
+	return &types.MsgLogicCallExecutedClaim{
+		EventNonce:        e.EventNonce.Uint64(),
+		BlockHeight:       e.Raw.BlockNumber,
+		BlockHash:         e.Raw.BlockHash.Hex(),
+		InvalidationId:    e.InvalidationId[:],
+		InvalidationNonce: e.InvalidationNonce.Uint64(),
+		Orchestrator:      orch,
+	}
+}
+
+func valsetUpdatedClaim(e *contracts.GravityValsetUpdatedEvent, orch string) *types.MsgValsetUpdatedClaim {
+	members := make([]types.BridgeValidator, len(e.Validators))
+	for i, validator := range e.Validators {
+		members[i] = types.BridgeValidator{
+			Power:           e.Powers[i].Uint64(),
+			EthereumAddress: validator.Hex(),
+		}
+	}
+	return &types.MsgValsetUpdatedClaim{
+		EventNonce:   e.EventNonce.Uint64(),
+		ValsetNonce:  e.NewValsetNonce.Uint64(),
+		BlockHeight:  e.Raw.BlockNumber,
+		BlockHash:    e.Raw.BlockHash.Hex(),
+		Members:      members,
+		RewardAmount: sdk.NewIntFromBigInt(e.RewardAmount),
+		RewardToken:  e.RewardToken.Hex(),
+		Orchestrator: orch,
+	}
+}
+
+func forkDetectedClaim(height uint64, observed common.Hash, conflicting common.Hash, orch string) *types.MsgForkDetectedClaim {
+	return &types.MsgForkDetectedClaim{
+		EthereumHeight:       height,
+		ObservedBlockHash:    observed.Hex(),
+		ConflictingBlockHash: conflicting.Hex(),
+		Orchestrator:         orch,
+	}
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+
+	"github.com/onomyprotocol/arc/module/eth/app"
+	"github.com/onomyprotocol/arc/module/eth/app/params"
+	"github.com/onomyprotocol/arc/module/eth/contracts"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// orchestrator attests to the Gravity.sol events and signs the confirms of a validator
+type orchestrator struct {
+	cfg          config
+	logger       log.Logger
+	encCfg       params.EncodingConfig
+	conn         *grpc.ClientConn
+	gravityQuery types.QueryClient
+	authQuery    authtypes.QueryClient
+	txService    txtypes.ServiceClient
+	keyring      keyring.Keyring
+	keyName      string
+	address      sdk.AccAddress
+	chainID      string
+	gravityID    string
+	eth          *ethclient.Client
+	ethChainID   *big.Int
+	gravity      *contracts.Gravity
+	ethKey       *ecdsa.PrivateKey
+	// nextBlock is the first Ethereum block not scanned for events yet
+	nextBlock uint64
+	// scannedHash is the hash of the last scanned Ethereum block, when it was scanned
+	scannedHash common.Hash
+	// fork is the reorg reported by the orchestrator, once set no more events are observed
+	fork *types.MsgForkDetectedClaim
+}
+
+// newOrchestrator connects to the gravity chain and to the Ethereum node and checks the keys
+// are the delegate keys of a validator
+func newOrchestrator(ctx context.Context, cfg config, logger log.Logger) (*orchestrator, error) {
+	sdkConfig := sdk.GetConfig()
+	sdkConfig.SetBech32PrefixForAccount(cfg.addressPrefix, cfg.addressPrefix+sdk.PrefixPublic)
+
+	info, err := cfg.keyring.Key(cfg.keyName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the cosmos key %s", cfg.keyName)
+	}
+
+	conn, err := grpc.DialContext(ctx, cfg.cosmosGRPC, grpc.WithInsecure())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to %s", cfg.cosmosGRPC)
+	}
+	o := &orchestrator{
+		cfg:          cfg,
+		logger:       logger,
+		encCfg:       app.MakeEncodingConfig(),
+		conn:         conn,
+		gravityQuery: types.NewQueryClient(conn),
+		authQuery:    authtypes.NewQueryClient(conn),
+		txService:    txtypes.NewServiceClient(conn),
+		keyring:      cfg.keyring,
+		keyName:      cfg.keyName,
+		address:      info.GetAddress(),
+		ethKey:       cfg.ethereumKey,
+		nextBlock:    cfg.startBlock,
+	}
+
+	if err := o.connect(ctx); err != nil {
+		o.close()
+		return nil, err
+	}
+
+	logger.Info("orchestrator started",
+		"orchestrator", o.address.String(),
+		"ethereum-address", gethcrypto.PubkeyToAddress(cfg.ethereumKey.PublicKey).Hex(),
+		"chain-id", o.chainID,
+		"contract", cfg.gravityContract.Hex(),
+		"gravity-id", o.gravityID,
+	)
+	return o, nil
+}
+
+// connect reads the chain settings and connects to the Ethereum node
+func (o *orchestrator) connect(ctx context.Context) error {
+	nodeInfo, err := tmservice.NewServiceClient(o.conn).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		return errors.Wrap(err, "failed to query the node info")
+	}
+	o.chainID = nodeInfo.DefaultNodeInfo.Network
+
+	params, err := o.gravityQuery.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return errors.Wrap(err, "failed to query the gravity params")
+	}
+	o.gravityID = params.Params.GravityId
+
+	delegates, err := o.gravityQuery.GetDelegateKeyByOrchestrator(ctx, &types.QueryDelegateKeysByOrchestratorAddress{OrchestratorAddress: o.address.String()})
+	if err != nil {
+		return errors.Wrapf(err, "%s is not the orchestrator of a validator", o.address)
+	}
+	ethAddress := gethcrypto.PubkeyToAddress(o.ethKey.PublicKey).Hex()
+	if !strings.EqualFold(delegates.EthAddress, ethAddress) {
+		return fmt.Errorf("the validator registered the ethereum address %s, not %s", delegates.EthAddress, ethAddress)
+	}
+
+	if o.eth, err = ethclient.DialContext(ctx, o.cfg.ethereumRPC); err != nil {
+		return errors.Wrapf(err, "failed to connect to %s", o.cfg.ethereumRPC)
+	}
+	if o.ethChainID, err = o.eth.ChainID(ctx); err != nil {
+		return errors.Wrap(err, "failed to query the ethereum chain id")
+	}
+	o.gravity, err = contracts.NewGravity(o.cfg.gravityContract, o.eth)
+	return err
+}
+
+func (o *orchestrator) close() {
+	o.conn.Close()
+	if o.eth != nil {
+		o.eth.Close()
+	}
+}
+
+// run attests and signs every loop delay until ctx is done, a failed loop is logged and retried
+// on the next one
+func (o *orchestrator) run(ctx context.Context) error {
+	for {
+		if err := o.observeEvents(ctx); err != nil {
+			o.logger.Error("observing the ethereum events failed", "err", err)
+		}
+		if err := o.signPending(ctx); err != nil {
+			o.logger.Error("signing failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(o.cfg.loopDelay):
+		}
+	}
+}
+
+// broadcast signs msgs with the orchestrator key and broadcasts them in block mode
+func (o *orchestrator) broadcast(ctx context.Context, msgs ...sdk.Msg) error {
+	res, err := o.authQuery.Account(ctx, &authtypes.QueryAccountRequest{Address: o.address.String()})
+	if err != nil {
+		return errors.Wrap(err, "failed to query the orchestrator account")
+	}
+	var account authtypes.AccountI
+	if err := o.encCfg.InterfaceRegistry.UnpackAny(res.Account, &account); err != nil {
+		return err
+	}
+
+	txf := tx.Factory{}.
+		WithChainID(o.chainID).
+		WithKeybase(o.keyring).
+		WithTxConfig(o.encCfg.TxConfig).
+		WithAccountNumber(account.GetAccountNumber()).
+		WithSequence(account.GetSequence()).
+		WithGas(o.cfg.gas).
+		WithFees(o.cfg.fees.String()).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(txf, o.keyName, txBuilder, true); err != nil {
+		return err
+	}
+	txBytes, err := o.encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	broadcast, err := o.txService.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_BLOCK,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to broadcast")
+	}
+	if broadcast.TxResponse.Code != 0 {
+		return fmt.Errorf("transaction %s failed with code %d: %s",
+			broadcast.TxResponse.TxHash, broadcast.TxResponse.Code, broadcast.TxResponse.RawLog)
+	}
+	return nil
+}
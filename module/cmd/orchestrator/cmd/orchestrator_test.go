@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/contracts"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+var (
+	testToken   = common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	testSender  = common.HexToAddress("0xf7C9b0e4B1B8A85bD1b6E6fE27a1D5f1E8fC7A30")
+	testRelayer = common.HexToAddress("0x5Df2a9c8d7C5E3A0F7b7f30A56D8B6C3bEe0c912")
+	testOrch    = sdk.AccAddress(make([]byte, 20)).String()
+)
+
+func TestPendingClaims(t *testing.T) {
+	claims := []claim{
+		&types.MsgSendToCosmosClaim{EventNonce: 4},
+		&types.MsgBatchSendToEthClaim{EventNonce: 2},
+		&types.MsgValsetUpdatedClaim{EventNonce: 5},
+		&types.MsgERC20DeployedClaim{EventNonce: 3},
+	}
+
+	pending := pendingClaims(claims, 2)
+	require.Len(t, pending, 3)
+	for i, nonce := range []uint64{3, 4, 5} {
+		assert.Equal(t, nonce, pending[i].GetEventNonce())
+	}
+
+	assert.Empty(t, pendingClaims(claims, 5))
+}
+
+func TestClaims(t *testing.T) {
+	raw := ethtypes.Log{
+		BlockNumber: 120,
+		BlockHash:   common.HexToHash("0x2b7d9f1a3c5e7b9d1f3a5c7e9b1d3f5a7c9e1b3d5f7a9c1e3b5d7f9a1c3e5b7d"),
+	}
+
+	deposit := sendToCosmosClaim(&contracts.GravitySendToCosmosEvent{
+		TokenContract: testToken,
+		Sender:        testSender,
+		Destination:   "not a cosmos address",
+		Amount:        big.NewInt(1000),
+		EventNonce:    big.NewInt(7),
+		Raw:           raw,
+	}, testOrch)
+	require.NoError(t, deposit.ValidateBasic())
+	assert.Equal(t, uint64(120), deposit.BlockHeight)
+	assert.Equal(t, raw.BlockHash.Hex(), deposit.BlockHash)
+	assert.Equal(t, sdk.NewInt(1000), deposit.Amount)
+	assert.Equal(t, "not a cosmos address", deposit.CosmosReceiver)
+
+	batch := batchSendToEthClaim(&contracts.GravityTransactionBatchExecutedEvent{
+		BatchNonce: big.NewInt(3),
+		Token:      testToken,
+		EventNonce: big.NewInt(8),
+		Raw:        raw,
+	}, testRelayer, testOrch)
+	require.NoError(t, batch.ValidateBasic())
+	assert.Equal(t, testRelayer.Hex(), batch.Relayer)
+
+	deployed := erc20DeployedClaim(&contracts.GravityERC20DeployedEvent{
+		CosmosDenom:   "stake",
+		TokenContract: testToken,
+		Name:          "Stake",
+		Symbol:        "STK",
+		Decimals:      6,
+		EventNonce:    big.NewInt(9),
+		Raw:           raw,
+	}, testOrch)
+	require.NoError(t, deployed.ValidateBasic())
+	assert.Equal(t, uint64(6), deployed.Decimals)
+
+	logicCall := logicCallExecutedClaim(&contracts.GravityLogicCallEvent{
+		InvalidationId:    [32]byte{1},
+		InvalidationNonce: big.NewInt(2),
+		EventNonce:        big.NewInt(10),
+		Raw:               raw,
+	}, testOrch)
+	require.NoError(t, logicCall.ValidateBasic())
+	assert.Len(t, logicCall.InvalidationId, 32)
+
+	valset := valsetUpdatedClaim(&contracts.GravityValsetUpdatedEvent{
+		NewValsetNonce: big.NewInt(4),
+		EventNonce:     big.NewInt(11),
+		RewardAmount:   big.NewInt(0),
+		RewardToken:    common.Address{},
+		Validators:     []common.Address{testSender, testRelayer},
+		Powers:         []*big.Int{big.NewInt(3000000000), big.NewInt(1294967295)},
+		Raw:            raw,
+	}, testOrch)
+	require.NoError(t, valset.ValidateBasic())
+	require.Len(t, valset.Members, 2)
+	assert.Equal(t, types.BridgeValidator{Power: 3000000000, EthereumAddress: testSender.Hex()}, valset.Members[0])
+}
+
+func TestForkDetectedClaim(t *testing.T) {
+	observed := common.HexToHash("0x1c9e7f0a6b3d4c2e8f5a9b0d7e6c3f2a1b4d8e9f0c7a6b5d3e2f1a0b9c8d7e6f")
+	conflicting := common.HexToHash("0x8a4f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b07d2")
+
+	fork := forkDetectedClaim(120, observed, conflicting, testOrch)
+	require.NoError(t, fork.ValidateBasic())
+	assert.Equal(t, uint64(120), fork.EthereumHeight)
+	assert.Equal(t, observed.Hex(), fork.ObservedBlockHash)
+
+	// a block can not conflict with itself
+	assert.Error(t, forkDetectedClaim(120, observed, observed, testOrch).ValidateBasic())
+}
+
+func TestConfirms(t *testing.T) {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(gethcrypto.PubkeyToAddress(key.PublicKey).Hex())
+	require.NoError(t, err)
+	gravityID := "gravity-test"
+
+	verify := func(hash []byte, signature string) {
+		sig, err := hex.DecodeString(signature)
+		require.NoError(t, err)
+		require.NoError(t, types.ValidateEthereumSignature(hash, sig, *ethAddress))
+	}
+
+	valset := types.Valset{
+		Nonce:        2,
+		Members:      []types.BridgeValidator{{Power: 4294967295, EthereumAddress: ethAddress.GetAddress()}},
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+	}
+	valsetMsg, err := valsetConfirm(valset, gravityID, key, testOrch)
+	require.NoError(t, err)
+	require.NoError(t, valsetMsg.ValidateBasic())
+	verify(valset.GetCheckpoint(gravityID), valsetMsg.Signature)
+
+	batch := types.OutgoingTxBatch{
+		BatchNonce:    3,
+		BatchTimeout:  1000,
+		TokenContract: testToken.Hex(),
+		Transactions: []types.OutgoingTransferTx{{
+			Id:          1,
+			Sender:      testOrch,
+			DestAddress: testSender.Hex(),
+			Erc20Token:  types.ERC20Token{Contract: testToken.Hex(), Amount: sdk.NewInt(100)},
+			Erc20Fee:    types.ERC20Token{Contract: testToken.Hex(), Amount: sdk.NewInt(1)},
+		}},
+	}
+	batchMsg, err := batchConfirm(batch, gravityID, key, testOrch)
+	require.NoError(t, err)
+	require.NoError(t, batchMsg.ValidateBasic())
+	verify(batch.GetCheckpoint(gravityID), batchMsg.Signature)
+
+	call := types.OutgoingLogicCall{
+		LogicContractAddress: testRelayer.Hex(),
+		Payload:              []byte{1, 2},
+		Timeout:              1000,
+		InvalidationId:       make([]byte, 32),
+		InvalidationNonce:    1,
+	}
+	callMsg, err := logicCallConfirm(call, gravityID, key, testOrch)
+	require.NoError(t, err)
+	require.NoError(t, callMsg.ValidateBasic())
+	assert.Equal(t, hex.EncodeToString(call.InvalidationId), callMsg.InvalidationId)
+	verify(call.GetCheckpoint(gravityID), callMsg.Signature)
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/onomyprotocol/arc/module/eth/app"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/client/ethkeys"
+)
+
+const (
+	flagCosmosGRPC      = "cosmos-grpc"
+	flagCosmosMnemonic  = "cosmos-mnemonic"
+	flagCosmosKeyName   = "cosmos-key-name"
+	flagAddressPrefix   = "address-prefix"
+	flagFees            = "fees"
+	flagGas             = "gas"
+	flagEthereumRPC     = "ethereum-rpc"
+	flagEthereumKey     = "ethereum-key"
+	flagEthereumKeyName = "ethereum-key-name"
+	flagGravityContract = "gravity-contract"
+	flagStartBlock      = "start-block"
+	flagBlockDelay      = "block-delay"
+	flagLoopDelay       = "loop-delay"
+
+	// inMemoryKeyName is the name of the orchestrator key derived from the mnemonic flag
+	inMemoryKeyName = "orchestrator"
+
+	// the keys can be set from the environment instead of the flags to keep them out of the process list
+	envCosmosMnemonic = "ORCHESTRATOR_COSMOS_MNEMONIC"
+	envEthereumKey    = "ORCHESTRATOR_ETHEREUM_KEY"
+)
+
+// NewRootCmd creates the orchestrator command, it runs the Ethereum oracle and the confirm signer
+// of a validator
+func NewRootCmd() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "orchestrator",
+		Short: "Attests to Gravity.sol events and signs the valsets and batches of a validator",
+		Long: `Runs the orchestrator duties of a validator with the delegate keys it registered.
+
+Every loop the orchestrator submits a claim for every Gravity.sol event it has not attested to
+yet, once the event is --block-delay blocks deep, and signs every valset, batch and logic call
+still waiting for its confirm. This is a minimal reference implementation of the Rust
+orchestrator for platforms where building it is impractical, it doesn't relay.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := configFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+			o, err := newOrchestrator(cmd.Context(), cfg, logger)
+			if err != nil {
+				return err
+			}
+			defer o.close()
+
+			return o.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().String(flagCosmosGRPC, "localhost:9090", "The gRPC endpoint of a gravity chain node")
+	cmd.Flags().String(flagCosmosMnemonic, "", fmt.Sprintf("The mnemonic of the orchestrator account, may also be set with %s", envCosmosMnemonic))
+	cmd.Flags().String(flagCosmosKeyName, "", "The name of the orchestrator key in the keyring, used instead of --cosmos-mnemonic")
+	cmd.Flags().String(flagAddressPrefix, sdk.Bech32MainPrefix, "The bech32 prefix of the gravity chain accounts")
+	cmd.Flags().String(flagFees, "", "The fees paid for every transaction, e.g. 100stake")
+	cmd.Flags().Uint64(flagGas, 2000000, "The gas limit of every transaction")
+	cmd.Flags().String(flagEthereumRPC, "http://localhost:8545", "The JSON-RPC endpoint of an Ethereum node")
+	cmd.Flags().String(flagEthereumKey, "", fmt.Sprintf("The hex encoded Ethereum private key registered as delegate key, may also be set with %s", envEthereumKey))
+	cmd.Flags().String(flagEthereumKeyName, "", "The name of the Ethereum key in the keyring, used instead of --ethereum-key")
+	cmd.Flags().String(sdkflags.FlagKeyringBackend, sdkflags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(sdkflags.FlagKeyringDir, app.DefaultNodeHome, "The keyring directory")
+	cmd.Flags().String(flagGravityContract, "", "The address of the Gravity.sol contract")
+	cmd.Flags().Uint64(flagStartBlock, 0, "The Ethereum block to search the contract events from, usually the contract deployment block")
+	cmd.Flags().Uint64(flagBlockDelay, 6, "The number of blocks an event must be deep before it is attested to")
+	cmd.Flags().Duration(flagLoopDelay, 10*time.Second, "The delay between two loops")
+
+	return cmd
+}
+
+// config holds the orchestrator settings
+type config struct {
+	cosmosGRPC      string
+	keyring         keyring.Keyring
+	keyName         string
+	addressPrefix   string
+	fees            sdk.Coins
+	gas             uint64
+	ethereumRPC     string
+	ethereumKey     *ecdsa.PrivateKey
+	gravityContract common.Address
+	startBlock      uint64
+	blockDelay      uint64
+	loopDelay       time.Duration
+}
+
+// configFromFlags reads and validates the orchestrator settings
+func configFromFlags(flags *pflag.FlagSet) (cfg config, err error) {
+	if cfg.cosmosGRPC, err = flags.GetString(flagCosmosGRPC); err != nil {
+		return cfg, err
+	}
+
+	if cfg.keyring, cfg.keyName, err = cosmosKeyFromFlags(flags); err != nil {
+		return cfg, err
+	}
+
+	if cfg.addressPrefix, err = flags.GetString(flagAddressPrefix); err != nil {
+		return cfg, err
+	}
+	fees, err := flags.GetString(flagFees)
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.fees, err = sdk.ParseCoinsNormalized(fees); err != nil {
+		return cfg, errors.Wrapf(err, "invalid --%s", flagFees)
+	}
+	if cfg.gas, err = flags.GetUint64(flagGas); err != nil {
+		return cfg, err
+	}
+
+	if cfg.ethereumRPC, err = flags.GetString(flagEthereumRPC); err != nil {
+		return cfg, err
+	}
+	if cfg.ethereumKey, err = ethereumKeyFromFlags(flags); err != nil {
+		return cfg, err
+	}
+
+	contract, err := flags.GetString(flagGravityContract)
+	if err != nil {
+		return cfg, err
+	}
+	if !common.IsHexAddress(contract) {
+		return cfg, fmt.Errorf("invalid --%s %q", flagGravityContract, contract)
+	}
+	cfg.gravityContract = common.HexToAddress(contract)
+
+	if cfg.startBlock, err = flags.GetUint64(flagStartBlock); err != nil {
+		return cfg, err
+	}
+	if cfg.blockDelay, err = flags.GetUint64(flagBlockDelay); err != nil {
+		return cfg, err
+	}
+	if cfg.loopDelay, err = flags.GetDuration(flagLoopDelay); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// cosmosKeyFromFlags returns the keyring holding the orchestrator key and the name of the key, it
+// is the configured keyring when a key name is given, an in-memory one holding the key derived from
+// the mnemonic flag or environment variable otherwise
+func cosmosKeyFromFlags(flags *pflag.FlagSet) (keyring.Keyring, string, error) {
+	name, err := flags.GetString(flagCosmosKeyName)
+	if err != nil {
+		return nil, "", err
+	}
+	if name != "" {
+		kr, err := keyringFromFlags(flags)
+		if err != nil {
+			return nil, "", err
+		}
+		return kr, name, nil
+	}
+
+	mnemonic, err := flags.GetString(flagCosmosMnemonic)
+	if err != nil {
+		return nil, "", err
+	}
+	if mnemonic == "" {
+		mnemonic = os.Getenv(envCosmosMnemonic)
+	}
+	if mnemonic == "" {
+		return nil, "", fmt.Errorf("missing --%s or --%s", flagCosmosKeyName, flagCosmosMnemonic)
+	}
+	kr := keyring.NewInMemory()
+	if _, err := kr.NewAccount(inMemoryKeyName, mnemonic, keyring.DefaultBIP39Passphrase, sdk.GetConfig().GetFullFundraiserPath(), hd.Secp256k1); err != nil {
+		return nil, "", errors.Wrapf(err, "invalid --%s", flagCosmosMnemonic)
+	}
+	return kr, inMemoryKeyName, nil
+}
+
+// ethereumKeyFromFlags loads the Ethereum key from the keyring when a key name is given, from the
+// hex encoded flag or environment variable otherwise
+func ethereumKeyFromFlags(flags *pflag.FlagSet) (*ecdsa.PrivateKey, error) {
+	name, err := flags.GetString(flagEthereumKeyName)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		kr, err := keyringFromFlags(flags)
+		if err != nil {
+			return nil, err
+		}
+		return ethkeys.PrivateKey(kr, name)
+	}
+
+	hexKey, err := flags.GetString(flagEthereumKey)
+	if err != nil {
+		return nil, err
+	}
+	if hexKey == "" {
+		hexKey = os.Getenv(envEthereumKey)
+	}
+	key, err := gethcrypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid --%s", flagEthereumKey)
+	}
+	return key, nil
+}
+
+func keyringFromFlags(flags *pflag.FlagSet) (keyring.Keyring, error) {
+	backend, err := flags.GetString(sdkflags.FlagKeyringBackend)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := flags.GetString(sdkflags.FlagKeyringDir)
+	if err != nil {
+		return nil, err
+	}
+	return keyring.New(sdk.KeyringServiceName(), backend, dir, os.Stdin)
+}
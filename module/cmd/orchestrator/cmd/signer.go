@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// signPending submits a confirm for every valset, batch and logic call the validator has not signed yet
+func (o *orchestrator) signPending(ctx context.Context) error {
+	orch := o.address.String()
+	var msgs []sdk.Msg
+
+	valsets, err := o.gravityQuery.LastPendingValsetRequestByAddr(ctx, &types.QueryLastPendingValsetRequestByAddrRequest{Address: orch})
+	if err != nil {
+		return errors.Wrap(err, "failed to query the pending valsets")
+	}
+	for _, valset := range valsets.Valsets {
+		msg, err := valsetConfirm(valset, o.gravityID, o.ethKey, orch)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	batches, err := o.gravityQuery.LastPendingBatchRequestByAddr(ctx, &types.QueryLastPendingBatchRequestByAddrRequest{Address: orch})
+	if err != nil {
+		return errors.Wrap(err, "failed to query the pending batches")
+	}
+	for _, batch := range batches.Batch {
+		msg, err := batchConfirm(batch, o.gravityID, o.ethKey, orch)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	calls, err := o.gravityQuery.LastPendingLogicCallByAddr(ctx, &types.QueryLastPendingLogicCallByAddrRequest{Address: orch})
+	if err != nil {
+		return errors.Wrap(err, "failed to query the pending logic calls")
+	}
+	for _, call := range calls.Call {
+		msg, err := logicCallConfirm(call, o.gravityID, o.ethKey, orch)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+	if err := o.broadcast(ctx, msgs...); err != nil {
+		return errors.Wrap(err, "failed to submit the confirms")
+	}
+	o.logger.Info("submitted confirms", "valsets", len(valsets.Valsets), "batches", len(batches.Batch), "logic-calls", len(calls.Call))
+	return nil
+}
+
+func valsetConfirm(valset types.Valset, gravityID string, key *ecdsa.PrivateKey, orch string) (*types.MsgValsetConfirm, error) {
+	signature, err := types.NewEthereumSignature(valset.GetCheckpoint(gravityID), key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign valset %d", valset.Nonce)
+	}
+	return &types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: orch,
+		EthAddress:   gethcrypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Signature:    hex.EncodeToString(signature),
+	}, nil
+}
+
+func batchConfirm(batch types.OutgoingTxBatch, gravityID string, key *ecdsa.PrivateKey, orch string) (*types.MsgConfirmBatch, error) {
+	signature, err := types.NewEthereumSignature(batch.GetCheckpoint(gravityID), key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign batch %d of %s", batch.BatchNonce, batch.TokenContract)
+	}
+	return &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: batch.TokenContract,
+		EthSigner:     gethcrypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Orchestrator:  orch,
+		Signature:     hex.EncodeToString(signature),
+	}, nil
+}
+
+func logicCallConfirm(call types.OutgoingLogicCall, gravityID string, key *ecdsa.PrivateKey, orch string) (*types.MsgConfirmLogicCall, error) {
+	signature, err := types.NewEthereumSignature(call.GetCheckpoint(gravityID), key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign logic call %x/%d", call.InvalidationId, call.InvalidationNonce)
+	}
+	return &types.MsgConfirmLogicCall{
+		InvalidationId:    hex.EncodeToString(call.InvalidationId),
+		InvalidationNonce: call.InvalidationNonce,
+		EthSigner:         gethcrypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Orchestrator:      orch,
+		Signature:         hex.EncodeToString(signature),
+	}, nil
+}
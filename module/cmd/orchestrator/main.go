@@ -0,0 +1,13 @@
+package main
+
+import (
+	"os"
+
+	"github.com/onomyprotocol/arc/module/eth/cmd/orchestrator/cmd"
+)
+
+func main() {
+	if err := cmd.NewRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
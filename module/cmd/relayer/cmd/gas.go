@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// gasPrice applies the configured multiplier to the gas price suggested by the Ethereum node, it
+// fails when the result is above the configured maximum so that the relayer waits for cheaper gas
+func gasPrice(suggested *big.Int, multiplier float64, max *big.Int) (*big.Int, error) {
+	price, _ := new(big.Float).Mul(new(big.Float).SetInt(suggested), big.NewFloat(multiplier)).Int(nil)
+	if price.Cmp(max) > 0 {
+		return nil, fmt.Errorf("gas price %s is above the maximum of %s", price, max)
+	}
+	return price, nil
+}
+
+// batchFees returns the total of the fees a relayer is paid for executing batch
+func batchFees(batch types.OutgoingTxBatch) sdk.Int {
+	fees := sdk.ZeroInt()
+	for _, tx := range batch.Transactions {
+		fees = fees.Add(tx.Erc20Fee.Amount)
+	}
+	return fees
+}
+
+// isProfitable reports whether the fees of batch reach the minimum configured for its token,
+// tokens without a configured minimum are always relayed
+func isProfitable(batch types.OutgoingTxBatch, minBatchFees map[common.Address]sdk.Int) bool {
+	min, ok := minBatchFees[common.HexToAddress(batch.TokenContract)]
+	if !ok {
+		return true
+	}
+	return batchFees(batch).GTE(min)
+}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+
+	"github.com/onomyprotocol/arc/module/eth/contracts"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// relayer submits the valsets and batches signed on the gravity chain to the Gravity.sol contract
+type relayer struct {
+	cfg          config
+	logger       log.Logger
+	conn         *grpc.ClientConn
+	gravityQuery types.QueryClient
+	eth          *ethclient.Client
+	gravity      *contracts.Gravity
+	key          *ecdsa.PrivateKey
+	chainID      *big.Int
+	gravityID    string
+}
+
+// newRelayer connects to the gravity chain and to the Ethereum node
+func newRelayer(ctx context.Context, cfg config, logger log.Logger) (*relayer, error) {
+	conn, err := grpc.DialContext(ctx, cfg.cosmosGRPC, grpc.WithInsecure())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to %s", cfg.cosmosGRPC)
+	}
+	gravityQuery := types.NewQueryClient(conn)
+	params, err := gravityQuery.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to query the gravity params")
+	}
+
+	eth, err := ethclient.DialContext(ctx, cfg.ethereumRPC)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "failed to connect to %s", cfg.ethereumRPC)
+	}
+	chainID, err := eth.ChainID(ctx)
+	if err != nil {
+		conn.Close()
+		eth.Close()
+		return nil, errors.Wrap(err, "failed to query the ethereum chain id")
+	}
+	gravity, err := contracts.NewGravity(cfg.gravityContract, eth)
+	if err != nil {
+		conn.Close()
+		eth.Close()
+		return nil, err
+	}
+
+	logger.Info("relayer started",
+		"address", gethcrypto.PubkeyToAddress(cfg.ethereumKey.PublicKey).Hex(),
+		"contract", cfg.gravityContract.Hex(),
+		"chain-id", chainID.String(),
+		"gravity-id", params.Params.GravityId,
+	)
+
+	return &relayer{
+		cfg:          cfg,
+		logger:       logger,
+		conn:         conn,
+		gravityQuery: gravityQuery,
+		eth:          eth,
+		gravity:      gravity,
+		key:          cfg.ethereumKey,
+		chainID:      chainID,
+		gravityID:    params.Params.GravityId,
+	}, nil
+}
+
+func (r *relayer) close() {
+	r.conn.Close()
+	r.eth.Close()
+}
+
+// run relays every loop delay until ctx is done, a failed loop is logged and retried on the next one
+func (r *relayer) run(ctx context.Context) error {
+	for {
+		if err := r.relay(ctx); err != nil {
+			r.logger.Error("relaying failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.cfg.loopDelay):
+		}
+	}
+}
+
+// relay submits the newest signed valset update and then the signed batches, the batches are
+// signed by the valset the contract holds after the valset update
+func (r *relayer) relay(ctx context.Context) error {
+	suggested, err := r.eth.SuggestGasPrice(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to query the gas price")
+	}
+	price, err := gasPrice(suggested, r.cfg.gasPriceMultiplier, r.cfg.maxGasPrice)
+	if err != nil {
+		return err
+	}
+
+	current, err := r.currentValset(ctx)
+	if err != nil {
+		return err
+	}
+
+	if r.cfg.relayValsets {
+		updated, err := r.relayValset(ctx, current, price)
+		if err != nil {
+			return err
+		}
+		if updated != nil {
+			current = *updated
+		}
+	}
+
+	if r.cfg.relayBatches {
+		return r.relayBatches(ctx, current, price)
+	}
+	return nil
+}
+
+// currentValset rebuilds the valset held by the contract from the event of its last update
+func (r *relayer) currentValset(ctx context.Context) (contracts.ValsetArgs, error) {
+	nonce, err := r.gravity.StateLastValsetNonce(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return contracts.ValsetArgs{}, errors.Wrap(err, "failed to query the contract valset nonce")
+	}
+
+	//nolint: exhaustivestruct
+	events, err := r.gravity.FilterValsetUpdatedEvent(&bind.FilterOpts{Start: r.cfg.startBlock, Context: ctx}, []*big.Int{nonce})
+	if err != nil {
+		return contracts.ValsetArgs{}, errors.Wrap(err, "failed to query the contract valset updates")
+	}
+	defer events.Close()
+
+	var last *contracts.GravityValsetUpdatedEvent
+	for events.Next() {
+		last = events.Event
+	}
+	if err := events.Error(); err != nil {
+		return contracts.ValsetArgs{}, errors.Wrap(err, "failed to read the contract valset updates")
+	}
+	if last == nil {
+		return contracts.ValsetArgs{}, fmt.Errorf("update of the contract valset %s not found since block %d", nonce, r.cfg.startBlock)
+	}
+
+	return contracts.ValsetArgs{
+		Validators:   last.Validators,
+		Powers:       last.Powers,
+		ValsetNonce:  last.NewValsetNonce,
+		RewardAmount: last.RewardAmount,
+		RewardToken:  last.RewardToken,
+	}, nil
+}
+
+// relayValset submits the newest valset the current contract valset signed enough to pass the
+// contract power threshold, it returns the new contract valset or nil when nothing was relayed
+func (r *relayer) relayValset(ctx context.Context, current contracts.ValsetArgs, price *big.Int) (*contracts.ValsetArgs, error) {
+	res, err := r.gravityQuery.LastValsetRequests(ctx, &types.QueryLastValsetRequestsRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query the last valsets")
+	}
+
+	// the valsets are returned newest first, older valsets are only relayed when the newer
+	// ones are not signed yet
+	for _, valset := range res.Valsets {
+		if valset.Nonce <= current.ValsetNonce.Uint64() {
+			break
+		}
+
+		confirms, err := r.gravityQuery.ValsetConfirmsByNonce(ctx, &types.QueryValsetConfirmsByNonceRequest{Nonce: valset.Nonce})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to query the confirms of valset %d", valset.Nonce)
+		}
+		sigs, power := valsetSignatures(current, confirms.Confirms, valset.GetCheckpoint(r.gravityID))
+		if power <= types.EthereumSignaturePowerThreshold {
+			r.logger.Debug("valset not signed enough to be relayed", "nonce", valset.Nonce, "power", power)
+			continue
+		}
+
+		args, err := valsetArgs(valset)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := r.gravity.UpdateValset(r.transactOpts(ctx, price), args, current, sigs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to submit valset %d", valset.Nonce)
+		}
+		if err := r.waitMined(ctx, tx); err != nil {
+			return nil, errors.Wrapf(err, "valset %d", valset.Nonce)
+		}
+
+		r.logger.Info("relayed valset", "nonce", valset.Nonce, "tx", tx.Hash().Hex())
+		return &args, nil
+	}
+
+	return nil, nil
+}
+
+// relayBatches submits, for every token, the newest profitable batch the current contract valset
+// signed, executing a batch makes every older batch of the token invalid on the contract
+func (r *relayer) relayBatches(ctx context.Context, current contracts.ValsetArgs, price *big.Int) error {
+	res, err := r.gravityQuery.OutgoingTxBatches(ctx, &types.QueryOutgoingTxBatchesRequest{})
+	if err != nil {
+		return errors.Wrap(err, "failed to query the outgoing batches")
+	}
+	header, err := r.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to query the latest ethereum block")
+	}
+
+	batches := res.Batches
+	sort.Slice(batches, func(i, j int) bool {
+		return batches[i].BatchNonce > batches[j].BatchNonce
+	})
+
+	relayed := make(map[string]bool)
+	for _, batch := range batches {
+		token := common.HexToAddress(batch.TokenContract)
+		if relayed[token.Hex()] {
+			continue
+		}
+		logger := r.logger.With("token", token.Hex(), "nonce", batch.BatchNonce)
+
+		lastNonce, err := r.gravity.LastBatchNonce(&bind.CallOpts{Context: ctx}, token)
+		if err != nil {
+			return errors.Wrapf(err, "failed to query the last batch nonce of %s", token.Hex())
+		}
+		if batch.BatchNonce <= lastNonce.Uint64() {
+			continue
+		}
+		// the batch must be included before the timeout block, the transaction lands in the next block at best
+		if header.Number.Uint64()+1 >= batch.BatchTimeout {
+			logger.Debug("batch timed out")
+			continue
+		}
+		if !isProfitable(batch, r.cfg.minBatchFees) {
+			logger.Debug("batch fees below the minimum", "fees", batchFees(batch).String())
+			continue
+		}
+
+		calldata, err := r.gravityQuery.BatchCalldata(ctx, &types.QueryBatchCalldataRequest{
+			Nonce:           batch.BatchNonce,
+			ContractAddress: batch.TokenContract,
+		})
+		if err != nil {
+			// the batch is not signed enough yet
+			logger.Debug("batch not ready to be relayed", "err", err)
+			continue
+		}
+		if calldata.Calldata.ValsetNonce != current.ValsetNonce.Uint64() {
+			logger.Debug("batch signed by another valset than the contract one", "valset", calldata.Calldata.ValsetNonce)
+			continue
+		}
+
+		if err := r.submitBatch(ctx, current, calldata.Calldata, price); err != nil {
+			return errors.Wrapf(err, "batch %d of %s", batch.BatchNonce, token.Hex())
+		}
+		relayed[token.Hex()] = true
+	}
+
+	return nil
+}
+
+// submitBatch submits calldata signed by the current contract valset
+func (r *relayer) submitBatch(ctx context.Context, current contracts.ValsetArgs, calldata types.SubmitBatchCalldata, price *big.Int) error {
+	sigs, err := batchSignatures(calldata)
+	if err != nil {
+		return err
+	}
+	if len(sigs) != len(current.Validators) {
+		return fmt.Errorf("calldata holds %d signatures for %d validators", len(sigs), len(current.Validators))
+	}
+
+	amounts := make([]*big.Int, len(calldata.Amounts))
+	for i, amount := range calldata.Amounts {
+		amounts[i] = amount.BigInt()
+	}
+	fees := make([]*big.Int, len(calldata.Fees))
+	for i, fee := range calldata.Fees {
+		fees[i] = fee.BigInt()
+	}
+	destinations := make([]common.Address, len(calldata.Destinations))
+	for i, destination := range calldata.Destinations {
+		destinations[i] = common.HexToAddress(destination)
+	}
+
+	tx, err := r.gravity.SubmitBatch(
+		r.transactOpts(ctx, price), current, sigs, amounts, destinations, fees,
+		new(big.Int).SetUint64(calldata.BatchNonce), common.HexToAddress(calldata.TokenContract),
+		new(big.Int).SetUint64(calldata.BatchTimeout),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to submit")
+	}
+	if err := r.waitMined(ctx, tx); err != nil {
+		return err
+	}
+
+	r.logger.Info("relayed batch", "token", calldata.TokenContract, "nonce", calldata.BatchNonce, "tx", tx.Hash().Hex())
+	return nil
+}
+
+// transactOpts signs the transactions with the relayer key at the given gas price, the gas limit
+// is estimated which also rejects calls the contract would revert
+func (r *relayer) transactOpts(ctx context.Context, price *big.Int) *bind.TransactOpts {
+	opts, err := bind.NewKeyedTransactorWithChainID(r.key, r.chainID)
+	if err != nil {
+		// only fails on a nil chain id, which newRelayer rules out
+		panic(err)
+	}
+	opts.Context = ctx
+	opts.GasPrice = price
+	return opts
+}
+
+// waitMined waits for tx to be included and fails if it reverted
+func (r *relayer) waitMined(ctx context.Context, tx *ethtypes.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, r.eth, tx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to wait for transaction %s", tx.Hash().Hex())
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash().Hex())
+	}
+	return nil
+}
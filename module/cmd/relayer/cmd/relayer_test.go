@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/contracts"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+const testToken = "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+
+func TestValsetSignatures(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 3)
+	current := contracts.ValsetArgs{ValsetNonce: big.NewInt(1), RewardAmount: big.NewInt(0)}
+	for i := range keys {
+		var err error
+		keys[i], err = gethcrypto.GenerateKey()
+		require.NoError(t, err)
+		current.Validators = append(current.Validators, gethcrypto.PubkeyToAddress(keys[i].PublicKey))
+		current.Powers = append(current.Powers, big.NewInt(1431655765))
+	}
+
+	hash := gethcrypto.Keccak256([]byte("new valset checkpoint"))
+	confirm := func(key *ecdsa.PrivateKey, signed []byte) types.MsgValsetConfirm {
+		sig, err := types.NewEthereumSignature(signed, key)
+		require.NoError(t, err)
+		return types.MsgValsetConfirm{
+			Nonce:      2,
+			EthAddress: gethcrypto.PubkeyToAddress(key.PublicKey).Hex(),
+			Signature:  hex.EncodeToString(sig),
+		}
+	}
+
+	// confirms come in store order, the second validator signed another checkpoint
+	confirms := []types.MsgValsetConfirm{
+		confirm(keys[2], hash),
+		confirm(keys[1], gethcrypto.Keccak256([]byte("other checkpoint"))),
+		confirm(keys[0], hash),
+	}
+
+	sigs, power := valsetSignatures(current, confirms, hash)
+	require.Len(t, sigs, 3)
+	assert.Equal(t, uint64(2*1431655765), power)
+	assert.Equal(t, contracts.Signature{}, sigs[1])
+	for _, i := range []int{0, 2} {
+		assert.Contains(t, []uint8{27, 28}, sigs[i].V)
+		assert.NotEqual(t, [32]byte{}, sigs[i].R)
+	}
+
+	// two thirds of the power is not enough to pass the contract threshold
+	assert.LessOrEqual(t, power, types.EthereumSignaturePowerThreshold)
+	sigs, power = valsetSignatures(current, append(confirms, confirm(keys[1], hash)), hash)
+	assert.Greater(t, power, types.EthereumSignaturePowerThreshold)
+	assert.NotEqual(t, contracts.Signature{}, sigs[1])
+}
+
+func TestBatchSignatures(t *testing.T) {
+	calldata := types.SubmitBatchCalldata{
+		Validators: []string{"0x3b7F1bd09a2E1d1D1E7fE6d9A0f5E7bD1dB4cE21", "0x5Df2a9c8d7C5E3A0F7b7f30A56D8B6C3bEe0c912"},
+		V:          []uint32{28, 0},
+		R:          [][]byte{common.LeftPadBytes([]byte{1}, 32), make([]byte, 32)},
+		S:          [][]byte{common.LeftPadBytes([]byte{2}, 32), make([]byte, 32)},
+	}
+
+	sigs, err := batchSignatures(calldata)
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+	assert.Equal(t, uint8(28), sigs[0].V)
+	assert.Equal(t, byte(1), sigs[0].R[31])
+	assert.Equal(t, byte(2), sigs[0].S[31])
+	assert.Equal(t, contracts.Signature{}, sigs[1])
+
+	calldata.R = calldata.R[:1]
+	_, err = batchSignatures(calldata)
+	assert.Error(t, err)
+}
+
+func TestGasPrice(t *testing.T) {
+	max := big.NewInt(100e9)
+
+	price, err := gasPrice(big.NewInt(40e9), 1.5, max)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(60e9), price)
+
+	price, err = gasPrice(big.NewInt(100e9), 1, max)
+	require.NoError(t, err)
+	assert.Equal(t, max, price)
+
+	_, err = gasPrice(big.NewInt(80e9), 1.3, max)
+	assert.Error(t, err)
+}
+
+func TestIsProfitable(t *testing.T) {
+	batch := types.OutgoingTxBatch{
+		TokenContract: testToken,
+		Transactions: []types.OutgoingTransferTx{
+			{Erc20Fee: types.ERC20Token{Contract: testToken, Amount: sdk.NewInt(30)}},
+			{Erc20Fee: types.ERC20Token{Contract: testToken, Amount: sdk.NewInt(20)}},
+		},
+	}
+	assert.Equal(t, sdk.NewInt(50), batchFees(batch))
+
+	minBatchFees, err := parseMinBatchFees([]string{testToken + ":50"})
+	require.NoError(t, err)
+	assert.True(t, isProfitable(batch, minBatchFees))
+
+	minBatchFees, err = parseMinBatchFees([]string{testToken + ":51"})
+	require.NoError(t, err)
+	assert.False(t, isProfitable(batch, minBatchFees))
+
+	// tokens without a minimum are always relayed
+	assert.True(t, isProfitable(batch, map[common.Address]sdk.Int{}))
+
+	for _, invalid := range []string{testToken, "0x1234:10", testToken + ":-1", testToken + ":abc"} {
+		_, err := parseMinBatchFees([]string{invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestValsetArgs(t *testing.T) {
+	valset := types.Valset{
+		Nonce: 4,
+		Members: []types.BridgeValidator{
+			{Power: 3000000000, EthereumAddress: "0x3b7F1bd09a2E1d1D1E7fE6d9A0f5E7bD1dB4cE21"},
+		},
+		RewardAmount: sdk.NewInt(5),
+		RewardToken:  testToken,
+	}
+
+	args, err := valsetArgs(valset)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(4), args.ValsetNonce)
+	assert.Equal(t, []*big.Int{big.NewInt(3000000000)}, args.Powers)
+	assert.Equal(t, common.HexToAddress(testToken), args.RewardToken)
+	assert.Equal(t, big.NewInt(5), args.RewardAmount)
+
+	valset.RewardAmount = sdk.Int{}
+	valset.RewardToken = ""
+	args, err = valsetArgs(valset)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(0), args.RewardAmount)
+	assert.Equal(t, common.Address{}, args.RewardToken)
+
+	valset.Members[0].EthereumAddress = "invalid"
+	_, err = valsetArgs(valset)
+	assert.Error(t, err)
+}
@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/onomyprotocol/arc/module/eth/app"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/client/ethkeys"
+)
+
+const (
+	flagCosmosGRPC         = "cosmos-grpc"
+	flagEthereumRPC        = "ethereum-rpc"
+	flagEthereumKey        = "ethereum-key"
+	flagEthereumKeyName    = "ethereum-key-name"
+	flagGravityContract    = "gravity-contract"
+	flagStartBlock         = "start-block"
+	flagLoopDelay          = "loop-delay"
+	flagMaxGasPrice        = "max-gas-price"
+	flagGasPriceMultiplier = "gas-price-multiplier"
+	flagMinBatchFees       = "min-batch-fees"
+	flagRelayValsets       = "relay-valsets"
+	flagRelayBatches       = "relay-batches"
+
+	// envEthereumKey can be used instead of the ethereum-key flag to keep the key out of the process list
+	envEthereumKey = "RELAYER_ETHEREUM_KEY"
+)
+
+// NewRootCmd creates the relayer command, it relays the valsets and batches signed on the gravity
+// chain to the Gravity.sol contract
+func NewRootCmd() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "relayer",
+		Short: "Relays the valsets and batches signed on the gravity chain to Ethereum",
+		Long: `Relays the valsets and batches signed on the gravity chain to the Gravity.sol contract.
+
+Every loop the relayer reads the current valset of the contract, submits the newest valset update
+the current validators have signed and then the newest signed batch of every token. Batches are
+only relayed when their fees reach the minimum configured for the token with --min-batch-fees,
+and nothing is relayed while the Ethereum gas price is above --max-gas-price.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := configFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+			r, err := newRelayer(cmd.Context(), cfg, logger)
+			if err != nil {
+				return err
+			}
+			defer r.close()
+
+			return r.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().String(flagCosmosGRPC, "localhost:9090", "The gRPC endpoint of a gravity chain node")
+	cmd.Flags().String(flagEthereumRPC, "http://localhost:8545", "The JSON-RPC endpoint of an Ethereum node")
+	cmd.Flags().String(flagEthereumKey, "", fmt.Sprintf("The hex encoded Ethereum private key paying for the relayed transactions, may also be set with %s", envEthereumKey))
+	cmd.Flags().String(flagEthereumKeyName, "", "The name of the Ethereum key in the keyring, used instead of --ethereum-key")
+	cmd.Flags().String(sdkflags.FlagKeyringBackend, sdkflags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(sdkflags.FlagKeyringDir, app.DefaultNodeHome, "The keyring directory")
+	cmd.Flags().String(flagGravityContract, "", "The address of the Gravity.sol contract")
+	cmd.Flags().Uint64(flagStartBlock, 0, "The Ethereum block to search the contract valset updates from, usually the contract deployment block")
+	cmd.Flags().Duration(flagLoopDelay, 10*time.Second, "The delay between two relaying loops")
+	cmd.Flags().Uint64(flagMaxGasPrice, 300, "The maximum gas price in gwei, nothing is relayed while the network gas price is higher")
+	cmd.Flags().Float64(flagGasPriceMultiplier, 1, "The multiplier applied to the suggested gas price")
+	cmd.Flags().StringSlice(flagMinBatchFees, []string{}, "Comma separated minimum total batch fees per token, in the form <erc20 address>:<amount>")
+	cmd.Flags().Bool(flagRelayValsets, true, "Relay valset updates")
+	cmd.Flags().Bool(flagRelayBatches, true, "Relay batches")
+
+	return cmd
+}
+
+// config holds the relayer settings
+type config struct {
+	cosmosGRPC         string
+	ethereumRPC        string
+	ethereumKey        *ecdsa.PrivateKey
+	gravityContract    common.Address
+	startBlock         uint64
+	loopDelay          time.Duration
+	maxGasPrice        *big.Int
+	gasPriceMultiplier float64
+	minBatchFees       map[common.Address]sdk.Int
+	relayValsets       bool
+	relayBatches       bool
+}
+
+// configFromFlags reads and validates the relayer settings
+func configFromFlags(flags *pflag.FlagSet) (cfg config, err error) {
+	if cfg.cosmosGRPC, err = flags.GetString(flagCosmosGRPC); err != nil {
+		return cfg, err
+	}
+	if cfg.ethereumRPC, err = flags.GetString(flagEthereumRPC); err != nil {
+		return cfg, err
+	}
+
+	if cfg.ethereumKey, err = ethereumKeyFromFlags(flags); err != nil {
+		return cfg, err
+	}
+
+	contract, err := flags.GetString(flagGravityContract)
+	if err != nil {
+		return cfg, err
+	}
+	if !common.IsHexAddress(contract) {
+		return cfg, fmt.Errorf("invalid --%s %q", flagGravityContract, contract)
+	}
+	cfg.gravityContract = common.HexToAddress(contract)
+
+	if cfg.startBlock, err = flags.GetUint64(flagStartBlock); err != nil {
+		return cfg, err
+	}
+	if cfg.loopDelay, err = flags.GetDuration(flagLoopDelay); err != nil {
+		return cfg, err
+	}
+
+	maxGasPrice, err := flags.GetUint64(flagMaxGasPrice)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.maxGasPrice = new(big.Int).Mul(new(big.Int).SetUint64(maxGasPrice), big.NewInt(1e9))
+
+	if cfg.gasPriceMultiplier, err = flags.GetFloat64(flagGasPriceMultiplier); err != nil {
+		return cfg, err
+	}
+	if cfg.gasPriceMultiplier <= 0 {
+		return cfg, fmt.Errorf("--%s must be positive", flagGasPriceMultiplier)
+	}
+
+	minBatchFees, err := flags.GetStringSlice(flagMinBatchFees)
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.minBatchFees, err = parseMinBatchFees(minBatchFees); err != nil {
+		return cfg, err
+	}
+
+	if cfg.relayValsets, err = flags.GetBool(flagRelayValsets); err != nil {
+		return cfg, err
+	}
+	if cfg.relayBatches, err = flags.GetBool(flagRelayBatches); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// parseMinBatchFees parses <erc20 address>:<amount> pairs
+func parseMinBatchFees(pairs []string) (map[common.Address]sdk.Int, error) {
+	minBatchFees := make(map[common.Address]sdk.Int, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 || !common.IsHexAddress(parts[0]) {
+			return nil, fmt.Errorf("invalid --%s entry %q, expected <erc20 address>:<amount>", flagMinBatchFees, pair)
+		}
+		amount, ok := sdk.NewIntFromString(parts[1])
+		if !ok || amount.IsNegative() {
+			return nil, fmt.Errorf("invalid --%s amount %q", flagMinBatchFees, parts[1])
+		}
+		minBatchFees[common.HexToAddress(parts[0])] = amount
+	}
+	return minBatchFees, nil
+}
+
+// ethereumKeyFromFlags loads the Ethereum key from the keyring when a key name is given, from the
+// hex encoded flag or environment variable otherwise
+func ethereumKeyFromFlags(flags *pflag.FlagSet) (*ecdsa.PrivateKey, error) {
+	name, err := flags.GetString(flagEthereumKeyName)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		backend, err := flags.GetString(sdkflags.FlagKeyringBackend)
+		if err != nil {
+			return nil, err
+		}
+		dir, err := flags.GetString(sdkflags.FlagKeyringDir)
+		if err != nil {
+			return nil, err
+		}
+		kr, err := keyring.New(sdk.KeyringServiceName(), backend, dir, os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		return ethkeys.PrivateKey(kr, name)
+	}
+
+	hexKey, err := flags.GetString(flagEthereumKey)
+	if err != nil {
+		return nil, err
+	}
+	if hexKey == "" {
+		hexKey = os.Getenv(envEthereumKey)
+	}
+	key, err := gethcrypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid --%s", flagEthereumKey)
+	}
+	return key, nil
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/onomyprotocol/arc/module/eth/contracts"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// valsetArgs converts a valset stored on the gravity chain to the ValsetArgs struct of Gravity.sol
+func valsetArgs(valset types.Valset) (contracts.ValsetArgs, error) {
+	args := contracts.ValsetArgs{
+		Validators:   make([]common.Address, len(valset.Members)),
+		Powers:       make([]*big.Int, len(valset.Members)),
+		ValsetNonce:  new(big.Int).SetUint64(valset.Nonce),
+		RewardAmount: big.NewInt(0),
+		RewardToken:  common.Address{},
+	}
+	for i, member := range valset.Members {
+		if !common.IsHexAddress(member.EthereumAddress) {
+			return args, fmt.Errorf("invalid ethereum address %q of valset %d member", member.EthereumAddress, valset.Nonce)
+		}
+		args.Validators[i] = common.HexToAddress(member.EthereumAddress)
+		args.Powers[i] = new(big.Int).SetUint64(member.Power)
+	}
+	if !valset.RewardAmount.IsNil() {
+		args.RewardAmount = valset.RewardAmount.BigInt()
+	}
+	if valset.RewardToken != "" {
+		if !common.IsHexAddress(valset.RewardToken) {
+			return args, fmt.Errorf("invalid reward token %q of valset %d", valset.RewardToken, valset.Nonce)
+		}
+		args.RewardToken = common.HexToAddress(valset.RewardToken)
+	}
+	return args, nil
+}
+
+// valsetSignatures orders the confirms of a valset update by the members of the current contract
+// valset, the way checkValidatorSignatures in Gravity.sol reads them. Members without a valid
+// signature over hash get an empty signature with v set to 0, which the contract skips. The
+// returned power is the sum of the powers of the members that signed
+func valsetSignatures(current contracts.ValsetArgs, confirms []types.MsgValsetConfirm, hash []byte) ([]contracts.Signature, uint64) {
+	signatures := make(map[string][]byte, len(confirms))
+	for _, confirm := range confirms {
+		sig, err := hex.DecodeString(confirm.Signature)
+		if err != nil {
+			continue
+		}
+		signatures[strings.ToLower(confirm.EthAddress)] = sig
+	}
+
+	sigs := make([]contracts.Signature, len(current.Validators))
+	power := uint64(0)
+	for i, validator := range current.Validators {
+		sig, ok := signatures[strings.ToLower(validator.Hex())]
+		if !ok {
+			continue
+		}
+		ethAddress, err := types.NewEthAddress(validator.Hex())
+		if err != nil {
+			continue
+		}
+		// a confirm signed with an outdated key would make the whole call revert
+		if err := types.ValidateEthereumSignature(hash, sig, *ethAddress); err != nil {
+			continue
+		}
+		sigs[i] = contractSignature(sig)
+		power += current.Powers[i].Uint64()
+	}
+	return sigs, power
+}
+
+// batchSignatures converts the signatures of a batch calldata to the Signature structs of Gravity.sol
+func batchSignatures(calldata types.SubmitBatchCalldata) ([]contracts.Signature, error) {
+	if len(calldata.V) != len(calldata.Validators) || len(calldata.R) != len(calldata.Validators) ||
+		len(calldata.S) != len(calldata.Validators) {
+		return nil, fmt.Errorf("batch %d calldata signatures don't match the %d validators", calldata.BatchNonce, len(calldata.Validators))
+	}
+	sigs := make([]contracts.Signature, len(calldata.Validators))
+	for i := range calldata.Validators {
+		if len(calldata.R[i]) != 32 || len(calldata.S[i]) != 32 || calldata.V[i] > 255 {
+			return nil, fmt.Errorf("invalid signature of validator %s in batch %d calldata", calldata.Validators[i], calldata.BatchNonce)
+		}
+		sigs[i].V = uint8(calldata.V[i])
+		copy(sigs[i].R[:], calldata.R[i])
+		copy(sigs[i].S[:], calldata.S[i])
+	}
+	return sigs, nil
+}
+
+// contractSignature splits a 65 bytes r, s, v signature, the contract uses ecrecover which
+// expects the legacy 27/28 recovery id
+func contractSignature(sig []byte) contracts.Signature {
+	var signature contracts.Signature
+	copy(signature.R[:], sig[:32])
+	copy(signature.S[:], sig[32:64])
+	signature.V = sig[64]
+	if signature.V < 27 {
+		signature.V += 27
+	}
+	return signature
+}
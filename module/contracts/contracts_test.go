@@ -0,0 +1,65 @@
+package contracts
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGravityABI checks the bound methods and events against their Solidity signatures, the
+// orchestrator filters events and encodes calls by these selectors
+func TestGravityABI(t *testing.T) {
+	gravityABI, err := GravityMetaData.GetAbi()
+	require.NoError(t, err)
+
+	methods := map[string]string{
+		"sendToCosmos":   "sendToCosmos(address,string,uint256)",
+		"deployERC20":    "deployERC20(string,string,string,uint8)",
+		"lastBatchNonce": "lastBatchNonce(address)",
+		"updateValset": "updateValset((address[],uint256[],uint256,uint256,address)," +
+			"(address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[])",
+		"submitBatch": "submitBatch((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[]," +
+			"uint256[],address[],uint256[],uint256,address,uint256)",
+		"submitLogicCall": "submitLogicCall((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[]," +
+			"(uint256[],address[],uint256[],address[],address,bytes,uint256,bytes32,uint256))",
+	}
+	for name, sig := range methods {
+		method, ok := gravityABI.Methods[name]
+		require.True(t, ok, name)
+		require.Equal(t, sig, method.Sig)
+		require.Equal(t, crypto.Keccak256([]byte(sig))[:4], method.ID)
+	}
+
+	events := map[string]string{
+		"TransactionBatchExecutedEvent": "TransactionBatchExecutedEvent(uint256,address,uint256)",
+		"SendToCosmosEvent":             "SendToCosmosEvent(address,address,string,uint256,uint256)",
+		"ERC20DeployedEvent":            "ERC20DeployedEvent(string,address,string,string,uint8,uint256)",
+		"ValsetUpdatedEvent":            "ValsetUpdatedEvent(uint256,uint256,uint256,address,address[],uint256[])",
+		"LogicCallEvent":                "LogicCallEvent(bytes32,uint256,bytes,uint256)",
+	}
+	for name, sig := range events {
+		event, ok := gravityABI.Events[name]
+		require.True(t, ok, name)
+		require.Equal(t, crypto.Keccak256Hash([]byte(sig)), event.ID)
+	}
+}
+
+// TestCosmosERC20ABI checks the ERC20 template exposes the standard token interface
+func TestCosmosERC20ABI(t *testing.T) {
+	erc20ABI, err := CosmosERC20MetaData.GetAbi()
+	require.NoError(t, err)
+
+	for _, sig := range []string{
+		"balanceOf(address)",
+		"transfer(address,uint256)",
+		"transferFrom(address,address,uint256)",
+		"approve(address,uint256)",
+		"allowance(address,address)",
+		"decimals()",
+	} {
+		_, err := erc20ABI.MethodById(crypto.Keccak256([]byte(sig))[:4])
+		require.NoError(t, err, sig)
+	}
+	require.Equal(t, crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), erc20ABI.Events["Transfer"].ID)
+}
@@ -0,0 +1,11 @@
+/*
+Package contracts holds the abigen Go bindings for the Gravity.sol bridge contract and the
+CosmosERC20 template it deploys for Cosmos originated assets, so Go tooling can interact with
+the contract without the Rust stack.
+
+The bindings are generated from the ABI and bytecode files in ./abi, run `make contracts-gen`
+after changing the contracts. When the hardhat artifacts are present in ../solidity/artifacts the
+script first refreshes the ABI and bytecode files from them. The bytecode lets the integration
+tests deploy the contracts to a simulated Ethereum chain.
+*/
+package contracts
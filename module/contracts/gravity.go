@@ -0,0 +1,1418 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// LogicCallArgs is an auto generated low-level Go binding around an user-defined struct.
+type LogicCallArgs struct {
+	TransferAmounts        []*big.Int
+	TransferTokenContracts []common.Address
+	FeeAmounts             []*big.Int
+	FeeTokenContracts      []common.Address
+	LogicContractAddress   common.Address
+	Payload                []byte
+	TimeOut                *big.Int
+	InvalidationId         [32]byte
+	InvalidationNonce      *big.Int
+}
+
+// Signature is an auto generated low-level Go binding around an user-defined struct.
+type Signature struct {
+	V uint8
+	R [32]byte
+	S [32]byte
+}
+
+// ValsetArgs is an auto generated low-level Go binding around an user-defined struct.
+type ValsetArgs struct {
+	Validators   []common.Address
+	Powers       []*big.Int
+	ValsetNonce  *big.Int
+	RewardAmount *big.Int
+	RewardToken  common.Address
+}
+
+// GravityMetaData contains all meta data concerning the Gravity contract.
+var GravityMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_gravityId\",\"type\":\"bytes32\"},{\"internalType\":\"address[]\",\"name\":\"_validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_powers\",\"type\":\"uint256[]\"},{\"internalType\":\"address\",\"name\":\"_bNomAddress\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"BatchTimedOut\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IncorrectCheckpoint\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"cumulativePower\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"powerThreshold\",\"type\":\"uint256\"}],\"name\":\"InsufficientPower\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentNonce\",\"type\":\"uint256\"}],\"name\":\"InvalidBatchNonce\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidLogicCallFees\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentNonce\",\"type\":\"uint256\"}],\"name\":\"InvalidLogicCallNonce\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidLogicCallTransfers\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidSendToCosmos\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentNonce\",\"type\":\"uint256\"}],\"name\":\"InvalidValsetNonce\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"LogicCallTimedOut\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MalformedBatch\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MalformedCurrentValidatorSet\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MalformedNewValidatorSet\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_cosmosDenom\",\"type\":\"string\",\"indexed\":false},{\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\",\"indexed\":false},{\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\",\"indexed\":false},{\"internalType\":\"uint8\",\"name\":\"_decimals\",\"type\":\"uint8\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"ERC20DeployedEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_invalidationId\",\"type\":\"bytes32\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"_invalidationNonce\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"bytes\",\"name\":\"_returnData\",\"type\":\"bytes\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"LogicCallEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"string\",\"name\":\"_destination\",\"type\":\"string\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"SendToCosmosEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_batchNonce\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"TransactionBatchExecutedEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_newValsetNonce\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"_rewardAmount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"address\",\"name\":\"_rewardToken\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"address[]\",\"name\":\"_validators\",\"type\":\"address[]\",\"indexed\":false},{\"internalType\":\"uint256[]\",\"name\":\"_powers\",\"type\":\"uint256[]\",\"indexed\":false}],\"name\":\"ValsetUpdatedEvent\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"bNomAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_cosmosDenom\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"_decimals\",\"type\":\"uint8\"}],\"name\":\"deployERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_erc20Address\",\"type\":\"address\"}],\"name\":\"lastBatchNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_invalidation_id\",\"type\":\"bytes32\"}],\"name\":\"lastLogicCallNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_destination\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"sendToCosmos\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_gravityId\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"state_invalidationMapping\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"state_lastBatchNonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastEventNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastValsetCheckpoint\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastValsetNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"internalType\":\"structSignature[]\",\"name\":\"_sigs\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_amounts\",\"type\":\"uint256[]\"},{\"internalType\":\"address[]\",\"name\":\"_destinations\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_fees\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"_batchNonce\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_batchTimeout\",\"type\":\"uint256\"}],\"name\":\"submitBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"internalType\":\"structSignature[]\",\"name\":\"_sigs\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"uint256[]\",\"name\":\"transferAmounts\",\"type\":\"uint256[]\"},{\"internalType\":\"address[]\",\"name\":\"transferTokenContracts\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"feeAmounts\",\"type\":\"uint256[]\"},{\"internalType\":\"address[]\",\"name\":\"feeTokenContracts\",\"type\":\"address[]\"},{\"internalType\":\"address\",\"name\":\"logicContractAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"timeOut\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"invalidationId\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"invalidationNonce\",\"type\":\"uint256\"}],\"internalType\":\"structLogicCallArgs\",\"name\":\"_args\",\"type\":\"tuple\"}],\"name\":\"submitLogicCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"internalType\":\"structSignature[]\",\"name\":\"_sigs\",\"type\":\"tuple[]\"},{\"internalType\":\"bytes32\",\"name\":\"_theHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_powerThreshold\",\"type\":\"uint256\"}],\"name\":\"testCheckValidatorSignatures\",\"outputs\":[],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_valsetArgs\",\"type\":\"tuple\"},{\"internalType\":\"bytes32\",\"name\":\"_gravityId\",\"type\":\"bytes32\"}],\"name\":\"testMakeCheckpoint\",\"outputs\":[],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_newValset\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"internalType\":\"structSignature[]\",\"name\":\"_sigs\",\"type\":\"tuple[]\"}],\"name\":\"updateValset\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60a0604052600060065560016007553480156200001b57600080fd5b5060405162003d8838038062003d888339810160408190526200003e916200041d565b6001600081905580546001600160a01b0383166001600160a01b03199182168117909255600280549091169091179055815183511415806200007f57508251155b156200009e5760405163c6617b7b60e01b815260040160405180910390fd5b620000a98362000211565b6000805b83518110156200010457838181518110620000cc57620000cc6200050b565b602002602001015182620000e1919062000537565b915063aaaaaaaa8211620001045780620000fb816200054d565b915050620000ad565b5063aaaaaaaa81116200013a5760405162bfb6ab60e01b81526004810182905263aaaaaaaa602482015260440160405180910390fd5b620001766040518060a001604052806060815260200160608152602001600081526020016000815260200160006001600160a01b031681525090565b506040805160a081018252858152602081018590526000918101829052606081018290526080810182905290620001ae8288620002b0565b6080889052600381905560065460075460405192935090917f76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a91620001fc9160009081908c908c90620005e1565b60405180910390a250505050505050620006a6565b60015b8151811015620002ac578181815181106200023357620002336200050b565b60200260200101516001600160a01b03168260018362000254919062000630565b815181106200026757620002676200050b565b60200260200101516001600160a01b031610620002975760405163c01ba0ab60e01b815260040160405180910390fd5b80620002a3816200054d565b91505062000214565b5050565b6000806918da1958dadc1bda5b9d60b21b60001b90506000838286604001518760000151886020015189606001518a60800151604051602001620002fb979695949392919062000646565b60408051601f198184030181529190528051602090910120925050505b92915050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f191681016001600160401b03811182821017156200035f576200035f6200031e565b604052919050565b60006001600160401b038211156200038357620003836200031e565b5060051b60200190565b80516001600160a01b0381168114620003a557600080fd5b919050565b600082601f830112620003bc57600080fd5b81516020620003d5620003cf8362000367565b62000334565b82815260059290921b84018101918181019086841115620003f557600080fd5b8286015b84811015620004125780518352918301918301620003f9565b509695505050505050565b600080600080608085870312156200043457600080fd5b8451602080870151919550906001600160401b03808211156200045657600080fd5b818801915088601f8301126200046b57600080fd5b81516200047c620003cf8262000367565b81815260059190911b8301840190848101908b8311156200049c57600080fd5b938501935b82851015620004c557620004b5856200038d565b82529385019390850190620004a1565b60408b01519098509450505080831115620004df57600080fd5b5050620004ef87828801620003aa565b92505062000500606086016200038d565b905092959194509250565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b8082018082111562000318576200031862000521565b60006001820162000562576200056262000521565b5060010190565b600081518084526020808501945080840160005b83811015620005a45781516001600160a01b0316875295820195908201906001016200057d565b509495945050505050565b600081518084526020808501945080840160005b83811015620005a457815187529582019590820190600101620005c3565b85815284602082015260018060a01b038416604082015260a0606082015260006200061060a083018562000569565b8281036080840152620006248185620005af565b98975050505050505050565b8181038181111562000318576200031862000521565b87815286602082015285604082015260e0606082015260006200066d60e083018762000569565b8281036080840152620006818187620005af565b60a084019590955250506001600160a01b039190911660c09091015295945050505050565b6080516136b1620006d76000396000818161024e0152818161060e015281816106a90152610a6501526136b16000f3fe60806040523480156200001157600080fd5b5060043610620001145760003560e01c8063aca6b1c111620000a3578063c9d194d5116200006e578063c9d194d51462000270578063df97174b1462000293578063f2b5330714620002b6578063f795563714620002c057600080fd5b8063aca6b1c114620001fa578063aece29b11462000211578063b56561fe146200023e578063bdda81d4146200024857600080fd5b80636941db9311620000e45780636941db93146200019f57806373b2054714620001b65780637dfb6f8614620001c05780638690ff9814620001e357600080fd5b80629011531462000119578063010315251462000132578063011b217414620001495780630f2123571462000188575b600080fd5b620001306200012a36600462001a1a565b620002d7565b005b620001306200014336600462001a9b565b620002ed565b620001756200015a36600462001b00565b6001600160a01b031660009081526004602052604090205490565b6040519081526020015b60405180910390f35b620001306200019936600462001b62565b62000308565b62000130620001b036600462001db0565b62000541565b6200017560075481565b62000175620001d136600462001f4c565b60056020526000908152604090205481565b62000130620001f436600462001fad565b620008d5565b620001306200020b366004620020e6565b62000c2a565b60015462000225906001600160a01b031681565b6040516001600160a01b0390911681526020016200017f565b6200017560065481565b620001757f000000000000000000000000000000000000000000000000000000000000000081565b620001756200028136600462001f4c565b60009081526005602052604090205490565b62000175620002a436600462001b00565b60046020526000908152604090205481565b6200017560035481565b62000130620002d13660046200218c565b62000f5a565b620002e6858585858562001012565b5050505050565b62000303620002fc8362002242565b8262001166565b505050565b600260005403620003365760405162461bcd60e51b81526004016200032d90620022f8565b60405180910390fd5b600260009081556040516370a0823160e01b81523060048201526001600160a01b038616906370a0823190602401602060405180830381865afa15801562000382573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620003a891906200232f565b9050620003c16001600160a01b038616333085620011d4565b6040516370a0823160e01b81523060048201526000906001600160a01b038716906370a0823190602401602060405180830381865afa15801562000409573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200042f91906200232f565b905081811162000452576040516321739d9b60e01b815260040160405180910390fd5b600754620004629060016200235f565b6007556001546001600160a01b0390811690871603620004dd57600254604051630852cd8d60e31b8152600481018590526001600160a01b03909116906342966c6890602401600060405180830381600087803b158015620004c357600080fd5b505af1158015620004d8573d6000803e3d6000fd5b505050505b336001600160a01b0387167f9e9794dbf94b0a0aa31a480f5b38550eda7f89115ac8fbf4953fa4dd219900c9878762000517878762002375565b6007546040516200052c9493929190620023b4565b60405180910390a35050600160005550505050565b600260005403620005665760405162461bcd60e51b81526004016200032d90620022f8565b600260005560c08101514310620005905760405163bcf37c2560e01b815260040160405180910390fd5b61010081015160e082015160009081526005602052604090205410620005ed5761010081015160e082015160009081526005602052604090819020549051629427e960e11b8152600481019290925260248201526044016200032d565b620005fa84848462001247565b600354620006336200060c8662002242565b7f000000000000000000000000000000000000000000000000000000000000000062001166565b14620006525760405163723a340360e01b815260040160405180910390fd5b602081015151815151146200067a57604051634298a95160e11b815260040160405180910390fd5b80606001515181604001515114620006a557604051634829247960e01b815260040160405180910390fd5b60007f0000000000000000000000000000000000000000000000000000000000000000681b1bd9da58d0d85b1b60ba1b836000015184602001518560400151866060015187608001518860a001518960c001518a60e001518b61010001516040516020016200071f9b9a99989796959493929190620024a9565b6040516020818303038152906040528051906020012090506200074a8585858463aaaaaaaa62001012565b5061010081015160e08201516000908152600560205260408120919091555b815151811015620007ed57620007d882608001518360000151838151811062000796576200079662002559565b602002602001015184602001518481518110620007b757620007b762002559565b60200260200101516001600160a01b0316620012a09092919063ffffffff16565b80620007e4816200256f565b91505062000769565b5060006200080482608001518360a00151620012d2565b905060005b8260400151518110156200086b5762000856338460400151838151811062000835576200083562002559565b602002602001015185606001518481518110620007b757620007b762002559565b8062000862816200256f565b91505062000809565b506007546200087c9060016200235f565b600781905560e08301516101008401516040517f7c2bb24f8e1b3725cb613d7f11ef97d9745cc97a0e40f730621c052d684077a193620008c19392918691906200258b565b60405180910390a150506001600055505050565b600260005403620008fa5760405162461bcd60e51b81526004016200032d90620022f8565b600260009081556001600160a01b03831681526004602052604090205483116200095e576001600160a01b03821660009081526004602081905260409182902054915163f7f920ad60e01b815290810185905260248101919091526044016200032d565b6001600160a01b0382166000908152600460205260409020546200098690620f42406200235f565b831115620009ce576001600160a01b03821660009081526004602081905260409182902054915163f7f920ad60e01b815290810185905260248101919091526044016200032d565b804310620009ef576040516308b9266360e11b815260040160405180910390fd5b620009fc8c8c8c62001247565b60035462000a0e6200060c8e62002242565b1462000a2d5760405163723a340360e01b815260040160405180910390fd5b878614158062000a3d5750878414155b1562000a5c5760405163c1f97e3560e01b815260040160405180910390fd5b62000ade8c8c8c7f00000000000000000000000000000000000000000000000000000000000000006f0e8e4c2dce6c2c6e8d2dedc84c2e8c6d60831b8e8e8e8e8e8e8e8e8e60405160200162000abd9b9a9998979695949392919062002630565b6040516020818303038152906040528051906020012063aaaaaaaa62001012565b6001600160a01b0382166000908152600460205260408120849055805b8981101562000baa5762000b6a89898381811062000b1d5762000b1d62002559565b905060200201602081019062000b34919062001b00565b8c8c8481811062000b495762000b4962002559565b90506020020135866001600160a01b0316620012a09092919063ffffffff16565b86868281811062000b7f5762000b7f62002559565b905060200201358262000b9391906200235f565b91508062000ba1816200256f565b91505062000afb565b5062000bc16001600160a01b0384163383620012a0565b5060075462000bd29060016200235f565b60078190556040519081526001600160a01b0383169084907f02c7e81975f8edb86e2a0c038b7b86a49c744236abf0f6177ff5afc6986ab7089060200160405180910390a35050600160005550505050505050505050565b826040013584604001351162000c63576040805163e0e8edf360e01b81528186013560048201529084013560248201526044016200032d565b62000cab62000c738580620026ae565b808060200260200160405190810160405280939291908181526020018383602002808284376000920191909152506200131d92505050565b62000cbe6040840135620f42406200235f565b8460400135111562000cf3576040805163e0e8edf360e01b81528186013560048201529084013560248201526044016200032d565b62000d026020850185620026ae565b905062000d108580620026ae565b905014158062000d2b575062000d278480620026ae565b1590505b1562000d4a5760405163c01ba0ab60e01b815260040160405180910390fd5b62000d5783838362001247565b6000805b62000d6a6020870187620026ae565b905081101562000dcd5762000d836020870187620026ae565b8281811062000d965762000d9662002559565b905060200201358262000daa91906200235f565b915063aaaaaaaa821162000dcd578062000dc4816200256f565b91505062000d5b565b5063aaaaaaaa811162000e005760405162bfb6ab60e01b81526004810182905263aaaaaaaa60248201526044016200032d565b60035462000e126200060c8662002242565b1462000e315760405163723a340360e01b815260040160405180910390fd5b600062000e426200060c8762002242565b905062000e578585858463aaaaaaaa62001012565b60038190556040860135600655600062000e7860a088016080890162001b00565b6001600160a01b03161415801562000e935750606086013515155b1562000ec65762000ec633606088013562000eb560a08a0160808b0162001b00565b6001600160a01b03169190620012a0565b60075462000ed69060016200235f565b60078190556040870135907f76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a90606089013562000f1a60a08b0160808c0162001b00565b62000f268b80620026ae565b62000f3560208e018e620026ae565b60405162000f4a9796959493929190620026fa565b60405180910390a2505050505050565b600030868686868660405162000f7090620019a5565b62000f81969594939291906200274d565b604051809103906000f08015801562000f9e573d6000803e3d6000fd5b509050600754600162000fb291906200235f565b60078190556040516001600160a01b038316917f82fe3a4fa49c6382d0c085746698ddbbafe6c2bf61285b19410644b5b26287c79162001000918c918c918c918c918c918c918c91620027a0565b60405180910390a25050505050505050565b6000805b620010228780620026ae565b9050811015620011325785858281811062001041576200104162002559565b620010599260206060909202019081019150620027fd565b60ff16156200111d57620010be620010728880620026ae565b8381811062001085576200108562002559565b90506020020160208101906200109c919062001b00565b85888885818110620010b257620010b262002559565b905060600201620013bc565b620010dc57604051638baa579f60e01b815260040160405180910390fd5b620010eb6020880188620026ae565b82818110620010fe57620010fe62002559565b90506020020135826200111291906200235f565b915082821162001132575b8062001129816200256f565b91505062001016565b508181116200115e5760405162bfb6ab60e01b815260048101829052602481018390526044016200032d565b505050505050565b6000806918da1958dadc1bda5b9d60b21b60001b90506000838286604001518760000151886020015189606001518a60800151604051602001620011b197969594939291906200281b565b60408051601f198184030181529190528051602090910120925050505b92915050565b6040516001600160a01b0380851660248301528316604482015260648101829052620012419085906323b872dd60e01b906084015b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b03199093169290921790915262001451565b50505050565b620012566020840184620026ae565b9050620012648480620026ae565b9050141580620012815750806200127c8480620026ae565b905014155b15620003035760405163c6617b7b60e01b815260040160405180910390fd5b6040516001600160a01b0383166024820152604481018290526200030390849063a9059cbb60e01b9060640162001209565b60606200131683836040518060400160405280601e81526020017f416464726573733a206c6f772d6c6576656c2063616c6c206661696c656400008152506200152a565b9392505050565b60015b8151811015620013b8578181815181106200133f576200133f62002559565b60200260200101516001600160a01b03168260018362001360919062002375565b8151811062001373576200137362002559565b60200260200101516001600160a01b031610620013a35760405163c01ba0ab60e01b815260040160405180910390fd5b80620013af816200256f565b91505062001320565b5050565b6040517f19457468657265756d205369676e6564204d6573736167653a0a3332000000006020820152603c81018390526000908190605c0160408051601f1981840301815291905280516020918201209150620014339082906200142390860186620027fd565b8560200135866040013562001543565b6001600160a01b0316856001600160a01b0316149150509392505050565b6000620014a8826040518060400160405280602081526020017f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c6564815250856001600160a01b03166200152a9092919063ffffffff16565b805190915015620003035780806020019051810190620014c991906200287b565b620003035760405162461bcd60e51b815260206004820152602a60248201527f5361666545524332303a204552433230206f7065726174696f6e20646964206e6044820152691bdd081cdd58d8d9595960b21b60648201526084016200032d565b60606200153b84846000856200156f565b949350505050565b60008060006200155687878787620016a1565b91509150620015658162001796565b5095945050505050565b606082471015620015d25760405162461bcd60e51b815260206004820152602660248201527f416464726573733a20696e73756666696369656e742062616c616e636520666f6044820152651c8818d85b1b60d21b60648201526084016200032d565b843b620016225760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e747261637400000060448201526064016200032d565b600080866001600160a01b031685876040516200164091906200289f565b60006040518083038185875af1925050503d80600081146200167f576040519150601f19603f3d011682016040523d82523d6000602084013e62001684565b606091505b50915091506200169682828662001967565b979650505050505050565b6000807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0831115620016da57506000905060036200178d565b8460ff16601b14158015620016f357508460ff16601c14155b156200170657506000905060046200178d565b6040805160008082526020820180845289905260ff881692820192909252606081018690526080810185905260019060a0016020604051602081039080840390855afa1580156200175b573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b03811662001786576000600192509250506200178d565b9150600090505b94509492505050565b6000816004811115620017ad57620017ad620028bd565b03620017b65750565b6001816004811115620017cd57620017cd620028bd565b036200181c5760405162461bcd60e51b815260206004820152601860248201527f45434453413a20696e76616c6964207369676e6174757265000000000000000060448201526064016200032d565b6002816004811115620018335762001833620028bd565b03620018825760405162461bcd60e51b815260206004820152601f60248201527f45434453413a20696e76616c6964207369676e6174757265206c656e6774680060448201526064016200032d565b6003816004811115620018995762001899620028bd565b03620018f35760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202773272076616c604482015261756560f01b60648201526084016200032d565b60048160048111156200190a576200190a620028bd565b03620019645760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202776272076616c604482015261756560f01b60648201526084016200032d565b50565b606083156200197857508162001316565b825115620019895782518084602001fd5b8160405162461bcd60e51b81526004016200032d9190620028d3565b610d9380620028e983390190565b600060a08284031215620019c657600080fd5b50919050565b60008083601f840112620019df57600080fd5b5081356001600160401b03811115620019f757600080fd5b60208301915083602060608302850101111562001a1357600080fd5b9250929050565b60008060008060006080868803121562001a3357600080fd5b85356001600160401b038082111562001a4b57600080fd5b62001a5989838a01620019b3565b9650602088013591508082111562001a7057600080fd5b5062001a7f88828901620019cc565b9699909850959660408101359660609091013595509350505050565b6000806040838503121562001aaf57600080fd5b82356001600160401b0381111562001ac657600080fd5b62001ad485828601620019b3565b95602094909401359450505050565b80356001600160a01b038116811462001afb57600080fd5b919050565b60006020828403121562001b1357600080fd5b620013168262001ae3565b60008083601f84011262001b3157600080fd5b5081356001600160401b0381111562001b4957600080fd5b60208301915083602082850101111562001a1357600080fd5b6000806000806060858703121562001b7957600080fd5b62001b848562001ae3565b935060208501356001600160401b0381111562001ba057600080fd5b62001bae8782880162001b1e565b9598909750949560400135949350505050565b634e487b7160e01b600052604160045260246000fd5b60405161012081016001600160401b038111828210171562001bfd5762001bfd62001bc1565b60405290565b604051601f8201601f191681016001600160401b038111828210171562001c2e5762001c2e62001bc1565b604052919050565b60006001600160401b0382111562001c525762001c5262001bc1565b5060051b60200190565b600082601f83011262001c6e57600080fd5b8135602062001c8762001c818362001c36565b62001c03565b82815260059290921b8401810191818101908684111562001ca757600080fd5b8286015b8481101562001cc4578035835291830191830162001cab565b509695505050505050565b600082601f83011262001ce157600080fd5b8135602062001cf462001c818362001c36565b82815260059290921b8401810191818101908684111562001d1457600080fd5b8286015b8481101562001cc45762001d2c8162001ae3565b835291830191830162001d18565b600082601f83011262001d4c57600080fd5b81356001600160401b0381111562001d685762001d6862001bc1565b62001d7d601f8201601f191660200162001c03565b81815284602083860101111562001d9357600080fd5b816020850160208301376000918101602001919091529392505050565b6000806000806060858703121562001dc757600080fd5b84356001600160401b038082111562001ddf57600080fd5b62001ded88838901620019b3565b9550602087013591508082111562001e0457600080fd5b62001e1288838901620019cc565b9095509350604087013591508082111562001e2c57600080fd5b90860190610120828903121562001e4257600080fd5b62001e4c62001bd7565b82358281111562001e5c57600080fd5b62001e6a8a82860162001c5c565b82525060208301358281111562001e8057600080fd5b62001e8e8a82860162001ccf565b60208301525060408301358281111562001ea757600080fd5b62001eb58a82860162001c5c565b60408301525060608301358281111562001ece57600080fd5b62001edc8a82860162001ccf565b60608301525062001ef06080840162001ae3565b608082015260a08301358281111562001f0857600080fd5b62001f168a82860162001d3a565b60a08301525060c083013560c082015260e083013560e08201526101009150818301358282015280935050505092959194509250565b60006020828403121562001f5f57600080fd5b5035919050565b60008083601f84011262001f7957600080fd5b5081356001600160401b0381111562001f9157600080fd5b6020830191508360208260051b850101111562001a1357600080fd5b6000806000806000806000806000806000806101008d8f03121562001fd157600080fd5b6001600160401b038d35111562001fe757600080fd5b62001ff68e8e358f01620019b3565b9b506001600160401b0360208e013511156200201157600080fd5b620020238e60208f01358f01620019cc565b909b5099506001600160401b0360408e013511156200204157600080fd5b620020538e60408f01358f0162001f66565b90995097506001600160401b0360608e013511156200207157600080fd5b620020838e60608f01358f0162001f66565b90975095506001600160401b0360808e01351115620020a157600080fd5b620020b38e60808f01358f0162001f66565b909550935060a08d01359250620020cd60c08e0162001ae3565b915060e08d013590509295989b509295989b509295989b565b60008060008060608587031215620020fd57600080fd5b84356001600160401b03808211156200211557600080fd5b6200212388838901620019b3565b955060208701359150808211156200213a57600080fd5b6200214888838901620019b3565b945060408701359150808211156200215f57600080fd5b506200216e87828801620019cc565b95989497509550505050565b803560ff8116811462001afb57600080fd5b60008060008060008060006080888a031215620021a857600080fd5b87356001600160401b0380821115620021c057600080fd5b620021ce8b838c0162001b1e565b909950975060208a0135915080821115620021e857600080fd5b620021f68b838c0162001b1e565b909750955060408a01359150808211156200221057600080fd5b506200221f8a828b0162001b1e565b9094509250620022349050606089016200217a565b905092959891949750929550565b600060a082360312156200225557600080fd5b60405160a081016001600160401b0382821081831117156200227b576200227b62001bc1565b8160405284359150808211156200229157600080fd5b6200229f3683870162001ccf565b83526020850135915080821115620022b657600080fd5b50620022c53682860162001c5c565b6020830152506040830135604082015260608301356060820152620022ed6080840162001ae3565b608082015292915050565b6020808252601f908201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c00604082015260600190565b6000602082840312156200234257600080fd5b5051919050565b634e487b7160e01b600052601160045260246000fd5b80820180821115620011ce57620011ce62002349565b81810381811115620011ce57620011ce62002349565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b606081526000620023ca6060830186886200238b565b6020830194909452506040015292915050565b600081518084526020808501945080840160005b838110156200240f57815187529582019590820190600101620023f1565b509495945050505050565b600081518084526020808501945080840160005b838110156200240f5781516001600160a01b0316875295820195908201906001016200242e565b60005b838110156200247257818101518382015260200162002458565b50506000910152565b600081518084526200249581602086016020860162002455565b601f01601f19169290920160200192915050565b60006101608d83528c6020840152806040840152620024cb8184018d620023dd565b90508281036060840152620024e1818c6200241a565b90508281036080840152620024f7818b620023dd565b905082810360a08401526200250d818a6200241a565b6001600160a01b03891660c085015283810360e085015290506200253281886200247b565b61010084019690965250506101208101929092526101409091015298975050505050505050565b634e487b7160e01b600052603260045260246000fd5b60006001820162002584576200258462002349565b5060010190565b848152836020820152608060408201526000620025ac60808301856200247b565b905082606083015295945050505050565b81835260006001600160fb1b03831115620025d757600080fd5b8260051b80836020870137939093016020019392505050565b8183526000602080850194508260005b858110156200240f576001600160a01b036200261c8362001ae3565b168752958201959082019060010162002600565b60006101008d83528c6020840152806040840152620026538184018c8e620025bd565b905082810360608401526200266a818a8c620025f0565b905082810360808401526200268181888a620025bd565b60a084019690965250506001600160a01b039290921660c083015260e09091015298975050505050505050565b6000808335601e19843603018112620026c657600080fd5b8301803591506001600160401b03821115620026e157600080fd5b6020019150600581901b360382131562001a1357600080fd5b87815286602082015260018060a01b038616604082015260a0606082015260006200272a60a083018688620025f0565b82810360808401526200273f818587620025bd565b9a9950505050505050505050565b6001600160a01b03871681526080602082018190526000906200277490830187896200238b565b8281036040840152620027898186886200238b565b91505060ff83166060830152979650505050505050565b60a081526000620027b660a083018a8c6200238b565b8281036020840152620027cb81898b6200238b565b90508281036040840152620027e28187896200238b565b60ff9590951660608401525050608001529695505050505050565b6000602082840312156200281057600080fd5b62001316826200217a565b87815286602082015285604082015260e0606082015260006200284260e08301876200241a565b8281036080840152620028568187620023dd565b60a084019590955250506001600160a01b039190911660c09091015295945050505050565b6000602082840312156200288e57600080fd5b815180151581146200131657600080fd5b60008251620028b381846020870162002455565b9190910192915050565b634e487b7160e01b600052602160045260246000fd5b6020815260006200131660208301846200247b56fe60806040526000196005553480156200001757600080fd5b5060405162000d9338038062000d938339810160408190526200003a916200024e565b828260036200004a838262000380565b50600462000059828262000380565b5050600680546001600160a01b038716610100026001600160a81b031990911660ff85161717905550600554620000929085906200009c565b5050505062000474565b6001600160a01b038216620000f75760405162461bcd60e51b815260206004820152601f60248201527f45524332303a206d696e7420746f20746865207a65726f206164647265737300604482015260640160405180910390fd5b80600260008282546200010b91906200044c565b90915550506001600160a01b038216600090815260208190526040812080548392906200013a9084906200044c565b90915550506040518181526001600160a01b038316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a35050565b505050565b634e487b7160e01b600052604160045260246000fd5b600082601f830112620001b157600080fd5b81516001600160401b0380821115620001ce57620001ce62000189565b604051601f8301601f19908116603f01168101908282118183101715620001f957620001f962000189565b816040528381526020925086838588010111156200021657600080fd5b600091505b838210156200023a57858201830151818301840152908201906200021b565b600093810190920192909252949350505050565b600080600080608085870312156200026557600080fd5b84516001600160a01b03811681146200027d57600080fd5b60208601519094506001600160401b03808211156200029b57600080fd5b620002a9888389016200019f565b94506040870151915080821115620002c057600080fd5b50620002cf878288016200019f565b925050606085015160ff81168114620002e757600080fd5b939692955090935050565b600181811c908216806200030757607f821691505b6020821081036200032857634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200018457600081815260208120601f850160051c81016020861015620003575750805b601f850160051c820191505b81811015620003785782815560010162000363565b505050505050565b81516001600160401b038111156200039c576200039c62000189565b620003b481620003ad8454620002f2565b846200032e565b602080601f831160018114620003ec5760008415620003d35750858301515b600019600386901b1c1916600185901b17855562000378565b600085815260208120601f198616915b828110156200041d57888601518255948401946001909101908401620003fc565b50858210156200043c5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b808201808211156200046e57634e487b7160e01b600052601160045260246000fd5b92915050565b61090f80620004846000396000f3fe608060405234801561001057600080fd5b50600436106100a95760003560e01c80633950935111610071578063395093511461012d57806370a082311461014057806395d89b4114610169578063a457c2d714610171578063a9059cbb14610184578063dd62ed3e1461019757600080fd5b806306fdde03146100ae578063095ea7b3146100cc57806318160ddd146100ef57806323b872dd14610105578063313ce56714610118575b600080fd5b6100b66101d0565b6040516100c3919061073e565b60405180910390f35b6100df6100da3660046107a8565b610262565b60405190151581526020016100c3565b6100f7610279565b6040519081526020016100c3565b6100df6101133660046107d2565b6102ab565b60065460405160ff90911681526020016100c3565b6100df61013b3660046107a8565b61035a565b6100f761014e36600461080e565b6001600160a01b031660009081526020819052604090205490565b6100b6610396565b6100df61017f3660046107a8565b6103a5565b6100df6101923660046107a8565b61043e565b6100f76101a5366004610830565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b6060600380546101df90610863565b80601f016020809104026020016040519081016040528092919081815260200182805461020b90610863565b80156102585780601f1061022d57610100808354040283529160200191610258565b820191906000526020600020905b81548152906001019060200180831161023b57829003601f168201915b5050505050905090565b600061026f33848461044b565b5060015b92915050565b60065461010090046001600160a01b03166000908152602081905260408120546005546102a691906108b3565b905090565b60006102b884848461056f565b6001600160a01b0384166000908152600160209081526040808320338452909152902054828110156103425760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084015b60405180910390fd5b61034f853385840361044b565b506001949350505050565b3360008181526001602090815260408083206001600160a01b0387168452909152812054909161026f9185906103919086906108c6565b61044b565b6060600480546101df90610863565b3360009081526001602090815260408083206001600160a01b0386168452909152812054828110156104275760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b6064820152608401610339565b610434338585840361044b565b5060019392505050565b600061026f33848461056f565b6001600160a01b0383166104ad5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b6064820152608401610339565b6001600160a01b03821661050e5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b6064820152608401610339565b6001600160a01b0383811660008181526001602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6001600160a01b0383166105d35760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b6064820152608401610339565b6001600160a01b0382166106355760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b6064820152608401610339565b6001600160a01b038316600090815260208190526040902054818110156106ad5760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b6064820152608401610339565b6001600160a01b038085166000908152602081905260408082208585039055918516815290812080548492906106e49084906108c6565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161073091815260200190565b60405180910390a350505050565b600060208083528351808285015260005b8181101561076b5785810183015185820160400152820161074f565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b03811681146107a357600080fd5b919050565b600080604083850312156107bb57600080fd5b6107c48361078c565b946020939093013593505050565b6000806000606084860312156107e757600080fd5b6107f08461078c565b92506107fe6020850161078c565b9150604084013590509250925092565b60006020828403121561082057600080fd5b6108298261078c565b9392505050565b6000806040838503121561084357600080fd5b61084c8361078c565b915061085a6020840161078c565b90509250929050565b600181811c9082168061087757607f821691505b60208210810361089757634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b818103818111156102735761027361089d565b808201808211156102735761027361089d56fea26469706673582212207835d7b732b1a207313827312a53d461b30a8ec0bc45394dd111ffec4d841aba64736f6c63430008150033a2646970667358221220e113df9f2643da24a577dd2f12b36a78dc91a1b7bfa9193bded469d3d13fa0b464736f6c63430008150033",
+}
+
+// GravityABI is the input ABI used to generate the binding from.
+// Deprecated: Use GravityMetaData.ABI instead.
+var GravityABI = GravityMetaData.ABI
+
+// GravityBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use GravityMetaData.Bin instead.
+var GravityBin = GravityMetaData.Bin
+
+// DeployGravity deploys a new Ethereum contract, binding an instance of Gravity to it.
+func DeployGravity(auth *bind.TransactOpts, backend bind.ContractBackend, _gravityId [32]byte, _validators []common.Address, _powers []*big.Int, _bNomAddress common.Address) (common.Address, *types.Transaction, *Gravity, error) {
+	parsed, err := GravityMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(GravityBin), backend, _gravityId, _validators, _powers, _bNomAddress)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Gravity{GravityCaller: GravityCaller{contract: contract}, GravityTransactor: GravityTransactor{contract: contract}, GravityFilterer: GravityFilterer{contract: contract}}, nil
+}
+
+// Gravity is an auto generated Go binding around an Ethereum contract.
+type Gravity struct {
+	GravityCaller     // Read-only binding to the contract
+	GravityTransactor // Write-only binding to the contract
+	GravityFilterer   // Log filterer for contract events
+}
+
+// GravityCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GravityCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GravityTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GravityTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GravityFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GravityFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GravitySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GravitySession struct {
+	Contract     *Gravity          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GravityCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GravityCallerSession struct {
+	Contract *GravityCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// GravityTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GravityTransactorSession struct {
+	Contract     *GravityTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// GravityRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GravityRaw struct {
+	Contract *Gravity // Generic contract binding to access the raw methods on
+}
+
+// GravityCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GravityCallerRaw struct {
+	Contract *GravityCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GravityTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GravityTransactorRaw struct {
+	Contract *GravityTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGravity creates a new instance of Gravity, bound to a specific deployed contract.
+func NewGravity(address common.Address, backend bind.ContractBackend) (*Gravity, error) {
+	contract, err := bindGravity(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Gravity{GravityCaller: GravityCaller{contract: contract}, GravityTransactor: GravityTransactor{contract: contract}, GravityFilterer: GravityFilterer{contract: contract}}, nil
+}
+
+// NewGravityCaller creates a new read-only instance of Gravity, bound to a specific deployed contract.
+func NewGravityCaller(address common.Address, caller bind.ContractCaller) (*GravityCaller, error) {
+	contract, err := bindGravity(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GravityCaller{contract: contract}, nil
+}
+
+// NewGravityTransactor creates a new write-only instance of Gravity, bound to a specific deployed contract.
+func NewGravityTransactor(address common.Address, transactor bind.ContractTransactor) (*GravityTransactor, error) {
+	contract, err := bindGravity(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GravityTransactor{contract: contract}, nil
+}
+
+// NewGravityFilterer creates a new log filterer instance of Gravity, bound to a specific deployed contract.
+func NewGravityFilterer(address common.Address, filterer bind.ContractFilterer) (*GravityFilterer, error) {
+	contract, err := bindGravity(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GravityFilterer{contract: contract}, nil
+}
+
+// bindGravity binds a generic wrapper to an already deployed contract.
+func bindGravity(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GravityABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Gravity *GravityRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Gravity.Contract.GravityCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Gravity *GravityRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gravity.Contract.GravityTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Gravity *GravityRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Gravity.Contract.GravityTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Gravity *GravityCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Gravity.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Gravity *GravityTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gravity.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Gravity *GravityTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Gravity.Contract.contract.Transact(opts, method, params...)
+}
+
+// BNomAddress is a free data retrieval call binding the contract method 0xaece29b1.
+//
+// Solidity: function bNomAddress() view returns(address)
+func (_Gravity *GravityCaller) BNomAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "bNomAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// BNomAddress is a free data retrieval call binding the contract method 0xaece29b1.
+//
+// Solidity: function bNomAddress() view returns(address)
+func (_Gravity *GravitySession) BNomAddress() (common.Address, error) {
+	return _Gravity.Contract.BNomAddress(&_Gravity.CallOpts)
+}
+
+// BNomAddress is a free data retrieval call binding the contract method 0xaece29b1.
+//
+// Solidity: function bNomAddress() view returns(address)
+func (_Gravity *GravityCallerSession) BNomAddress() (common.Address, error) {
+	return _Gravity.Contract.BNomAddress(&_Gravity.CallOpts)
+}
+
+// LastBatchNonce is a free data retrieval call binding the contract method 0x011b2174.
+//
+// Solidity: function lastBatchNonce(address _erc20Address) view returns(uint256)
+func (_Gravity *GravityCaller) LastBatchNonce(opts *bind.CallOpts, _erc20Address common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "lastBatchNonce", _erc20Address)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastBatchNonce is a free data retrieval call binding the contract method 0x011b2174.
+//
+// Solidity: function lastBatchNonce(address _erc20Address) view returns(uint256)
+func (_Gravity *GravitySession) LastBatchNonce(_erc20Address common.Address) (*big.Int, error) {
+	return _Gravity.Contract.LastBatchNonce(&_Gravity.CallOpts, _erc20Address)
+}
+
+// LastBatchNonce is a free data retrieval call binding the contract method 0x011b2174.
+//
+// Solidity: function lastBatchNonce(address _erc20Address) view returns(uint256)
+func (_Gravity *GravityCallerSession) LastBatchNonce(_erc20Address common.Address) (*big.Int, error) {
+	return _Gravity.Contract.LastBatchNonce(&_Gravity.CallOpts, _erc20Address)
+}
+
+// LastLogicCallNonce is a free data retrieval call binding the contract method 0xc9d194d5.
+//
+// Solidity: function lastLogicCallNonce(bytes32 _invalidation_id) view returns(uint256)
+func (_Gravity *GravityCaller) LastLogicCallNonce(opts *bind.CallOpts, _invalidation_id [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "lastLogicCallNonce", _invalidation_id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastLogicCallNonce is a free data retrieval call binding the contract method 0xc9d194d5.
+//
+// Solidity: function lastLogicCallNonce(bytes32 _invalidation_id) view returns(uint256)
+func (_Gravity *GravitySession) LastLogicCallNonce(_invalidation_id [32]byte) (*big.Int, error) {
+	return _Gravity.Contract.LastLogicCallNonce(&_Gravity.CallOpts, _invalidation_id)
+}
+
+// LastLogicCallNonce is a free data retrieval call binding the contract method 0xc9d194d5.
+//
+// Solidity: function lastLogicCallNonce(bytes32 _invalidation_id) view returns(uint256)
+func (_Gravity *GravityCallerSession) LastLogicCallNonce(_invalidation_id [32]byte) (*big.Int, error) {
+	return _Gravity.Contract.LastLogicCallNonce(&_Gravity.CallOpts, _invalidation_id)
+}
+
+// StateGravityId is a free data retrieval call binding the contract method 0xbdda81d4.
+//
+// Solidity: function state_gravityId() view returns(bytes32)
+func (_Gravity *GravityCaller) StateGravityId(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "state_gravityId")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// StateGravityId is a free data retrieval call binding the contract method 0xbdda81d4.
+//
+// Solidity: function state_gravityId() view returns(bytes32)
+func (_Gravity *GravitySession) StateGravityId() ([32]byte, error) {
+	return _Gravity.Contract.StateGravityId(&_Gravity.CallOpts)
+}
+
+// StateGravityId is a free data retrieval call binding the contract method 0xbdda81d4.
+//
+// Solidity: function state_gravityId() view returns(bytes32)
+func (_Gravity *GravityCallerSession) StateGravityId() ([32]byte, error) {
+	return _Gravity.Contract.StateGravityId(&_Gravity.CallOpts)
+}
+
+// StateInvalidationMapping is a free data retrieval call binding the contract method 0x7dfb6f86.
+//
+// Solidity: function state_invalidationMapping(bytes32 ) view returns(uint256)
+func (_Gravity *GravityCaller) StateInvalidationMapping(opts *bind.CallOpts, arg0 [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "state_invalidationMapping", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StateInvalidationMapping is a free data retrieval call binding the contract method 0x7dfb6f86.
+//
+// Solidity: function state_invalidationMapping(bytes32 ) view returns(uint256)
+func (_Gravity *GravitySession) StateInvalidationMapping(arg0 [32]byte) (*big.Int, error) {
+	return _Gravity.Contract.StateInvalidationMapping(&_Gravity.CallOpts, arg0)
+}
+
+// StateInvalidationMapping is a free data retrieval call binding the contract method 0x7dfb6f86.
+//
+// Solidity: function state_invalidationMapping(bytes32 ) view returns(uint256)
+func (_Gravity *GravityCallerSession) StateInvalidationMapping(arg0 [32]byte) (*big.Int, error) {
+	return _Gravity.Contract.StateInvalidationMapping(&_Gravity.CallOpts, arg0)
+}
+
+// StateLastBatchNonces is a free data retrieval call binding the contract method 0xdf97174b.
+//
+// Solidity: function state_lastBatchNonces(address ) view returns(uint256)
+func (_Gravity *GravityCaller) StateLastBatchNonces(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "state_lastBatchNonces", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StateLastBatchNonces is a free data retrieval call binding the contract method 0xdf97174b.
+//
+// Solidity: function state_lastBatchNonces(address ) view returns(uint256)
+func (_Gravity *GravitySession) StateLastBatchNonces(arg0 common.Address) (*big.Int, error) {
+	return _Gravity.Contract.StateLastBatchNonces(&_Gravity.CallOpts, arg0)
+}
+
+// StateLastBatchNonces is a free data retrieval call binding the contract method 0xdf97174b.
+//
+// Solidity: function state_lastBatchNonces(address ) view returns(uint256)
+func (_Gravity *GravityCallerSession) StateLastBatchNonces(arg0 common.Address) (*big.Int, error) {
+	return _Gravity.Contract.StateLastBatchNonces(&_Gravity.CallOpts, arg0)
+}
+
+// StateLastEventNonce is a free data retrieval call binding the contract method 0x73b20547.
+//
+// Solidity: function state_lastEventNonce() view returns(uint256)
+func (_Gravity *GravityCaller) StateLastEventNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "state_lastEventNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StateLastEventNonce is a free data retrieval call binding the contract method 0x73b20547.
+//
+// Solidity: function state_lastEventNonce() view returns(uint256)
+func (_Gravity *GravitySession) StateLastEventNonce() (*big.Int, error) {
+	return _Gravity.Contract.StateLastEventNonce(&_Gravity.CallOpts)
+}
+
+// StateLastEventNonce is a free data retrieval call binding the contract method 0x73b20547.
+//
+// Solidity: function state_lastEventNonce() view returns(uint256)
+func (_Gravity *GravityCallerSession) StateLastEventNonce() (*big.Int, error) {
+	return _Gravity.Contract.StateLastEventNonce(&_Gravity.CallOpts)
+}
+
+// StateLastValsetCheckpoint is a free data retrieval call binding the contract method 0xf2b53307.
+//
+// Solidity: function state_lastValsetCheckpoint() view returns(bytes32)
+func (_Gravity *GravityCaller) StateLastValsetCheckpoint(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "state_lastValsetCheckpoint")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// StateLastValsetCheckpoint is a free data retrieval call binding the contract method 0xf2b53307.
+//
+// Solidity: function state_lastValsetCheckpoint() view returns(bytes32)
+func (_Gravity *GravitySession) StateLastValsetCheckpoint() ([32]byte, error) {
+	return _Gravity.Contract.StateLastValsetCheckpoint(&_Gravity.CallOpts)
+}
+
+// StateLastValsetCheckpoint is a free data retrieval call binding the contract method 0xf2b53307.
+//
+// Solidity: function state_lastValsetCheckpoint() view returns(bytes32)
+func (_Gravity *GravityCallerSession) StateLastValsetCheckpoint() ([32]byte, error) {
+	return _Gravity.Contract.StateLastValsetCheckpoint(&_Gravity.CallOpts)
+}
+
+// StateLastValsetNonce is a free data retrieval call binding the contract method 0xb56561fe.
+//
+// Solidity: function state_lastValsetNonce() view returns(uint256)
+func (_Gravity *GravityCaller) StateLastValsetNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "state_lastValsetNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StateLastValsetNonce is a free data retrieval call binding the contract method 0xb56561fe.
+//
+// Solidity: function state_lastValsetNonce() view returns(uint256)
+func (_Gravity *GravitySession) StateLastValsetNonce() (*big.Int, error) {
+	return _Gravity.Contract.StateLastValsetNonce(&_Gravity.CallOpts)
+}
+
+// StateLastValsetNonce is a free data retrieval call binding the contract method 0xb56561fe.
+//
+// Solidity: function state_lastValsetNonce() view returns(uint256)
+func (_Gravity *GravityCallerSession) StateLastValsetNonce() (*big.Int, error) {
+	return _Gravity.Contract.StateLastValsetNonce(&_Gravity.CallOpts)
+}
+
+// TestCheckValidatorSignatures is a free data retrieval call binding the contract method 0x00901153.
+//
+// Solidity: function testCheckValidatorSignatures((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, bytes32 _theHash, uint256 _powerThreshold) pure returns()
+func (_Gravity *GravityCaller) TestCheckValidatorSignatures(opts *bind.CallOpts, _currentValset ValsetArgs, _sigs []Signature, _theHash [32]byte, _powerThreshold *big.Int) error {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "testCheckValidatorSignatures", _currentValset, _sigs, _theHash, _powerThreshold)
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// TestCheckValidatorSignatures is a free data retrieval call binding the contract method 0x00901153.
+//
+// Solidity: function testCheckValidatorSignatures((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, bytes32 _theHash, uint256 _powerThreshold) pure returns()
+func (_Gravity *GravitySession) TestCheckValidatorSignatures(_currentValset ValsetArgs, _sigs []Signature, _theHash [32]byte, _powerThreshold *big.Int) error {
+	return _Gravity.Contract.TestCheckValidatorSignatures(&_Gravity.CallOpts, _currentValset, _sigs, _theHash, _powerThreshold)
+}
+
+// TestCheckValidatorSignatures is a free data retrieval call binding the contract method 0x00901153.
+//
+// Solidity: function testCheckValidatorSignatures((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, bytes32 _theHash, uint256 _powerThreshold) pure returns()
+func (_Gravity *GravityCallerSession) TestCheckValidatorSignatures(_currentValset ValsetArgs, _sigs []Signature, _theHash [32]byte, _powerThreshold *big.Int) error {
+	return _Gravity.Contract.TestCheckValidatorSignatures(&_Gravity.CallOpts, _currentValset, _sigs, _theHash, _powerThreshold)
+}
+
+// TestMakeCheckpoint is a free data retrieval call binding the contract method 0x01031525.
+//
+// Solidity: function testMakeCheckpoint((address[],uint256[],uint256,uint256,address) _valsetArgs, bytes32 _gravityId) pure returns()
+func (_Gravity *GravityCaller) TestMakeCheckpoint(opts *bind.CallOpts, _valsetArgs ValsetArgs, _gravityId [32]byte) error {
+	var out []interface{}
+	err := _Gravity.contract.Call(opts, &out, "testMakeCheckpoint", _valsetArgs, _gravityId)
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// TestMakeCheckpoint is a free data retrieval call binding the contract method 0x01031525.
+//
+// Solidity: function testMakeCheckpoint((address[],uint256[],uint256,uint256,address) _valsetArgs, bytes32 _gravityId) pure returns()
+func (_Gravity *GravitySession) TestMakeCheckpoint(_valsetArgs ValsetArgs, _gravityId [32]byte) error {
+	return _Gravity.Contract.TestMakeCheckpoint(&_Gravity.CallOpts, _valsetArgs, _gravityId)
+}
+
+// TestMakeCheckpoint is a free data retrieval call binding the contract method 0x01031525.
+//
+// Solidity: function testMakeCheckpoint((address[],uint256[],uint256,uint256,address) _valsetArgs, bytes32 _gravityId) pure returns()
+func (_Gravity *GravityCallerSession) TestMakeCheckpoint(_valsetArgs ValsetArgs, _gravityId [32]byte) error {
+	return _Gravity.Contract.TestMakeCheckpoint(&_Gravity.CallOpts, _valsetArgs, _gravityId)
+}
+
+// DeployERC20 is a paid mutator transaction binding the contract method 0xf7955637.
+//
+// Solidity: function deployERC20(string _cosmosDenom, string _name, string _symbol, uint8 _decimals) returns()
+func (_Gravity *GravityTransactor) DeployERC20(opts *bind.TransactOpts, _cosmosDenom string, _name string, _symbol string, _decimals uint8) (*types.Transaction, error) {
+	return _Gravity.contract.Transact(opts, "deployERC20", _cosmosDenom, _name, _symbol, _decimals)
+}
+
+// DeployERC20 is a paid mutator transaction binding the contract method 0xf7955637.
+//
+// Solidity: function deployERC20(string _cosmosDenom, string _name, string _symbol, uint8 _decimals) returns()
+func (_Gravity *GravitySession) DeployERC20(_cosmosDenom string, _name string, _symbol string, _decimals uint8) (*types.Transaction, error) {
+	return _Gravity.Contract.DeployERC20(&_Gravity.TransactOpts, _cosmosDenom, _name, _symbol, _decimals)
+}
+
+// DeployERC20 is a paid mutator transaction binding the contract method 0xf7955637.
+//
+// Solidity: function deployERC20(string _cosmosDenom, string _name, string _symbol, uint8 _decimals) returns()
+func (_Gravity *GravityTransactorSession) DeployERC20(_cosmosDenom string, _name string, _symbol string, _decimals uint8) (*types.Transaction, error) {
+	return _Gravity.Contract.DeployERC20(&_Gravity.TransactOpts, _cosmosDenom, _name, _symbol, _decimals)
+}
+
+// SendToCosmos is a paid mutator transaction binding the contract method 0x0f212357.
+//
+// Solidity: function sendToCosmos(address _tokenContract, string _destination, uint256 _amount) returns()
+func (_Gravity *GravityTransactor) SendToCosmos(opts *bind.TransactOpts, _tokenContract common.Address, _destination string, _amount *big.Int) (*types.Transaction, error) {
+	return _Gravity.contract.Transact(opts, "sendToCosmos", _tokenContract, _destination, _amount)
+}
+
+// SendToCosmos is a paid mutator transaction binding the contract method 0x0f212357.
+//
+// Solidity: function sendToCosmos(address _tokenContract, string _destination, uint256 _amount) returns()
+func (_Gravity *GravitySession) SendToCosmos(_tokenContract common.Address, _destination string, _amount *big.Int) (*types.Transaction, error) {
+	return _Gravity.Contract.SendToCosmos(&_Gravity.TransactOpts, _tokenContract, _destination, _amount)
+}
+
+// SendToCosmos is a paid mutator transaction binding the contract method 0x0f212357.
+//
+// Solidity: function sendToCosmos(address _tokenContract, string _destination, uint256 _amount) returns()
+func (_Gravity *GravityTransactorSession) SendToCosmos(_tokenContract common.Address, _destination string, _amount *big.Int) (*types.Transaction, error) {
+	return _Gravity.Contract.SendToCosmos(&_Gravity.TransactOpts, _tokenContract, _destination, _amount)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x8690ff98.
+//
+// Solidity: function submitBatch((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, uint256[] _amounts, address[] _destinations, uint256[] _fees, uint256 _batchNonce, address _tokenContract, uint256 _batchTimeout) returns()
+func (_Gravity *GravityTransactor) SubmitBatch(opts *bind.TransactOpts, _currentValset ValsetArgs, _sigs []Signature, _amounts []*big.Int, _destinations []common.Address, _fees []*big.Int, _batchNonce *big.Int, _tokenContract common.Address, _batchTimeout *big.Int) (*types.Transaction, error) {
+	return _Gravity.contract.Transact(opts, "submitBatch", _currentValset, _sigs, _amounts, _destinations, _fees, _batchNonce, _tokenContract, _batchTimeout)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x8690ff98.
+//
+// Solidity: function submitBatch((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, uint256[] _amounts, address[] _destinations, uint256[] _fees, uint256 _batchNonce, address _tokenContract, uint256 _batchTimeout) returns()
+func (_Gravity *GravitySession) SubmitBatch(_currentValset ValsetArgs, _sigs []Signature, _amounts []*big.Int, _destinations []common.Address, _fees []*big.Int, _batchNonce *big.Int, _tokenContract common.Address, _batchTimeout *big.Int) (*types.Transaction, error) {
+	return _Gravity.Contract.SubmitBatch(&_Gravity.TransactOpts, _currentValset, _sigs, _amounts, _destinations, _fees, _batchNonce, _tokenContract, _batchTimeout)
+}
+
+// SubmitBatch is a paid mutator transaction binding the contract method 0x8690ff98.
+//
+// Solidity: function submitBatch((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, uint256[] _amounts, address[] _destinations, uint256[] _fees, uint256 _batchNonce, address _tokenContract, uint256 _batchTimeout) returns()
+func (_Gravity *GravityTransactorSession) SubmitBatch(_currentValset ValsetArgs, _sigs []Signature, _amounts []*big.Int, _destinations []common.Address, _fees []*big.Int, _batchNonce *big.Int, _tokenContract common.Address, _batchTimeout *big.Int) (*types.Transaction, error) {
+	return _Gravity.Contract.SubmitBatch(&_Gravity.TransactOpts, _currentValset, _sigs, _amounts, _destinations, _fees, _batchNonce, _tokenContract, _batchTimeout)
+}
+
+// SubmitLogicCall is a paid mutator transaction binding the contract method 0x6941db93.
+//
+// Solidity: function submitLogicCall((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, (uint256[],address[],uint256[],address[],address,bytes,uint256,bytes32,uint256) _args) returns()
+func (_Gravity *GravityTransactor) SubmitLogicCall(opts *bind.TransactOpts, _currentValset ValsetArgs, _sigs []Signature, _args LogicCallArgs) (*types.Transaction, error) {
+	return _Gravity.contract.Transact(opts, "submitLogicCall", _currentValset, _sigs, _args)
+}
+
+// SubmitLogicCall is a paid mutator transaction binding the contract method 0x6941db93.
+//
+// Solidity: function submitLogicCall((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, (uint256[],address[],uint256[],address[],address,bytes,uint256,bytes32,uint256) _args) returns()
+func (_Gravity *GravitySession) SubmitLogicCall(_currentValset ValsetArgs, _sigs []Signature, _args LogicCallArgs) (*types.Transaction, error) {
+	return _Gravity.Contract.SubmitLogicCall(&_Gravity.TransactOpts, _currentValset, _sigs, _args)
+}
+
+// SubmitLogicCall is a paid mutator transaction binding the contract method 0x6941db93.
+//
+// Solidity: function submitLogicCall((address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs, (uint256[],address[],uint256[],address[],address,bytes,uint256,bytes32,uint256) _args) returns()
+func (_Gravity *GravityTransactorSession) SubmitLogicCall(_currentValset ValsetArgs, _sigs []Signature, _args LogicCallArgs) (*types.Transaction, error) {
+	return _Gravity.Contract.SubmitLogicCall(&_Gravity.TransactOpts, _currentValset, _sigs, _args)
+}
+
+// UpdateValset is a paid mutator transaction binding the contract method 0xaca6b1c1.
+//
+// Solidity: function updateValset((address[],uint256[],uint256,uint256,address) _newValset, (address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs) returns()
+func (_Gravity *GravityTransactor) UpdateValset(opts *bind.TransactOpts, _newValset ValsetArgs, _currentValset ValsetArgs, _sigs []Signature) (*types.Transaction, error) {
+	return _Gravity.contract.Transact(opts, "updateValset", _newValset, _currentValset, _sigs)
+}
+
+// UpdateValset is a paid mutator transaction binding the contract method 0xaca6b1c1.
+//
+// Solidity: function updateValset((address[],uint256[],uint256,uint256,address) _newValset, (address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs) returns()
+func (_Gravity *GravitySession) UpdateValset(_newValset ValsetArgs, _currentValset ValsetArgs, _sigs []Signature) (*types.Transaction, error) {
+	return _Gravity.Contract.UpdateValset(&_Gravity.TransactOpts, _newValset, _currentValset, _sigs)
+}
+
+// UpdateValset is a paid mutator transaction binding the contract method 0xaca6b1c1.
+//
+// Solidity: function updateValset((address[],uint256[],uint256,uint256,address) _newValset, (address[],uint256[],uint256,uint256,address) _currentValset, (uint8,bytes32,bytes32)[] _sigs) returns()
+func (_Gravity *GravityTransactorSession) UpdateValset(_newValset ValsetArgs, _currentValset ValsetArgs, _sigs []Signature) (*types.Transaction, error) {
+	return _Gravity.Contract.UpdateValset(&_Gravity.TransactOpts, _newValset, _currentValset, _sigs)
+}
+
+// GravityERC20DeployedEventIterator is returned from FilterERC20DeployedEvent and is used to iterate over the raw logs and unpacked data for ERC20DeployedEvent events raised by the Gravity contract.
+type GravityERC20DeployedEventIterator struct {
+	Event *GravityERC20DeployedEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GravityERC20DeployedEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GravityERC20DeployedEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GravityERC20DeployedEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GravityERC20DeployedEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GravityERC20DeployedEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GravityERC20DeployedEvent represents a ERC20DeployedEvent event raised by the Gravity contract.
+type GravityERC20DeployedEvent struct {
+	CosmosDenom   string
+	TokenContract common.Address
+	Name          string
+	Symbol        string
+	Decimals      uint8
+	EventNonce    *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterERC20DeployedEvent is a free log retrieval operation binding the contract event 0x82fe3a4fa49c6382d0c085746698ddbbafe6c2bf61285b19410644b5b26287c7.
+//
+// Solidity: event ERC20DeployedEvent(string _cosmosDenom, address indexed _tokenContract, string _name, string _symbol, uint8 _decimals, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) FilterERC20DeployedEvent(opts *bind.FilterOpts, _tokenContract []common.Address) (*GravityERC20DeployedEventIterator, error) {
+
+	var _tokenContractRule []interface{}
+	for _, _tokenContractItem := range _tokenContract {
+		_tokenContractRule = append(_tokenContractRule, _tokenContractItem)
+	}
+
+	logs, sub, err := _Gravity.contract.FilterLogs(opts, "ERC20DeployedEvent", _tokenContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GravityERC20DeployedEventIterator{contract: _Gravity.contract, event: "ERC20DeployedEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchERC20DeployedEvent is a free log subscription operation binding the contract event 0x82fe3a4fa49c6382d0c085746698ddbbafe6c2bf61285b19410644b5b26287c7.
+//
+// Solidity: event ERC20DeployedEvent(string _cosmosDenom, address indexed _tokenContract, string _name, string _symbol, uint8 _decimals, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) WatchERC20DeployedEvent(opts *bind.WatchOpts, sink chan<- *GravityERC20DeployedEvent, _tokenContract []common.Address) (event.Subscription, error) {
+
+	var _tokenContractRule []interface{}
+	for _, _tokenContractItem := range _tokenContract {
+		_tokenContractRule = append(_tokenContractRule, _tokenContractItem)
+	}
+
+	logs, sub, err := _Gravity.contract.WatchLogs(opts, "ERC20DeployedEvent", _tokenContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GravityERC20DeployedEvent)
+				if err := _Gravity.contract.UnpackLog(event, "ERC20DeployedEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseERC20DeployedEvent is a log parse operation binding the contract event 0x82fe3a4fa49c6382d0c085746698ddbbafe6c2bf61285b19410644b5b26287c7.
+//
+// Solidity: event ERC20DeployedEvent(string _cosmosDenom, address indexed _tokenContract, string _name, string _symbol, uint8 _decimals, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) ParseERC20DeployedEvent(log types.Log) (*GravityERC20DeployedEvent, error) {
+	event := new(GravityERC20DeployedEvent)
+	if err := _Gravity.contract.UnpackLog(event, "ERC20DeployedEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GravityLogicCallEventIterator is returned from FilterLogicCallEvent and is used to iterate over the raw logs and unpacked data for LogicCallEvent events raised by the Gravity contract.
+type GravityLogicCallEventIterator struct {
+	Event *GravityLogicCallEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GravityLogicCallEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GravityLogicCallEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GravityLogicCallEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GravityLogicCallEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GravityLogicCallEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GravityLogicCallEvent represents a LogicCallEvent event raised by the Gravity contract.
+type GravityLogicCallEvent struct {
+	InvalidationId    [32]byte
+	InvalidationNonce *big.Int
+	ReturnData        []byte
+	EventNonce        *big.Int
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogicCallEvent is a free log retrieval operation binding the contract event 0x7c2bb24f8e1b3725cb613d7f11ef97d9745cc97a0e40f730621c052d684077a1.
+//
+// Solidity: event LogicCallEvent(bytes32 _invalidationId, uint256 _invalidationNonce, bytes _returnData, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) FilterLogicCallEvent(opts *bind.FilterOpts) (*GravityLogicCallEventIterator, error) {
+
+	logs, sub, err := _Gravity.contract.FilterLogs(opts, "LogicCallEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &GravityLogicCallEventIterator{contract: _Gravity.contract, event: "LogicCallEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchLogicCallEvent is a free log subscription operation binding the contract event 0x7c2bb24f8e1b3725cb613d7f11ef97d9745cc97a0e40f730621c052d684077a1.
+//
+// Solidity: event LogicCallEvent(bytes32 _invalidationId, uint256 _invalidationNonce, bytes _returnData, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) WatchLogicCallEvent(opts *bind.WatchOpts, sink chan<- *GravityLogicCallEvent) (event.Subscription, error) {
+
+	logs, sub, err := _Gravity.contract.WatchLogs(opts, "LogicCallEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GravityLogicCallEvent)
+				if err := _Gravity.contract.UnpackLog(event, "LogicCallEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogicCallEvent is a log parse operation binding the contract event 0x7c2bb24f8e1b3725cb613d7f11ef97d9745cc97a0e40f730621c052d684077a1.
+//
+// Solidity: event LogicCallEvent(bytes32 _invalidationId, uint256 _invalidationNonce, bytes _returnData, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) ParseLogicCallEvent(log types.Log) (*GravityLogicCallEvent, error) {
+	event := new(GravityLogicCallEvent)
+	if err := _Gravity.contract.UnpackLog(event, "LogicCallEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GravitySendToCosmosEventIterator is returned from FilterSendToCosmosEvent and is used to iterate over the raw logs and unpacked data for SendToCosmosEvent events raised by the Gravity contract.
+type GravitySendToCosmosEventIterator struct {
+	Event *GravitySendToCosmosEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GravitySendToCosmosEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GravitySendToCosmosEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GravitySendToCosmosEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GravitySendToCosmosEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GravitySendToCosmosEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GravitySendToCosmosEvent represents a SendToCosmosEvent event raised by the Gravity contract.
+type GravitySendToCosmosEvent struct {
+	TokenContract common.Address
+	Sender        common.Address
+	Destination   string
+	Amount        *big.Int
+	EventNonce    *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendToCosmosEvent is a free log retrieval operation binding the contract event 0x9e9794dbf94b0a0aa31a480f5b38550eda7f89115ac8fbf4953fa4dd219900c9.
+//
+// Solidity: event SendToCosmosEvent(address indexed _tokenContract, address indexed _sender, string _destination, uint256 _amount, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) FilterSendToCosmosEvent(opts *bind.FilterOpts, _tokenContract []common.Address, _sender []common.Address) (*GravitySendToCosmosEventIterator, error) {
+
+	var _tokenContractRule []interface{}
+	for _, _tokenContractItem := range _tokenContract {
+		_tokenContractRule = append(_tokenContractRule, _tokenContractItem)
+	}
+	var _senderRule []interface{}
+	for _, _senderItem := range _sender {
+		_senderRule = append(_senderRule, _senderItem)
+	}
+
+	logs, sub, err := _Gravity.contract.FilterLogs(opts, "SendToCosmosEvent", _tokenContractRule, _senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GravitySendToCosmosEventIterator{contract: _Gravity.contract, event: "SendToCosmosEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchSendToCosmosEvent is a free log subscription operation binding the contract event 0x9e9794dbf94b0a0aa31a480f5b38550eda7f89115ac8fbf4953fa4dd219900c9.
+//
+// Solidity: event SendToCosmosEvent(address indexed _tokenContract, address indexed _sender, string _destination, uint256 _amount, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) WatchSendToCosmosEvent(opts *bind.WatchOpts, sink chan<- *GravitySendToCosmosEvent, _tokenContract []common.Address, _sender []common.Address) (event.Subscription, error) {
+
+	var _tokenContractRule []interface{}
+	for _, _tokenContractItem := range _tokenContract {
+		_tokenContractRule = append(_tokenContractRule, _tokenContractItem)
+	}
+	var _senderRule []interface{}
+	for _, _senderItem := range _sender {
+		_senderRule = append(_senderRule, _senderItem)
+	}
+
+	logs, sub, err := _Gravity.contract.WatchLogs(opts, "SendToCosmosEvent", _tokenContractRule, _senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GravitySendToCosmosEvent)
+				if err := _Gravity.contract.UnpackLog(event, "SendToCosmosEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendToCosmosEvent is a log parse operation binding the contract event 0x9e9794dbf94b0a0aa31a480f5b38550eda7f89115ac8fbf4953fa4dd219900c9.
+//
+// Solidity: event SendToCosmosEvent(address indexed _tokenContract, address indexed _sender, string _destination, uint256 _amount, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) ParseSendToCosmosEvent(log types.Log) (*GravitySendToCosmosEvent, error) {
+	event := new(GravitySendToCosmosEvent)
+	if err := _Gravity.contract.UnpackLog(event, "SendToCosmosEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GravityTransactionBatchExecutedEventIterator is returned from FilterTransactionBatchExecutedEvent and is used to iterate over the raw logs and unpacked data for TransactionBatchExecutedEvent events raised by the Gravity contract.
+type GravityTransactionBatchExecutedEventIterator struct {
+	Event *GravityTransactionBatchExecutedEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GravityTransactionBatchExecutedEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GravityTransactionBatchExecutedEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GravityTransactionBatchExecutedEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GravityTransactionBatchExecutedEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GravityTransactionBatchExecutedEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GravityTransactionBatchExecutedEvent represents a TransactionBatchExecutedEvent event raised by the Gravity contract.
+type GravityTransactionBatchExecutedEvent struct {
+	BatchNonce *big.Int
+	Token      common.Address
+	EventNonce *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransactionBatchExecutedEvent is a free log retrieval operation binding the contract event 0x02c7e81975f8edb86e2a0c038b7b86a49c744236abf0f6177ff5afc6986ab708.
+//
+// Solidity: event TransactionBatchExecutedEvent(uint256 indexed _batchNonce, address indexed _token, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) FilterTransactionBatchExecutedEvent(opts *bind.FilterOpts, _batchNonce []*big.Int, _token []common.Address) (*GravityTransactionBatchExecutedEventIterator, error) {
+
+	var _batchNonceRule []interface{}
+	for _, _batchNonceItem := range _batchNonce {
+		_batchNonceRule = append(_batchNonceRule, _batchNonceItem)
+	}
+	var _tokenRule []interface{}
+	for _, _tokenItem := range _token {
+		_tokenRule = append(_tokenRule, _tokenItem)
+	}
+
+	logs, sub, err := _Gravity.contract.FilterLogs(opts, "TransactionBatchExecutedEvent", _batchNonceRule, _tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GravityTransactionBatchExecutedEventIterator{contract: _Gravity.contract, event: "TransactionBatchExecutedEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchTransactionBatchExecutedEvent is a free log subscription operation binding the contract event 0x02c7e81975f8edb86e2a0c038b7b86a49c744236abf0f6177ff5afc6986ab708.
+//
+// Solidity: event TransactionBatchExecutedEvent(uint256 indexed _batchNonce, address indexed _token, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) WatchTransactionBatchExecutedEvent(opts *bind.WatchOpts, sink chan<- *GravityTransactionBatchExecutedEvent, _batchNonce []*big.Int, _token []common.Address) (event.Subscription, error) {
+
+	var _batchNonceRule []interface{}
+	for _, _batchNonceItem := range _batchNonce {
+		_batchNonceRule = append(_batchNonceRule, _batchNonceItem)
+	}
+	var _tokenRule []interface{}
+	for _, _tokenItem := range _token {
+		_tokenRule = append(_tokenRule, _tokenItem)
+	}
+
+	logs, sub, err := _Gravity.contract.WatchLogs(opts, "TransactionBatchExecutedEvent", _batchNonceRule, _tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GravityTransactionBatchExecutedEvent)
+				if err := _Gravity.contract.UnpackLog(event, "TransactionBatchExecutedEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransactionBatchExecutedEvent is a log parse operation binding the contract event 0x02c7e81975f8edb86e2a0c038b7b86a49c744236abf0f6177ff5afc6986ab708.
+//
+// Solidity: event TransactionBatchExecutedEvent(uint256 indexed _batchNonce, address indexed _token, uint256 _eventNonce)
+func (_Gravity *GravityFilterer) ParseTransactionBatchExecutedEvent(log types.Log) (*GravityTransactionBatchExecutedEvent, error) {
+	event := new(GravityTransactionBatchExecutedEvent)
+	if err := _Gravity.contract.UnpackLog(event, "TransactionBatchExecutedEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GravityValsetUpdatedEventIterator is returned from FilterValsetUpdatedEvent and is used to iterate over the raw logs and unpacked data for ValsetUpdatedEvent events raised by the Gravity contract.
+type GravityValsetUpdatedEventIterator struct {
+	Event *GravityValsetUpdatedEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GravityValsetUpdatedEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GravityValsetUpdatedEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GravityValsetUpdatedEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GravityValsetUpdatedEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GravityValsetUpdatedEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GravityValsetUpdatedEvent represents a ValsetUpdatedEvent event raised by the Gravity contract.
+type GravityValsetUpdatedEvent struct {
+	NewValsetNonce *big.Int
+	EventNonce     *big.Int
+	RewardAmount   *big.Int
+	RewardToken    common.Address
+	Validators     []common.Address
+	Powers         []*big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterValsetUpdatedEvent is a free log retrieval operation binding the contract event 0x76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a.
+//
+// Solidity: event ValsetUpdatedEvent(uint256 indexed _newValsetNonce, uint256 _eventNonce, uint256 _rewardAmount, address _rewardToken, address[] _validators, uint256[] _powers)
+func (_Gravity *GravityFilterer) FilterValsetUpdatedEvent(opts *bind.FilterOpts, _newValsetNonce []*big.Int) (*GravityValsetUpdatedEventIterator, error) {
+
+	var _newValsetNonceRule []interface{}
+	for _, _newValsetNonceItem := range _newValsetNonce {
+		_newValsetNonceRule = append(_newValsetNonceRule, _newValsetNonceItem)
+	}
+
+	logs, sub, err := _Gravity.contract.FilterLogs(opts, "ValsetUpdatedEvent", _newValsetNonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GravityValsetUpdatedEventIterator{contract: _Gravity.contract, event: "ValsetUpdatedEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchValsetUpdatedEvent is a free log subscription operation binding the contract event 0x76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a.
+//
+// Solidity: event ValsetUpdatedEvent(uint256 indexed _newValsetNonce, uint256 _eventNonce, uint256 _rewardAmount, address _rewardToken, address[] _validators, uint256[] _powers)
+func (_Gravity *GravityFilterer) WatchValsetUpdatedEvent(opts *bind.WatchOpts, sink chan<- *GravityValsetUpdatedEvent, _newValsetNonce []*big.Int) (event.Subscription, error) {
+
+	var _newValsetNonceRule []interface{}
+	for _, _newValsetNonceItem := range _newValsetNonce {
+		_newValsetNonceRule = append(_newValsetNonceRule, _newValsetNonceItem)
+	}
+
+	logs, sub, err := _Gravity.contract.WatchLogs(opts, "ValsetUpdatedEvent", _newValsetNonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GravityValsetUpdatedEvent)
+				if err := _Gravity.contract.UnpackLog(event, "ValsetUpdatedEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValsetUpdatedEvent is a log parse operation binding the contract event 0x76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a.
+//
+// Solidity: event ValsetUpdatedEvent(uint256 indexed _newValsetNonce, uint256 _eventNonce, uint256 _rewardAmount, address _rewardToken, address[] _validators, uint256[] _powers)
+func (_Gravity *GravityFilterer) ParseValsetUpdatedEvent(log types.Log) (*GravityValsetUpdatedEvent, error) {
+	event := new(GravityValsetUpdatedEvent)
+	if err := _Gravity.contract.UnpackLog(event, "ValsetUpdatedEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
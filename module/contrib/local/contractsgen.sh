@@ -0,0 +1,33 @@
+#!/usr/bin/env bash
+
+set -eo pipefail
+
+if ! command -v abigen &> /dev/null; then
+	echo "abigen not found, install it with: go install github.com/ethereum/go-ethereum/cmd/abigen@v1.10.10"
+	exit 1
+fi
+
+artifacts=../solidity/artifacts/contracts
+
+# contract name, hardhat artifact and output file
+contracts=(
+	"Gravity Gravity.sol/Gravity.json gravity.go"
+	"CosmosERC20 CosmosToken.sol/CosmosERC20.json cosmos_erc20.go"
+)
+
+for contract in "${contracts[@]}"; do
+	read -r name artifact out <<< "$contract"
+
+	# refresh the versioned abi and bytecode from a local `npx hardhat compile`
+	if [ -f "$artifacts/$artifact" ]; then
+		jq '.abi' "$artifacts/$artifact" > "contracts/abi/$name.abi"
+		jq -r '.bytecode' "$artifacts/$artifact" > "contracts/abi/$name.bin"
+	fi
+
+	bin=""
+	if [ -f "contracts/abi/$name.bin" ]; then
+		bin="--bin contracts/abi/$name.bin"
+	fi
+
+	abigen --abi "contracts/abi/$name.abi" $bin --pkg contracts --type "$name" --out "contracts/$out"
+done
@@ -0,0 +1,140 @@
+package integration
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+const ethereumDest = "0x3b7F1bd09a2E1d1D1E7fE6d9A0f5E7bD1dB4cE21"
+
+// TestBridgeEndToEnd moves tokens from the Gravity.sol contract to Cosmos and back, with every validator attesting
+// to the contract events and confirming the valset and the batch relayed to the contract
+func TestBridgeEndToEnd(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	h := newHarness(t, 2)
+	gravityID := h.params().GravityId
+
+	// the bootstrap valset is available right after genesis, deploy the contract against it
+	valset := h.valset(1)
+	require.Len(t, valset.Members, len(h.orchestrators))
+	eth := newEthereum(t, gravityID, valset)
+	deployed := valset
+	deployed.Nonce = 0
+	deployed.RewardAmount = sdk.ZeroInt()
+	deployed.RewardToken = types.ZeroAddressString
+
+	tokenAddress, token := eth.deployERC20()
+	erc20, err := types.NewEthAddress(tokenAddress.Hex())
+	require.NoError(t, err)
+	denom := types.GravityDenom(*erc20)
+	receiver := h.orchestrators[0].Address
+
+	t.Run("deposit, attest and mint", func(t *testing.T) {
+		eth.sendToCosmos(tokenAddress, token, receiver, 1000)
+		// the deployment valset and the deposit
+		claims := eth.claims()
+		require.Len(t, claims, 2)
+		h.attest(claims)
+
+		require.Equal(t, sdk.NewInt(1000), h.balance(receiver, denom))
+	})
+
+	t.Run("confirm and update the valset", func(t *testing.T) {
+		checkpoint := valset.GetCheckpoint(gravityID)
+		for _, orch := range h.orchestrators {
+			signature, err := types.NewEthereumSignature(checkpoint, orch.ethKey)
+			require.NoError(t, err)
+			h.broadcast(orch, &types.MsgValsetConfirm{
+				Nonce:        valset.Nonce,
+				Orchestrator: orch.Address.String(),
+				EthAddress:   orch.ethAddress,
+				Signature:    hex.EncodeToString(signature),
+			})
+		}
+
+		confirms, err := h.gravityQuery.ValsetConfirmsByNonce(context.Background(),
+			&types.QueryValsetConfirmsByNonceRequest{Nonce: valset.Nonce})
+		require.NoError(t, err)
+		require.Len(t, confirms.Confirms, len(h.orchestrators))
+
+		// a single validator doesn't hold enough power to update the valset
+		require.Error(t, eth.updateValset(valset, deployed, confirms.Confirms[:1]))
+		require.NoError(t, eth.updateValset(valset, deployed, confirms.Confirms))
+
+		claims := eth.claims()
+		require.Len(t, claims, 1)
+		h.attest(claims)
+
+		res, err := h.gravityQuery.BootstrapInfo(context.Background(), &types.QueryBootstrapInfoRequest{
+			OrchestratorAddress: h.orchestrators[0].Address.String(),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res.LastObservedValset)
+		require.Equal(t, valset.Nonce, res.LastObservedValset.Nonce)
+	})
+
+	t.Run("send, batch, confirm and execute", func(t *testing.T) {
+		sender := h.orchestrators[0]
+		h.broadcast(sender, &types.MsgSendToEth{
+			Sender:    sender.Address.String(),
+			EthDest:   ethereumDest,
+			Amount:    sdk.NewInt64Coin(denom, 600),
+			BridgeFee: sdk.NewInt64Coin(denom, 50),
+		})
+		h.broadcast(sender, &types.MsgRequestBatch{Sender: sender.Address.String(), Denom: denom})
+		require.Equal(t, sdk.NewInt(350), h.balance(receiver, denom))
+
+		batches, err := h.gravityQuery.OutgoingTxBatches(context.Background(), &types.QueryOutgoingTxBatchesRequest{})
+		require.NoError(t, err)
+		require.Len(t, batches.Batches, 1)
+		batch := batches.Batches[0]
+
+		checkpoint := batch.GetCheckpoint(gravityID)
+		for _, orch := range h.orchestrators {
+			signature, err := types.NewEthereumSignature(checkpoint, orch.ethKey)
+			require.NoError(t, err)
+			h.broadcast(orch, &types.MsgConfirmBatch{
+				Nonce:         batch.BatchNonce,
+				TokenContract: batch.TokenContract,
+				EthSigner:     orch.ethAddress,
+				Orchestrator:  orch.Address.String(),
+				Signature:     hex.EncodeToString(signature),
+			})
+		}
+
+		confirms, err := h.gravityQuery.BatchConfirms(context.Background(), &types.QueryBatchConfirmsRequest{
+			Nonce:           batch.BatchNonce,
+			ContractAddress: batch.TokenContract,
+		})
+		require.NoError(t, err)
+		require.Len(t, confirms.Confirms, len(h.orchestrators))
+
+		// a single validator doesn't hold enough power to relay the batch
+		require.Error(t, eth.submitBatch(batch, valset, confirms.Confirms[:1]))
+
+		require.NoError(t, eth.submitBatch(batch, valset, confirms.Confirms))
+		require.Equal(t, sdk.NewInt(600), eth.balanceOf(token, common.HexToAddress(ethereumDest)))
+		require.Equal(t, sdk.NewInt(50), eth.balanceOf(token, eth.relayer.From))
+
+		// replaying the executed batch is rejected by the contract
+		require.Error(t, eth.submitBatch(batch, valset, confirms.Confirms))
+
+		claims := eth.claims()
+		require.Len(t, claims, 1)
+		h.attest(claims)
+
+		batches, err = h.gravityQuery.OutgoingTxBatches(context.Background(), &types.QueryOutgoingTxBatchesRequest{})
+		require.NoError(t, err)
+		require.Empty(t, batches.Batches)
+	})
+}
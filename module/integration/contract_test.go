@@ -0,0 +1,274 @@
+package integration
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/contracts"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// simulatedChainID is the chain id of the go-ethereum simulated backend
+const simulatedChainID = 1337
+
+// ethereum is a simulated Ethereum chain running the compiled Gravity.sol contract, so that the checks of the contract
+// (nonces, timeouts, the valset checkpoint and the signature power threshold) are the ones the Cosmos side is tested
+// against. It reads the claims the orchestrators submit from the contract events, the way the orchestrator oracle does
+type ethereum struct {
+	t        *testing.T
+	backend  *backends.SimulatedBackend
+	deployer *bind.TransactOpts
+	relayer  *bind.TransactOpts
+	address  common.Address
+	gravity  *contracts.Gravity
+	// lastBlock is the last block whose events were turned into claims
+	lastBlock uint64
+}
+
+// newEthereum deploys Gravity.sol against valset, the way the contract deployer uses the latest valset of the Cosmos
+// chain as constructor arguments. The contract starts at valset nonce 0, the valset has to be relayed to reach its nonce
+func newEthereum(t *testing.T, gravityID string, valset types.Valset) *ethereum {
+	t.Helper()
+
+	deployer, relayer := newTransactor(t), newTransactor(t)
+	balance := new(big.Int).Exp(big.NewInt(10), big.NewInt(24), nil)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		deployer.From: {Balance: balance},
+		relayer.From:  {Balance: balance},
+	}, 30000000)
+	t.Cleanup(func() { backend.Close() })
+
+	var id [32]byte
+	copy(id[:], gravityID)
+	args := valsetArgs(t, types.Valset{
+		Nonce:        0,
+		Members:      valset.Members,
+		Height:       0,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+	})
+	address, _, gravity, err := contracts.DeployGravity(deployer, backend, id, args.Validators, args.Powers, common.Address{})
+	require.NoError(t, err)
+	backend.Commit()
+
+	return &ethereum{
+		t:         t,
+		backend:   backend,
+		deployer:  deployer,
+		relayer:   relayer,
+		address:   address,
+		gravity:   gravity,
+		lastBlock: 0,
+	}
+}
+
+// newTransactor returns the transact options of a new key
+func newTransactor(t *testing.T) *bind.TransactOpts {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(simulatedChainID))
+	require.NoError(t, err)
+	return opts
+}
+
+// deployERC20 deploys an ERC20 whose whole supply is held by the deployer
+func (e *ethereum) deployERC20() (common.Address, *contracts.CosmosERC20) {
+	e.t.Helper()
+	address, _, token, err := contracts.DeployCosmosERC20(e.deployer, e.backend, e.deployer.From, "Test", "TST", 18)
+	require.NoError(e.t, err)
+	e.backend.Commit()
+	return address, token
+}
+
+// sendToCosmos deposits amount of the deployer's token to receiver through the contract
+func (e *ethereum) sendToCosmos(tokenAddress common.Address, token *contracts.CosmosERC20, receiver sdk.AccAddress, amount int64) {
+	e.t.Helper()
+	e.transact(token.Approve(e.deployer, e.address, big.NewInt(amount)))
+	e.transact(e.gravity.SendToCosmos(e.deployer, tokenAddress, receiver.String(), big.NewInt(amount)))
+}
+
+// updateValset relays valset, signed by the given confirms of the members of current
+func (e *ethereum) updateValset(valset, current types.Valset, confirms []types.MsgValsetConfirm) error {
+	e.t.Helper()
+	signatures := make(map[string]string, len(confirms))
+	for _, confirm := range confirms {
+		signatures[confirm.EthAddress] = confirm.Signature
+	}
+	tx, err := e.gravity.UpdateValset(e.relayer, valsetArgs(e.t, valset), valsetArgs(e.t, current), e.signatures(current, signatures))
+	if err != nil {
+		return err
+	}
+	e.transact(tx, nil)
+	return nil
+}
+
+// submitBatch relays batch, signed by the given confirms of the members of current
+func (e *ethereum) submitBatch(batch types.OutgoingTxBatch, current types.Valset, confirms []types.MsgConfirmBatch) error {
+	e.t.Helper()
+	signatures := make(map[string]string, len(confirms))
+	for _, confirm := range confirms {
+		signatures[confirm.EthSigner] = confirm.Signature
+	}
+	var (
+		amounts      []*big.Int
+		destinations []common.Address
+		fees         []*big.Int
+	)
+	for _, tx := range batch.Transactions {
+		amounts = append(amounts, tx.Erc20Token.Amount.BigInt())
+		destinations = append(destinations, common.HexToAddress(tx.DestAddress))
+		fees = append(fees, tx.Erc20Fee.Amount.BigInt())
+	}
+	tx, err := e.gravity.SubmitBatch(
+		e.relayer, valsetArgs(e.t, current), e.signatures(current, signatures), amounts, destinations, fees,
+		new(big.Int).SetUint64(batch.BatchNonce), common.HexToAddress(batch.TokenContract),
+		new(big.Int).SetUint64(batch.BatchTimeout),
+	)
+	if err != nil {
+		return err
+	}
+	e.transact(tx, nil)
+	return nil
+}
+
+// signatures orders the signatures by the members of valset, the members which did not sign are left with a zero v
+// which the contract skips
+func (e *ethereum) signatures(valset types.Valset, signatures map[string]string) []contracts.Signature {
+	e.t.Helper()
+	out := make([]contracts.Signature, len(valset.Members))
+	for i, member := range valset.Members {
+		signature, ok := signatures[member.EthereumAddress]
+		if !ok {
+			continue
+		}
+		sigBytes, err := hex.DecodeString(signature)
+		require.NoError(e.t, err)
+		require.Len(e.t, sigBytes, 65)
+		copy(out[i].R[:], sigBytes[:32])
+		copy(out[i].S[:], sigBytes[32:64])
+		// the Cosmos side stores the 0/1 recovery id, the contract expects the legacy 27/28 one
+		out[i].V = sigBytes[64]
+		if out[i].V < 27 {
+			out[i].V += 27
+		}
+	}
+	return out
+}
+
+// transact mines tx and requires it succeeded
+func (e *ethereum) transact(tx *gethtypes.Transaction, err error) {
+	e.t.Helper()
+	require.NoError(e.t, err)
+	e.backend.Commit()
+	receipt, err := e.backend.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(e.t, err)
+	require.Equal(e.t, gethtypes.ReceiptStatusSuccessful, receipt.Status)
+}
+
+// balanceOf returns the token balance of holder
+func (e *ethereum) balanceOf(token *contracts.CosmosERC20, holder common.Address) sdk.Int {
+	e.t.Helper()
+	balance, err := token.BalanceOf(&bind.CallOpts{}, holder)
+	require.NoError(e.t, err)
+	return sdk.NewIntFromBigInt(balance)
+}
+
+// claims returns the claims of the contract events emitted since the last call, by event nonce. The orchestrator is
+// left for the caller to fill in
+func (e *ethereum) claims() []types.EthereumClaim {
+	e.t.Helper()
+	head := e.backend.Blockchain().CurrentBlock().NumberU64()
+	opts := &bind.FilterOpts{Start: e.lastBlock + 1, End: &head}
+	e.lastBlock = head
+
+	var claims []types.EthereumClaim
+	valsets, err := e.gravity.FilterValsetUpdatedEvent(opts, nil)
+	require.NoError(e.t, err)
+	for valsets.Next() {
+		event := valsets.Event
+		members := make([]types.BridgeValidator, len(event.Validators))
+		for i := range event.Validators {
+			members[i] = types.BridgeValidator{Power: event.Powers[i].Uint64(), EthereumAddress: event.Validators[i].Hex()}
+		}
+		claims = append(claims, &types.MsgValsetUpdatedClaim{
+			EventNonce:   event.EventNonce.Uint64(),
+			ValsetNonce:  event.NewValsetNonce.Uint64(),
+			BlockHeight:  event.Raw.BlockNumber,
+			Members:      members,
+			RewardAmount: sdk.NewIntFromBigInt(event.RewardAmount),
+			RewardToken:  event.RewardToken.Hex(),
+			BlockHash:    event.Raw.BlockHash.Hex(),
+		})
+	}
+	require.NoError(e.t, valsets.Error())
+
+	deposits, err := e.gravity.FilterSendToCosmosEvent(opts, nil, nil)
+	require.NoError(e.t, err)
+	for deposits.Next() {
+		event := deposits.Event
+		claims = append(claims, &types.MsgSendToCosmosClaim{
+			EventNonce:     event.EventNonce.Uint64(),
+			BlockHeight:    event.Raw.BlockNumber,
+			TokenContract:  event.TokenContract.Hex(),
+			Amount:         sdk.NewIntFromBigInt(event.Amount),
+			EthereumSender: event.Sender.Hex(),
+			CosmosReceiver: event.Destination,
+			BlockHash:      event.Raw.BlockHash.Hex(),
+		})
+	}
+	require.NoError(e.t, deposits.Error())
+
+	batches, err := e.gravity.FilterTransactionBatchExecutedEvent(opts, nil, nil)
+	require.NoError(e.t, err)
+	for batches.Next() {
+		event := batches.Event
+		tx, _, err := e.backend.TransactionByHash(context.Background(), event.Raw.TxHash)
+		require.NoError(e.t, err)
+		sender, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		require.NoError(e.t, err)
+		claims = append(claims, &types.MsgBatchSendToEthClaim{
+			EventNonce:    event.EventNonce.Uint64(),
+			BlockHeight:   event.Raw.BlockNumber,
+			BatchNonce:    event.BatchNonce.Uint64(),
+			TokenContract: event.Token.Hex(),
+			Relayer:       sender.Hex(),
+			BlockHash:     event.Raw.BlockHash.Hex(),
+		})
+	}
+	require.NoError(e.t, batches.Error())
+
+	sort.Slice(claims, func(i, j int) bool { return claims[i].GetEventNonce() < claims[j].GetEventNonce() })
+	return claims
+}
+
+// valsetArgs converts valset to the ValsetArgs of the contract
+func valsetArgs(t *testing.T, valset types.Valset) contracts.ValsetArgs {
+	args := contracts.ValsetArgs{
+		Validators:   make([]common.Address, len(valset.Members)),
+		Powers:       make([]*big.Int, len(valset.Members)),
+		ValsetNonce:  new(big.Int).SetUint64(valset.Nonce),
+		RewardAmount: big.NewInt(0),
+		RewardToken:  common.HexToAddress(valset.RewardToken),
+	}
+	for i, member := range valset.Members {
+		require.True(t, common.IsHexAddress(member.EthereumAddress))
+		args.Validators[i] = common.HexToAddress(member.EthereumAddress)
+		args.Powers[i] = new(big.Int).SetUint64(member.Power)
+	}
+	if !valset.RewardAmount.IsNil() {
+		args.RewardAmount = valset.RewardAmount.BigInt()
+	}
+	return args
+}
@@ -0,0 +1,12 @@
+/*
+Package integration holds end to end tests of the gravity module which run entirely in `go test`.
+
+The Cosmos side is a full gravity app network started with in-process Tendermint nodes, every
+validator has its delegate keys set in genesis and acts as its own orchestrator. The Ethereum side
+is a go-ethereum simulated backend running the compiled Gravity.sol contract, the valsets and
+batches confirmed on the Cosmos side are relayed to it and the orchestrators attest to the events
+it emits. No Rust orchestrator or Ethereum node is required.
+
+The tests are skipped with `go test -short`.
+*/
+package integration
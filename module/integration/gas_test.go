@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// TestSendToEthGasEstimation checks the simulated gas of MsgSendToEth covers the gas used once the
+// transaction is delivered, pool insertion included, without a gas adjustment
+func TestSendToEthGasEstimation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	h := newHarness(t, 1)
+	eth := newEthereum(t, h.params().GravityId, h.valset(1))
+	tokenAddress, token := eth.deployERC20()
+	erc20, err := types.NewEthAddress(tokenAddress.Hex())
+	require.NoError(t, err)
+	denom := types.GravityDenom(*erc20)
+	sender := h.orchestrators[0]
+
+	eth.sendToCosmos(tokenAddress, token, sender.Address, 1000)
+	h.attest(eth.claims())
+
+	// the pool grows with every transfer, the estimate has to follow it
+	for i := 0; i < 3; i++ {
+		msg := &types.MsgSendToEth{
+			Sender:    sender.Address.String(),
+			EthDest:   ethereumDest,
+			Amount:    sdk.NewInt64Coin(denom, 100),
+			BridgeFee: sdk.NewInt64Coin(denom, 10),
+		}
+		estimated := h.simulate(sender, msg)
+		used := uint64(h.broadcast(sender, msg).GasUsed)
+
+		require.GreaterOrEqual(t, used, uint64(keeper.OutgoingTxPoolInsertionGas))
+		require.GreaterOrEqual(t, estimated, used)
+		// the simulated tx carries no signature, the ante handler charges for a placeholder one
+		require.InDelta(t, used, estimated, float64(used)/20)
+	}
+}
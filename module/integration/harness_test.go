@@ -0,0 +1,236 @@
+package integration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/onomyprotocol/arc/module/eth/app"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// orchestrator is a network validator together with the Ethereum key it signs confirms with,
+// the validator account is also used as the orchestrator address
+type orchestrator struct {
+	*network.Validator
+	ethKey     *ecdsa.PrivateKey
+	ethAddress string
+}
+
+// harness runs an in-process gravity network
+type harness struct {
+	t             *testing.T
+	network       *network.Network
+	orchestrators []orchestrator
+	gravityQuery  types.QueryClient
+	bankQuery     banktypes.QueryClient
+}
+
+// newHarness starts a network of numValidators gravity validators, all of them with delegate keys
+// registered in the gravity genesis
+func newHarness(t *testing.T, numValidators int) *harness {
+	t.Helper()
+
+	encCfg := app.MakeEncodingConfig()
+	cfg := network.DefaultConfig()
+	cfg.Codec = encCfg.Marshaler
+	cfg.TxConfig = encCfg.TxConfig
+	cfg.LegacyAmino = encCfg.Amino
+	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return app.NewGravityApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, map[int64]bool{}, val.Ctx.Config.RootDir, 0,
+			encCfg,
+			simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+	cfg.GenesisState = app.ModuleBasics.DefaultGenesis(encCfg.Marshaler)
+	cfg.NumValidators = numValidators
+	cfg.TimeoutCommit = 500 * time.Millisecond
+	cfg.MinGasPrices = fmt.Sprintf("0%s", cfg.BondDenom)
+
+	// the network derives the validator keys from these mnemonics, which lets us register
+	// the delegate keys of every validator in genesis before it is started
+	kb := keyring.NewInMemory()
+	gravityGenesis := types.DefaultGenesisState()
+	ethKeys := make([]*ecdsa.PrivateKey, numValidators)
+	for i := 0; i < numValidators; i++ {
+		info, mnemonic, err := kb.NewMnemonic(
+			fmt.Sprintf("node%d", i), keyring.English, sdk.GetConfig().GetFullFundraiserPath(),
+			keyring.DefaultBIP39Passphrase, hd.Secp256k1,
+		)
+		require.NoError(t, err)
+		cfg.Mnemonics = append(cfg.Mnemonics, mnemonic)
+
+		ethKeys[i], err = gethcrypto.GenerateKey()
+		require.NoError(t, err)
+		gravityGenesis.DelegateKeys = append(gravityGenesis.DelegateKeys, types.MsgSetOrchestratorAddress{
+			Validator:    sdk.ValAddress(info.GetAddress()).String(),
+			Orchestrator: info.GetAddress().String(),
+			EthAddress:   gethcrypto.PubkeyToAddress(ethKeys[i].PublicKey).Hex(),
+		})
+	}
+	cfg.GenesisState[types.ModuleName] = cfg.Codec.MustMarshalJSON(gravityGenesis)
+
+	net := network.New(t, cfg)
+	t.Cleanup(net.Cleanup)
+	_, err := net.WaitForHeight(1)
+	require.NoError(t, err)
+
+	h := &harness{
+		t:            t,
+		network:      net,
+		gravityQuery: types.NewQueryClient(net.Validators[0].ClientCtx),
+		bankQuery:    banktypes.NewQueryClient(net.Validators[0].ClientCtx),
+	}
+	for i, val := range net.Validators {
+		h.orchestrators = append(h.orchestrators, orchestrator{
+			Validator:  val,
+			ethKey:     ethKeys[i],
+			ethAddress: gethcrypto.PubkeyToAddress(ethKeys[i].PublicKey).Hex(),
+		})
+	}
+	return h
+}
+
+// broadcast signs msgs with the key of orch and broadcasts them in block mode, only the
+// first validator serves RPC so every transaction goes through its node
+func (h *harness) broadcast(orch orchestrator, msgs ...sdk.Msg) *sdk.TxResponse {
+	h.t.Helper()
+
+	clientCtx := orch.ClientCtx.
+		WithClient(h.network.Validators[0].RPCClient).
+		WithFromAddress(orch.Address).
+		WithFromName(orch.Moniker).
+		WithBroadcastMode(flags.BroadcastBlock)
+
+	txf, err := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithKeybase(clientCtx.Keyring).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithGas(1000000).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
+		Prepare(clientCtx)
+	require.NoError(h.t, err)
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	require.NoError(h.t, err)
+	require.NoError(h.t, tx.Sign(txf, orch.Moniker, txBuilder, true))
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(h.t, err)
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	require.NoError(h.t, err)
+	require.Equal(h.t, uint32(0), res.Code, res.RawLog)
+	return res
+}
+
+// simulate returns the gas the tx service estimates for msgs signed by orch, the way wallets
+// estimate it before broadcasting
+func (h *harness) simulate(orch orchestrator, msgs ...sdk.Msg) uint64 {
+	h.t.Helper()
+
+	clientCtx := orch.ClientCtx.
+		WithClient(h.network.Validators[0].RPCClient).
+		WithFromAddress(orch.Address).
+		WithFromName(orch.Moniker)
+
+	txf, err := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithKeybase(clientCtx.Keyring).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
+		Prepare(clientCtx)
+	require.NoError(h.t, err)
+
+	txBytes, err := tx.BuildSimTx(txf, msgs...)
+	require.NoError(h.t, err)
+	res, err := txtypes.NewServiceClient(clientCtx).Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: txBytes})
+	require.NoError(h.t, err)
+	return res.GasInfo.GasUsed
+}
+
+// waitForNextBlock makes sure the EndBlocker of the block including the last broadcast
+// transactions has been committed before state is queried
+func (h *harness) waitForNextBlock() {
+	h.t.Helper()
+	require.NoError(h.t, h.network.WaitForNextBlock())
+}
+
+// params returns the gravity params of the network
+func (h *harness) params() types.Params {
+	h.t.Helper()
+	res, err := h.gravityQuery.Params(context.Background(), &types.QueryParamsRequest{})
+	require.NoError(h.t, err)
+	return res.Params
+}
+
+// valset returns the stored valset with the given nonce
+func (h *harness) valset(nonce uint64) types.Valset {
+	h.t.Helper()
+	res, err := h.gravityQuery.ValsetRequest(context.Background(), &types.QueryValsetRequestRequest{Nonce: nonce})
+	require.NoError(h.t, err)
+	require.NotNil(h.t, res.Valset, "valset %d not found", nonce)
+	return *res.Valset
+}
+
+// balance returns the balance of denom held by addr
+func (h *harness) balance(addr sdk.AccAddress, denom string) sdk.Int {
+	h.t.Helper()
+	res, err := h.bankQuery.Balance(context.Background(), banktypes.NewQueryBalanceRequest(addr, denom))
+	require.NoError(h.t, err)
+	return res.Balance.Amount
+}
+
+// attest has every orchestrator submit claims, in event nonce order and in a single transaction, the way the
+// orchestrators relay the events they read from the contract
+func (h *harness) attest(claims []types.EthereumClaim) {
+	h.t.Helper()
+	require.NotEmpty(h.t, claims)
+	for _, orch := range h.orchestrators {
+		msgs := make([]sdk.Msg, len(claims))
+		for i, claim := range claims {
+			switch claim := claim.(type) {
+			case *types.MsgValsetUpdatedClaim:
+				c := *claim
+				c.Orchestrator = orch.Address.String()
+				msgs[i] = &c
+			case *types.MsgSendToCosmosClaim:
+				c := *claim
+				c.Orchestrator = orch.Address.String()
+				msgs[i] = &c
+			case *types.MsgBatchSendToEthClaim:
+				c := *claim
+				c.Orchestrator = orch.Address.String()
+				msgs[i] = &c
+			default:
+				h.t.Fatalf("unexpected claim %T", claim)
+			}
+		}
+		h.broadcast(orch, msgs...)
+	}
+	h.waitForNextBlock()
+}
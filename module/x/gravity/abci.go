@@ -1,6 +1,10 @@
 package gravity
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -10,14 +14,46 @@ import (
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	measureEndBlockStep("pending_param_changes", func() { k.ApplyPendingParamChanges(ctx) })
+	measureEndBlockStep("bridge_binding", func() { k.BindBridgeIfUnbound(ctx) })
 	params := k.GetParams(ctx)
-	slashing(ctx, k)
-	attestationTally(ctx, k)
-	cleanupTimedOutBatches(ctx, k)
-	cleanupTimedOutLogicCalls(ctx, k)
-	createValsets(ctx, k)
-	pruneValsets(ctx, k, params)
-	pruneAttestations(ctx, k)
+	measureEndBlockStep("slashing", func() { slashing(ctx, k) })
+	measureEndBlockStep("attestation_tally", func() { attestationTally(ctx, k) })
+	measureEndBlockStep("scheduled_transactions", func() { k.ReleaseScheduledTransactions(ctx) })
+	measureEndBlockStep("recurring_sends", func() { k.SendRecurringSendsToEth(ctx) })
+	measureEndBlockStep("batch_timeouts", func() { cleanupTimedOutBatches(ctx, k) })
+	measureEndBlockStep("logic_call_timeouts", func() { cleanupTimedOutLogicCalls(ctx, k) })
+	measureEndBlockStep("batch_relay_latency", func() { checkBatchRelayLatency(ctx, k, params) })
+	measureEndBlockStep("valset_creation", func() { createValsets(ctx, k) })
+	measureEndBlockStep("valset_pruning", func() { k.PruneValsets(ctx, params) })
+	measureEndBlockStep("attestation_pruning", func() { pruneAttestations(ctx, k) })
+	measureEndBlockStep("batch_archiving", func() { k.ArchiveExecutedBatches(ctx, params) })
+	measureEndBlockStep("bridge_usage_pruning", func() { k.PruneBridgeUsage(ctx, params) })
+	measureEndBlockStep("expedited_proposals", func() { k.CheckExpeditedProposals(ctx, params) })
+	measureEndBlockStep("store_metrics", func() { reportStoreMetrics(ctx, k) })
+	measureEndBlockStep("bridge_checkpoint", func() { k.UpdateBridgeCheckpoint(ctx) })
+}
+
+// measureEndBlockStep runs a step of the EndBlocker and reports its duration as the end_blocker_<step> telemetry
+// summary of the gravity module. Only the wall clock is read, which never reaches the state, so nodes with and without
+// telemetry stay in consensus
+func measureEndBlockStep(step string, run func()) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker, step)
+	run()
+}
+
+// reportStoreMetrics reports the store metrics as telemetry gauges every StoreMetricsTelemetryInterval blocks, on
+// nodes which enabled it. It only reads the store so that nodes with and without telemetry stay in consensus
+func reportStoreMetrics(ctx sdk.Context, k keeper.Keeper) {
+	if !k.StoreMetricsTelemetry() || ctx.BlockHeight()%keeper.StoreMetricsTelemetryInterval != 0 {
+		return
+	}
+	for _, metric := range k.GetStoreMetrics(ctx) {
+		telemetry.ModuleSetGauge(types.ModuleName, float32(metric.Count), "store", metric.Kind, "count")
+		telemetry.ModuleSetGauge(types.ModuleName, float32(metric.Bytes), "store", metric.Kind, "bytes")
+	}
 }
 
 func createValsets(ctx sdk.Context, k keeper.Keeper) {
@@ -27,11 +63,26 @@ func createValsets(ctx sdk.Context, k keeper.Keeper) {
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an attestation to a new Valset
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of CurrentValset and latest valset request is > 5%
+	// 3. If a validator holding more than the ValsetRequestSlashPowerThreshold of the latest valset power was slashed
+	//      in the current block. (we persist the block height in hooks.go) Slashing is followed by jailing or tombstoning
+	//      so this removes the validator's key from the Ethereum checkpoint as soon as possible.
+	// 4. If power change between validators of CurrentValset and latest valset request is > 5%
+	// None of these apply while the latest valset is an emergency valset published by governance which was not yet
+	// observed on Ethereum, only its observation is read so the warning below does not apply.
+	// No valset is created before MinBridgeValidators validators registered their delegate keys, the checkpoint of a
+	// bootstrapping network would otherwise be controlled by its first validator.
+
+	if k.StoreDueEmergencyValset(ctx) != nil || k.IsEmergencyValsetUnobserved(ctx) {
+		return
+	}
+	if !k.HasMinBridgeValidators(ctx) {
+		return
+	}
 
 	// get the last valsets to compare against
 	latestValset := k.GetLatestValset(ctx)
 	lastUnbondingHeight := k.GetLastUnBondingBlockHeight(ctx)
+	lastSlashRequestHeight := k.GetLastSlashValsetRequestBlockHeight(ctx)
 
 	significantPowerDiff := false
 	if latestValset != nil {
@@ -59,34 +110,13 @@ func createValsets(ctx sdk.Context, k keeper.Keeper) {
 		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers) > 0.05
 	}
 
-	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff {
+	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) ||
+		(lastSlashRequestHeight == uint64(ctx.BlockHeight())) || significantPowerDiff {
 		// if the conditions are true, put in a new validator set request to be signed and submitted to Ethereum
 		k.SetValsetRequest(ctx)
 	}
 }
 
-func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
-	// Validator set pruning
-	// prune all validator sets with a nonce less than the
-	// last observed nonce, they can't be submitted any longer
-	//
-	// Only prune valsets after the signed valsets window has passed
-	// so that slashing can occur the block before we remove them
-	lastObserved := k.GetLastObservedValset(ctx)
-	currentBlock := uint64(ctx.BlockHeight())
-	tooEarly := currentBlock < params.SignedValsetsWindow
-	if lastObserved != nil && !tooEarly {
-		earliestToPrune := currentBlock - params.SignedValsetsWindow
-		sets := k.GetValsets(ctx)
-		for _, set := range sets {
-			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
-				k.DeleteValset(ctx, set.Nonce)
-				k.DeleteValsetConfirms(ctx, set.Nonce)
-			}
-		}
-	}
-}
-
 func slashing(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 
@@ -100,12 +130,26 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 // "Observe" those who have passed the threshold. Break the loop once we see
 // an attestation that has not passed the threshold
 func attestationTally(ctx sdk.Context, k keeper.Keeper) {
-	params := k.GetParams(ctx)
 	// bridge is currently disabled, do not process attestations from Ethereum
-	if !params.BridgeActive {
+	if !k.IsBridgeActive(ctx) {
 		return
 	}
 
+	// the deposits observed are minted and sent together once the attestations were tallied
+	k.ObserveDeposits(ctx, func(ctx sdk.Context) {
+		// the held deposits of resumed tokens were observed before the pending attestations
+		k.ReleaseHeldDeposits(ctx)
+		// after a long halt the observed event nonce lags far behind the claims, observe them over several blocks
+		if k.IsAttestationCatchUp(ctx) {
+			k.CatchUpAttestations(ctx)
+		} else {
+			tallyAttestations(ctx, k)
+		}
+	})
+}
+
+// tallyAttestations tries the attestations at the event nonces after the last observed one
+func tallyAttestations(ctx sdk.Context, k keeper.Keeper) {
 	attmap, keys := k.GetAttestationMapping(ctx)
 
 	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
@@ -116,25 +160,20 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 		// They are ordered by when the first attestation at the event nonce was received.
 		// This order is not important.
 		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
+			// We skip over all attestations at or before the last observed event nonce, they have already been
+			// observed or lost to the attestation observed at their nonce. We also skip the event nonces with a
+			// queued attestation, it is applied once the nonces before it are observed.
 			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryAttestation(ctx, &att)
+			// TryAttestation counts the votes of every other attestation. Once an attestation at the event nonce
+			// one higher than the last observed one has enough votes, it is observed along with the queued
+			// attestations at the event nonces following it. An attestation with enough votes further ahead is
+			// queued instead, so the attestations are applied strictly in event nonce order even when a later
+			// event gets its votes first. If the attestation applied the nonces after it, we will skip them
+			// when we get to them since the lastObservedEventNonce was incremented.
+			if nonce <= k.GetLastObservedEventNonce(ctx) || k.IsAttestationQueued(ctx, nonce) {
+				continue
 			}
+			k.TryAttestation(ctx, &att)
 		}
 	}
 }
@@ -164,6 +203,41 @@ func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 	}
 }
 
+// checkBatchRelayLatency emits a warning event for every batch that is still unexecuted BatchRelayLatencySla blocks
+// after it collected enough signatures to be relayed. Such a batch points to relayers having stopped, rather than
+// validators failing to sign, so the warning is emitted once per batch to give operators an early signal
+func checkBatchRelayLatency(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	if params.BatchRelayLatencySla == 0 {
+		return
+	}
+	currentBlock := uint64(ctx.BlockHeight())
+	batches := k.GetOutgoingTxBatches(ctx)
+	for _, batch := range batches {
+		if batch.RelayableSinceHeight == 0 {
+			// not relayable yet, validators not signing is handled by slashing
+			continue
+		}
+		if batch.RelayableSinceHeight+params.BatchRelayLatencySla >= currentBlock {
+			continue
+		}
+		if k.HasBatchRelayLatencySLAWarned(ctx, batch.TokenContract, batch.BatchNonce) {
+			continue
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBatchRelayLatencySLA,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyTokenContract, batch.TokenContract.GetAddress()),
+				sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(batch.BatchNonce)),
+				sdk.NewAttribute(types.AttributeKeyRelayableSinceHeight, fmt.Sprint(batch.RelayableSinceHeight)),
+				sdk.NewAttribute(types.AttributeKeyBatchAge, fmt.Sprint(currentBlock-batch.RelayableSinceHeight)),
+				sdk.NewAttribute(types.AttributeKeyBatchRelayLatencySLA, fmt.Sprint(params.BatchRelayLatencySla)),
+			),
+		)
+		k.SetBatchRelayLatencySLAWarned(ctx, batch.TokenContract, batch.BatchNonce)
+	}
+}
+
 // cleanupTimedOutBatches deletes logic calls that have passed their expiration on Ethereum
 // keep in mind several things when modifying this function
 // A) unlike nonces timeouts are not monotonically increasing, meaning call 5 can have a later timeout than batch 6
@@ -220,7 +294,7 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 
 	unslashedValsets := k.GetUnSlashedValsets(ctx, params.SignedValsetsWindow)
 
-	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	currentBondedSet := k.ValidatorSet.GetBondedValidatorsByPower(ctx)
 	unbondingValidators := getUnbondingValidators(ctx, k)
 
 	for _, vs := range unslashedValsets {
@@ -241,12 +315,12 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeValsetCreated {
 				// Check if validator has confirmed valset or not
 				_, found := confirms[val.GetOperator().String()]
-				// slash validators for not confirming valsets
-				if !found {
+				// slash validators for not confirming valsets, unless the downtime overlap policy exempts them
+				if !found && !k.OverlapsConsensusDowntime(ctx, consAddr, params.SignedValsetsWindow) {
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
 					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
+						k.ValidatorSet.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
 						ctx.EventManager().EmitEvent(
 							sdk.NewEvent(
 								sdk.EventTypeMessage,
@@ -254,7 +328,8 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 							),
 						)
 
-						k.StakingKeeper.Jail(ctx, consAddr)
+						k.ValidatorSet.Jail(ctx, consAddr)
+						k.SetBridgeJailedHeight(ctx, val.GetOperator(), uint64(ctx.BlockHeight()))
 					}
 
 				}
@@ -268,7 +343,7 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if err != nil {
 				panic(err)
 			}
-			validator, found := k.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addr))
+			validator, found := k.ValidatorSet.GetValidator(ctx, sdk.ValAddress(addr))
 			if !found {
 				panic("Unable to find validator!")
 			}
@@ -286,19 +361,20 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 				// Check if validator has confirmed valset or not
 				_, found := confirms[validator.GetOperator().String()]
 
-				// slash validators for not confirming valsets
-				if !found {
+				// slash validators for not confirming valsets, unless the downtime overlap policy exempts them
+				if !found && !k.OverlapsConsensusDowntime(ctx, valConsAddr, params.SignedValsetsWindow) {
 					// refresh validator before slashing/jailing
 					validator = updateValidator(ctx, k, validator.GetOperator())
 					if !validator.IsJailed() {
-						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
+						k.ValidatorSet.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
 						ctx.EventManager().EmitEvent(
 							sdk.NewEvent(
 								sdk.EventTypeMessage,
 								sdk.NewAttribute("ValsetSignatureSlashing", valConsAddr.String()),
 							),
 						)
-						k.StakingKeeper.Jail(ctx, valConsAddr)
+						k.ValidatorSet.Jail(ctx, valConsAddr)
+						k.SetBridgeJailedHeight(ctx, validator.GetOperator(), uint64(ctx.BlockHeight()))
 					}
 				}
 			}
@@ -313,7 +389,7 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 // pull in individual validators as needed to check that we are not jailing them twice, or slashing
 // them improperly
 func updateValidator(ctx sdk.Context, k keeper.Keeper, val sdk.ValAddress) stakingtypes.Validator {
-	valObj, found := k.StakingKeeper.GetValidator(ctx, val)
+	valObj, found := k.ValidatorSet.GetValidator(ctx, val)
 	if !found {
 		// this should be impossible, we haven't even progressed a single block since we got the list
 		panic("Validator exited set during endblocker?")
@@ -324,9 +400,9 @@ func updateValidator(ctx sdk.Context, k keeper.Keeper, val sdk.ValAddress) staki
 // getUnbondingValidators gets all currently unbonding validators in groups based on
 // the block at which they will finish validating.
 func getUnbondingValidators(ctx sdk.Context, k keeper.Keeper) (addresses []string) {
-	blockTime := ctx.BlockTime().Add(k.StakingKeeper.GetParams(ctx).UnbondingTime)
+	blockTime := ctx.BlockTime().Add(k.ValidatorSet.UnbondingTime(ctx))
 	blockHeight := ctx.BlockHeight()
-	unbondingValIterator := k.StakingKeeper.ValidatorQueueIterator(ctx, blockTime, blockHeight)
+	unbondingValIterator := k.ValidatorSet.ValidatorQueueIterator(ctx, blockTime, blockHeight)
 	defer unbondingValIterator.Close()
 
 	// All unbonding validators
@@ -375,8 +451,11 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 		return
 	}
 
-	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	currentBondedSet := k.ValidatorSet.GetBondedValidatorsByPower(ctx)
 	unslashedBatches := k.GetUnSlashedBatches(ctx, maxHeight)
+	// with escalating penalties a validator moves at most one step up per block, missing every batch of an outage
+	// is a single miss
+	escalated := make(map[string]bool)
 	for _, batch := range unslashedBatches {
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
 		confirms := prepBatchConfirms(ctx, k, batch)
@@ -392,19 +471,28 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeBatchCreated {
 				// check if validator confirmed the batch
 				_, found := confirms[val.GetOperator().String()]
-				// slashing for not confirming the batch
-				if !found {
+				if found && params.EscalateBatchSigningPenalties {
+					k.ResetMissedBatchSignatures(ctx, val.GetOperator())
+				}
+				// slashing for not confirming the batch, unless the downtime overlap policy exempts the validator
+				if !found && !k.OverlapsConsensusDowntime(ctx, consAddr, params.SignedBatchesWindow) {
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
-					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBatch)
+					if params.EscalateBatchSigningPenalties {
+						if !val.IsJailed() && !escalated[val.GetOperator().String()] {
+							escalated[val.GetOperator().String()] = true
+							escalateBatchSigningPenalty(ctx, k, params, val, consAddr)
+						}
+					} else if !val.IsJailed() {
+						k.ValidatorSet.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBatch)
 						ctx.EventManager().EmitEvent(
 							sdk.NewEvent(
 								sdk.EventTypeMessage,
 								sdk.NewAttribute("BatchSignatureSlashing", consAddr.String()),
 							),
 						)
-						k.StakingKeeper.Jail(ctx, consAddr)
+						k.ValidatorSet.Jail(ctx, consAddr)
+						k.SetBridgeJailedHeight(ctx, val.GetOperator(), uint64(ctx.BlockHeight()))
 					}
 				}
 			}
@@ -414,6 +502,43 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
+// escalateBatchSigningPenalty counts a batch signature missed by a validator and penalises it accordingly: the first
+// miss only emits a warning event, the second jails the validator and the third and later ones slash and jail it
+func escalateBatchSigningPenalty(ctx sdk.Context, k keeper.Keeper, params types.Params, val stakingtypes.Validator, consAddr sdk.ConsAddress) {
+	missed := k.IncrementMissedBatchSignatures(ctx, val.GetOperator())
+
+	var penalty string
+	switch {
+	case missed == 1:
+		penalty = "warning"
+	case missed == 2:
+		penalty = "jail"
+		k.ValidatorSet.Jail(ctx, consAddr)
+		k.SetBridgeJailedHeight(ctx, val.GetOperator(), uint64(ctx.BlockHeight()))
+	default:
+		penalty = "slash"
+		k.ValidatorSet.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBatch)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute("BatchSignatureSlashing", consAddr.String()),
+			),
+		)
+		k.ValidatorSet.Jail(ctx, consAddr)
+		k.SetBridgeJailedHeight(ctx, val.GetOperator(), uint64(ctx.BlockHeight()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchSignatureMissed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+			sdk.NewAttribute(types.AttributeKeyMissedBatchSignatures, fmt.Sprint(missed)),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty),
+		),
+	)
+}
+
 // prepLogicCallConfirms loads all confirmations into a hashmap indexed by validatorAddr
 // reducing the lookup time dramatically and separating out the task of looking up
 // the orchestrator for each validator
@@ -452,7 +577,7 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 		return
 	}
 
-	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	currentBondedSet := k.ValidatorSet.GetBondedValidatorsByPower(ctx)
 	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight)
 	for _, call := range unslashedLogicCalls {
 
@@ -469,18 +594,20 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeCallCreated {
 				// check that the validator confirmed the logic call
 				_, found := confirms[val.GetOperator().String()]
-				if !found {
+				// unless the downtime overlap policy exempts the validator
+				if !found && !k.OverlapsConsensusDowntime(ctx, consAddr, params.SignedLogicCallsWindow) {
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
 					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionLogicCall)
+						k.ValidatorSet.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionLogicCall)
 						ctx.EventManager().EmitEvent(
 							sdk.NewEvent(
 								sdk.EventTypeMessage,
 								sdk.NewAttribute("LogicCallSignatureSlashing", consAddr.String()),
 							),
 						)
-						k.StakingKeeper.Jail(ctx, consAddr)
+						k.ValidatorSet.Jail(ctx, consAddr)
+						k.SetBridgeJailedHeight(ctx, val.GetOperator(), uint64(ctx.BlockHeight()))
 					}
 				}
 			}
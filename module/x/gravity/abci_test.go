@@ -8,9 +8,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
@@ -26,6 +32,43 @@ func TestValsetCreationIfNotAvailable(t *testing.T) {
 	require.True(t, len(valsets) == 1)
 }
 
+// Tests that the EndBlocker reports the duration of its steps on nodes with telemetry
+func TestEndBlockerStepDurations(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	//nolint: exhaustivestruct
+	metrics, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "gravity", EnableHostnameLabel: false})
+	require.NoError(t, err)
+
+	EndBlocker(ctx, input.GravityKeeper)
+
+	res, err := metrics.Gather(telemetry.FormatDefault)
+	require.NoError(t, err)
+	for _, step := range []string{"slashing", "attestation_tally", "valset_creation", "attestation_pruning"} {
+		require.Contains(t, string(res.Metrics), "gravity.end_blocker."+step)
+	}
+}
+
+func TestValsetCreationRequiresMinBridgeValidators(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MinBridgeValidators = 6
+	pk.SetParams(ctx, params)
+
+	// five registered validators neither create a valset nor activate the bridge
+	EndBlocker(ctx, pk)
+	require.Empty(t, pk.GetValsets(ctx))
+	require.False(t, pk.IsBridgeActive(ctx))
+	_, err := pk.BuildOutgoingTXBatch(ctx, types.ZeroAddress(), 10)
+	require.Error(t, err)
+
+	params.MinBridgeValidators = 5
+	pk.SetParams(ctx, params)
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetValsets(ctx), 1)
+	require.True(t, pk.IsBridgeActive(ctx))
+}
+
 func TestValsetCreationUponUnbonding(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -47,6 +90,73 @@ func TestValsetCreationUponUnbonding(t *testing.T) {
 	assert.NotEqual(t, currentValsetNonce, pk.GetLatestValsetNonce(ctx))
 }
 
+func TestValsetCreationUponSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+
+	pk.SetValsetRequest(ctx)
+	currentValsetNonce := pk.GetLatestValsetNonce(ctx)
+
+	// slashing a validator holding less than the threshold does not request a valset
+	params := pk.GetParams(ctx)
+	params.ValsetRequestSlashPowerThreshold = sdk.NewDecWithPrec(25, 2)
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	power := val.GetConsensusPower(sdk.DefaultPowerReduction)
+	// a slash this small does not significantly change the valset power
+	input.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, sdk.NewDecWithPrec(1, 2))
+	EndBlocker(ctx, pk)
+	assert.Equal(t, currentValsetNonce, pk.GetLatestValsetNonce(ctx))
+
+	// every validator holds a fifth of the power, which is above a 5% threshold
+	params.ValsetRequestSlashPowerThreshold = sdk.NewDecWithPrec(5, 2)
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	input.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, sdk.NewDecWithPrec(1, 2))
+	EndBlocker(ctx, pk)
+	assert.Equal(t, currentValsetNonce+1, pk.GetLatestValsetNonce(ctx))
+}
+
+//nolint: exhaustivestruct
+func TestValsetCreationSuppressedByEmergencyValset(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+
+	pk.SetValsetRequest(ctx)
+	currentValsetNonce := pk.GetLatestValsetNonce(ctx)
+
+	proposal := &types.EmergencyValsetProposal{
+		Title:       "rescue",
+		Description: "rescue",
+		Members:     []types.BridgeValidator{{Power: 4294967295, EthereumAddress: keeper.EthAddrs[0].String()}},
+	}
+	require.NoError(t, pk.HandleEmergencyValsetProposal(ctx, proposal))
+
+	// the EndBlocker stores the emergency valset once the timelock passed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + keeper.EmergencyValsetTimelock)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, currentValsetNonce+1, pk.GetLatestValsetNonce(ctx))
+	emergency := pk.GetLatestValset(ctx)
+	assert.Equal(t, proposal.Members, emergency.Members)
+
+	// an unbonding does not replace the unobserved emergency valset
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	pk.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	EndBlocker(ctx, pk)
+	assert.Equal(t, currentValsetNonce+1, pk.GetLatestValsetNonce(ctx))
+
+	// once observed the validator set is compared against it again
+	pk.SetLastObservedValset(ctx, *emergency)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, currentValsetNonce+2, pk.GetLatestValsetNonce(ctx))
+}
+
 func TestValsetSlashing_ValsetCreated_Before_ValidatorBonded(t *testing.T) {
 	//	Don't slash validators if valset is created before he is bonded.
 
@@ -109,6 +219,56 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 
 }
 
+func TestValsetSlashing_DowntimeOverlapPolicy(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := input.GravityKeeper.GetParams(ctx)
+	params.DowntimeOverlapPolicy = types.DOWNTIME_OVERLAP_POLICY_SKIP_BRIDGE
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
+	vs, err := pk.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	vs.Height = uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
+	vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
+	pk.StoreValset(ctx, vs)
+	pk.SetLatestValsetNonce(ctx, vs.Nonce)
+
+	// only the last two validators sign
+	for i, orch := range keeper.OrchAddrs {
+		if i < 3 {
+			continue
+		}
+		ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i].String())
+		require.NoError(t, err)
+		pk.SetValsetConfirm(ctx, *types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, orch, "dummysig"))
+	}
+
+	signingInfo := func(i int) (sdk.ConsAddress, slashingtypes.ValidatorSigningInfo) {
+		consAddr, err := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i]).GetConsAddr()
+		require.NoError(t, err)
+		info, found := input.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		return consAddr, info
+	}
+	// the first validator is missing consensus blocks
+	consAddr, info := signingInfo(0)
+	info.MissedBlocksCounter = 5
+	input.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	// the second validator was jailed for downtime within the signing window and has since been unjailed
+	consAddr, info = signingInfo(1)
+	info.JailedUntil = ctx.BlockTime().Add(input.SlashingKeeper.DowntimeJailDuration(ctx) - time.Second)
+	input.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	EndBlocker(ctx, pk)
+
+	// validators offline for consensus are not also slashed for the bridge signatures
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
+	// the third validator was online and is slashed
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[2]).IsJailed())
+}
+
 func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T) {
 	//	Slashing Conditions for Unbonding Validator
 
@@ -237,6 +397,83 @@ func TestBatchSlashing(t *testing.T) {
 
 }
 
+func TestBatchSlashing_EscalatingPenalties(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.EscalateBatchSigningPenalties = true
+	pk.SetParams(ctx, params)
+
+	nonce := uint64(0)
+	// storeBatches stores batches old enough to be slashed for in the next block, signed by the validators with
+	// the given indexes
+	storeBatches := func(count int, signers ...int) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) + 2)
+		for b := 0; b < count; b++ {
+			nonce++
+			batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+				BatchNonce:    nonce,
+				BatchTimeout:  0,
+				Transactions:  []types.OutgoingTransferTx{},
+				TokenContract: keeper.TokenContractAddrs[0],
+				Block:         uint64(ctx.BlockHeight()) - params.SignedBatchesWindow - uint64(count-b),
+			})
+			require.NoError(t, err)
+			pk.StoreBatch(ctx, *batch)
+			for _, i := range signers {
+				pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+					Nonce:         nonce,
+					TokenContract: keeper.TokenContractAddrs[0],
+					EthSigner:     keeper.EthAddrs[i].String(),
+					Orchestrator:  keeper.OrchAddrs[i].String(),
+					Signature:     "",
+				})
+			}
+		}
+		// every validator signs the valsets so only the batches are slashed for
+		for _, vs := range pk.GetValsets(ctx) {
+			for i, orch := range keeper.OrchAddrs {
+				ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i].String())
+				require.NoError(t, err)
+				pk.SetValsetConfirm(ctx, *types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, orch, "dummysig"))
+			}
+		}
+	}
+	validator := func(i int) stakingtypes.ValidatorI {
+		return input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i])
+	}
+	tokens := validator(0).GetTokens()
+
+	// missing every batch of a block is a single miss, which is only a warning
+	storeBatches(2, 2, 3, 4)
+	EndBlocker(ctx, pk)
+	require.False(t, validator(0).IsJailed())
+	require.False(t, validator(1).IsJailed())
+	require.Equal(t, uint64(1), pk.GetMissedBatchSignatures(ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(1), pk.GetMissedBatchSignatures(ctx, keeper.ValAddrs[1]))
+
+	// the second miss jails without slashing, signing a batch resets the misses
+	storeBatches(1, 1, 2, 3, 4)
+	EndBlocker(ctx, pk)
+	require.True(t, validator(0).IsJailed())
+	require.Equal(t, tokens, validator(0).GetTokens())
+	_, found := pk.GetBridgeJailedHeight(ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, uint64(2), pk.GetMissedBatchSignatures(ctx, keeper.ValAddrs[0]))
+	require.False(t, validator(1).IsJailed())
+	require.Zero(t, pk.GetMissedBatchSignatures(ctx, keeper.ValAddrs[1]))
+
+	// the third miss slashes
+	consAddr, err := validator(0).GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Unjail(ctx, consAddr)
+	storeBatches(1, 1, 2, 3, 4)
+	EndBlocker(ctx, pk)
+	require.True(t, validator(0).IsJailed())
+	require.True(t, validator(0).GetTokens().LT(tokens))
+	require.Equal(t, uint64(3), pk.GetMissedBatchSignatures(ctx, keeper.ValAddrs[0]))
+}
+
 func TestValsetEmission(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -425,3 +662,399 @@ func TestValsetPruning(t *testing.T) {
 	require.Nil(t, pk.GetValset(ctx, firstValsetNonce))
 	require.Equal(t, 0, len(pk.GetValsetConfirms(ctx, firstValsetNonce)))
 }
+
+func TestBatchRelayLatencySLA(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.BatchRelayLatencySla = 10
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(100)
+	// create the valset the batch is signed against
+	EndBlocker(ctx, pk)
+
+	batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  0,
+		Transactions:  []types.OutgoingTransferTx{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Block:         uint64(ctx.BlockHeight() - 20),
+	})
+	require.NoError(t, err)
+	pk.StoreBatch(ctx, *batch)
+
+	countWarnings := func(ctx sdk.Context) (count int) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeBatchRelayLatencySLA {
+				count++
+			}
+		}
+		return
+	}
+
+	// not signed by enough validators to be relayed, no warning
+	pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: keeper.TokenContractAddrs[0],
+		EthSigner:     keeper.EthAddrs[0].String(),
+		Orchestrator:  keeper.OrchAddrs[0].String(),
+		Signature:     "",
+	})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.Equal(t, 0, countWarnings(ctx))
+
+	for i, orch := range keeper.OrchAddrs {
+		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: keeper.TokenContractAddrs[0],
+			EthSigner:     keeper.EthAddrs[i].String(),
+			Orchestrator:  orch.String(),
+			Signature:     "",
+		})
+	}
+	require.True(t, pk.GetBatchSignedPower(ctx, *batch) >= types.EthereumSignaturePowerThreshold)
+	pk.TryMarkBatchRelayable(ctx, batch.TokenContract, batch.BatchNonce)
+
+	// the sla is measured from the height the batch became relayable, not from its creation
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.Equal(t, 0, countWarnings(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.Equal(t, 0, countWarnings(ctx))
+
+	// fully signed and past the sla, warn exactly once
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.Equal(t, 1, countWarnings(ctx))
+	require.True(t, pk.HasBatchRelayLatencySLAWarned(ctx, batch.TokenContract, batch.BatchNonce))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.Equal(t, 0, countWarnings(ctx))
+}
+
+//nolint: exhaustivestruct
+func TestDepositsAggregated(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+
+	var (
+		receivers           = []sdk.AccAddress{keeper.RandomAccAddress(), keeper.RandomAccAddress(), keeper.RandomAccAddress()}
+		tokenETHAddr, denom = keeper.RandomEthAddress()
+	)
+	for i, receiver := range []sdk.AccAddress{receivers[0], receivers[1], receivers[0], receivers[2]} {
+		for _, orch := range keeper.OrchAddrs {
+			_, err := h(ctx, &types.MsgSendToCosmosClaim{
+				EventNonce:     uint64(i + 1),
+				BlockHeight:    uint64(i + 1),
+				TokenContract:  tokenETHAddr,
+				Amount:         sdk.NewInt(100),
+				EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+				CosmosReceiver: receiver.String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(4), pk.GetLastObservedEventNonce(ctx))
+	require.Equal(t, int64(200), input.BankKeeper.GetBalance(ctx, receivers[0], denom).Amount.Int64())
+	require.Equal(t, int64(100), input.BankKeeper.GetBalance(ctx, receivers[1], denom).Amount.Int64())
+	require.Equal(t, int64(100), input.BankKeeper.GetBalance(ctx, receivers[2], denom).Amount.Int64())
+
+	// the vouchers are minted once and every receiver is sent its deposits once
+	mints, transfers := 0, 0
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case banktypes.EventTypeCoinMint:
+			mints++
+		case banktypes.EventTypeTransfer:
+			transfers++
+		}
+	}
+	require.Equal(t, 1, mints)
+	require.Equal(t, len(receivers), transfers)
+}
+
+//nolint: exhaustivestruct
+func TestDepositsHeldWhileTokenPaused(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+
+	var (
+		receiver                = keeper.RandomAccAddress()
+		pausedAddr, pausedDenom = keeper.RandomEthAddress()
+		tokenETHAddr, denom     = keeper.RandomEthAddress()
+	)
+	params := pk.GetParams(ctx)
+	params.DepositPausedTokens = []string{pausedAddr}
+	pk.SetParams(ctx, params)
+
+	for i, token := range []string{pausedAddr, tokenETHAddr} {
+		for _, orch := range keeper.OrchAddrs {
+			_, err := h(ctx, &types.MsgSendToCosmosClaim{
+				EventNonce:     uint64(i + 1),
+				BlockHeight:    uint64(i + 1),
+				TokenContract:  token,
+				Amount:         sdk.NewInt(100),
+				EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+				CosmosReceiver: receiver.String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	// the deposit of the paused token is observed in order but held
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(2), pk.GetLastObservedEventNonce(ctx))
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, pausedDenom).IsZero())
+	require.Equal(t, int64(100), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	held := pk.GetHeldDeposits(ctx, receiver.String())
+	require.Len(t, held, 1)
+	require.Equal(t, types.HELD_DEPOSIT_REASON_TOKEN_PAUSED, held[0].Reason)
+	heldAcc := input.AccountKeeper.GetModuleAddress(types.HeldDepositsAccountName)
+	require.Equal(t, int64(100), input.BankKeeper.GetBalance(ctx, heldAcc, pausedDenom).Amount.Int64())
+
+	// the held deposit is exported with the genesis
+	genesis := keeper.ExportGenesis(ctx, pk)
+	require.Equal(t, held, genesis.HeldDeposits)
+
+	// once the token is resumed the held deposit is handled
+	params.DepositPausedTokens = []string{}
+	pk.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.Equal(t, int64(100), input.BankKeeper.GetBalance(ctx, receiver, pausedDenom).Amount.Int64())
+	require.Empty(t, pk.GetHeldDeposits(ctx, ""))
+	require.True(t, input.BankKeeper.GetBalance(ctx, heldAcc, pausedDenom).IsZero())
+}
+
+// Tests that the deposits which would take the vouchers of a token over its supply cap are held until the cap is
+// raised, or refunded under the refund policy, while the deposits fitting under the cap are credited
+//nolint: exhaustivestruct
+func TestDepositsOverSupplyCap(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+
+	var (
+		receiver            = keeper.RandomAccAddress()
+		sender              = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr, denom = keeper.RandomEthAddress()
+	)
+	params := pk.GetParams(ctx)
+	params.TokenSupplyCaps = []types.ERC20Token{{Contract: tokenETHAddr, Amount: sdk.NewInt(250)}}
+	pk.SetParams(ctx, params)
+
+	deposit := func(nonce uint64, amount int64) {
+		for _, orch := range keeper.OrchAddrs {
+			_, err := h(ctx, &types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				BlockHeight:    nonce,
+				TokenContract:  tokenETHAddr,
+				Amount:         sdk.NewInt(amount),
+				EthereumSender: sender,
+				CosmosReceiver: receiver.String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	// the second deposit would exceed the cap, the third still fits under it
+	deposit(1, 100)
+	deposit(2, 200)
+	deposit(3, 100)
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+	require.Equal(t, int64(200), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	held := pk.GetHeldDeposits(ctx, receiver.String())
+	require.Len(t, held, 1)
+	require.Equal(t, uint64(2), held[0].EventNonce)
+	require.Equal(t, types.HELD_DEPOSIT_REASON_SUPPLY_CAP_EXCEEDED, held[0].Reason)
+
+	// the held deposit is released once it fits under the raised cap
+	params.TokenSupplyCaps[0].Amount = sdk.NewInt(399)
+	pk.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetHeldDeposits(ctx, ""), 1)
+	params.TokenSupplyCaps[0].Amount = sdk.NewInt(400)
+	pk.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.Empty(t, pk.GetHeldDeposits(ctx, ""))
+	require.Equal(t, int64(400), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+
+	// under the refund policy a deposit over the cap is sent back right away
+	params.SupplyCapPolicy = types.SUPPLY_CAP_POLICY_REFUND
+	pk.SetParams(ctx, params)
+	deposit(4, 50)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(4), pk.GetLastObservedEventNonce(ctx))
+	require.Empty(t, pk.GetHeldDeposits(ctx, ""))
+	require.Equal(t, int64(400), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	refunds := pk.GetUnbatchedTransactions(ctx)
+	require.Len(t, refunds, 1)
+	require.Equal(t, sender, refunds[0].DestAddress.GetAddress())
+	require.Equal(t, int64(50), refunds[0].Erc20Token.Amount.Int64())
+}
+
+//nolint: exhaustivestruct
+func TestAttestationCatchUp(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+
+	params := pk.GetParams(ctx)
+	params.AttestationCatchUpLag = 10
+	pk.SetParams(ctx, params)
+
+	var (
+		receivers           = []sdk.AccAddress{keeper.RandomAccAddress(), keeper.RandomAccAddress()}
+		blockedReceiver     = authtypes.NewModuleAddress(disttypes.ModuleName)
+		tokenETHAddr, denom = keeper.RandomEthAddress()
+		claims              = uint64(keeper.AttestationCatchUpBatchSize + 5)
+	)
+	// the chain was halted while the orchestrators kept claiming, the last deposit is to a blocked address
+	for nonce := uint64(1); nonce <= claims; nonce++ {
+		receiver := receivers[nonce%2]
+		if nonce == claims {
+			receiver = blockedReceiver
+		}
+		for _, orch := range keeper.OrchAddrs {
+			_, err := h(ctx, &types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				BlockHeight:    nonce,
+				TokenContract:  tokenETHAddr,
+				Amount:         sdk.NewInt(int64(nonce)),
+				EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+				CosmosReceiver: receiver.String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+	}
+	require.True(t, pk.IsAttestationCatchUp(ctx))
+	require.Equal(t, claims, pk.GetHighestClaimedEventNonce(ctx))
+
+	// the first block observes a bounded number of event nonces and sends their deposits together
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(keeper.AttestationCatchUpBatchSize), pk.GetLastObservedEventNonce(ctx))
+	sum := func(from, to, parity uint64) int64 {
+		total := int64(0)
+		for nonce := from; nonce <= to; nonce++ {
+			if nonce%2 == parity {
+				total += int64(nonce)
+			}
+		}
+		return total
+	}
+	for parity, receiver := range receivers {
+		balance := input.BankKeeper.GetBalance(ctx, receiver, denom)
+		require.Equal(t, sum(1, keeper.AttestationCatchUpBatchSize, uint64(parity)), balance.Amount.Int64())
+	}
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	// the next block observes the rest
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.Equal(t, claims, pk.GetLastObservedEventNonce(ctx))
+	require.False(t, pk.IsAttestationCatchUp(ctx))
+	for parity, receiver := range receivers {
+		balance := input.BankKeeper.GetBalance(ctx, receiver, denom)
+		require.Equal(t, sum(1, claims-1, uint64(parity)), balance.Amount.Int64())
+	}
+	communityPool := input.DistKeeper.GetFeePool(ctx).CommunityPool
+	require.Equal(t, sdk.NewDec(int64(claims)), communityPool.AmountOf(denom))
+	require.Equal(t, sum(1, claims, 0)+sum(1, claims, 1), input.BankKeeper.GetSupply(ctx, denom).Amount.Int64())
+}
+
+// orderRecordingHooks records the event nonces of the applied attestations
+type orderRecordingHooks struct {
+	nonces []uint64
+}
+
+func (h *orderRecordingHooks) AfterAttestationApplied(_ sdk.Context, claim types.EthereumClaim, _ bool) {
+	h.nonces = append(h.nonces, claim.GetEventNonce())
+}
+
+// Tests that the attestations getting their votes before the event nonces preceding them are queued, and applied in
+// event nonce order once the skipped event nonce is filled
+//
+//nolint: exhaustivestruct
+func TestAttestationsAppliedInOrder(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	hooks := &orderRecordingHooks{}
+	input.GravityKeeper.SetAttestationHooks(hooks)
+	pk := input.GravityKeeper
+
+	var (
+		receiver            = keeper.RandomAccAddress()
+		tokenETHAddr, denom = keeper.RandomEthAddress()
+		votes               = make([]string, len(keeper.ValAddrs))
+	)
+	for i, val := range keeper.ValAddrs {
+		votes[i] = val.String()
+	}
+	setAttestation := func(nonce uint64, votes []string) {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(int64(nonce)),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: receiver.String(),
+			Orchestrator:   keeper.OrchAddrs[0].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(claim)
+		require.NoError(t, err)
+		hash, err := claim.ClaimHash(types.ClaimEncodingVersion)
+		require.NoError(t, err)
+		pk.SetAttestation(ctx, nonce, hash, &types.Attestation{
+			Votes:            votes,
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            any,
+			ClaimHashVersion: types.ClaimEncodingVersion,
+		})
+	}
+
+	// the event nonce 1 lacks votes while 2, 3 and 5 have them, 4 is not claimed yet
+	setAttestation(1, votes[:3])
+	setAttestation(2, votes)
+	setAttestation(3, votes)
+	setAttestation(5, votes)
+	EndBlocker(ctx, pk)
+	require.Zero(t, pk.GetLastObservedEventNonce(ctx))
+	require.Equal(t, []uint64{2, 3, 5}, pk.GetQueuedAttestationNonces(ctx))
+	require.Empty(t, hooks.nonces)
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).IsZero())
+
+	// filling the skipped event nonce applies it and the queued ones up to the next gap
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	setAttestation(1, votes[:4])
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+	require.Equal(t, []uint64{1, 2, 3}, hooks.nonces)
+	require.Equal(t, []uint64{5}, pk.GetQueuedAttestationNonces(ctx))
+	require.Equal(t, int64(6), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+
+	// an attestation tried directly out of order is queued rather than applied
+	setAttestation(4, votes)
+	attmap, _ := pk.GetAttestationMapping(ctx)
+	pk.TryAttestation(ctx, &attmap[5][0])
+	require.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+	pk.TryAttestation(ctx, &attmap[4][0])
+	require.Equal(t, uint64(5), pk.GetLastObservedEventNonce(ctx))
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, hooks.nonces)
+	require.Empty(t, pk.GetQueuedAttestationNonces(ctx))
+}
@@ -0,0 +1,56 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// UnjailDecorator rejects the slashing module's MsgUnjail for validators jailed by the gravity module for
+// missing bridge signatures, these validators must unjail through MsgUnjailValidator which checks that they
+// have caught up with the bridge signatures first
+type UnjailDecorator struct {
+	k keeper.ReadOnlyKeeper
+}
+
+// NewUnjailDecorator returns a new UnjailDecorator
+func NewUnjailDecorator(k keeper.ReadOnlyKeeper) UnjailDecorator {
+	return UnjailDecorator{k: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d UnjailDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks the messages of a tx, including those executed through authz
+func (d UnjailDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *slashingtypes.MsgUnjail:
+			val, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddr)
+			}
+			if _, found := d.k.GetBridgeJailedHeight(ctx, val); found {
+				return sdkerrors.Wrap(types.ErrInvalid, "validator was jailed for missing bridge signatures, unjail with the gravity module")
+			}
+		case *authz.MsgExec:
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, execMsgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package gravity
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// msgsTx is a minimal sdk.Tx carrying only messages
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx msgsTx) ValidateBasic() error { return nil }
+
+func TestUnjailDecorator(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	decorator := NewUnjailDecorator(pk)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Jail(ctx, consAddr)
+
+	unjail := slashingtypes.NewMsgUnjail(keeper.ValAddrs[0])
+	exec := authz.NewMsgExec(keeper.AccAddrs[1], []sdk.Msg{unjail})
+
+	// validators jailed for other reasons may use the slashing module
+	_, err = decorator.AnteHandle(ctx, msgsTx{unjail}, false, next)
+	require.NoError(t, err)
+
+	pk.SetBridgeJailedHeight(ctx, keeper.ValAddrs[0], uint64(ctx.BlockHeight()))
+	_, err = decorator.AnteHandle(ctx, msgsTx{unjail}, false, next)
+	require.Error(t, err)
+	_, err = decorator.AnteHandle(ctx, msgsTx{&exec}, false, next)
+	require.Error(t, err)
+
+	// the gravity module unjails the validator, there are no outstanding confirms
+	h := NewHandler(pk)
+	_, err = h(ctx, types.NewMsgUnjailValidator(keeper.ValAddrs[0]))
+	require.NoError(t, err)
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	_, err = decorator.AnteHandle(ctx, msgsTx{unjail}, false, next)
+	require.NoError(t, err)
+}
@@ -0,0 +1,159 @@
+package gravity
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// The benchmarks run on a LevelDB database on disk. The state is committed before the timer starts, so that the
+// store reads go through IAVL to the database as on a node rather than to the writes kept in memory, and every
+// iteration runs in a cache context which is discarded, as a block of a node which is not committed.
+// Run them with make bench, the setup of 100k pool transactions takes a while.
+
+const (
+	benchPoolTxs    = 100000
+	benchValidators = 175
+	benchEvents     = 10
+)
+
+// newBenchDB returns a LevelDB database in a temporary directory
+func newBenchDB(b *testing.B) dbm.DB {
+	b.Helper()
+	db, err := dbm.NewGoLevelDB("gravity", b.TempDir())
+	require.NoError(b, err)
+	b.Cleanup(func() { require.NoError(b, db.Close()) })
+	return db
+}
+
+// commitBench writes the state of ctx to the database, ctx then reads the committed stores
+func commitBench(ctx sdk.Context) {
+	ctx.MultiStore().(sdk.CommitMultiStore).Commit()
+}
+
+// setupBenchChain returns a chain of benchValidators validators of equal power which set their delegate keys, along
+// with their orchestrators
+func setupBenchChain(b *testing.B) (keeper.TestInput, sdk.Context, []sdk.AccAddress) {
+	b.Helper()
+	weights := make([]uint64, benchValidators)
+	for i := range weights {
+		weights[i] = 1000000000
+	}
+	input, ctx := keeper.SetupTestChainWithDB(b, newBenchDB(b), weights, true)
+
+	// the validators and their orchestrators share the key in SetupTestChain
+	var orchestrators []sdk.AccAddress
+	for _, val := range input.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		orchestrators = append(orchestrators, sdk.AccAddress(val.GetOperator()))
+	}
+	return input, ctx, orchestrators
+}
+
+// fillBenchPool adds benchPoolTxs transactions of a token with random fees to the pool and returns the token
+func fillBenchPool(b *testing.B, input keeper.TestInput, ctx sdk.Context) types.EthAddress {
+	b.Helper()
+	contract, _ := keeper.RandomEthAddress()
+	tokenContract, err := types.NewEthAddress(contract)
+	require.NoError(b, err)
+	receiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(b, err)
+
+	sender := keeper.RandomAccAddress()
+	input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	total, err := types.NewInternalERC20Token(sdk.NewInt(2000*benchPoolTxs), contract)
+	require.NoError(b, err)
+	vouchers := sdk.NewCoins(total.GravityCoin())
+	require.NoError(b, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(b, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, vouchers))
+
+	denom := total.GravityCoin().Denom
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < benchPoolTxs; i++ {
+		amount := sdk.NewInt64Coin(denom, 1000)
+		fee := sdk.NewInt64Coin(denom, 1+rng.Int63n(999))
+		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, sender, *receiver, amount, fee)
+		require.NoError(b, err)
+	}
+	return *tokenContract
+}
+
+// claimBenchDeposits has every orchestrator claim benchEvents deposits following the last observed event
+func claimBenchDeposits(b *testing.B, ctx sdk.Context, k keeper.Keeper, orchestrators []sdk.AccAddress) {
+	b.Helper()
+	h := NewHandler(k)
+	contract, _ := keeper.RandomEthAddress()
+	receiver := keeper.RandomAccAddress()
+	first := k.GetLastObservedEventNonce(ctx) + 1
+	for nonce := first; nonce < first+benchEvents; nonce++ {
+		for _, orch := range orchestrators {
+			_, err := h(ctx, &types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				BlockHeight:    nonce,
+				TokenContract:  contract,
+				Amount:         sdk.NewInt(100),
+				EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+				CosmosReceiver: receiver.String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(b, err)
+		}
+	}
+}
+
+// Benchmarks building a batch of the transactions with the highest fees out of benchPoolTxs pool transactions
+func BenchmarkBuildOutgoingTXBatch(b *testing.B) {
+	input := keeper.CreateTestEnvWithDB(b, newBenchDB(b))
+	ctx := input.Context
+	tokenContract := fillBenchPool(b, input, ctx)
+	commitBench(ctx)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		batch, err := input.GravityKeeper.BuildOutgoingTXBatch(cacheCtx, tokenContract, keeper.OutgoingTxBatchSize)
+		require.NoError(b, err)
+		require.Len(b, batch.Transactions, keeper.OutgoingTxBatchSize)
+	}
+}
+
+// Benchmarks the tally of benchEvents deposits claimed by each of benchValidators validators
+func BenchmarkAttestationTally(b *testing.B) {
+	input, ctx, orchestrators := setupBenchChain(b)
+	pk := input.GravityKeeper
+	claimBenchDeposits(b, ctx, pk, orchestrators)
+	commitBench(ctx)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		attestationTally(cacheCtx, pk)
+		require.Equal(b, uint64(benchEvents), pk.GetLastObservedEventNonce(cacheCtx))
+	}
+}
+
+// Benchmarks the EndBlocker of a chain of benchValidators validators with a valset request by each of them,
+// benchEvents claimed deposits, a batch waiting for signatures and benchPoolTxs pool transactions
+func BenchmarkEndBlocker(b *testing.B) {
+	input, ctx, orchestrators := setupBenchChain(b)
+	pk := input.GravityKeeper
+	claimBenchDeposits(b, ctx, pk, orchestrators)
+	tokenContract := fillBenchPool(b, input, ctx)
+	_, err := pk.BuildOutgoingTXBatch(ctx, tokenContract, keeper.OutgoingTxBatchSize)
+	require.NoError(b, err)
+	commitBench(ctx)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		EndBlocker(cacheCtx, pk)
+	}
+}
@@ -1,15 +1,23 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
 
+// FlagProve requests a merkle proof of the queried store entry along with its value
+const FlagProve = "prove"
+
 func GetQueryCmd() *cobra.Command {
 	//nolint: exhaustivestruct
 	gravityQueryCmd := &cobra.Command{
@@ -26,6 +34,39 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
 		CmdGetPendingSendToEth(),
+		CmdGetBatchRelayLatency(),
+		CmdGetBridgeFeeTiers(),
+		CmdGetBatchCalldata(),
+		CmdGetERC20DeployedRejections(),
+		CmdGetStoreMetrics(),
+		CmdGetGravityProposals(),
+		CmdGetTotalValueLocked(),
+		CmdGetDelegateKeyCoverage(),
+		CmdGetModuleVersions(),
+		CmdGetHeldDeposits(),
+		CmdGetForkAttestations(),
+		CmdGetObservedBlockHashes(),
+		CmdGetBridgeCheckpoint(),
+		CmdGetMsgDescriptors(),
+		CmdGetSelfBridgeLimit(),
+		CmdGetGravityProposalMetadata(),
+		CmdGetVoucherOrigin(),
+		CmdGetExecutedBatch(),
+		CmdGetAppModules(),
+		CmdGetEarliestNeededValset(),
+		CmdGetBootstrapInfo(),
+		CmdGetPendingParamChanges(),
+		CmdGetBridgeRoute(),
+		CmdGetBridgeBinding(),
+		CmdGetStateProof(),
+		CmdGetERC20Provenances(),
+		CmdGetBuildInfo(),
+		CmdGetBurnedFees(),
+		CmdGetBridgeUsage(),
+		CmdGetPendingIbcAutoForwards(),
+		CmdGetValsetRelayPackage(),
+		CmdGetRequiredChainFee(),
+		CmdGetBatchFees(),
 	}...)
 
 	return gravityQueryCmd
@@ -195,3 +236,969 @@ func CmdGetPendingSendToEth() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetBatchRelayLatency() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-relay-latency [token contract]",
+		Short: "Query the average number of blocks batches wait to be relayed, for one or all tokens",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBatchRelayLatencyRequest{}
+			if len(args) == 1 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.BatchRelayLatency(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeFeeTiers() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-fee-tiers [token contract]",
+		Short: "Query the fast, normal and slow bridge fees recently batched within 10, 100 and 1000 blocks, for one or all tokens",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeFeeTiersRequest{}
+			if len(args) == 1 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.BridgeFeeTiers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchCalldata() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-calldata [token contract] [nonce]",
+		Short: "Query the submitBatch arguments of a batch that is ready to be relayed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchCalldataRequest{
+				Nonce:           nonce,
+				ContractAddress: args[0],
+			}
+
+			res, err := queryClient.BatchCalldata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetERC20DeployedRejections() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-deployed-rejections [denom]",
+		Short: "Query why observed ERC20 deployments were not paired with their denom, for one or all denoms",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryERC20DeployedRejectionsRequest{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.ERC20DeployedRejections(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetStoreMetrics() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "store-metrics",
+		Short: "Query the number and approximate size in bytes of the attestations, batches, confirms and pool txs in the store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryStoreMetricsRequest{}
+
+			res, err := queryClient.StoreMetrics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetGravityProposals() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-proposals",
+		Short: "Query the gravity governance proposals in their deposit or voting period, with their decoded content",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryGravityProposalsRequest{}
+
+			res, err := queryClient.GravityProposals(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetTotalValueLocked() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "total-value-locked",
+		Short: "Query the amount locked by the bridge of every bridged token and their aggregate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalValueLockedRequest{}
+
+			res, err := queryClient.TotalValueLocked(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeyCoverage() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-key-coverage",
+		Short: "Query the percentage of the bonded power whose validators registered their Ethereum and orchestrator keys, and the validators which did not",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeyCoverageRequest{}
+
+			res, err := queryClient.DelegateKeyCoverage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetModuleVersions() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "module-versions",
+		Short: "Query the consensus version of every module in state and in the binary of the node, to check the migrations an upgrade to that binary runs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryModuleVersionsRequest{}
+
+			res, err := queryClient.ModuleVersions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetEarliestNeededValset() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "earliest-needed-valset",
+		Short: "Query the valset with the lowest nonce which can not be pruned yet, the valsets before it are deleted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEarliestNeededValsetRequest{}
+
+			res, err := queryClient.EarliestNeededValset(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBootstrapInfo() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bootstrap-info [orchestrator-address]",
+		Short: "Query everything an orchestrator needs on startup: the params, the last observed state, its delegate keys and the current valset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBootstrapInfoRequest{
+				OrchestratorAddress: args[0],
+			}
+
+			res, err := queryClient.BootstrapInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPendingParamChanges() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "pending-param-changes",
+		Short: "Query the changes of critical params passed by governance which wait for their apply height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingParamChangesRequest{}
+
+			res, err := queryClient.PendingParamChanges(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeRoute() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-route [denom] [eth-dest]",
+		Short: "Query whether a denom held on this chain can be sent to an Ethereum address, the steps to take and the fees",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeRouteRequest{
+				Denom:   args[0],
+				EthDest: args[1],
+			}
+
+			res, err := queryClient.BridgeRoute(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeBinding() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-binding",
+		Short: "Query the Gravity.sol deployment the chain is bound to, claims of another bridge contract are rejected",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeBindingRequest{}
+
+			res, err := queryClient.BridgeBinding(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetStateProof() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "state-proof [attestation|batch|denom-to-erc20|erc20-to-denom] [args...]",
+		Short: "Query a gravity state entry with the merkle proof of its store entry",
+		Long: `Query a gravity state entry with the merkle proof of its store entry, or of its absence.
+The entries and their args are:
+  attestation [nonce] [claim-hash-version] [claim-hash-hex]
+  batch [token-contract] [batch-nonce]
+  denom-to-erc20 [denom]
+  erc20-to-denom [token-contract]
+The proof is verified against the app hash of the header of the block after the returned height.`,
+		Args: cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req, err := stateProofKeyRequest(args)
+			if err != nil {
+				return err
+			}
+			keyRes, err := queryClient.StateProofKey(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			//nolint: exhaustivestruct
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   types.StateProofPath,
+				Data:   keyRes.Key,
+				Height: keyRes.Height,
+				Prove:  true,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&types.StateProof{
+				StoreName: keyRes.StoreName,
+				Key:       keyRes.Key,
+				Value:     res.Value,
+				Height:    res.Height,
+				Proof:     res.ProofOps,
+			})
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// stateProofKeyRequest parses the args of the state-proof command into the request of the key of the entry
+func stateProofKeyRequest(args []string) (*types.QueryStateProofKeyRequest, error) {
+	wantArgs := func(n int) error {
+		if len(args) != n {
+			return fmt.Errorf("%s takes %d args, got %d", args[0], n-1, len(args)-1)
+		}
+		return nil
+	}
+	switch args[0] {
+	case "attestation":
+		if err := wantArgs(4); err != nil {
+			return nil, err
+		}
+		nonce, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		version, err := strconv.ParseUint(args[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := hex.DecodeString(strings.TrimPrefix(args[3], "0x"))
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryStateProofKeyRequest{
+			Entry:            types.STATE_PROOF_ENTRY_ATTESTATION,
+			Nonce:            nonce,
+			ClaimHashVersion: version,
+			ClaimHash:        hash,
+		}, nil
+	case "batch":
+		if err := wantArgs(3); err != nil {
+			return nil, err
+		}
+		nonce, err := strconv.ParseUint(args[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryStateProofKeyRequest{Entry: types.STATE_PROOF_ENTRY_BATCH, TokenContract: args[1], Nonce: nonce}, nil
+	case "denom-to-erc20":
+		if err := wantArgs(2); err != nil {
+			return nil, err
+		}
+		return &types.QueryStateProofKeyRequest{Entry: types.STATE_PROOF_ENTRY_DENOM_TO_ERC20, Denom: args[1]}, nil
+	case "erc20-to-denom":
+		if err := wantArgs(2); err != nil {
+			return nil, err
+		}
+		return &types.QueryStateProofKeyRequest{Entry: types.STATE_PROOF_ENTRY_ERC20_TO_DENOM, TokenContract: args[1]}, nil
+	default:
+		return nil, fmt.Errorf("unknown state entry %s", args[0])
+	}
+}
+
+func CmdGetERC20Provenances() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-provenances [token-contract]",
+		Short: "Query the first observed deposit and the deposits since of Ethereum originated ERC20s, for one or all tokens",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryERC20ProvenancesRequest{}
+			if len(args) == 1 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.ERC20Provenances(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBuildInfo() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "build-info",
+		Short: "Query the version of the binary of the node and the EVM chain, contract ABI and protos it was built for",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BuildInfo(cmd.Context(), &types.QueryBuildInfoRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBurnedFees() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "burned-fees",
+		Short: "Query the total of the collected bridge fees burned and the share of the fees burned",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedFees(cmd.Context(), &types.QueryBurnedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeUsage() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-usage [epoch] [address]",
+		Short: "Query the volume the accounts bridged in an epoch, the current one if it is omitted or 0, of every account or one",
+		Args:  cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeUsageRequest{}
+			if len(args) > 0 {
+				epoch, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				req.Epoch = epoch
+			}
+			if len(args) > 1 {
+				req.Address = args[1]
+			}
+
+			res, err := queryClient.BridgeUsage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPendingIbcAutoForwards() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "pending-ibc-auto-forwards [limit]",
+		Short: "Query the deposits waiting to be forwarded to other chains over IBC, all of them if the limit is omitted or 0",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingIbcAutoForwardsRequest{}
+			if len(args) > 0 {
+				limit, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				req.Limit = limit
+			}
+
+			res, err := queryClient.PendingIbcAutoForwards(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValsetRelayPackage() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-relay-package [nonce]",
+		Short: "Query the signatures a relayer submits with a valset update, stored once the valset became relayable",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValsetRelayPackage(cmd.Context(), &types.QueryValsetRelayPackageRequest{Nonce: nonce})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetRequiredChainFee() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "required-chain-fee [amount]",
+		Short: "Query the smallest chain fee a send-to-eth of the amount pays",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RequiredChainFee(cmd.Context(), &types.QueryRequiredChainFeeRequest{Amount: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchFees() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-fees",
+		Short: "Query, per token, the fees and transaction count of the next batch and of the whole unbatched pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BatchFees(cmd.Context(), &types.QueryBatchFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAppModules() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "app-modules",
+		Short: "Query the stores mounted by the binary of the node, its module versions and the optional modules, e.g. wasm, it includes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAppModulesRequest{}
+
+			res, err := queryClient.AppModules(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetHeldDeposits() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "held-deposits [cosmos-receiver]",
+		Short: "Query the deposits held until governance releases or refunds them, for one or all receivers",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryHeldDepositsRequest{}
+			if len(args) == 1 {
+				req.CosmosReceiver = args[0]
+			}
+
+			res, err := queryClient.HeldDeposits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetForkAttestations() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "fork-attestations",
+		Short: "Query the Ethereum reorgs deeper than the confirmation depth reported by the orchestrators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ForkAttestations(cmd.Context(), &types.QueryForkAttestationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetObservedBlockHashes() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "observed-block-hashes",
+		Short: "Query the Ethereum block hashes attested to with the most recent observed events",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ObservedBlockHashes(cmd.Context(), &types.QueryObservedBlockHashesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// provenBridgeCheckpoint is a bridge checkpoint along with the merkle proof of its store entry, which is verified
+// against the app hash of the header of the next block
+type provenBridgeCheckpoint struct {
+	BridgeCheckpoint types.BridgeCheckpoint `json:"bridge_checkpoint"`
+	Height           int64                  `json:"height"`
+	ProofOps         *tmcrypto.ProofOps     `json:"proof_ops"`
+}
+
+func CmdGetBridgeCheckpoint() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-checkpoint",
+		Short: "Query the last observed Ethereum event and valset checkpoint written at the end of the last block",
+		Long: `Query the last observed Ethereum event and valset checkpoint written at the end of the last block.
+With --prove the store entry is queried with its merkle proof at the returned height, which light clients verify
+against the app hash of the header of the next block.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			prove, err := cmd.Flags().GetBool(FlagProve)
+			if err != nil {
+				return err
+			}
+
+			if !prove {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.BridgeCheckpoint(cmd.Context(), &types.QueryBridgeCheckpointRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			//nolint: exhaustivestruct
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   types.StateProofPath,
+				Data:   []byte(types.BridgeCheckpointKey),
+				Height: clientCtx.Height,
+				Prove:  true,
+			})
+			if err != nil {
+				return err
+			}
+			var checkpoint types.BridgeCheckpoint
+			if err := clientCtx.Codec.Unmarshal(res.Value, &checkpoint); err != nil {
+				return err
+			}
+			return clientCtx.PrintObjectLegacy(provenBridgeCheckpoint{
+				BridgeCheckpoint: checkpoint,
+				Height:           res.Height,
+				ProofOps:         res.ProofOps,
+			})
+		},
+	}
+	cmd.Flags().Bool(FlagProve, false, "query the merkle proof of the checkpoint along with it")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetMsgDescriptors() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "msg-descriptors",
+		Short: "Query the gravity Msg types with their fields and validation rules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MsgDescriptors(cmd.Context(), &types.QueryMsgDescriptorsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetSelfBridgeLimit() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "self-bridge-limit [address]",
+		Short: "Query the limit an account set on the coins it sends to Ethereum, with its pending limit and spending in the current window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySelfBridgeLimitRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.SelfBridgeLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetGravityProposalMetadata() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-proposal-metadata [proposal id]",
+		Short: "Query the metadata a governance proposal was submitted with through submit-gravity-proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryGravityProposalMetadataRequest{
+				ProposalId: proposalID,
+			}
+
+			res, err := queryClient.GravityProposalMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetVoucherOrigin() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "voucher-origin [denom]",
+		Short: "Query the ERC20, source chain, decimals and symbol of a gravity voucher, a bridged cosmos denom or an IBC voucher of either",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVoucherOriginRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.VoucherOrigin(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetExecutedBatch() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "executed-batch [token contract] [nonce]",
+		Short: "Query a batch whose execution on Ethereum was observed, from the executed batches or the batch archive",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryExecutedBatchRequest{
+				TokenContract: args[0],
+				Nonce:         nonce,
+			}
+
+			res, err := queryClient.ExecutedBatch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -12,13 +13,27 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
 	"github.com/spf13/cobra"
 
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
 
+// FlagExecuteAfterHeight is the block height from which a send-to-eth may be batched
+const FlagExecuteAfterHeight = "execute-after-height"
+
+// FlagChainFee is the chain fee a send-to-eth pays to the stakers
+const FlagChainFee = "chain-fee"
+
+// FlagExpiration is the unix timestamp at which an authz grant expires
+const FlagExpiration = "expiration"
+
+// FlagMetadata is the metadata a gravity proposal is submitted with
+const FlagMetadata = "metadata"
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	// needed for governance proposal txs in cli case
 	// internal check prevents double registration in node case
@@ -36,11 +51,23 @@ func GetTxCmd(storeKey string) *cobra.Command {
 	gravityTxCmd.AddCommand([]*cobra.Command{
 		CmdSendToEth(),
 		CmdCancelSendToEth(),
+		CmdCreateRecurringSendToEth(),
+		CmdCancelRecurringSendToEth(),
+		CmdSetSelfBridgeLimit(),
+		CmdExecuteIbcAutoForwards(),
+		CmdGrantSendToEth(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
+		CmdUnjailValidator(),
 		CmdGovIbcMetadataProposal(),
+		CmdGovIbcMetadataProposalJSON(),
 		CmdGovAirdropProposal(),
 		CmdGovUnhaltBridgeProposal(),
+		CmdGovRecoverStrandedFundsProposal(),
+		CmdGovEmergencyValsetProposal(),
+		CmdGovReleaseHeldDepositsProposal(),
+		CmdGovRefundHeldDepositsProposal(),
+		CmdSubmitGravityProposal(),
 	}...)
 
 	return gravityTxCmd
@@ -112,6 +139,46 @@ func CmdGovIbcMetadataProposal() *cobra.Command {
 	return cmd
 }
 
+func CmdGovIbcMetadataProposalJSON() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-ibc-metadata-json [ibc-denom] [name] [symbol] [decimals]",
+		Short: "Prints the proposal json for gov-ibc-metadata, built from the denom trace of the given IBC token queried from the transfer module",
+		Long: `Prints the proposal json for gov-ibc-metadata, built from the denom trace of the given IBC token queried from the transfer module.
+The base unit is the IBC denom and the display unit is the lowercase symbol with the given decimals, which become the decimals of the ERC20 deployed on Ethereum.
+Review the title and descriptions before submitting it.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			decimals, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return sdkerrors.Wrap(err, "decimals")
+			}
+
+			queryClient := ibctransfertypes.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomTrace(cmd.Context(), &ibctransfertypes.QueryDenomTraceRequest{Hash: args[0]})
+			if err != nil {
+				return sdkerrors.Wrap(err, "denom trace")
+			}
+
+			proposal, err := types.NewIBCMetadataProposal(*res.DenomTrace, args[1], args[2], uint32(decimals))
+			if err != nil {
+				return err
+			}
+			contents, err := json.MarshalIndent(proposal, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(contents) + "\n")
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // AirDropProposalPlain is a struct with plaintext recipients so that the proposal.json can be readable
 // and not subject to the strange encoding of the airdrop proposal tx where the recipients are packed as 20
 // byte sets
@@ -259,12 +326,192 @@ func CmdGovUnhaltBridgeProposal() *cobra.Command {
 	return cmd
 }
 
+func CmdGovRecoverStrandedFundsProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-recover-stranded-funds [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to move funds stranded in the gravity module account to the community pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) > 1 {
+				return fmt.Errorf("coin amounts too long, expecting just 1 coin amount for the initial deposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.RecoverStrandedFundsProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGovReleaseHeldDepositsProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-release-held-deposits [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to send held deposits to their Cosmos receivers",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) > 1 {
+				return fmt.Errorf("coin amounts too long, expecting just 1 coin amount for the initial deposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.ReleaseHeldDepositsProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGovRefundHeldDepositsProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-refund-held-deposits [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to send held deposits back to their Ethereum senders",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) > 1 {
+				return fmt.Errorf("coin amounts too long, expecting just 1 coin amount for the initial deposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.RefundHeldDepositsProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdSendToEth() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
-		Use:   "send-to-eth [eth-dest] [amount] [bridge-fee]",
-		Short: "Adds a new entry to the transaction pool to withdraw an amount from the Ethereum bridge contract. This will not execute until a batch is requested and then actually relayed. Your funds can be reclaimed using cancel-send-to-eth so long as they remain in the pool",
-		Args:  cobra.ExactArgs(3),
+		Use:   "send-to-eth [eth-dest] [amount] [bridge-fee] [relay-fee]",
+		Short: "Adds a new entry to the transaction pool to withdraw an amount from the Ethereum bridge contract. This will not execute until a batch is requested and then actually relayed. Your funds can be reclaimed using cancel-send-to-eth so long as they remain in the pool. The optional relay fee, in any denom, is paid to the relayer on this chain. With --execute-after-height the funds are locked immediately but the transfer only enters the pool at that block height",
+		Args:  cobra.RangeArgs(3, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -290,12 +537,38 @@ func CmdSendToEth() *cobra.Command {
 				return fmt.Errorf("coin amounts too long, expecting just 1 coin amount for both amount and bridgeFee")
 			}
 
+			var relayFee *sdk.Coin
+			if len(args) == 4 {
+				fee, err := sdk.ParseCoinNormalized(args[3])
+				if err != nil {
+					return sdkerrors.Wrap(err, "relay fee")
+				}
+				relayFee = &fee
+			}
+			executeAfterHeight, err := cmd.Flags().GetUint64(FlagExecuteAfterHeight)
+			if err != nil {
+				return err
+			}
+			var chainFee *sdk.Coin
+			if arg, err := cmd.Flags().GetString(FlagChainFee); err != nil {
+				return err
+			} else if arg != "" {
+				fee, err := sdk.ParseCoinNormalized(arg)
+				if err != nil {
+					return sdkerrors.Wrap(err, "chain fee")
+				}
+				chainFee = &fee
+			}
+
 			// Make the message
 			msg := types.MsgSendToEth{
-				Sender:    cosmosAddr.String(),
-				EthDest:   ethAddr.GetAddress(),
-				Amount:    amount[0],
-				BridgeFee: bridgeFee[0],
+				Sender:             cosmosAddr.String(),
+				EthDest:            ethAddr.GetAddress(),
+				Amount:             amount[0],
+				BridgeFee:          bridgeFee[0],
+				RelayFee:           relayFee,
+				ExecuteAfterHeight: executeAfterHeight,
+				ChainFee:           chainFee,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -304,6 +577,8 @@ func CmdSendToEth() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().Uint64(FlagExecuteAfterHeight, 0, "the block height from which the transfer may be batched, zero to add it to the pool immediately")
+	cmd.Flags().String(FlagChainFee, "", "the chain fee paid to the stakers, in the denom of the amount, see required-chain-fee for the minimum")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -342,6 +617,219 @@ func CmdCancelSendToEth() *cobra.Command {
 	return cmd
 }
 
+func CmdCreateRecurringSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "create-recurring-send-to-eth [eth-dest] [amount] [bridge-fee] [interval] [count]",
+		Short: "Escrows count times the amount and bridge fee, and adds a transfer of amount to the transaction pool every interval blocks starting with this block. The escrow of the transfers not sent yet can be reclaimed using cancel-recurring-send-to-eth",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			ethAddr, err := types.NewEthAddress(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid eth address")
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "amount")
+			}
+			bridgeFee, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bridge fee")
+			}
+			interval, err := strconv.ParseUint(args[3], 0, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse interval")
+			}
+			count, err := strconv.ParseUint(args[4], 0, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse count")
+			}
+
+			// Make the message
+			msg := types.NewMsgCreateRecurringSendToEth(cosmosAddr, *ethAddr, amount, bridgeFee, interval, count)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelRecurringSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "cancel-recurring-send-to-eth [recurring send id]",
+		Short: "Stops a recurring send to Ethereum and refunds the escrow of the transfers not sent yet, the transfers already in the transaction pool are not affected",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			id, err := strconv.ParseUint(args[0], 0, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse recurring send id")
+			}
+
+			// Make the message
+			msg := types.NewMsgCancelRecurringSendToEth(cosmosAddr, id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetSelfBridgeLimit() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "set-self-bridge-limit [limit]",
+		Short: "Limits the coins the sending account can send to Ethereum per day, a stricter limit applies at once and a looser one, or \"\" to remove it, after a day",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			limit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid limit")
+			}
+
+			// Make the message
+			msg := types.NewMsgSetSelfBridgeLimit(cosmosAddr, limit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdExecuteIbcAutoForwards() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "execute-ibc-auto-forwards [forwards-to-clear]",
+		Short: "Sends the oldest deposits waiting to be forwarded to other chains over IBC",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			forwardsToClear, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid forwards to clear")
+			}
+
+			// Make the message
+			msg := types.NewMsgExecuteIbcAutoForwards(cosmosAddr, forwardsToClear)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGrantSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "grant-send-to-eth [grantee] [spend-limit] [allowed eth destinations...]",
+		Short: "Grants an account the right to send coins of the sending account to Ethereum, up to the spend limit counting amounts and fees, to the given destinations or any destination if none are given",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid grantee")
+			}
+			spendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid spend limit")
+			}
+			authorization := types.NewSendToEthAuthorization(spendLimit, args[2:])
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+			expiration, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			// Make the message
+			msg, err := authz.NewMsgGrant(cosmosAddr, grantee, authorization, time.Unix(expiration, 0))
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The unix timestamp the grant expires at, a year from now by default")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUnjailValidator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "unjail-validator",
+		Short: "Unjails the validator operated by the sending key, a validator jailed for missing bridge signatures must first submit the confirms it is missing",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(cliCtx.GetFromAddress())
+
+			// Make the message
+			msg := types.NewMsgUnjailValidator(valAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdRequestBatch() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
@@ -397,3 +885,111 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdSubmitGravityProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "submit-gravity-proposal [path-to-content-json] [initial-deposit]",
+		Short: "Submits any gravity governance proposal through the gravity Msg service, the content json names its type with \"@type\", e.g. \"/gravity.v1.UnhaltBridgeProposal\"",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+			var content govtypes.Content
+			if err := cliCtx.Codec.UnmarshalInterfaceJSON(contents, &content); err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not a valid proposal")
+			}
+
+			// Make the message
+			msg, err := types.NewMsgSubmitGravityProposal(cosmosAddr, content, initialDeposit, metadata)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the proposal, such as a link to its discussion")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGovEmergencyValsetProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-emergency-valset [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to publish a hand constructed valset, such as a rescue multisig, as the next Ethereum checkpoint",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) > 1 {
+				return fmt.Errorf("coin amounts too long, expecting just 1 coin amount for the initial deposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.EmergencyValsetProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,73 @@
+// Package ethkeys stores the Ethereum delegate keys of orchestrators in the cosmos keyring, so
+// that they are protected by the same file, os or kwallet backends as the cosmos keys instead of
+// being handled as raw hex
+package ethkeys
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// HDPath is the derivation path of Ethereum wallets, keys derived from a mnemonic with it hold
+// the same address as in MetaMask and the other Ethereum wallets
+const HDPath = "m/44'/60'/0'/0/0"
+
+// importPassphrase only protects the armored key while it is imported, the keyring backend
+// encrypts the key it stores on its own
+const importPassphrase = "gravity-eth-key-import"
+
+// New generates a mnemonic and stores the Ethereum key derived from it under name
+func New(kr keyring.Keyring, name string) (keyring.Info, string, error) {
+	return kr.NewMnemonic(name, keyring.English, HDPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+}
+
+// Add derives an Ethereum key from mnemonic and stores it under name
+func Add(kr keyring.Keyring, name, mnemonic, bip39Passphrase string) (keyring.Info, error) {
+	return kr.NewAccount(name, mnemonic, bip39Passphrase, HDPath, hd.Secp256k1)
+}
+
+// Import stores an existing Ethereum private key under name
+func Import(kr keyring.Keyring, name string, key *ecdsa.PrivateKey) (keyring.Info, error) {
+	privKey := &secp256k1.PrivKey{Key: gethcrypto.FromECDSA(key)}
+	armor := crypto.EncryptArmorPrivKey(privKey, importPassphrase, string(hd.Secp256k1Type))
+	if err := kr.ImportPrivKey(name, armor, importPassphrase); err != nil {
+		return nil, err
+	}
+	return kr.Key(name)
+}
+
+// PrivateKey returns the Ethereum private key stored under name
+func PrivateKey(kr keyring.Keyring, name string) (*ecdsa.PrivateKey, error) {
+	info, err := kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.GetAlgo() != hd.Secp256k1Type {
+		return nil, fmt.Errorf("key %s is a %s key, ethereum keys are %s", name, info.GetAlgo(), hd.Secp256k1Type)
+	}
+	privKey, err := keyring.NewUnsafe(kr).UnsafeExportPrivKeyHex(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the private key %s", name)
+	}
+	return gethcrypto.HexToECDSA(privKey)
+}
+
+// Address returns the Ethereum address of a key stored in the keyring
+func Address(info keyring.Info) (common.Address, error) {
+	if info.GetAlgo() != hd.Secp256k1Type {
+		return common.Address{}, fmt.Errorf("key %s is a %s key, ethereum keys are %s", info.GetName(), info.GetAlgo(), hd.Secp256k1Type)
+	}
+	pubKey, err := gethcrypto.DecompressPubkey(info.GetPubKey().Bytes())
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "invalid public key of %s", info.GetName())
+	}
+	return gethcrypto.PubkeyToAddress(*pubKey), nil
+}
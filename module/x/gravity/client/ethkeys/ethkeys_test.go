@@ -0,0 +1,48 @@
+package ethkeys_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/client/ethkeys"
+)
+
+func TestAdd(t *testing.T) {
+	kr := keyring.NewInMemory()
+	// the first address of the hardhat and ganache test mnemonic
+	mnemonic := "test test test test test test test test test test test junk"
+
+	info, err := ethkeys.Add(kr, "eth", mnemonic, keyring.DefaultBIP39Passphrase)
+	require.NoError(t, err)
+	address, err := ethkeys.Address(info)
+	require.NoError(t, err)
+	require.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", address.Hex())
+
+	key, err := ethkeys.PrivateKey(kr, "eth")
+	require.NoError(t, err)
+	require.Equal(t, address, gethcrypto.PubkeyToAddress(key.PublicKey))
+}
+
+func TestImport(t *testing.T) {
+	kr := keyring.NewInMemory()
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	info, err := ethkeys.Import(kr, "eth", key)
+	require.NoError(t, err)
+	address, err := ethkeys.Address(info)
+	require.NoError(t, err)
+	require.Equal(t, gethcrypto.PubkeyToAddress(key.PublicKey), address)
+
+	stored, err := ethkeys.PrivateKey(kr, "eth")
+	require.NoError(t, err)
+	require.Equal(t, gethcrypto.FromECDSA(key), gethcrypto.FromECDSA(stored))
+
+	_, err = ethkeys.Import(kr, "eth", key)
+	require.Error(t, err, "keys are not overwritten")
+	_, err = ethkeys.PrivateKey(kr, "missing")
+	require.Error(t, err)
+}
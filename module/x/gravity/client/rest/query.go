@@ -1,214 +1,235 @@
 package rest
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
 
-func getValsetRequestHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		nonce := vars[nonce]
+// queryContext returns the client context querying at the height requested by r, if any, and a client of the
+// gravity gRPC query service through it. The response has been written if ok is false
+func queryContext(w http.ResponseWriter, cliCtx client.Context, r *http.Request) (client.Context, types.QueryClient, bool) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return cliCtx, nil, false
+	}
+	return cliCtx, types.NewQueryClient(cliCtx), true
+}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/valsetRequest/%s", storeName, nonce))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+// writeQueryResponse writes the JSON of the response to a gRPC query along with the height it was queried at,
+// or the error of the query
+func writeQueryResponse(w http.ResponseWriter, cliCtx client.Context, res proto.Message, err error) {
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "valset not found")
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	bz, err := cliCtx.Codec.MarshalJSON(res)
+	if rest.CheckInternalServerError(w, err) {
+		return
+	}
+	rest.PostProcessResponse(w, cliCtx, bz)
+}
+
+func paramsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-
-		var out types.Valset
-		cliCtx.Codec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.Params(r.Context(), &types.QueryParamsRequest{})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
-// USED BY RUST
-func batchByNonceHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func getValsetRequestHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		nonce := vars[nonce]
-		denom := vars[tokenAddress]
-
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/batch/%s/%s", storeName, nonce, denom))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		valsetNonce, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[nonce])
+		if !ok {
+			return
+		}
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-		if len(res) == 0 {
+		res, err := queryClient.ValsetRequest(r.Context(), &types.QueryValsetRequestRequest{Nonce: valsetNonce})
+		if err == nil && res.Valset == nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, "valset not found")
 			return
 		}
-
-		var out types.OutgoingTxBatch
-		cliCtx.Codec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
 // USED BY RUST
-func lastBatchesHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func batchByNonceHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/lastBatches", storeName))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		vars := mux.Vars(r)
+		batchNonce, ok := rest.ParseUint64OrReturnBadRequest(w, vars[nonce])
+		if !ok {
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "valset not found")
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
+		res, err := queryClient.BatchRequestByNonce(r.Context(), &types.QueryBatchRequestByNonceRequest{
+			Nonce:           batchNonce,
+			ContractAddress: vars[tokenAddress],
+		})
+		writeQueryResponse(w, cliCtx, res, err)
+	}
+}
 
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+// USED BY RUST
+func lastBatchesHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		res, err := queryClient.OutgoingTxBatches(r.Context(), &types.QueryOutgoingTxBatchesRequest{})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
 // gets all the confirm messages for a given validator set nonce
-func allValsetConfirmsHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func allValsetConfirmsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		nonce := vars[nonce]
-
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/valsetConfirms/%s", storeName, nonce))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		valsetNonce, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[nonce])
+		if !ok {
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "valset confirms not found")
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.ValsetConfirmsByNonce(r.Context(), &types.QueryValsetConfirmsByNonceRequest{Nonce: valsetNonce})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
 // gets all the confirm messages for a given transaction batch
-func allBatchConfirmsHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func allBatchConfirmsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		nonce := vars[nonce]
-		denom := vars[tokenAddress]
-
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/batchConfirms/%s/%s", storeName, nonce, denom))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		batchNonce, ok := rest.ParseUint64OrReturnBadRequest(w, vars[nonce])
+		if !ok {
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "valset confirms not found")
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.BatchConfirms(r.Context(), &types.QueryBatchConfirmsRequest{
+			Nonce:           batchNonce,
+			ContractAddress: vars[tokenAddress],
+		})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
-func lastValsetRequestsHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func lastValsetRequestsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/lastValsetRequests", storeName))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "valset requests not found")
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.LastValsetRequests(r.Context(), &types.QueryLastValsetRequestsRequest{})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
-func lastValsetRequestsByAddressHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func lastValsetRequestsByAddressHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		operatorAddr := vars[bech32ValidatorAddress]
-
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/lastPendingValsetRequest/%s", storeName, operatorAddr))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "no pending valset requests found")
+		res, err := queryClient.LastPendingValsetRequestByAddr(r.Context(), &types.QueryLastPendingValsetRequestByAddrRequest{
+			Address: mux.Vars(r)[bech32ValidatorAddress],
+		})
+		writeQueryResponse(w, cliCtx, res, err)
+	}
+}
+
+func lastBatchesByAddressHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-
-		var out types.Valset
-		cliCtx.Codec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.LastPendingBatchRequestByAddr(r.Context(), &types.QueryLastPendingBatchRequestByAddrRequest{
+			Address: mux.Vars(r)[bech32ValidatorAddress],
+		})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
-func lastBatchesByAddressHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func currentValsetHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		operatorAddr := vars[bech32ValidatorAddress]
-
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/lastPendingBatchRequest/%s", storeName, operatorAddr))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "no pending valset requests found")
+		res, err := queryClient.CurrentValset(r.Context(), &types.QueryCurrentValsetRequest{})
+		writeQueryResponse(w, cliCtx, res, err)
+	}
+}
+
+func batchFeesHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-
-		var out types.OutgoingTxBatch
-		cliCtx.Codec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.BatchFees(r.Context(), &types.QueryBatchFeeRequest{})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
-func currentValsetHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+// gets the transfers of a sender to Ethereum which are waiting in the pool or in a batch
+func pendingSendToEthHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/currentValset", storeName))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-		var out types.Valset
-		cliCtx.Codec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.GetPendingSendToEth(r.Context(), &types.QueryPendingSendToEth{
+			SenderAddress: mux.Vars(r)[bech32Address],
+		})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
-func denomToERC20Handler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func denomToERC20Handler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		denom := vars[denom]
-
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/DenomToERC20/%s", storeName, denom))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.DenomToERC20(r.Context(), &types.QueryDenomToERC20Request{Denom: mux.Vars(r)[denom]})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
 
-func ERC20ToDenomHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func ERC20ToDenomHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		ERC20 := vars[tokenAddress]
-
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/ERC20ToDenom/%s", storeName, ERC20))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		cliCtx, queryClient, ok := queryContext(w, cliCtx, r)
+		if !ok {
 			return
 		}
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		res, err := queryClient.ERC20ToDenom(r.Context(), &types.QueryERC20ToDenomRequest{Erc20: mux.Vars(r)[tokenAddress]})
+		writeQueryResponse(w, cliCtx, res, err)
 	}
 }
@@ -13,6 +13,7 @@ const (
 	tokenAddress           = "tokenAddress"
 	denom                  = "denom"
 	bech32ValidatorAddress = "bech32ValidatorAddress"
+	bech32Address          = "bech32Address"
 )
 
 // Here are the routes that are actually queried by the rust
@@ -23,44 +24,62 @@ const (
 // "gravity/pending_batch_requests/{}"
 // "gravity/transaction_batches/"
 // "gravity/signed_batches"
+//
+// The queries are served by the gRPC query service, so that clients of the legacy LCD can use the bridge without
+// moving to gRPC first. Transactions are only generated, their signing and broadcasting are left to the client
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx client.Context, r *mux.Router, storeName string) {
 
+	// Returns the params of the module
+	r.HandleFunc(fmt.Sprintf("/%s/params", storeName), paramsHandler(cliCtx)).Methods("GET")
+
 	/// Valsets
 
 	// This endpoint gets all of the validator set confirmations for a given nonce. In order to determine if a valset is complete
 	// the relayer queries the latest valsets and then compares the number of members they show versus the length of this endpoints output
 	// if they match every validator has submitted a signature and we can go forward with relaying that validator set update.
-	r.HandleFunc(fmt.Sprintf("/%s/valset_confirm/{%s}", storeName, nonce), allValsetConfirmsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/valset_confirm/{%s}", storeName, nonce), allValsetConfirmsHandler(cliCtx)).Methods("GET")
 	// gets the latest 5 validator set requests, used heavily by the relayer. Which hits this endpoint before checking which
 	// of these last 5 have sufficient signatures to relay
-	r.HandleFunc(fmt.Sprintf("/%s/valset_requests", storeName), lastValsetRequestsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/valset_requests", storeName), lastValsetRequestsHandler(cliCtx)).Methods("GET")
 	// Returns the last 'pending' (unsigned) validator set for a given validator address.
-	r.HandleFunc(fmt.Sprintf("/%s/pending_valset_requests/{%s}", storeName, bech32ValidatorAddress), lastValsetRequestsByAddressHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pending_valset_requests/{%s}", storeName, bech32ValidatorAddress), lastValsetRequestsByAddressHandler(cliCtx)).Methods("GET")
 	// gets valset request by nonce, used to look up a specific valset. This is needed to lookup data about the current validator set on the contract
 	// and determine what can or can not be submitted as a relayer
-	r.HandleFunc(fmt.Sprintf("/%s/valset_request/{%s}", storeName, nonce), getValsetRequestHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/valset_request/{%s}", storeName, nonce), getValsetRequestHandler(cliCtx)).Methods("GET")
 	// Provides the current validator set with powers and eth addresses, useful to check the current validator state
 	// used to deploy the contract by the contract deployer script
-	r.HandleFunc(fmt.Sprintf("/%s/current_valset", storeName), currentValsetHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/current_valset", storeName), currentValsetHandler(cliCtx)).Methods("GET")
 
 	/// Batches
 
 	// The Ethereum signer queries this endpoint and signs whatever it returns once per loop iteration
-	r.HandleFunc(fmt.Sprintf("/%s/pending_batch_requests/{%s}", storeName, bech32ValidatorAddress), lastBatchesByAddressHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pending_batch_requests/{%s}", storeName, bech32ValidatorAddress), lastBatchesByAddressHandler(cliCtx)).Methods("GET")
 	// Gets all outgoing batches in the batch queue, up to 100
-	r.HandleFunc(fmt.Sprintf("/%s/transaction_batches", storeName), lastBatchesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/transaction_batches", storeName), lastBatchesHandler(cliCtx)).Methods("GET")
 	// Gets a specific batch request from the outgoing queue by denom
-	r.HandleFunc(fmt.Sprintf("/%s/transaction_batch/{%s}/{%s}", storeName, nonce, tokenAddress), batchByNonceHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/transaction_batch/{%s}/{%s}", storeName, nonce, tokenAddress), batchByNonceHandler(cliCtx)).Methods("GET")
 	// This endpoint gets all of the batch confirmations for a given nonce and denom In order to determine if a batch is complete
 	// the relayer will compare the valset power on the contract to the number of signatures
-	r.HandleFunc(fmt.Sprintf("/%s/batch_confirm/{%s}/{%s}", storeName, nonce, tokenAddress), allBatchConfirmsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/batch_confirm/{%s}/{%s}", storeName, nonce, tokenAddress), allBatchConfirmsHandler(cliCtx)).Methods("GET")
+
+	// Gets the fees of the transfers waiting in the pool for every token, the fees a relayer would receive by batching them
+	r.HandleFunc(fmt.Sprintf("/%s/batch_fees", storeName), batchFeesHandler(cliCtx)).Methods("GET")
+
+	/// Transfers to Ethereum
+
+	// Gets the transfers of a sender which are waiting in the pool or in a batch
+	r.HandleFunc(fmt.Sprintf("/%s/pending_send_to_eth/{%s}", storeName, bech32Address), pendingSendToEthHandler(cliCtx)).Methods("GET")
+	// Generates a transaction sending tokens to Ethereum
+	r.HandleFunc(fmt.Sprintf("/%s/send_to_eth", storeName), sendToEthHandler(cliCtx)).Methods("POST")
+	// Generates a transaction cancelling a transfer to Ethereum which is not batched yet
+	r.HandleFunc(fmt.Sprintf("/%s/cancel_send_to_eth", storeName), cancelSendToEthHandler(cliCtx)).Methods("POST")
 
 	/// Cosmos originated assets
 
 	// This handler lets you retrieve the ERC20 contract corresponding to a given denom
-	r.HandleFunc(fmt.Sprintf("/%s/denom_to_erc20/{%s}", storeName, denom), denomToERC20Handler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/denom_to_erc20/{%s}", storeName, denom), denomToERC20Handler(cliCtx)).Methods("GET")
 	// This handler lets you retrieve the denom corresponding to a given ERC20 contract
-	r.HandleFunc(fmt.Sprintf("/%s/erc20_to_denom/{%s}", storeName, tokenAddress), ERC20ToDenomHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/erc20_to_denom/{%s}", storeName, tokenAddress), ERC20ToDenomHandler(cliCtx)).Methods("GET")
 }
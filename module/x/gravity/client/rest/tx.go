@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -98,3 +99,85 @@ type bootstrapConfirmReq struct {
 	GravityID             string                 `json:"gravity_id"`
 	StartThreshold        uint64                 `json:"start_threshold"`
 }
+
+type sendToEthReq struct {
+	BaseReq            rest.BaseReq `json:"base_req"`
+	EthDest            string       `json:"eth_dest"`
+	Amount             sdk.Coin     `json:"amount"`
+	BridgeFee          sdk.Coin     `json:"bridge_fee"`
+	ExecuteAfterHeight string       `json:"execute_after_height"`
+}
+
+// generates the unsigned transaction sending amount from the sender of the base request to eth_dest, its signing
+// and broadcasting are left to the client
+func sendToEthHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req sendToEthReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(baseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		ethDest, err := types.NewEthAddress(req.EthDest)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgSendToEth(sender, *ethDest, req.Amount, req.BridgeFee)
+		if req.ExecuteAfterHeight != "" {
+			msg.ExecuteAfterHeight, err = strconv.ParseUint(req.ExecuteAfterHeight, 10, 64)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
+
+type cancelSendToEthReq struct {
+	BaseReq       rest.BaseReq `json:"base_req"`
+	TransactionID string       `json:"transaction_id"`
+}
+
+// generates the unsigned transaction cancelling a transfer of the sender of the base request which is not batched yet
+func cancelSendToEthHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req cancelSendToEthReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(baseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		txID, ok := rest.ParseUint64OrReturnBadRequest(w, req.TransactionID)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCancelSendToEth(sender, txID)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
@@ -13,8 +13,8 @@ import (
 )
 
 // Have the validators put in a erc20<>denom relation with ERC20DeployedEvent
-// Send some coins of that denom into the cosmos module
-// Check that the coins are locked, not burned
+// Send some coins of that denom to Ethereum in an executed batch
+// Check that the coins are locked in the module account, not burned
 // Have the validators put in a deposit event for that ERC20
 // Check that the coins are unlocked and sent to the right account
 
@@ -84,9 +84,9 @@ func addDenomToERC20Relation(tv *testingVars, myNonce uint64) {
 		require.NoError(tv.t, err)
 
 		// check if attestations persisted
-		hash, err := ethClaim.ClaimHash()
+		hash, err := ethClaim.ClaimHash(types.ClaimEncodingVersion)
 		require.NoError(tv.t, err)
-		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, hash)
+		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, types.ClaimEncodingVersion, hash)
 		require.NotNil(tv.t, a)
 	}
 
@@ -140,6 +140,21 @@ func lockCoinsInModule(tv *testingVars) {
 	balance2 := tv.input.BankKeeper.GetAllBalances(tv.ctx, userCosmosAddr)
 	assert.Equal(tv.t, sdk.Coins{sdk.NewCoin(denom, startingCoinAmount.Sub(sendAmount).Sub(feeAmount))}, balance2)
 
+	// Check that the unbatched pool balance has gone up
+	poolAddr := tv.input.AccountKeeper.GetModuleAddress(types.UnbatchedPoolAccountName)
+	assert.Equal(tv.t,
+		sdk.Coins{sdk.NewCoin(denom, sendAmount.Add(feeAmount))},
+		tv.input.BankKeeper.GetAllBalances(tv.ctx, poolAddr),
+	)
+
+	// Execute the batch sending the coins to Ethereum
+	tokenContract, err := types.NewEthAddress(tv.erc20)
+	require.NoError(tv.t, err)
+	batch, err := tv.input.GravityKeeper.BuildOutgoingTXBatch(tv.ctx, *tokenContract, keeper.OutgoingTxBatchSize)
+	require.NoError(tv.t, err)
+	tv.input.GravityKeeper.OutgoingTxBatchExecuted(tv.ctx, *tokenContract, batch.BatchNonce, nil)
+	assert.True(tv.t, tv.input.BankKeeper.GetAllBalances(tv.ctx, poolAddr).IsZero())
+
 	// Check that gravity balance has gone up
 	gravityAddr := tv.input.AccountKeeper.GetModuleAddress(types.ModuleName)
 	assert.Equal(tv.t,
@@ -176,9 +191,9 @@ func acceptDepositEvent(tv *testingVars, myNonce uint64) {
 		EndBlocker(tv.ctx, tv.input.GravityKeeper)
 
 		// check that attestation persisted
-		hash, err := ethClaim.ClaimHash()
+		hash, err := ethClaim.ClaimHash(types.ClaimEncodingVersion)
 		require.NoError(tv.t, err)
-		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, hash)
+		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, types.ClaimEncodingVersion, hash)
 		require.NotNil(tv.t, a)
 	}
 
@@ -234,9 +249,9 @@ func addIbcDenomToERC20Relation(tv *testingVars, myNonce uint64) {
 		require.NoError(tv.t, err)
 
 		// check if attestations persisted
-		hash, err := ethClaim.ClaimHash()
+		hash, err := ethClaim.ClaimHash(types.ClaimEncodingVersion)
 		require.NoError(tv.t, err)
-		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, hash)
+		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, types.ClaimEncodingVersion, hash)
 		require.NotNil(tv.t, a)
 	}
 
@@ -255,3 +270,98 @@ func addIbcDenomToERC20Relation(tv *testingVars, myNonce uint64) {
 	assert.Equal(tv.t, tv.denom, gotDenom)
 	assert.Equal(tv.t, tv.erc20, gotERC20.GetAddress())
 }
+
+// Have the validators observe ERC20 deployments which do not match the denom metadata exactly
+// Check that the denom stays unpaired and that the rejection reasons can be queried
+func TestERC20DeployedRejection(t *testing.T) {
+	tv := initializeTestingVars(t)
+	tv.input.BankKeeper.SetDenomMetaData(tv.ctx, banktypes.Metadata{
+		Name:   "Graviton",
+		Symbol: "GRAV",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ugraviton", Exponent: uint32(0)},
+			{Denom: "graviton", Exponent: uint32(6)},
+		},
+		Base:    "ugraviton",
+		Display: "graviton",
+	})
+	tv.input.BankKeeper.SetDenomMetaData(tv.ctx, banktypes.Metadata{
+		Name:       "Stake",
+		Symbol:     "STAKE",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ustake", Exponent: uint32(0)}},
+		Base:       "ustake",
+		Display:    "stake",
+	})
+	otherERC20, _ := keeper.RandomEthAddress()
+
+	deployed := func(denom, erc20, name, symbol string, decimals uint64) types.MsgERC20DeployedClaim {
+		return types.MsgERC20DeployedClaim{
+			CosmosDenom:   denom,
+			TokenContract: erc20,
+			Name:          name,
+			Symbol:        symbol,
+			Decimals:      decimals,
+		}
+	}
+	rejected := []struct {
+		claim  types.MsgERC20DeployedClaim
+		reason string
+	}{
+		{claim: deployed(tv.denom, tv.erc20, "graviton", "GRAV", 6), reason: "name"},
+		{claim: deployed(tv.denom, tv.erc20, "Graviton", "GRAV ", 6), reason: "symbol"},
+		// the decimals must not be truncated to the width of the exponent
+		{claim: deployed(tv.denom, tv.erc20, "Graviton", "GRAV", 1<<32+6), reason: "decimals"},
+		{claim: deployed("ustake", otherERC20, "Stake", "STAKE", 0), reason: "display denom"},
+	}
+
+	nonce := uint64(1)
+	for _, tc := range rejected {
+		observeERC20Deployed(tv, nonce, tc.claim)
+		_, exists := tv.input.GravityKeeper.GetCosmosOriginatedERC20(tv.ctx, tc.claim.CosmosDenom)
+		require.False(t, exists)
+		nonce++
+	}
+
+	// the exact metadata pairs the denom, which can then neither be paired again nor its ERC20 reused
+	observeERC20Deployed(tv, nonce, deployed(tv.denom, tv.erc20, "Graviton", "GRAV", 6))
+	nonce++
+	erc20, exists := tv.input.GravityKeeper.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	require.True(t, exists)
+	require.Equal(t, tv.erc20, erc20.GetAddress())
+
+	observeERC20Deployed(tv, nonce, deployed(tv.denom, otherERC20, "Graviton", "GRAV", 6))
+	nonce++
+	observeERC20Deployed(tv, nonce, deployed("ustake", tv.erc20, "Stake", "STAKE", 0))
+	erc20, _ = tv.input.GravityKeeper.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	require.Equal(t, tv.erc20, erc20.GetAddress())
+	_, exists = tv.input.GravityKeeper.GetCosmosOriginatedERC20(tv.ctx, "ustake")
+	require.False(t, exists)
+
+	res, err := keeper.NewQueryServerImpl(tv.input.GravityKeeper).ERC20DeployedRejections(sdk.WrapSDKContext(tv.ctx),
+		&types.QueryERC20DeployedRejectionsRequest{Denom: tv.denom})
+	require.NoError(t, err)
+	require.Len(t, res.Rejections, 4)
+	for i, tc := range rejected[:3] {
+		require.Equal(t, uint64(i+1), res.Rejections[i].EventNonce)
+		require.Equal(t, tc.claim.TokenContract, res.Rejections[i].TokenContract)
+		require.Contains(t, res.Rejections[i].Reason, tc.reason)
+	}
+	require.Contains(t, res.Rejections[3].Reason, "already exists for denom")
+
+	res, err = keeper.NewQueryServerImpl(tv.input.GravityKeeper).ERC20DeployedRejections(sdk.WrapSDKContext(tv.ctx),
+		&types.QueryERC20DeployedRejectionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Rejections, 6)
+	require.Contains(t, res.Rejections[5].Reason, "is already paired with denom")
+}
+
+func observeERC20Deployed(tv *testingVars, nonce uint64, claim types.MsgERC20DeployedClaim) {
+	for _, v := range keeper.OrchAddrs {
+		ethClaim := claim
+		ethClaim.EventNonce = nonce
+		ethClaim.Orchestrator = v.String()
+		_, err := tv.h(tv.ctx, &ethClaim)
+		require.NoError(tv.t, err)
+	}
+	EndBlocker(tv.ctx, tv.input.GravityKeeper)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -130,9 +131,9 @@ func TestMsgSendToCosmosClaim(t *testing.T) {
 		require.NoError(t, err)
 
 		// and attestation persisted
-		hash, err := ethClaim.ClaimHash()
+		hash, err := ethClaim.ClaimHash(types.ClaimEncodingVersion)
 		require.NoError(t, err)
-		a := input.GravityKeeper.GetAttestation(ctx, uint64(1), hash)
+		a := input.GravityKeeper.GetAttestation(ctx, uint64(1), types.ClaimEncodingVersion, hash)
 		require.NotNil(t, a)
 
 		// Test to reject duplicate deposit
@@ -238,9 +239,9 @@ func TestMsgSendToCosmosClaimWithDenomSwap(t *testing.T) {
 		require.NoError(t, err)
 
 		// and attestation persisted
-		hash, err := ethClaim.ClaimHash()
+		hash, err := ethClaim.ClaimHash(types.ClaimEncodingVersion)
 		require.NoError(t, err)
-		a := input.GravityKeeper.GetAttestation(ctx, uint64(1), hash)
+		a := input.GravityKeeper.GetAttestation(ctx, uint64(1), types.ClaimEncodingVersion, hash)
 		require.NotNil(t, a)
 
 		// Test to reject duplicate deposit
@@ -257,6 +258,73 @@ func TestMsgSendToCosmosClaimWithDenomSwap(t *testing.T) {
 	assert.Equal(t, sdk.Coins{sdk.NewCoin(swapDenom, amountA)}, balance)
 }
 
+// Tests that native ETH deposits, reported with the zero address or as WETH, are credited as gravity-eth vouchers with
+// metadata, which are sent back to Ethereum as WETH once the weth contract is set
+//nolint: exhaustivestruct
+func TestMsgSendToCosmosClaimNativeEth(t *testing.T) {
+	var (
+		myCosmosAddr = keeper.RandomAccAddress()
+		anyETHAddr   = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		wethAddr, _  = keeper.RandomEthAddress()
+		myBlockTime  = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+		amount, _    = sdk.NewIntFromString("50000000000000000000") // 50 ETH
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	h := NewHandler(input.GravityKeeper)
+
+	deposit := func(nonce uint64, tokenContract string) {
+		for _, v := range keeper.OrchAddrs {
+			ethClaim := types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				TokenContract:  tokenContract,
+				Amount:         amount,
+				EthereumSender: anyETHAddr,
+				CosmosReceiver: myCosmosAddr.String(),
+				Orchestrator:   v.String(),
+			}
+			ctx = ctx.WithBlockTime(myBlockTime)
+			_, err := h(ctx, &ethClaim)
+			require.NoError(t, err)
+			EndBlocker(ctx, input.GravityKeeper)
+		}
+	}
+
+	// before the contract wraps ETH the vouchers can not be sent back
+	deposit(1, types.ZeroAddressString)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(types.NativeEthDenom, amount)), input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+	metadata, found := input.BankKeeper.GetDenomMetaData(ctx, types.NativeEthDenom)
+	require.True(t, found)
+	assert.Equal(t, types.NativeEthMetadata(), metadata)
+	require.NoError(t, metadata.Validate())
+	_, _, err := input.GravityKeeper.DenomToERC20Lookup(ctx, types.NativeEthDenom)
+	require.Error(t, err)
+	_, _, err = input.GravityKeeper.DenomToERC20Lookup(ctx, types.GravityDenomPrefix+types.ZeroAddressString)
+	require.Error(t, err)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.WethContract = wethAddr
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// wrapped ETH is the same voucher
+	deposit(2, wethAddr)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(types.NativeEthDenom, amount.MulRaw(2))), input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+	cosmosOriginated, erc20, err := input.GravityKeeper.DenomToERC20Lookup(ctx, types.NativeEthDenom)
+	require.NoError(t, err)
+	assert.False(t, cosmosOriginated)
+	assert.Equal(t, wethAddr, erc20.GetAddress())
+
+	_, err = h(ctx, &types.MsgSendToEth{
+		Sender:    myCosmosAddr.String(),
+		EthDest:   anyETHAddr,
+		Amount:    sdk.NewCoin(types.NativeEthDenom, amount),
+		BridgeFee: sdk.NewCoin(types.NativeEthDenom, sdk.NewInt(1)),
+	})
+	require.NoError(t, err)
+	unbatched := input.GravityKeeper.GetUnbatchedTransactions(ctx)
+	require.Len(t, unbatched, 1)
+	assert.Equal(t, wethAddr, unbatched[0].Erc20Token.Contract.GetAddress())
+}
+
 //nolint: exhaustivestruct
 func TestEthereumBlacklist(t *testing.T) {
 	var (
@@ -301,9 +369,9 @@ func TestEthereumBlacklist(t *testing.T) {
 		require.NoError(t, err)
 
 		// and attestation persisted
-		hash, err := ethClaim.ClaimHash()
+		hash, err := ethClaim.ClaimHash(types.ClaimEncodingVersion)
 		require.NoError(t, err)
-		a := input.GravityKeeper.GetAttestation(ctx, uint64(1), hash)
+		a := input.GravityKeeper.GetAttestation(ctx, uint64(1), types.ClaimEncodingVersion, hash)
 		require.NotNil(t, a)
 
 		// Test to reject duplicate deposit
@@ -319,13 +387,17 @@ func TestEthereumBlacklist(t *testing.T) {
 	balance := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
 	assert.NotEqual(t, sdk.Coins{sdk.NewCoin(denom, amountA)}, balance)
 
-	// Make sure that the balance is empty since funds should be sent to the community pool
+	// Make sure that the balance is empty since the deposit of a blacklisted sender is held
 	assert.Equal(t, balance, sdk.Coins{})
 
-	//Check community pool has received the money instead of the address
-	community_pool_balance := input.DistKeeper.GetFeePool(ctx).CommunityPool
-	assert.Equal(t, sdk.NewDecCoinsFromCoins(sdk.NewCoin(denom, amountA)), community_pool_balance)
-
+	// Check the deposit is held for governance instead of sent to the address
+	held := input.GravityKeeper.GetHeldDeposit(ctx, 1)
+	require.NotNil(t, held)
+	assert.Equal(t, types.HELD_DEPOSIT_REASON_SENDER_BLACKLISTED, held.Reason)
+	assert.Equal(t, sdk.NewCoin(denom, amountA), held.Amount)
+	heldAcc := input.AccountKeeper.GetModuleAddress(types.HeldDepositsAccountName)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin(denom, amountA)}, input.BankKeeper.GetAllBalances(ctx, heldAcc))
+	assert.True(t, input.DistKeeper.GetFeePool(ctx).CommunityPool.IsZero())
 }
 
 const biggestInt = "115792089237316195423570985008687907853269984665640564039457584007913129639935" // 2^256 - 1
@@ -483,9 +555,9 @@ func TestMsgSendToCosmosClaimSpreadVotes(t *testing.T) {
 		require.NoError(t, err)
 
 		// and attestation persisted
-		hash, err := ethClaim.ClaimHash()
+		hash, err := ethClaim.ClaimHash(types.ClaimEncodingVersion)
 		require.NoError(t, err)
-		a1 := input.GravityKeeper.GetAttestation(ctx, myNonce, hash)
+		a1 := input.GravityKeeper.GetAttestation(ctx, myNonce, types.ClaimEncodingVersion, hash)
 		require.NotNil(t, a1)
 		// and vouchers not yet added to the account
 		balance1 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
@@ -500,9 +572,9 @@ func TestMsgSendToCosmosClaimSpreadVotes(t *testing.T) {
 	require.NoError(t, err)
 
 	// and attestation persisted
-	hash, err := ethClaim.ClaimHash()
+	hash, err := ethClaim.ClaimHash(types.ClaimEncodingVersion)
 	require.NoError(t, err)
-	a2 := input.GravityKeeper.GetAttestation(ctx, myNonce, hash)
+	a2 := input.GravityKeeper.GetAttestation(ctx, myNonce, types.ClaimEncodingVersion, hash)
 	require.NotNil(t, a2)
 	// and vouchers now added to the account
 	balance2 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
@@ -516,9 +588,9 @@ func TestMsgSendToCosmosClaimSpreadVotes(t *testing.T) {
 	require.NoError(t, err)
 
 	// and attestation persisted
-	hash, err = ethClaim.ClaimHash()
+	hash, err = ethClaim.ClaimHash(types.ClaimEncodingVersion)
 	require.NoError(t, err)
-	a3 := input.GravityKeeper.GetAttestation(ctx, myNonce, hash)
+	a3 := input.GravityKeeper.GetAttestation(ctx, myNonce, types.ClaimEncodingVersion, hash)
 	require.NotNil(t, a3)
 	// and no additional added to the account
 	balance3 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
@@ -586,6 +658,172 @@ func TestMsgSendToCosmosForeignPrefixedAddress(t *testing.T) {
 	require.Equal(t, nativeBals, sdk.NewCoins(sdk.NewCoin(erc20Denom, expectedDoubleBalance)))
 }
 
+//nolint: exhaustivestruct
+func TestInvalidReceiverPolicy(t *testing.T) {
+	var (
+		tokenETHAddr, denom = keeper.RandomEthAddress()
+		anyETHSender        = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		blacklistedSender   = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		amount              = sdk.NewInt(100)
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+	h := NewHandler(k)
+	params := k.GetParams(ctx)
+	params.EthereumBlacklist = []string{blacklistedSender}
+
+	// every deposit is sent to an undecodable receiver under a different policy
+	deposits := []struct {
+		policy types.InvalidReceiverPolicy
+		sender string
+	}{
+		{types.INVALID_RECEIVER_POLICY_COMMUNITY_POOL, anyETHSender},
+		{types.INVALID_RECEIVER_POLICY_HOLD, anyETHSender},
+		{types.INVALID_RECEIVER_POLICY_REFUND, anyETHSender},
+		{types.INVALID_RECEIVER_POLICY_REFUND, blacklistedSender},
+	}
+	for i, deposit := range deposits {
+		params.InvalidReceiverPolicy = deposit.policy
+		k.SetParams(ctx, params)
+		for _, orch := range keeper.OrchAddrs {
+			_, err := h(ctx, &types.MsgSendToCosmosClaim{
+				EventNonce:     uint64(i + 1),
+				BlockHeight:    uint64(i + 1),
+				TokenContract:  tokenETHAddr,
+				Amount:         amount,
+				EthereumSender: deposit.sender,
+				CosmosReceiver: "not a bech32 address",
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+		EndBlocker(ctx, k)
+		require.Equal(t, uint64(i+1), k.GetLastObservedEventNonce(ctx))
+	}
+
+	// the first deposit is sent to the community pool
+	communityPool := input.DistKeeper.GetFeePool(ctx).CommunityPool
+	assert.Equal(t, sdk.NewDecCoinsFromCoins(sdk.NewCoin(denom, amount)), communityPool)
+
+	// the second is held, like the fourth whose blacklisted sender can not be refunded
+	for _, nonce := range []uint64{2, 4} {
+		held := k.GetHeldDeposit(ctx, nonce)
+		require.NotNil(t, held)
+		assert.Equal(t, types.HELD_DEPOSIT_REASON_INVALID_RECEIVER, held.Reason)
+	}
+
+	// the third is refunded to its sender
+	require.Nil(t, k.GetHeldDeposit(ctx, 3))
+	unbatched := k.GetUnbatchedTransactions(ctx)
+	require.Len(t, unbatched, 1)
+	assert.Equal(t, anyETHSender, unbatched[0].DestAddress.GetAddress())
+	assert.Equal(t, amount, unbatched[0].Erc20Token.Amount)
+}
+
+// Tests that a deposit to a module account follows the invalid receiver policy unless its module is allowed to
+// receive deposits or module account receivers are not blocked
+//nolint: exhaustivestruct
+func TestModuleAccountReceiver(t *testing.T) {
+	var (
+		tokenETHAddr, denom = keeper.RandomEthAddress()
+		anyETHSender        = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		amount              = sdk.NewInt(100)
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+	h := NewHandler(k)
+	// a module account the bank module does not block
+	moduleAcc := authtypes.NewEmptyModuleAccount("liquidstake")
+	input.AccountKeeper.SetAccount(ctx, input.AccountKeeper.NewAccount(ctx, moduleAcc))
+	params := k.GetParams(ctx)
+	params.InvalidReceiverPolicy = types.INVALID_RECEIVER_POLICY_HOLD
+
+	deposits := []struct {
+		block   bool
+		allowed []string
+	}{
+		{true, []string{}},
+		{true, []string{"liquidstake"}},
+		{false, []string{}},
+	}
+	for i, deposit := range deposits {
+		params.BlockModuleAccountReceivers = deposit.block
+		params.AllowedReceiverModules = deposit.allowed
+		k.SetParams(ctx, params)
+		for _, orch := range keeper.OrchAddrs {
+			_, err := h(ctx, &types.MsgSendToCosmosClaim{
+				EventNonce:     uint64(i + 1),
+				BlockHeight:    uint64(i + 1),
+				TokenContract:  tokenETHAddr,
+				Amount:         amount,
+				EthereumSender: anyETHSender,
+				CosmosReceiver: moduleAcc.GetAddress().String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+		EndBlocker(ctx, k)
+		require.Equal(t, uint64(i+1), k.GetLastObservedEventNonce(ctx))
+	}
+
+	// the first deposit is held as sent to an invalid receiver, the others are minted into the module account
+	held := k.GetHeldDeposit(ctx, 1)
+	require.NotNil(t, held)
+	assert.Equal(t, types.HELD_DEPOSIT_REASON_INVALID_RECEIVER, held.Reason)
+	require.Nil(t, k.GetHeldDeposit(ctx, 2))
+	require.Nil(t, k.GetHeldDeposit(ctx, 3))
+	assert.Equal(t, sdk.NewCoin(denom, amount.MulRaw(2)), input.BankKeeper.GetBalance(ctx, moduleAcc.GetAddress(), denom))
+}
+
+// Tests that the first observed deposit of an Ethereum originated ERC20 records its provenance, that later deposits
+// only add to its count and total, and that the provenance is exported and queried
+func TestERC20Provenance(t *testing.T) {
+	var (
+		tokenETHAddr, _ = keeper.RandomEthAddress()
+		firstSender     = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		laterSender     = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		receiver        = keeper.RandomAccAddress()
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+	h := NewHandler(k)
+
+	for i, sender := range []string{firstSender, laterSender} {
+		for _, orch := range keeper.OrchAddrs {
+			_, err := h(ctx, &types.MsgSendToCosmosClaim{
+				EventNonce:     uint64(i + 1),
+				BlockHeight:    uint64(100 + i),
+				TokenContract:  tokenETHAddr,
+				Amount:         sdk.NewInt(int64(10 * (i + 1))),
+				EthereumSender: sender,
+				CosmosReceiver: receiver.String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+		EndBlocker(ctx, k)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+
+	expected := types.ERC20Provenance{
+		TokenContract:       tokenETHAddr,
+		FirstDepositor:      firstSender,
+		FirstReceiver:       receiver.String(),
+		FirstEventNonce:     1,
+		FirstEthereumHeight: 100,
+		FirstHeight:         uint64(ctx.BlockHeight() - 2),
+		DepositCount:        2,
+		TotalDeposited:      sdk.NewInt(30),
+	}
+	res, err := keeper.NewQueryServerImpl(k).ERC20Provenances(sdk.WrapSDKContext(ctx), &types.QueryERC20ProvenancesRequest{TokenContract: tokenETHAddr})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ERC20Provenance{expected}, res.Provenances)
+	res, err = keeper.NewQueryServerImpl(k).ERC20Provenances(sdk.WrapSDKContext(ctx), &types.QueryERC20ProvenancesRequest{TokenContract: firstSender})
+	require.NoError(t, err)
+	assert.Empty(t, res.Provenances)
+	assert.Equal(t, []types.ERC20Provenance{expected}, keeper.ExportGenesis(ctx, k).Erc20Provenances)
+}
+
 //nolint: exhaustivestruct
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	var (
@@ -627,13 +865,13 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	queryO := types.QueryDelegateKeysByOrchestratorAddress{
 		OrchestratorAddress: cosmosAddress.String(),
 	}
-	_, err = k.GetDelegateKeyByOrchestrator(wctx, &queryO)
+	_, err = keeper.NewQueryServerImpl(k).GetDelegateKeyByOrchestrator(wctx, &queryO)
 	require.NoError(t, err)
 
 	queryE := types.QueryDelegateKeysByEthAddress{
 		EthAddress: ethAddress.GetAddress(),
 	}
-	_, err = k.GetDelegateKeyByEth(wctx, &queryE)
+	_, err = keeper.NewQueryServerImpl(k).GetDelegateKeyByEth(wctx, &queryE)
 	require.NoError(t, err)
 
 	// try to set values again. This should fail see issue #344 for why allowing this
@@ -703,6 +941,18 @@ func TestMsgValsetConfirm(t *testing.T) {
 	_, err = h(ctx, msg)
 	require.Error(t, err)
 
+	// try a malformed signature, this is rejected before the signature is recovered
+	msg = &types.MsgValsetConfirm{
+		Nonce:        1,
+		Orchestrator: keeper.OrchAddrs[0].String(),
+		EthAddress:   ethAddress,
+		Signature:    signature[0:128],
+	}
+	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+	require.Nil(t, k.GetValsetConfirm(ctx, 1, keeper.OrchAddrs[0]))
+
 	msg = &types.MsgValsetConfirm{
 		Nonce:        1,
 		Orchestrator: keeper.OrchAddrs[0].String(),
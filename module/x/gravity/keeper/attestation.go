@@ -41,19 +41,21 @@ func (k Keeper) Attest(
 	}
 
 	// Tries to get an attestation with the same eventNonce and claim as the claim that was submitted.
-	hash, err := claim.ClaimHash()
+	version := k.GetClaimHashVersion(ctx, claim)
+	hash, err := claim.ClaimHash(version)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "unable to compute claim hash")
 	}
-	att := k.GetAttestation(ctx, claim.GetEventNonce(), hash)
+	att := k.GetAttestation(ctx, claim.GetEventNonce(), version, hash)
 
 	// If it does not exist, create a new one.
 	if att == nil {
 		att = &types.Attestation{
-			Observed: false,
-			Votes:    []string{},
-			Height:   uint64(ctx.BlockHeight()),
-			Claim:    anyClaim,
+			Observed:         false,
+			Votes:            []string{},
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            anyClaim,
+			ClaimHashVersion: version,
 		}
 	}
 
@@ -67,116 +69,195 @@ func (k Keeper) Attest(
 }
 
 // TryAttestation checks if an attestation has enough votes to be applied to the consensus state
-// and has not already been marked Observed, then calls processAttestation to actually apply it to the state,
-// and then marks it Observed and emits an event.
+// and has not already been marked Observed. An attestation at the event nonce following the last observed one is
+// applied with applyAttestation, followed by the attestations queued at the next event nonces. An attestation further
+// ahead is queued until the event nonces before it are observed, so that the attestations are always applied
+// strictly in event nonce order, even when they get their votes out of order.
 func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	claim, err := k.UnpackAttestationClaim(att)
 	if err != nil {
 		panic("could not cast to claim")
 	}
-	hash, err := claim.ClaimHash()
+	hash, err := claim.ClaimHash(att.ClaimHashVersion)
 	if err != nil {
 		panic("unable to compute claim hash")
 	}
-	// If the attestation has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
 	// This conditional stops the attestation from accidentally being applied twice.
-	if !att.Observed {
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
-		// TODO: The different integer types and math here needs a careful review
-		totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
-		requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
-		attestationPower := sdk.NewInt(0)
-		for _, validator := range att.Votes {
-			val, err := sdk.ValAddressFromBech32(validator)
-			if err != nil {
-				panic(err)
-			}
-			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
-			// Add it to the attestation power's sum
-			attestationPower = attestationPower.Add(sdk.NewInt(validatorPower))
-			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
-			// process the attestation, set Observed to true, and break
-			if attestationPower.GTE(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
-				// this check is performed at the next level up so this should never panic
-				// outside of programmer error.
-				if claim.GetEventNonce() != lastEventNonce+1 {
-					panic("attempting to apply events to state out of order")
-				}
-				k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
-
-				att.Observed = true
-				k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
-
-				k.processAttestation(ctx, att, claim)
-				k.emitObservedEvent(ctx, att, claim)
-
-				break
-			}
-		}
-	} else {
+	if att.Observed {
 		// We panic here because this should never happen
 		panic("attempting to process observed attestation")
 	}
+	// If the attestation has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
+	if !k.hasObservationPower(ctx, att) {
+		return
+	}
+	lastEventNonce := k.GetLastObservedEventNonce(ctx)
+	switch {
+	case claim.GetEventNonce() == lastEventNonce+1:
+		k.applyAttestation(ctx, att, claim)
+		k.applyQueuedAttestations(ctx)
+	case claim.GetEventNonce() > lastEventNonce+1:
+		k.queueAttestation(ctx, claim.GetEventNonce(), hash, att)
+	default:
+		// this check is performed at the next level up so this should never panic
+		// outside of programmer error.
+		panic("attempting to apply events to state out of order")
+	}
+}
+
+// hasObservationPower sums the current powers of all validators who have voted on the attestation and returns whether
+// it passes the current threshold
+func (k Keeper) hasObservationPower(ctx sdk.Context, att *types.Attestation) bool {
+	// TODO: The different integer types and math here needs a careful review
+	totalPower := k.ValidatorSet.GetLastTotalPower(ctx)
+	requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
+	attestationPower := sdk.NewInt(0)
+	for _, validator := range att.Votes {
+		val, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			panic(err)
+		}
+		validatorPower := k.ValidatorSet.GetLastValidatorPower(ctx, val)
+		// Add it to the attestation power's sum
+		attestationPower = attestationPower.Add(sdk.NewInt(validatorPower))
+		// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
+		// the attestation can be observed
+		if attestationPower.GTE(requiredPower) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyAttestation marks the attestation at the event nonce following the last observed one Observed, applies it
+// with processAttestation and emits an event
+func (k Keeper) applyAttestation(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
+	hash, err := claim.ClaimHash(att.ClaimHashVersion)
+	if err != nil {
+		panic("unable to compute claim hash")
+	}
+	if claim.GetEventNonce() != k.GetLastObservedEventNonce(ctx)+1 {
+		panic("attempting to apply events to state out of order")
+	}
+	k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
+	k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
+	k.recordObservedBlockHash(ctx, att, claim)
+	// the catch up applies the attestations without going through the queue
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetAttestationApplyQueueKey(claim.GetEventNonce())))
+
+	att.Observed = true
+	k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
+
+	k.processAttestation(ctx, att, claim)
+	k.emitObservedEvent(ctx, att, claim)
 }
 
 // processAttestation actually applies the attestation to the consensus state
 func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
-	hash, err := claim.ClaimHash()
+	hash, err := claim.ClaimHash(att.ClaimHashVersion)
 	if err != nil {
 		panic("unable to compute claim hash")
 	}
 	// then execute in a new Tx so that we can store state on failure
 	xCtx, commit := ctx.CacheContext()
-	if err := k.AttestationHandler.Handle(xCtx, *att, claim); err != nil { // execute with a transient storage
+	err = k.AttestationHandler.Handle(xCtx, *att, claim) // execute with a transient storage
+	if err != nil {
 		// If the attestation fails, something has gone wrong and we can't recover it. Log and move on
 		// The attestation will still be marked "Observed", allowing the oracle to progress properly
 		k.logger(ctx).Error("attestation failed",
 			"cause", err.Error(),
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"id", types.GetAttestationKey(claim.GetEventNonce(), att.ClaimHashVersion, hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
+		// a rejected ERC20 deployment leaves the denom unpaired, record why so that the deployer
+		// knows what to fix before deploying again
+		if deployed, ok := claim.(*types.MsgERC20DeployedClaim); ok {
+			k.setERC20DeployedRejection(ctx, deployed, err)
+		}
 	} else {
 		commit() // persist transient storage
 		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
 	}
+	k.attestationHooks.AfterAttestationApplied(ctx, claim, err != nil)
 }
 
 // emitObservedEvent emits an event with information about an attestation that has been applied to
 // consensus state.
 func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
-	hash, err := claim.ClaimHash()
+	hash, err := claim.ClaimHash(att.ClaimHashVersion)
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "unable to compute claim hash"))
 	}
 	observationEvent := sdk.NewEvent(
 		types.EventTypeObservation,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyAttestationType, string(claim.GetType())),
+		sdk.NewAttribute(types.AttributeKeyAttestationType, claim.GetType().String()),
 		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx).GetAddress()),
 		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
 		// todo: serialize with hex/ base64 ?
 		sdk.NewAttribute(types.AttributeKeyAttestationID,
-			string(types.GetAttestationKey(claim.GetEventNonce(), hash))),
+			string(types.GetAttestationKey(claim.GetEventNonce(), att.ClaimHashVersion, hash))),
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.GetEventNonce())),
 		// TODO: do we want to emit more information?
 	)
 	ctx.EventManager().EmitEvent(observationEvent)
 }
 
-// SetAttestation sets the attestation in the store
+// GetClaimHashVersion returns the claim hash version a new attestation of claim is keyed with, claims of Ethereum
+// events before the cutover height of the claim hash version param are hashed with the previous version
+func (k Keeper) GetClaimHashVersion(ctx sdk.Context, claim types.EthereumClaim) uint64 {
+	params := k.GetParams(ctx)
+	if params.ClaimHashVersion > 1 && claim.GetBlockHeight() < params.ClaimHashVersionEthereumHeight {
+		return params.ClaimHashVersion - 1
+	}
+	return params.ClaimHashVersion
+}
+
+// SetAttestation sets the attestation in the store, keyed with the claim hash version of the attestation
 func (k Keeper) SetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte, att *types.Attestation) {
 	store := ctx.KVStore(k.storeKey)
-	aKey := []byte(types.GetAttestationKey(eventNonce, claimHash))
+	aKey := []byte(types.GetAttestationKey(eventNonce, att.ClaimHashVersion, claimHash))
 	store.Set(aKey, k.cdc.MustMarshal(att))
 }
 
-// GetAttestation return an attestation given a nonce
-func (k Keeper) GetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte) *types.Attestation {
+// RekeyAttestations moves every attestation under the key of its claim hash version and the hash of its claim,
+// for attestations stored under a previous key format. Attestations stored before they recorded their claim hash
+// version were hashed with the first version
+func (k Keeper) RekeyAttestations(ctx sdk.Context) error {
+	var (
+		keys         [][]byte
+		attestations []types.Attestation
+	)
+	k.IterateAttestaions(ctx, func(key []byte, att types.Attestation) bool {
+		keys = append(keys, key)
+		attestations = append(attestations, att)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for i := range attestations {
+		if attestations[i].ClaimHashVersion == 0 {
+			attestations[i].ClaimHashVersion = 1
+		}
+		claim, err := k.UnpackAttestationClaim(&attestations[i])
+		if err != nil {
+			return sdkerrors.Wrap(err, "unpacking attestation claim")
+		}
+		hash, err := claim.ClaimHash(attestations[i].ClaimHashVersion)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "unable to compute claim hash of event %d", claim.GetEventNonce())
+		}
+		store.Delete(keys[i])
+		k.SetAttestation(ctx, claim.GetEventNonce(), hash, &attestations[i])
+	}
+	return nil
+}
+
+// GetAttestation return an attestation given a nonce and the hash of its claim in a claim hash version
+func (k Keeper) GetAttestation(ctx sdk.Context, eventNonce uint64, claimHashVersion uint64, claimHash []byte) *types.Attestation {
 	store := ctx.KVStore(k.storeKey)
-	aKey := []byte(types.GetAttestationKey(eventNonce, claimHash))
+	aKey := []byte(types.GetAttestationKey(eventNonce, claimHashVersion, claimHash))
 	bz := store.Get(aKey)
 	if len(bz) == 0 {
 		return nil
@@ -192,13 +273,13 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, att types.Attestation) {
 	if err != nil {
 		panic("Bad Attestation in DeleteAttestation")
 	}
-	hash, err := claim.ClaimHash()
+	hash, err := claim.ClaimHash(att.ClaimHashVersion)
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "unable to compute claim hash"))
 	}
 	store := ctx.KVStore(k.storeKey)
 
-	store.Delete([]byte(types.GetAttestationKey(claim.GetEventNonce(), hash)))
+	store.Delete([]byte(types.GetAttestationKey(claim.GetEventNonce(), att.ClaimHashVersion, hash)))
 }
 
 // GetAttestationMapping returns a mapping of eventnonce -> attestations at that nonce
@@ -335,11 +416,12 @@ func (k Keeper) GetLastObservedValset(ctx sdk.Context) *types.Valset {
 		return nil
 	}
 	valset := types.Valset{
-		Nonce:        0,
-		Members:      []types.BridgeValidator{},
-		Height:       0,
-		RewardAmount: sdk.Int{},
-		RewardToken:  "",
+		Nonce:                0,
+		Members:              []types.BridgeValidator{},
+		Height:               0,
+		RewardAmount:         sdk.Int{},
+		RewardToken:          "",
+		RelayableSinceHeight: 0,
 	}
 	k.cdc.MustUnmarshal(bytes, &valset)
 	return &valset
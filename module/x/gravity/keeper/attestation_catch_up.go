@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// AttestationCatchUpBatchSize is the maximum number of event nonces observed by an EndBlock in catch up mode
+const AttestationCatchUpBatchSize = 500
+
+// GetHighestClaimedEventNonce returns the highest event nonce an attestation is stored for
+func (k Keeper) GetHighestClaimedEventNonce(ctx sdk.Context) uint64 {
+	iter := ctx.KVStore(k.storeKey).ReverseIterator(prefixRange([]byte(types.OracleAttestationKey)))
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0
+	}
+	var att types.Attestation
+	k.cdc.MustUnmarshal(iter.Value(), &att)
+	claim, err := k.UnpackAttestationClaim(&att)
+	if err != nil {
+		panic("couldn't cast to claim")
+	}
+	return claim.GetEventNonce()
+}
+
+// IsAttestationCatchUp returns true when the last observed event nonce lags the highest claimed event nonce by more
+// than the AttestationCatchUpLag param
+func (k Keeper) IsAttestationCatchUp(ctx sdk.Context) bool {
+	lag := k.GetParams(ctx).AttestationCatchUpLag
+	if lag == 0 {
+		return false
+	}
+	return k.GetHighestClaimedEventNonce(ctx) > k.GetLastObservedEventNonce(ctx)+lag
+}
+
+// getAttestationsByNonce returns the attestations at an event nonce
+func (k Keeper) getAttestationsByNonce(ctx sdk.Context, nonce uint64) []types.Attestation {
+	iter := ctx.KVStore(k.storeKey).Iterator(prefixRange([]byte(types.GetAttestationNoncePrefix(nonce))))
+	defer iter.Close()
+
+	var attestations []types.Attestation
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &att)
+		attestations = append(attestations, att)
+	}
+	return attestations
+}
+
+// CatchUpAttestations observes up to AttestationCatchUpBatchSize event nonces in order, reading only the attestations
+// at the next event nonce instead of the whole attestation store
+func (k Keeper) CatchUpAttestations(ctx sdk.Context) {
+	for i := 0; i < AttestationCatchUpBatchSize; i++ {
+		nonce := k.GetLastObservedEventNonce(ctx) + 1
+		for _, att := range k.getAttestationsByNonce(ctx, nonce) {
+			att := att
+			k.TryAttestation(ctx, &att)
+			if k.GetLastObservedEventNonce(ctx) == nonce {
+				break
+			}
+		}
+		if k.GetLastObservedEventNonce(ctx) != nonce {
+			break
+		}
+	}
+}
@@ -3,12 +3,12 @@ package keeper
 import (
 	"fmt"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
-	"math/big"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	distypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
@@ -51,6 +51,92 @@ func (a AttestationHandler) SendToCommunityPool(ctx sdk.Context, coins sdk.Coins
 	return nil
 }
 
+// handleInvalidReceiver disposes of a deposit held by the module account whose receiver can not receive it, as the
+// InvalidReceiverPolicy param decides. A deposit the refund policy can not refund is held for governance instead
+func (a AttestationHandler) handleInvalidReceiver(
+	ctx sdk.Context,
+	claim *types.MsgSendToCosmosClaim,
+	sender types.EthAddress,
+	tokenContract types.EthAddress,
+	coin sdk.Coin,
+) error {
+	switch a.keeper.GetInvalidReceiverPolicy(ctx) {
+	case types.INVALID_RECEIVER_POLICY_REFUND:
+		a.refundDepositOrHold(ctx, claim, sender, tokenContract, coin, types.HELD_DEPOSIT_REASON_INVALID_RECEIVER)
+		return nil
+	case types.INVALID_RECEIVER_POLICY_HOLD:
+		a.keeper.holdDeposit(ctx, claim, coin, types.HELD_DEPOSIT_REASON_INVALID_RECEIVER)
+		return nil
+	default:
+		return a.SendToCommunityPool(ctx, sdk.NewCoins(coin))
+	}
+}
+
+// handleSupplyCapExceeded disposes of a deposit held by the module account which would exceed the supply cap of its
+// token, as the SupplyCapPolicy param decides
+func (a AttestationHandler) handleSupplyCapExceeded(
+	ctx sdk.Context,
+	claim *types.MsgSendToCosmosClaim,
+	sender types.EthAddress,
+	tokenContract types.EthAddress,
+	coin sdk.Coin,
+) {
+	if a.keeper.GetSupplyCapPolicy(ctx) == types.SUPPLY_CAP_POLICY_REFUND {
+		a.refundDepositOrHold(ctx, claim, sender, tokenContract, coin, types.HELD_DEPOSIT_REASON_SUPPLY_CAP_EXCEEDED)
+		return
+	}
+	a.keeper.holdDeposit(ctx, claim, coin, types.HELD_DEPOSIT_REASON_SUPPLY_CAP_EXCEEDED)
+}
+
+// refundDepositOrHold refunds a deposit held by the module account to its Ethereum sender right away, a deposit which
+// can not be refunded is held for reason instead
+func (a AttestationHandler) refundDepositOrHold(
+	ctx sdk.Context,
+	claim *types.MsgSendToCosmosClaim,
+	sender types.EthAddress,
+	tokenContract types.EthAddress,
+	coin sdk.Coin,
+	reason types.HeldDepositReason,
+) {
+	xCtx, commit := ctx.CacheContext()
+	txID, fee, err := a.keeper.refundDeposit(xCtx, types.ModuleName, sender, tokenContract, coin)
+	if err == nil {
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDepositRefunded,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.EventNonce)),
+				sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+				sdk.NewAttribute(types.AttributeKeyFeePaid, fee.String()),
+			),
+		)
+		return
+	}
+	a.keeper.logger(ctx).Info("Holding deposit which can not be refunded",
+		"cause", err.Error(),
+		"reason", reason.String(),
+		"nonce", fmt.Sprint(claim.GetEventNonce()),
+	)
+	a.keeper.holdDeposit(ctx, claim, coin, reason)
+}
+
+// queueIbcAutoForward queues a deposit credited to the local account of a receiver of another chain to be forwarded
+// to it over IBC, when the IbcAutoForwardChannels param has a channel for the chain
+func (a AttestationHandler) queueIbcAutoForward(ctx sdk.Context, claim *types.MsgSendToCosmosClaim, coin sdk.Coin) {
+	channel, found := a.keeper.ibcAutoForwardChannel(ctx, claim.CosmosReceiver)
+	if !found {
+		return
+	}
+	a.keeper.queueIbcAutoForward(ctx, types.PendingIbcAutoForward{
+		ForeignReceiver: claim.CosmosReceiver,
+		Token:           coin,
+		IbcChannel:      channel,
+		EventNonce:      claim.EventNonce,
+	})
+}
+
 // Handle is the entry point for Attestation processing.
 func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim types.EthereumClaim) error {
 	switch claim := claim.(type) {
@@ -67,25 +153,29 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 		// a bogus event, this would create lost tokens stuck in the bridge
 		// and not accessible to anyone
 		if errTokenAddress != nil {
-			hash, _ := claim.ClaimHash()
+			hash, _ := claim.ClaimHash(att.ClaimHashVersion)
 			a.keeper.logger(ctx).Error("Invalid token contract",
 				"cause", errTokenAddress.Error(),
 				"claim type", claim.GetType(),
-				"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+				"id", types.GetAttestationKey(claim.GetEventNonce(), att.ClaimHashVersion, hash),
 				"nonce", fmt.Sprint(claim.GetEventNonce()),
 			)
 			return sdkerrors.Wrap(errTokenAddress, "invalid token contract on claim")
 		}
 		if errEthereumSender != nil {
-			hash, _ := claim.ClaimHash()
+			hash, _ := claim.ClaimHash(att.ClaimHashVersion)
 			a.keeper.logger(ctx).Error("Invalid ethereum sender",
 				"cause", errEthereumSender.Error(),
 				"claim type", claim.GetType(),
-				"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+				"id", types.GetAttestationKey(claim.GetEventNonce(), att.ClaimHashVersion, hash),
 				"nonce", fmt.Sprint(claim.GetEventNonce()),
 			)
 			return sdkerrors.Wrap(errTokenAddress, "invalid ethereum sender on claim")
 		}
+		// claims stored before their amount was validated may still carry an amount no Ethereum event has
+		if err := types.ValidateUint256(claim.Amount); err != nil {
+			return sdkerrors.Wrap(err, "invalid amount on claim")
+		}
 
 		// While not strictly necessary, explicitly making the receiver a native address
 		// insulates us from the implicit address conversion done in x/bank's account store iterator
@@ -95,15 +185,37 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 			invalidAddress = true
 		}
 
-		// Checks the address if it's inside the blacklisted address list and marks
-		// if it's inside the list.
-		if a.keeper.IsOnBlacklist(ctx, *ethereumSender) {
+		// In the EndBlock the deposits to valid receivers are minted and sent once every attestation was tallied,
+		// the receivers a send would fail for are detected up front
+		batch := depositBatchFromContext(ctx)
+		if batch != nil && !invalidAddress && a.bankKeeper.BlockedAddr(nativeReceiver) {
 			invalidAddress = true
 		}
 
+		// Vouchers minted into a module account could not be spent, unless governance allows its module
+		if !invalidAddress && a.keeper.IsBlockedModuleReceiver(ctx, nativeReceiver) {
+			invalidAddress = true
+		}
+
+		// The deposits of blacklisted senders and of deposit paused tokens to valid receivers are credited to the
+		// held deposits account, to be released or refunded later
+		holdReason := types.HELD_DEPOSIT_REASON_UNSPECIFIED
+		if !invalidAddress {
+			if a.keeper.IsOnBlacklist(ctx, *ethereumSender) {
+				holdReason = types.HELD_DEPOSIT_REASON_SENDER_BLACKLISTED
+			} else if a.keeper.IsDepositPaused(ctx, *tokenAddress) {
+				holdReason = types.HELD_DEPOSIT_REASON_TOKEN_PAUSED
+			}
+		}
+		held := holdReason != types.HELD_DEPOSIT_REASON_UNSPECIFIED
+
 		// Check if coin is Cosmos-originated asset and get denom
 		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *tokenAddress)
 		coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
+		if denom == types.NativeEthDenom {
+			a.keeper.setNativeEthMetadata(ctx)
+		}
+		capExceeded := false
 
 		if !isCosmosOriginated {
 			swapPair := a.keeper.GetParams(ctx).Erc20ToDenomPermanentSwap
@@ -114,58 +226,77 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 			// We need to mint eth-originated coins (aka vouchers)
 			// Make sure that users are not bridging an impossible amount
 			prevSupply := a.bankKeeper.GetSupply(ctx, denom)
-			newSupply := new(big.Int).Add(prevSupply.Amount.BigInt(), claim.Amount.BigInt())
-			if newSupply.BitLen() > 256 { // new supply overflows uint256
+			if batch != nil {
+				prevSupply = prevSupply.AddAmount(batch.mint.AmountOf(denom))
+			}
+			if _, err := types.AddUint256(prevSupply.Amount, claim.Amount); err != nil { // new supply overflows uint256
 				a.keeper.logger(ctx).Error("Deposit Overflow",
 					"claim type", claim.GetType(),
 					"nonce", fmt.Sprint(claim.GetEventNonce()),
 				)
-				return sdkerrors.Wrap(types.ErrIntOverflowAttestation, "invalid supply after SendToCosmos attestation")
+				return sdkerrors.Wrap(err, "invalid supply after SendToCosmos attestation")
 			}
+			a.keeper.recordERC20Provenance(ctx, *tokenAddress, claim)
 
-			if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-				// in this case we have lost tokens! They are in the bridge, but not
-				// in the community pool our out in some users balance, every instance of this
-				// error needs to be detected and resolved
-				hash, _ := claim.ClaimHash()
-				a.keeper.logger(ctx).Error("Failed minting",
-					"cause", err.Error(),
-					"claim type", claim.GetType(),
-					"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
-					"nonce", fmt.Sprint(claim.GetEventNonce()),
-				)
-				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+			// A deposit which would take the outstanding vouchers of its token over their cap is not credited
+			capExceeded = !invalidAddress && !held && a.keeper.exceedsSupplyCap(ctx, denom, prevSupply.Amount, claim.Amount)
+
+			// in the EndBlock the vouchers of a valid deposit are minted with the deposit batch
+			if batch == nil || invalidAddress || held || capExceeded {
+				if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+					// in this case we have lost tokens! They are in the bridge, but not
+					// in the community pool our out in some users balance, every instance of this
+					// error needs to be detected and resolved
+					hash, _ := claim.ClaimHash(att.ClaimHashVersion)
+					a.keeper.logger(ctx).Error("Failed minting",
+						"cause", err.Error(),
+						"claim type", claim.GetType(),
+						"id", types.GetAttestationKey(claim.GetEventNonce(), att.ClaimHashVersion, hash),
+						"nonce", fmt.Sprint(claim.GetEventNonce()),
+					)
+					return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+				}
 			}
 		}
 
-		if !invalidAddress { // valid address so far, try to lock up the coins in the requested cosmos address
+		if held {
+			a.keeper.holdDeposit(ctx, claim, coins[0], holdReason)
+		} else if capExceeded {
+			a.handleSupplyCapExceeded(ctx, claim, *ethereumSender, *tokenAddress, coins[0])
+		} else if batch != nil && !invalidAddress {
+			batch.add(nativeReceiver, coins, !isCosmosOriginated)
+			a.queueIbcAutoForward(ctx, claim, coins[0])
+		} else if !invalidAddress { // valid address so far, try to lock up the coins in the requested cosmos address
 			if err := a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, nativeReceiver, coins); err != nil {
 				// someone attempted to send tokens to a blacklisted user from Ethereum, log and send to Community pool
-				hash, _ := claim.ClaimHash()
+				hash, _ := claim.ClaimHash(att.ClaimHashVersion)
 				a.keeper.logger(ctx).Error("Blacklisted deposit",
 					"cause", err.Error(),
 					"claim type", claim.GetType(),
-					"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+					"id", types.GetAttestationKey(claim.GetEventNonce(), att.ClaimHashVersion, hash),
 					"nonce", fmt.Sprint(claim.GetEventNonce()),
 				)
 				invalidAddress = true
+			} else {
+				a.keeper.recordDepositUsage(ctx, nativeReceiver, coins)
+				a.queueIbcAutoForward(ctx, claim, coins[0])
 			}
 		}
 
-		// for whatever reason above, blacklisted, invalid string, etc this deposit is not valid
-		// we can't send the tokens back on the Ethereum side, and if we don't put them somewhere on
-		// the cosmos side they will be lost an inaccessible even though they are locked in the bridge.
-		// so we deposit the tokens into the community pool for later use
+		// for whatever reason above, invalid string, blocked receiver, etc this deposit is not valid
+		// if we don't put the tokens somewhere they will be lost an inaccessible even though they are
+		// locked in the bridge, so the InvalidReceiverPolicy param decides whether they are deposited
+		// into the community pool for later use, held for governance or sent back on the Ethereum side
 		if invalidAddress {
-			if err = a.SendToCommunityPool(ctx, coins); err != nil {
-				hash, _ := claim.ClaimHash()
-				a.keeper.logger(ctx).Error("Failed community pool send",
+			if err = a.handleInvalidReceiver(ctx, claim, *ethereumSender, *tokenAddress, coins[0]); err != nil {
+				hash, _ := claim.ClaimHash(att.ClaimHashVersion)
+				a.keeper.logger(ctx).Error("Failed invalid receiver deposit handling",
 					"cause", err.Error(),
 					"claim type", claim.GetType(),
-					"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+					"id", types.GetAttestationKey(claim.GetEventNonce(), att.ClaimHashVersion, hash),
 					"nonce", fmt.Sprint(claim.GetEventNonce()),
 				)
-				return sdkerrors.Wrap(err, "failed to send to Community pool")
+				return sdkerrors.Wrap(err, "failed to handle invalid receiver deposit")
 			}
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
@@ -192,7 +323,21 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 		if err != nil {
 			return sdkerrors.Wrap(err, "invalid token contract on batch")
 		}
-		a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim.BatchNonce)
+		var relayer *types.EthAddress
+		if claim.Relayer != "" {
+			relayer, err = types.NewEthAddress(claim.Relayer)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid relayer on batch")
+			}
+		}
+		// a Gravity contract executing a subset of the batch must report one bit per transaction of the batch
+		if batch := a.keeper.GetOutgoingTXBatch(ctx, *contract, claim.BatchNonce); batch != nil {
+			if _, _, err := batch.SplitBySuccess(claim.TxSuccessBitmap); err != nil {
+				return sdkerrors.Wrap(err, "invalid tx success bitmap on batch")
+			}
+		}
+		a.keeper.PayBatchRelayFees(ctx, *contract, claim.BatchNonce, relayer, claim.TxSuccessBitmap)
+		a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim.BatchNonce, claim.TxSuccessBitmap)
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				sdk.EventTypeMessage,
@@ -201,63 +346,9 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 		)
 		return nil
 	case *types.MsgERC20DeployedClaim:
-		tokenAddress, err := types.NewEthAddress(claim.TokenContract)
+		tokenAddress, err := a.verifyERC20Deployed(ctx, claim)
 		if err != nil {
-			return sdkerrors.Wrap(err, "invalid token contract on claim")
-		}
-		// Check if it already exists
-		existingERC20, exists := a.keeper.GetCosmosOriginatedERC20(ctx, claim.CosmosDenom)
-
-		if exists && existingERC20 != nil && tokenAddress != nil && existingERC20.GetAddress() != tokenAddress.GetAddress() {
-			return sdkerrors.Wrap(
-				types.ErrInvalid,
-				fmt.Sprintf("ERC20 %s already exists for denom %s", existingERC20, claim.CosmosDenom))
-		}
-
-		// Check if denom exists
-		metadata, ok := a.keeper.bankKeeper.GetDenomMetaData(ctx, claim.CosmosDenom)
-		if !ok || metadata.Base == "" {
-			return sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("denom not found %s", claim.CosmosDenom))
-		}
-
-		// Check if attributes of ERC20 match Cosmos denom
-		if claim.Name != metadata.Name {
-			return sdkerrors.Wrap(
-				types.ErrInvalid,
-				fmt.Sprintf("ERC20 name %s does not match denom name %s", claim.Name, metadata.Description))
-		}
-
-		if claim.Symbol != metadata.Symbol {
-			return sdkerrors.Wrap(
-				types.ErrInvalid,
-				fmt.Sprintf("ERC20 symbol %s does not match denom symbol %s", claim.Symbol, metadata.Display))
-		}
-
-		// ERC20 tokens use a very simple mechanism to tell you where to display the decimal point.
-		// The "decimals" field simply tells you how many decimal places there will be.
-		// Cosmos denoms have a system that is much more full featured, with enterprise-ready token denominations.
-		// There is a DenomUnits array that tells you what the name of each denomination of the
-		// token is.
-		// To correlate this with an ERC20 "decimals" field, we have to search through the DenomUnits array
-		// to find the DenomUnit which matches up to the main token "display" value. Then we take the
-		// "exponent" from this DenomUnit.
-		// If the correct DenomUnit is not found, it will default to 0. This will result in there being no decimal places
-		// in the token's ERC20 on Ethereum. So, for example, if this happened with Atom, 1 Atom would appear on Ethereum
-		// as 1 million Atoms, having 6 extra places before the decimal point.
-		// This will only happen with a Denom Metadata which is for all intents and purposes invalid, but I am not sure
-		// this is checked for at any other point.
-		decimals := uint32(0)
-		for _, denomUnit := range metadata.DenomUnits {
-			if denomUnit.Denom == metadata.Display {
-				decimals = denomUnit.Exponent
-				break
-			}
-		}
-
-		if decimals != uint32(claim.Decimals) {
-			return sdkerrors.Wrap(
-				types.ErrInvalid,
-				fmt.Sprintf("ERC20 decimals %d does not match denom decimals %d", claim.Decimals, decimals))
+			return err
 		}
 
 		// Add to denom-erc20 mapping
@@ -279,17 +370,18 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 		// the store, if they differ we should take some action to indicate to the
 		// user that bridge highjacking has occurred
 		a.keeper.SetLastObservedValset(ctx, types.Valset{
-			Nonce:        claim.ValsetNonce,
-			Members:      claim.Members,
-			Height:       0,
-			RewardAmount: claim.RewardAmount,
-			RewardToken:  claim.RewardToken,
+			Nonce:                claim.ValsetNonce,
+			Members:              claim.Members,
+			Height:               0,
+			RewardAmount:         claim.RewardAmount,
+			RewardToken:          claim.RewardToken,
+			RelayableSinceHeight: 0,
 		})
 		// if the reward is greater than zero and the reward token
 		// is valid then some reward was issued by this validator set
 		// and we need to either add to the total tokens for a Cosmos native
 		// token, or burn non cosmos native tokens
-		if claim.RewardAmount.GT(sdk.ZeroInt()) && claim.RewardToken != types.ZeroAddressString {
+		if !claim.RewardAmount.IsNil() && claim.RewardAmount.GT(sdk.ZeroInt()) && claim.RewardToken != types.ZeroAddressString {
 			// Check if coin is Cosmos-originated asset and get denom
 			isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *rewardAddress)
 			if isCosmosOriginated {
@@ -307,6 +399,9 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				//
 				// Note we are minting based on the claim! This is important as the reward value
 				// could change between when this event occurred and the present
+				if _, err := types.AddUint256(a.bankKeeper.GetSupply(ctx, denom).Amount, claim.RewardAmount); err != nil {
+					return sdkerrors.Wrap(err, "invalid supply after valset reward")
+				}
 				coins := sdk.Coins{sdk.NewCoin(denom, claim.RewardAmount)}
 				if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 					ctx.EventManager().EmitEvent(
@@ -336,8 +431,69 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 			),
 		)
 
+	case *types.MsgLogicCallExecutedClaim:
+		a.keeper.OutgoingLogicCallExecuted(ctx, claim.InvalidationId, claim.InvalidationNonce)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute("MsgLogicCallExecutedClaim", strconv.Itoa(int(claim.GetEventNonce()))),
+			),
+		)
+
 	default:
 		panic(fmt.Sprintf("Invalid event type for attestations %s", claim.GetType()))
 	}
 	return nil
 }
+
+// verifyERC20Deployed checks the ERC20 deployed by claim can be paired with its Cosmos denom. The pairing
+// can only be made once for a denom and for an ERC20, so everything the ERC20 exposes has to match
+// the denom metadata exactly.
+func (a AttestationHandler) verifyERC20Deployed(ctx sdk.Context, claim *types.MsgERC20DeployedClaim) (*types.EthAddress, error) {
+	tokenAddress, err := types.NewEthAddress(claim.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid token contract on claim")
+	}
+
+	// observing the existing pairing again is harmless, any other ERC20 or denom is not
+	if existingERC20, exists := a.keeper.GetCosmosOriginatedERC20(ctx, claim.CosmosDenom); exists && existingERC20.GetAddress() != tokenAddress.GetAddress() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "ERC20 %s already exists for denom %s", existingERC20.GetAddress(), claim.CosmosDenom)
+	}
+	if existingDenom, exists := a.keeper.GetCosmosOriginatedDenom(ctx, *tokenAddress); exists && existingDenom != claim.CosmosDenom {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "ERC20 %s is already paired with denom %s", tokenAddress.GetAddress(), existingDenom)
+	}
+
+	metadata, ok := a.keeper.bankKeeper.GetDenomMetaData(ctx, claim.CosmosDenom)
+	if !ok || metadata.Base == "" {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("denom not found %s", claim.CosmosDenom))
+	}
+
+	if metadata.Name == "" || claim.Name != metadata.Name {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "ERC20 name %q does not match denom name %q", claim.Name, metadata.Name)
+	}
+	if metadata.Symbol == "" || claim.Symbol != metadata.Symbol {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "ERC20 symbol %q does not match denom symbol %q", claim.Symbol, metadata.Symbol)
+	}
+
+	// ERC20 tokens use a very simple mechanism to tell you where to display the decimal point.
+	// The "decimals" field simply tells you how many decimal places there will be.
+	// Cosmos denoms have a system that is much more full featured, with a DenomUnits array naming each
+	// denomination of the token. To correlate this with an ERC20 "decimals" field the DenomUnit of the
+	// "display" denom has to exist, its "exponent" is the number of decimals. Defaulting to 0 decimals
+	// would make 1 Atom appear on Ethereum as 1 million Atoms.
+	var display *banktypes.DenomUnit
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display {
+			display = denomUnit
+			break
+		}
+	}
+	if display == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "denom %s has no denom unit for its display denom %q", claim.CosmosDenom, metadata.Display)
+	}
+	if uint64(display.Exponent) != claim.Decimals {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "ERC20 decimals %d does not match denom decimals %d", claim.Decimals, display.Exponent)
+	}
+
+	return tokenAddress, nil
+}
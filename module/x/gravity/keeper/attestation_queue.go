@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// queueAttestation queues an attestation which has the votes to be observed until the attestations at the event
+// nonces before it are observed. Only the first attestation getting the votes at an event nonce is queued
+// WARNING: Do not make this function public
+func (k Keeper) queueAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte, att *types.Attestation) {
+	if k.IsAttestationQueued(ctx, eventNonce) {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(
+		[]byte(types.GetAttestationApplyQueueKey(eventNonce)),
+		[]byte(types.GetAttestationKey(eventNonce, att.ClaimHashVersion, claimHash)),
+	)
+	k.logger(ctx).Info("attestation queued until the event nonces before it are observed",
+		"nonce", fmt.Sprint(eventNonce),
+		"last observed nonce", fmt.Sprint(k.GetLastObservedEventNonce(ctx)),
+	)
+}
+
+// IsAttestationQueued returns true if an attestation at eventNonce has the votes to be observed and waits for the
+// event nonces before it
+func (k Keeper) IsAttestationQueued(ctx sdk.Context, eventNonce uint64) bool {
+	return ctx.KVStore(k.storeKey).Has([]byte(types.GetAttestationApplyQueueKey(eventNonce)))
+}
+
+// GetQueuedAttestationNonces returns the event nonces of the queued attestations in increasing order
+func (k Keeper) GetQueuedAttestationNonces(ctx sdk.Context) (nonces []uint64) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AttestationApplyQueueKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		nonces = append(nonces, types.UInt64FromBytes(iter.Key()))
+	}
+	return
+}
+
+// applyQueuedAttestations applies the queued attestations following the last observed event nonce, in event nonce
+// order, until the next event nonce has none. The powers may have changed since an attestation was queued, it is
+// dropped from the queue if it lost its votes and is queued again by the tally once it has them
+// WARNING: Do not make this function public
+func (k Keeper) applyQueuedAttestations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for {
+		queueKey := []byte(types.GetAttestationApplyQueueKey(k.GetLastObservedEventNonce(ctx) + 1))
+		attKey := store.Get(queueKey)
+		if attKey == nil {
+			return
+		}
+		store.Delete(queueKey)
+		bz := store.Get(attKey)
+		if bz == nil {
+			return
+		}
+		var att types.Attestation
+		k.cdc.MustUnmarshal(bz, &att)
+		if att.Observed || !k.hasObservationPower(ctx, &att) {
+			return
+		}
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic("could not cast to claim")
+		}
+		k.applyAttestation(ctx, &att, claim)
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -34,11 +35,12 @@ func TestGetMostRecentAttestations(t *testing.T) {
 		any, _ := codectypes.NewAnyWithValue(&msg)
 		anys = append(anys, *any)
 		att := &types.Attestation{
-			Observed: false,
-			Height:   uint64(ctx.BlockHeight()),
-			Claim:    any,
+			Observed:         false,
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            any,
+			ClaimHashVersion: types.ClaimEncodingVersion,
 		}
-		hash, err := msg.ClaimHash()
+		hash, err := msg.ClaimHash(types.ClaimEncodingVersion)
 		require.NoError(t, err)
 		k.SetAttestation(ctx, nonce, hash, att)
 	}
@@ -51,3 +53,68 @@ func TestGetMostRecentAttestations(t *testing.T) {
 			"The %vth claim does not match our message: claim %v\n message %v", n, attest.Claim, msgs[n])
 	}
 }
+
+// Tests that the migration moves attestations stored under the hash of a previous claim encoding
+func TestRekeyAttestations(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Amount:         sdktypes.NewInt(1000),
+		EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		CosmosReceiver: "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		Orchestrator:   "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+	}
+	any, err := codectypes.NewAnyWithValue(&claim)
+	require.NoError(t, err)
+	att := &types.Attestation{Observed: false, Height: uint64(ctx.BlockHeight()), Claim: any}
+	oldHash := []byte("previous encoding hash")
+	k.SetAttestation(ctx, claim.EventNonce, oldHash, att)
+
+	require.NoError(t, NewMigrator(k).Migrate2to3(ctx))
+	// the attestations are rekeyed with the first version of the encoding, the one introduced by the migration
+	hash, err := claim.ClaimHash(1)
+	require.NoError(t, err)
+	require.Nil(t, k.GetAttestation(ctx, claim.EventNonce, 0, oldHash))
+	rekeyed := k.GetAttestation(ctx, claim.EventNonce, 1, hash)
+	require.Equal(t, att.Claim.Value, rekeyed.Claim.Value)
+	require.Equal(t, uint64(1), rekeyed.ClaimHashVersion)
+	attestations, _ := k.GetAttestationMapping(ctx)
+	require.Len(t, attestations[claim.EventNonce], 1)
+}
+
+// Tests that the block hashes of the observed claims hashed with them are kept for the last events of the segment
+func TestRecordObservedBlockHash(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	record := func(nonce uint64, version uint64, blockHash string) {
+		claim := &types.MsgBatchSendToEthClaim{EventNonce: nonce, BlockHeight: 100 + nonce, BlockHash: blockHash}
+		k.recordObservedBlockHash(ctx, &types.Attestation{Observed: true, ClaimHashVersion: version}, claim)
+	}
+	blockHash := "0x" + strings.Repeat("AB", 32)
+
+	// the hash is not attested to by the first version of the claim hash
+	record(1, 1, blockHash)
+	record(2, 2, "")
+	require.Empty(t, k.GetObservedBlockHashes(ctx))
+
+	for nonce := uint64(3); nonce <= ObservedBlockHashSegmentLength+4; nonce++ {
+		record(nonce, 2, blockHash)
+	}
+	hashes := k.GetObservedBlockHashes(ctx)
+	require.Len(t, hashes, ObservedBlockHashSegmentLength)
+	require.Equal(t, uint64(5), hashes[0].EventNonce)
+	require.Nil(t, k.GetObservedBlockHash(ctx, 4))
+	last := k.GetObservedBlockHash(ctx, ObservedBlockHashSegmentLength+4)
+	require.Equal(t, types.ObservedBlockHash{
+		EventNonce:     ObservedBlockHashSegmentLength + 4,
+		EthereumHeight: ObservedBlockHashSegmentLength + 104,
+		BlockHash:      strings.ToLower(blockHash),
+	}, *last)
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +17,7 @@ const OutgoingTxBatchSize = 100
 
 // BuildOutgoingTXBatch starts the following process chain:
 //   - find bridged denominator for given voucher type
+//   - refuse to create a batch if MaxOutgoingBatchesPerToken batches of this token type are still unexecuted
 //   - determine if an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //     have a higher total fees. If not exit without creating a batch
 //   - select available transactions from the outgoing transaction pool sorted by fee desc
@@ -28,9 +31,17 @@ func (k Keeper) BuildOutgoingTXBatch(
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "max elements value")
 	}
 	params := k.GetParams(ctx)
-	if !params.BridgeActive {
+	if !k.IsBridgeActive(ctx) {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "bridge paused")
 	}
+	if k.IsWithdrawalPaused(ctx, contract) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPaused, "withdrawals of %s", contract.GetAddress())
+	}
+
+	if params.MaxOutgoingBatchesPerToken != 0 &&
+		k.CountOutgoingTXBatchesByTokenType(ctx, contract) >= params.MaxOutgoingBatchesPerToken {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "%d batches of this token are waiting to be relayed", params.MaxOutgoingBatchesPerToken)
+	}
 
 	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, contract)
 
@@ -56,6 +67,8 @@ func (k Keeper) BuildOutgoingTXBatch(
 	} else if len(selectedTx) == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "no transactions of this type to batch")
 	}
+	k.recordBridgeFeeTiers(ctx, contract, selectedTx)
+	k.moveEscrow(ctx, types.UnbatchedPoolAccountName, types.BatchesAccountName, k.transfersEscrow(ctx, selectedTx))
 
 	nextID := k.autoIncrementID(ctx, []byte(types.KeyLastOutgoingBatchID))
 	batch, err := types.NewInternalOutgingTxBatch(nextID, k.getBatchTimeoutHeight(ctx), selectedTx, contract, 0)
@@ -103,30 +116,32 @@ func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 }
 
 // OutgoingTxBatchExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
-// It frees all the transactions in the batch, then cancels all earlier batches, this function panics instead
+// It frees the transactions of the batch set in the tx success bitmap, all of them if it is empty, and puts the
+// failed ones back into the pool, then cancels all earlier batches, this function panics instead
 // of returning errors because any failure will cause a double spend.
-func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) {
+func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64, txSuccessBitmap []byte) {
 	b := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
 	if b == nil {
 		panic(fmt.Sprintf("unknown batch nonce for outgoing tx batch %s %d", tokenContract, nonce))
 	}
+	executedTxs, failedTxs, err := b.SplitBySuccess(txSuccessBitmap)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "executing batch %s %d", tokenContract, nonce))
+	}
 	contract := b.TokenContract
-	// Burn tokens if they're Ethereum originated
+	// The tokens left the chain, Cosmos originated tokens stay locked in the module account against
+	// their ERC20 on Ethereum and Ethereum originated vouchers are burned
+	executed := k.transfersEscrow(ctx, executedTxs)
+	k.moveEscrow(ctx, types.BatchesAccountName, types.ModuleName, executed)
 	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, contract); !isCosmosOriginated {
-		totalToBurn := sdk.NewInt(0)
-		for _, tx := range b.Transactions {
-			totalToBurn = totalToBurn.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
-		}
-		// burn vouchers to send them back to ETH
-		erc20, err := types.NewInternalERC20Token(totalToBurn, contract.GetAddress())
-		if err != nil {
-			panic(sdkerrors.Wrapf(err, "invalid ERC20 address in executed batch"))
-		}
-		burnVouchers := sdk.NewCoins(erc20.GravityCoin())
-		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnVouchers); err != nil {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, executed); err != nil {
 			panic(err)
 		}
 	}
+	// The transactions the contract failed to execute go back to the pool as if their batch was canceled,
+	// keeping their relay fees and their pool entry height
+	k.repoolFailedTransfers(ctx, *b, failedTxs)
+	k.recordWithdrawalUsage(ctx, tokenContract, executedTxs)
 
 	// Iterate through remaining batches
 	k.IterateOutgoingTXBatches(ctx, func(key []byte, iter_batch types.InternalOutgoingTxBatch) bool {
@@ -140,12 +155,46 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		return false
 	})
 
+	// Track how long this batch waited to be relayed
+	k.recordBatchRelayLatency(ctx, *b)
+	for _, tx := range executedTxs {
+		k.deletePoolEntryHeight(ctx, tx.Id)
+	}
+
+	// Keep the batch for auditing, it is archived once past the retention window
+	k.recordExecutedBatch(ctx, *b, txSuccessBitmap)
+
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, *b)
 	// Delete it's confirmations as well
 	k.DeleteBatchConfirms(ctx, *b)
 }
 
+// repoolFailedTransfers adds the transactions of a batch which failed on Ethereum back to the pool
+func (k Keeper) repoolFailedTransfers(ctx sdk.Context, batch types.InternalOutgoingTxBatch, failed []*types.InternalOutgoingTransferTx) {
+	if len(failed) == 0 {
+		return
+	}
+	ids := make([]string, len(failed))
+	for i, tx := range failed {
+		if err := k.addUnbatchedTX(ctx, tx); err != nil {
+			panic(sdkerrors.Wrapf(err, "unable to add failed transaction back into pool %v", tx))
+		}
+		ids[i] = fmt.Sprint(tx.Id)
+	}
+	k.moveEscrow(ctx, types.BatchesAccountName, types.UnbatchedPoolAccountName, k.transfersEscrow(ctx, failed))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchPartiallyExecuted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, batch.TokenContract.GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(batch.BatchNonce)),
+			sdk.NewAttribute(types.AttributeKeyRepooledTxIDs, strings.Join(ids, ",")),
+		),
+	)
+}
+
 // StoreBatch stores a transaction batch, it will refuse to overwrite an existing
 // batch and panic instead, once a batch is stored in state signature collection begins
 // so no mutation of a batch in state can ever be valid
@@ -169,6 +218,7 @@ func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete([]byte(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce)))
+	k.deleteBatchRelayLatencySLAWarned(ctx, batch.TokenContract, batch.BatchNonce)
 }
 
 // pickUnbatchedTX find TX in pool and remove from "available" second index
@@ -245,6 +295,7 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAd
 			panic(sdkerrors.Wrapf(err, "unable to add batched transaction back into pool %v", tx))
 		}
 	}
+	k.moveEscrow(ctx, types.BatchesAccountName, types.UnbatchedPoolAccountName, k.transfersEscrow(ctx, batch.Transactions))
 
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, *batch)
@@ -291,6 +342,17 @@ func (k Keeper) GetOutgoingTxBatches(ctx sdk.Context) (out []types.InternalOutgo
 	return
 }
 
+// CountOutgoingTXBatchesByTokenType returns the number of unexecuted batches of a token type
+func (k Keeper) CountOutgoingTXBatchesByTokenType(ctx sdk.Context, token types.EthAddress) (count uint64) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GetOutgoingTxBatchContractPrefix(token)))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
 // GetLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
 func (k Keeper) GetLastOutgoingBatchByTokenType(ctx sdk.Context, token types.EthAddress) *types.InternalOutgoingTxBatch {
 	batches := k.GetOutgoingTxBatches(ctx)
@@ -335,7 +397,8 @@ func (k Keeper) GetLastSlashedBatchBlock(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedBatches returns all the unslashed batches in state
+// GetUnSlashedBatches returns all the unslashed batches in state, oldest first as the last slashed batch block
+// can only increase
 func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, maxHeight uint64) (out []types.InternalOutgoingTxBatch) {
 	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
 	batches := k.GetOutgoingTxBatches(ctx)
@@ -344,5 +407,8 @@ func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, maxHeight uint64) (out []ty
 			out = append(out, batch)
 		}
 	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Block < out[j].Block
+	})
 	return
 }
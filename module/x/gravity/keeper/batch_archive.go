@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+/////////////////////////////
+//     EXECUTED BATCHES    //
+/////////////////////////////
+
+// recordExecutedBatch keeps a batch whose execution was just observed in the executed batches, indexed by the
+// current height so that ArchiveExecutedBatches finds the batches past the retention window first
+func (k Keeper) recordExecutedBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch, txSuccessBitmap []byte) {
+	k.SetExecutedBatch(ctx, types.ExecutedBatch{
+		Batch:           batch.ToExternal(),
+		ExecutedHeight:  uint64(ctx.BlockHeight()),
+		EthBlockHeight:  k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight,
+		TxSuccessBitmap: txSuccessBitmap,
+	})
+}
+
+// SetExecutedBatch stores an executed batch until it is archived
+func (k Keeper) SetExecutedBatch(ctx sdk.Context, executed types.ExecutedBatch) {
+	contract := executedBatchContract(executed)
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetExecutedBatchKey(executed.ExecutedHeight, contract, executed.Batch.BatchNonce)
+	store.Set([]byte(key), k.cdc.MustMarshal(&executed))
+}
+
+// IterateExecutedBatches iterates over the executed batches not archived yet by ascending executed height
+func (k Keeper) IterateExecutedBatches(ctx sdk.Context, cb func(key []byte, executed types.ExecutedBatch) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExecutedBatchKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var executed types.ExecutedBatch
+		k.cdc.MustUnmarshal(iter.Value(), &executed)
+		// cb returns true to stop early
+		if cb(iter.Key(), executed) {
+			break
+		}
+	}
+}
+
+// GetExecutedBatches returns the executed batches not archived yet
+func (k Keeper) GetExecutedBatches(ctx sdk.Context) (out []types.ExecutedBatch) {
+	k.IterateExecutedBatches(ctx, func(_ []byte, executed types.ExecutedBatch) bool {
+		out = append(out, executed)
+		return false
+	})
+	return
+}
+
+// ArchiveExecutedBatches moves the executed batches whose execution was observed at least ExecutedBatchRetention
+// blocks ago into the batch archive, compressed
+func (k Keeper) ArchiveExecutedBatches(ctx sdk.Context, params types.Params) {
+	height := uint64(ctx.BlockHeight())
+	var archived [][]byte
+	k.IterateExecutedBatches(ctx, func(key []byte, executed types.ExecutedBatch) bool {
+		if executed.ExecutedHeight+params.ExecutedBatchRetention > height {
+			return true
+		}
+		k.SetArchivedBatch(ctx, executed)
+		archived = append(archived, key)
+		return false
+	})
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExecutedBatchKey))
+	for _, key := range archived {
+		prefixStore.Delete(key)
+	}
+}
+
+/////////////////////////////
+//      BATCH ARCHIVE      //
+/////////////////////////////
+
+// SetArchivedBatch stores an executed batch compressed in the batch archive, which is never pruned
+func (k Keeper) SetArchivedBatch(ctx sdk.Context, executed types.ExecutedBatch) {
+	contract := executedBatchContract(executed)
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetArchivedBatchKey(contract, executed.Batch.BatchNonce)
+	store.Set([]byte(key), compressArchivedBatch(k.cdc.MustMarshal(&executed)))
+}
+
+// GetArchivedBatch returns an executed batch from the batch archive, or nil if it is not archived
+func (k Keeper) GetArchivedBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) *types.ExecutedBatch {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.GetArchivedBatchKey(tokenContract, nonce)))
+	if len(bz) == 0 {
+		return nil
+	}
+	executed := k.unmarshalArchivedBatch(bz)
+	return &executed
+}
+
+// IterateArchivedBatches iterates over the batch archive by token contract and nonce
+func (k Keeper) IterateArchivedBatches(ctx sdk.Context, cb func(key []byte, executed types.ExecutedBatch) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ArchivedBatchKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(iter.Key(), k.unmarshalArchivedBatch(iter.Value())) {
+			break
+		}
+	}
+}
+
+// GetArchivedBatches returns every batch of the batch archive
+func (k Keeper) GetArchivedBatches(ctx sdk.Context) (out []types.ExecutedBatch) {
+	k.IterateArchivedBatches(ctx, func(_ []byte, executed types.ExecutedBatch) bool {
+		out = append(out, executed)
+		return false
+	})
+	return
+}
+
+// GetExecutedBatch returns a batch whose execution was observed from the executed batches or the batch archive, and
+// whether it was archived, or nil if neither keeps it
+func (k Keeper) GetExecutedBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) (*types.ExecutedBatch, bool) {
+	var found *types.ExecutedBatch
+	// the executed batches only span the retention window, they are looked up by scanning them
+	k.IterateExecutedBatches(ctx, func(_ []byte, executed types.ExecutedBatch) bool {
+		if executed.Batch.BatchNonce == nonce && executedBatchContract(executed).GetAddress() == tokenContract.GetAddress() {
+			found = &executed
+			return true
+		}
+		return false
+	})
+	if found != nil {
+		return found, false
+	}
+	if archived := k.GetArchivedBatch(ctx, tokenContract, nonce); archived != nil {
+		return archived, true
+	}
+	return nil, false
+}
+
+// unmarshalArchivedBatch decompresses and decodes an archived batch, panicking on a corrupted archive
+func (k Keeper) unmarshalArchivedBatch(bz []byte) types.ExecutedBatch {
+	reader, err := gzip.NewReader(bytes.NewReader(bz))
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "decompressing archived batch"))
+	}
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "decompressing archived batch"))
+	}
+	var executed types.ExecutedBatch
+	k.cdc.MustUnmarshal(raw, &executed)
+	return executed
+}
+
+// compressArchivedBatch gzips an encoded executed batch. The gzip header is left empty, without name or modification
+// time, so that every node writes the same bytes
+func compressArchivedBatch(raw []byte) []byte {
+	var buf bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "compressing archived batch"))
+	}
+	if _, err := writer.Write(raw); err != nil {
+		panic(sdkerrors.Wrap(err, "compressing archived batch"))
+	}
+	if err := writer.Close(); err != nil {
+		panic(sdkerrors.Wrap(err, "compressing archived batch"))
+	}
+	return buf.Bytes()
+}
+
+// executedBatchContract returns the token contract of an executed batch, panicking if it is invalid
+func executedBatchContract(executed types.ExecutedBatch) types.EthAddress {
+	contract, err := types.NewEthAddress(executed.Batch.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid token contract in executed batch"))
+	}
+	return *contract
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that executed batches are kept for the retention window, then archived compressed and still found by token
+// and nonce, and that both survive a genesis export and import
+func TestArchiveExecutedBatches(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender               = RandomAccAddress()
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.ExecutedBatchRetention = 100
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// execute two batches 50 blocks apart
+	var nonces []uint64
+	for i := 0; i < 2; i++ {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+1)), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+
+		batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+		input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, nil)
+		require.Nil(t, input.GravityKeeper.GetOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce))
+		nonces = append(nonces, batch.BatchNonce)
+	}
+	firstExecutedHeight := uint64(ctx.BlockHeight() - 50)
+
+	executed, archived := input.GravityKeeper.GetExecutedBatch(ctx, *myTokenContractAddr, nonces[0])
+	require.NotNil(t, executed)
+	assert.False(t, archived)
+	assert.Equal(t, firstExecutedHeight, executed.ExecutedHeight)
+	assert.Len(t, executed.Batch.Transactions, 1)
+
+	// the first batch is past the retention window 100 blocks after its execution, the second is not
+	ctx = ctx.WithBlockHeight(int64(firstExecutedHeight) + 100)
+	input.GravityKeeper.ArchiveExecutedBatches(ctx, params)
+	require.Len(t, input.GravityKeeper.GetExecutedBatches(ctx), 1)
+	require.Len(t, input.GravityKeeper.GetArchivedBatches(ctx), 1)
+
+	archivedBatch, archived := input.GravityKeeper.GetExecutedBatch(ctx, *myTokenContractAddr, nonces[0])
+	require.NotNil(t, archivedBatch)
+	assert.True(t, archived)
+	assert.Equal(t, *executed, *archivedBatch)
+
+	res, err := NewQueryServerImpl(input.GravityKeeper).ExecutedBatch(sdk.WrapSDKContext(ctx), &types.QueryExecutedBatchRequest{
+		TokenContract: myTokenContractAddr.GetAddress(),
+		Nonce:         nonces[1],
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.ExecutedBatch)
+	assert.False(t, res.Archived)
+
+	res, err = NewQueryServerImpl(input.GravityKeeper).ExecutedBatch(sdk.WrapSDKContext(ctx), &types.QueryExecutedBatchRequest{
+		TokenContract: myTokenContractAddr.GetAddress(),
+		Nonce:         nonces[1] + 1,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, res.ExecutedBatch)
+
+	genesis := ExportGenesis(ctx, input.GravityKeeper)
+	assert.Len(t, genesis.ExecutedBatches, 1)
+	assert.Equal(t, []types.ExecutedBatch{*archivedBatch}, genesis.ArchivedBatches)
+
+	imported := CreateTestEnv(t)
+	InitGenesis(imported.Context, imported.GravityKeeper, genesis)
+	assert.Equal(t, genesis.ExecutedBatches, imported.GravityKeeper.GetExecutedBatches(imported.Context))
+	assert.Equal(t, genesis.ArchivedBatches, imported.GravityKeeper.GetArchivedBatches(imported.Context))
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+/////////////////////////////
+//     BATCH CALLDATA      //
+/////////////////////////////
+
+// GetBatchCalldata assembles the arguments of a Gravity.sol submitBatch call for the given batch so
+// that relayers do not have to reimplement the ordering and signature encoding rules. The signatures
+// are ordered against the last valset observed on Ethereum, which is what the contract checks them
+// against, or against the latest valset when no valset update has been observed yet. An error is
+// returned if the batch is unknown or its signatures do not yet pass the Ethereum power threshold
+func (k Keeper) GetBatchCalldata(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) (*types.SubmitBatchCalldata, error) {
+	batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
+	if batch == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "batch")
+	}
+	valset := k.GetLastObservedValset(ctx)
+	if valset == nil {
+		valset = k.GetLatestValset(ctx)
+	}
+	if valset == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "no valset to sign the batch")
+	}
+
+	signatures := make(map[string][]byte)
+	for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract) {
+		sig, err := hex.DecodeString(confirm.Signature)
+		if err != nil || types.ValidateEthereumSignatureFormat(sig) != nil {
+			// confirms are verified when submitted, this can only be a store corruption
+			panic(sdkerrors.Wrapf(types.ErrInvalid, "invalid stored batch confirm signature from %s", confirm.EthSigner))
+		}
+		signatures[strings.ToLower(confirm.EthSigner)] = sig
+	}
+
+	calldata := types.SubmitBatchCalldata{
+		Validators:    make([]string, len(valset.Members)),
+		Powers:        make([]uint64, len(valset.Members)),
+		ValsetNonce:   valset.Nonce,
+		RewardAmount:  valset.RewardAmount,
+		RewardToken:   valset.RewardToken,
+		V:             make([]uint32, len(valset.Members)),
+		R:             make([][]byte, len(valset.Members)),
+		S:             make([][]byte, len(valset.Members)),
+		Amounts:       make([]sdk.Int, len(batch.Transactions)),
+		Destinations:  make([]string, len(batch.Transactions)),
+		Fees:          make([]sdk.Int, len(batch.Transactions)),
+		BatchNonce:    batch.BatchNonce,
+		TokenContract: batch.TokenContract.GetAddress(),
+		BatchTimeout:  batch.BatchTimeout,
+		SignedPower:   0,
+	}
+	if calldata.RewardAmount.IsNil() {
+		calldata.RewardAmount = sdk.ZeroInt()
+	}
+	for i, member := range valset.Members {
+		calldata.Validators[i] = member.EthereumAddress
+		calldata.Powers[i] = member.Power
+		calldata.R[i] = make([]byte, 32)
+		calldata.S[i] = make([]byte, 32)
+		sig, ok := signatures[strings.ToLower(member.EthereumAddress)]
+		if !ok {
+			continue
+		}
+		copy(calldata.R[i], sig[:32])
+		copy(calldata.S[i], sig[32:64])
+		// the contract uses ecrecover which expects the legacy 27/28 recovery id
+		v := uint32(sig[64])
+		if v < 27 {
+			v += 27
+		}
+		calldata.V[i] = v
+		calldata.SignedPower += member.Power
+	}
+	if calldata.SignedPower < types.EthereumSignaturePowerThreshold {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "batch has %d of the %d power required to be relayed",
+			calldata.SignedPower, types.EthereumSignaturePowerThreshold)
+	}
+
+	for i, tx := range batch.Transactions {
+		calldata.Amounts[i] = tx.Erc20Token.Amount
+		calldata.Destinations[i] = tx.DestAddress.GetAddress()
+		calldata.Fees[i] = tx.Erc20Fee.Amount
+	}
+	return &calldata, nil
+}
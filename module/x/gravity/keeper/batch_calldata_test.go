@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+func TestGetBatchCalldata(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	valset := pk.SetValsetRequest(ctx)
+
+	destination, _ := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+		BatchNonce:   1,
+		BatchTimeout: 500,
+		Transactions: []types.OutgoingTransferTx{{
+			Id:          1,
+			Sender:      AccAddrs[0].String(),
+			DestAddress: destination.GetAddress(),
+			Erc20Token:  types.ERC20Token{Contract: TokenContractAddrs[0], Amount: sdk.NewInt(100)},
+			Erc20Fee:    types.ERC20Token{Contract: TokenContractAddrs[0], Amount: sdk.NewInt(3)},
+			RelayFee:    nil,
+		}},
+		TokenContract:        TokenContractAddrs[0],
+		Block:                uint64(ctx.BlockHeight()),
+		RelayableSinceHeight: 0,
+	})
+	require.NoError(t, err)
+	pk.StoreBatch(ctx, *batch)
+
+	// signatures are verified on submission, the store only needs well formed ones
+	signature := func(i int, v byte) []byte {
+		sig := bytes.Repeat([]byte{byte(i + 1)}, 65)
+		sig[64] = v
+		return sig
+	}
+	confirm := func(i int, v byte) {
+		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: TokenContractAddrs[0],
+			EthSigner:     EthAddrs[i].String(),
+			Orchestrator:  OrchAddrs[i].String(),
+			Signature:     hex.EncodeToString(signature(i, v)),
+		})
+	}
+
+	_, err = pk.GetBatchCalldata(ctx, batch.TokenContract, 2)
+	require.Error(t, err)
+
+	// three of five equal validators is below the threshold
+	for i := 0; i < 3; i++ {
+		confirm(i, 0)
+	}
+	_, err = pk.GetBatchCalldata(ctx, batch.TokenContract, batch.BatchNonce)
+	require.Error(t, err)
+
+	confirm(3, 28)
+	calldata, err := pk.GetBatchCalldata(ctx, batch.TokenContract, batch.BatchNonce)
+	require.NoError(t, err)
+
+	require.Equal(t, valset.Nonce, calldata.ValsetNonce)
+	require.Len(t, calldata.Validators, len(valset.Members))
+	for i, member := range valset.Members {
+		require.Equal(t, member.EthereumAddress, calldata.Validators[i])
+		require.Equal(t, member.Power, calldata.Powers[i])
+
+		signer := -1
+		for j := 0; j < 4; j++ {
+			if EthAddrs[j].String() == member.EthereumAddress {
+				signer = j
+			}
+		}
+		if signer == -1 {
+			require.Equal(t, uint32(0), calldata.V[i])
+			require.Equal(t, make([]byte, 32), calldata.R[i])
+			require.Equal(t, make([]byte, 32), calldata.S[i])
+			continue
+		}
+		sig := signature(signer, 0)
+		require.Equal(t, sig[:32], calldata.R[i])
+		require.Equal(t, sig[32:64], calldata.S[i])
+		// recovery ids are always presented in the 27/28 format
+		if signer == 3 {
+			require.Equal(t, uint32(28), calldata.V[i])
+		} else {
+			require.Equal(t, uint32(27), calldata.V[i])
+		}
+	}
+	require.GreaterOrEqual(t, calldata.SignedPower, types.EthereumSignaturePowerThreshold)
+
+	require.Equal(t, []sdk.Int{sdk.NewInt(100)}, calldata.Amounts)
+	require.Equal(t, []string{destination.GetAddress()}, calldata.Destinations)
+	require.Equal(t, []sdk.Int{sdk.NewInt(3)}, calldata.Fees)
+	require.Equal(t, batch.BatchNonce, calldata.BatchNonce)
+	require.Equal(t, batch.TokenContract.GetAddress(), calldata.TokenContract)
+	require.Equal(t, uint64(500), calldata.BatchTimeout)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// BatchRelayLatencyWindow is the number of executed batches the relay latency
+// moving average is computed over
+const BatchRelayLatencyWindow = 10
+
+/////////////////////////////
+//   BATCH RELAY LATENCY   //
+/////////////////////////////
+
+// GetBatchRelayLatency returns the relay latency statistics for a token, or nil if
+// no batch of this token has been executed yet
+func (k Keeper) GetBatchRelayLatency(ctx sdk.Context, tokenContract types.EthAddress) *types.BatchRelayLatency {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetBatchRelayLatencyKey(tokenContract)))
+	if len(bz) == 0 {
+		return nil
+	}
+	var latency types.BatchRelayLatency
+	k.cdc.MustUnmarshal(bz, &latency)
+	return &latency
+}
+
+// SetBatchRelayLatency stores the relay latency statistics for a token
+func (k Keeper) SetBatchRelayLatency(ctx sdk.Context, latency types.BatchRelayLatency) {
+	contract, err := types.NewEthAddress(latency.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid token contract in batch relay latency"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetBatchRelayLatencyKey(*contract)), k.cdc.MustMarshal(&latency))
+}
+
+// IterateBatchRelayLatencies iterates over the relay latency statistics of every token
+func (k Keeper) IterateBatchRelayLatencies(ctx sdk.Context, cb func(key []byte, latency types.BatchRelayLatency) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BatchRelayLatencyKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var latency types.BatchRelayLatency
+		k.cdc.MustUnmarshal(iter.Value(), &latency)
+		// cb returns true to stop early
+		if cb(iter.Key(), latency) {
+			break
+		}
+	}
+}
+
+// GetBatchRelayLatencies returns the relay latency statistics of every token
+func (k Keeper) GetBatchRelayLatencies(ctx sdk.Context) (out []types.BatchRelayLatency) {
+	k.IterateBatchRelayLatencies(ctx, func(_ []byte, latency types.BatchRelayLatency) bool {
+		out = append(out, latency)
+		return false
+	})
+	return
+}
+
+// recordBatchRelayLatency updates the moving average relay latency of the batch's token
+// with the number of blocks between the batch creation and the current block, where the
+// execution of the batch on Ethereum has been observed
+func (k Keeper) recordBatchRelayLatency(ctx sdk.Context, batch types.InternalOutgoingTxBatch) {
+	current := uint64(ctx.BlockHeight())
+	if current < batch.Block {
+		// batches imported from genesis may carry the block of a previous chain
+		return
+	}
+	blocks := current - batch.Block
+
+	latency := k.GetBatchRelayLatency(ctx, batch.TokenContract)
+	if latency == nil {
+		latency = &types.BatchRelayLatency{
+			TokenContract:  batch.TokenContract.GetAddress(),
+			AverageBlocks:  0,
+			LastBlocks:     0,
+			LastBatchNonce: 0,
+			Samples:        0,
+		}
+	}
+	latency.Samples++
+	window := latency.Samples
+	if window > BatchRelayLatencyWindow {
+		window = BatchRelayLatencyWindow
+	}
+	latency.AverageBlocks = (latency.AverageBlocks*(window-1) + blocks) / window
+	latency.LastBlocks = blocks
+	latency.LastBatchNonce = batch.BatchNonce
+	k.SetBatchRelayLatency(ctx, *latency)
+}
+
+// GetBatchSignedPower returns the normalized power of the last observed valset that has confirmed the given batch,
+// this is the valset the Gravity contract checks the signatures against when a relayer submits the batch, once it
+// passes types.EthereumSignaturePowerThreshold the batch is relayable. The latest valset is used until a valset has
+// been observed, and a valset with invalid members has no power
+func (k Keeper) GetBatchSignedPower(ctx sdk.Context, batch types.InternalOutgoingTxBatch) uint64 {
+	valset := k.GetLastObservedValset(ctx)
+	if valset == nil {
+		valset = k.GetLatestValset(ctx)
+	}
+	if valset == nil {
+		return 0
+	}
+	members, err := types.BridgeValidators(valset.Members).ToInternal()
+	if err != nil {
+		ctx.Logger().Error("invalid valset members, batch has no signed power", "valset nonce", valset.Nonce, "error", err)
+		return 0
+	}
+	signers := make(map[string]struct{})
+	for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract) {
+		signers[strings.ToLower(confirm.EthSigner)] = struct{}{}
+	}
+	return members.PowerOfSigners(signers)
+}
+
+// HasBatchRelayLatencySLAWarned returns true if a latency warning was already emitted for the batch
+func (k Keeper) HasBatchRelayLatencySLAWarned(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has([]byte(types.GetBatchRelayLatencySLAWarnedKey(tokenContract, nonce)))
+}
+
+// SetBatchRelayLatencySLAWarned records that a latency warning has been emitted for the batch
+func (k Keeper) SetBatchRelayLatencySLAWarned(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetBatchRelayLatencySLAWarnedKey(tokenContract, nonce)), []byte{1})
+}
+
+// deleteBatchRelayLatencySLAWarned removes the latency warning record of a batch
+func (k Keeper) deleteBatchRelayLatencySLAWarned(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.GetBatchRelayLatencySLAWarnedKey(tokenContract, nonce)))
+}
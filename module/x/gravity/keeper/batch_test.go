@@ -230,7 +230,7 @@ func TestBatches(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, secondBatch.BatchNonce, nil)
 
 	// check batch has been deleted
 	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
@@ -450,7 +450,7 @@ func TestBatchesFullCoins(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, secondBatch.BatchNonce, nil)
 
 	// check batch has been deleted
 	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
@@ -587,7 +587,7 @@ func TestManyBatches(t *testing.T) {
 		gotBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, *contractAddr, batch.BatchNonce)
 		// we may have already deleted some of the batches in this list by executing later ones
 		if gotBatch != nil {
-			input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *contractAddr, batch.BatchNonce)
+			input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *contractAddr, batch.BatchNonce, nil)
 		}
 	}
 }
@@ -741,6 +741,55 @@ func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 	require.NotNil(t, gotFirstBatch)
 }
 
+// test that no batch is created for a token that has too many unexecuted batches
+func TestMaxOutgoingBatchesPerToken(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.MaxOutgoingBatchesPerToken = 2
+	input.GravityKeeper.SetParams(ctx, params)
+
+	var (
+		mySender               = RandomAccAddress()
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	// every transfer pays more than the last so that each new batch is more profitable
+	for i := 1; i <= 3; i++ {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i)), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+
+		_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+		if i <= 2 {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+	require.Equal(t, uint64(2), input.GravityKeeper.CountOutgoingTXBatchesByTokenType(ctx, *myTokenContractAddr))
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *myTokenContractAddr), 1)
+
+	// zero disables the limit
+	params.MaxOutgoingBatchesPerToken = 0
+	input.GravityKeeper.SetParams(ctx, params)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), input.GravityKeeper.CountOutgoingTXBatchesByTokenType(ctx, *myTokenContractAddr))
+}
+
 //nolint: exhaustivestruct
 // test that tokens on the blacklist do not enter batches
 func TestEthereumBlacklistBatches(t *testing.T) {
@@ -863,3 +912,268 @@ func TestGetFees(t *testing.T) {
 		}
 	}
 }
+
+//nolint: exhaustivestruct
+// test that executed batches update the moving average relay latency of their token
+func TestBatchRelayLatency(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender               = RandomAccAddress()
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	require.Nil(t, input.GravityKeeper.GetBatchRelayLatency(ctx, *myTokenContractAddr))
+
+	// execute two batches, the first waiting 10 blocks and the second 20 blocks
+	for i, wait := range []int64{10, 20} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+1)), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+
+		batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + wait)
+		input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, nil)
+	}
+
+	latency := input.GravityKeeper.GetBatchRelayLatency(ctx, *myTokenContractAddr)
+	require.NotNil(t, latency)
+	assert.Equal(t, myTokenContractAddr.GetAddress(), latency.TokenContract)
+	assert.Equal(t, uint64(2), latency.Samples)
+	assert.Equal(t, uint64(20), latency.LastBlocks)
+	assert.Equal(t, uint64(2), latency.LastBatchNonce)
+	assert.Equal(t, uint64(15), latency.AverageBlocks)
+
+	res, err := NewQueryServerImpl(input.GravityKeeper).BatchRelayLatency(sdk.WrapSDKContext(ctx), &types.QueryBatchRelayLatencyRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.BatchRelayLatency{*latency}, res.Latencies)
+}
+
+// Tests that the fee tiers average the lowest fees batched within their blocks and that the entry heights of the
+// executed transfers are removed
+func TestBridgeFeeTiers(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender               = RandomAccAddress()
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+		denom                  = token.GravityCoin().Denom
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	addTx := func(fee int64) uint64 {
+		id, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, fee))
+		require.NoError(t, err)
+		return id
+	}
+
+	require.Nil(t, input.GravityKeeper.GetBridgeFeeTiers(ctx, *myTokenContractAddr))
+
+	// the first batch holds a transfer which waited 55 blocks and one which waited 5 blocks
+	slowID := addTx(5)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+	addTx(8)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	require.NoError(t, err)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, nil)
+	_, found := input.GravityKeeper.getPoolEntryHeight(ctx, slowID)
+	require.False(t, found)
+
+	// the second batch only holds a transfer which waited 500 blocks
+	addTx(20)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 500)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+	require.NoError(t, err)
+
+	tiers := input.GravityKeeper.GetBridgeFeeTiers(ctx, *myTokenContractAddr)
+	require.NotNil(t, tiers)
+	assert.Equal(t, types.BridgeFeeTier{Blocks: BridgeFeeTierFastBlocks, Fee: sdk.NewInt(8), Samples: 1}, tiers.Fast)
+	assert.Equal(t, types.BridgeFeeTier{Blocks: BridgeFeeTierNormalBlocks, Fee: sdk.NewInt(5), Samples: 1}, tiers.Normal)
+	assert.Equal(t, types.BridgeFeeTier{Blocks: BridgeFeeTierSlowBlocks, Fee: sdk.NewInt(12), Samples: 2}, tiers.Slow)
+
+	res, err := NewQueryServerImpl(input.GravityKeeper).BridgeFeeTiers(sdk.WrapSDKContext(ctx), &types.QueryBridgeFeeTiersRequest{
+		TokenContract: myTokenContractAddr.GetAddress(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []types.BridgeFeeTiers{*tiers}, res.FeeTiers)
+}
+
+// Tests that relay fees are held by the module, accounted per denom and paid to the relayer on execution
+func TestBatchRelayFees(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	var (
+		mySender               = RandomAccAddress()
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+		denom                  = token.GravityCoin().Denom
+	)
+	require.NoError(t, err)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers.Add(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers.Add(sdk.NewInt64Coin("stake", 100))))
+
+	// two transfers paying for the relay in stake, one paying only on Ethereum
+	_, err = input.GravityKeeper.AddToOutgoingPoolWithRelayFee(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 1), sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.AddToOutgoingPoolWithRelayFee(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 2), sdk.NewInt64Coin("stake", 15))
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 3))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 75), input.BankKeeper.GetBalance(ctx, mySender, "stake"))
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	fees := input.GravityKeeper.GetBatchFeeByTokenType(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.Equal(t, sdk.NewInt(6), fees.TotalFees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), fees.RelayFees)
+
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), batch.RelayFees())
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// the relayer is a validator's delegate key, so the fees are allocated to the validator through the distribution
+	// module, which keeps its commission apart from the rewards of its delegators
+	relayer, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	validator, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	validator.Commission.Rate = sdk.NewDecWithPrec(1, 1)
+	input.StakingKeeper.SetValidator(ctx, validator)
+	valAcc := sdk.AccAddress(ValAddrs[0])
+	accBefore := input.BankKeeper.GetBalance(ctx, valAcc, "stake")
+	before := input.DistKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]).AmountOf("stake")
+	input.GravityKeeper.PayBatchRelayFees(ctx, *myTokenContractAddr, batch.BatchNonce, relayer, nil)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, nil)
+	require.Equal(t, accBefore, input.BankKeeper.GetBalance(ctx, valAcc, "stake"))
+	require.Equal(t, before.Add(sdk.NewDec(25)), input.DistKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]).AmountOf("stake"))
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), input.DistKeeper.GetValidatorAccumulatedCommission(ctx, ValAddrs[0]).Commission.AmountOf("stake"))
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// an unknown relayer leaves the fees to the community pool
+	_, err = input.GravityKeeper.AddToOutgoingPoolWithRelayFee(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 1), sdk.NewInt64Coin("stake", 20))
+	require.NoError(t, err)
+	batch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	communityPool := input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake")
+	input.GravityKeeper.PayBatchRelayFees(ctx, *myTokenContractAddr, batch.BatchNonce, nil, nil)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, nil)
+	require.Equal(t, communityPool.Add(sdk.NewDec(20)), input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake"))
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// with a relayer fee share the validator is paid its truncated share and the rest goes to the community pool
+	params := input.GravityKeeper.GetParams(ctx)
+	params.RelayerFeeShare = sdk.NewDecWithPrec(3, 1)
+	input.GravityKeeper.SetParams(ctx, params)
+	_, err = input.GravityKeeper.AddToOutgoingPoolWithRelayFee(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 1), sdk.NewInt64Coin("stake", 15))
+	require.NoError(t, err)
+	batch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	before = input.DistKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]).AmountOf("stake")
+	communityPool = input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake")
+	input.GravityKeeper.PayBatchRelayFees(ctx, *myTokenContractAddr, batch.BatchNonce, relayer, nil)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, nil)
+	require.Equal(t, before.Add(sdk.NewDec(4)), input.DistKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]).AmountOf("stake"))
+	require.Equal(t, communityPool.Add(sdk.NewDec(11)), input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake"))
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// cancelling a transfer refunds its relay fee
+	txID, err := input.GravityKeeper.AddToOutgoingPoolWithRelayFee(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 1), sdk.NewInt64Coin("stake", 5))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 35), input.BankKeeper.GetBalance(ctx, mySender, "stake"))
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, txID, mySender))
+	require.Equal(t, sdk.NewInt64Coin("stake", 40), input.BankKeeper.GetBalance(ctx, mySender, "stake"))
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+}
+
+// Tests that a batch executed only in part burns the executed transfers and pays their relay fees, while the failed
+// transfers go back to the pool with their relay fees and can be batched again
+func TestPartialBatchExecution(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	var (
+		mySender               = RandomAccAddress()
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+		denom                  = token.GravityCoin().Denom
+	)
+	require.NoError(t, err)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers.Add(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers.Add(sdk.NewInt64Coin("stake", 100))))
+
+	for i := int64(1); i <= 3; i++ {
+		_, err = input.GravityKeeper.AddToOutgoingPoolWithRelayFee(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, i), sdk.NewInt64Coin("stake", 10*i))
+		require.NoError(t, err)
+	}
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 3)
+	failed := batch.Transactions[1]
+	supply := input.BankKeeper.GetSupply(ctx, denom)
+
+	// the bitmap must have one bit per transaction of the batch
+	tooLong := ctx.WithEventManager(sdk.NewEventManager())
+	require.Panics(t, func() {
+		input.GravityKeeper.OutgoingTxBatchExecuted(tooLong, *myTokenContractAddr, batch.BatchNonce, []byte{0x0f})
+	})
+
+	relayer, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	rewards := func() sdk.Dec {
+		return input.DistKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]).AmountOf("stake")
+	}
+	before := rewards()
+	input.GravityKeeper.PayBatchRelayFees(ctx, *myTokenContractAddr, batch.BatchNonce, relayer, []byte{0x05})
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, []byte{0x05})
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// only the executed transfers were paid for and burned
+	paid := types.TransfersRelayFees([]*types.InternalOutgoingTransferTx{batch.Transactions[0], batch.Transactions[2]})
+	require.Equal(t, before.Add(paid[0].Amount.ToDec()), rewards())
+	burned := batch.Transactions[0].Erc20Token.Amount.Add(batch.Transactions[0].Erc20Fee.Amount).
+		Add(batch.Transactions[2].Erc20Token.Amount).Add(batch.Transactions[2].Erc20Fee.Amount)
+	require.Equal(t, supply.Amount.Sub(burned), input.BankKeeper.GetSupply(ctx, denom).Amount)
+
+	// the failed transfer is back in the pool and its execution is recorded
+	unbatched := input.GravityKeeper.GetUnbatchedTransactions(ctx)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, failed.Id, unbatched[0].Id)
+	require.Equal(t, failed.RelayFee, unbatched[0].RelayFee)
+	executed, _ := input.GravityKeeper.GetExecutedBatch(ctx, *myTokenContractAddr, batch.BatchNonce)
+	require.NotNil(t, executed)
+	require.Equal(t, []byte{0x05}, executed.TxSuccessBitmap)
+
+	// and is relayed in a later batch
+	batch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	input.GravityKeeper.PayBatchRelayFees(ctx, *myTokenContractAddr, batch.BatchNonce, relayer, nil)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, nil)
+	require.Equal(t, before.Add(paid[0].Amount.ToDec()).Add(failed.RelayFee.Amount.ToDec()), rewards())
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// bindingParamKeys are the params the bridge binding is taken from, a governance change of one of them rebinds the
+// bridge
+var bindingParamKeys = [][]byte{
+	types.ParamsStoreKeyBridgeEthereumAddress,
+	types.ParamsStoreKeyGravityID,
+}
+
+// isBindingParam returns true if a change of the gravity param key rebinds the bridge
+func isBindingParam(key string) bool {
+	for _, binding := range bindingParamKeys {
+		if key == string(binding) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBridgeBinding returns the Gravity.sol deployment the chain is bound to, if any
+func (k Keeper) GetBridgeBinding(ctx sdk.Context) (types.BridgeBinding, bool) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.BridgeBindingKey))
+	if bz == nil {
+		return types.BridgeBinding{}, false
+	}
+	var binding types.BridgeBinding
+	k.cdc.MustUnmarshal(bz, &binding)
+	return binding, true
+}
+
+// setBridgeBinding stores the bridge binding
+// WARNING: Do not make this function public
+func (k Keeper) setBridgeBinding(ctx sdk.Context, binding types.BridgeBinding) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.BridgeBindingKey), k.cdc.MustMarshal(&binding))
+}
+
+// BindBridge binds the chain to the bridge address and gravity id of the params. The zero and the empty address are
+// not a deployment and leave the binding as it is
+func (k Keeper) BindBridge(ctx sdk.Context) {
+	var address string
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeEthereumAddress, &address)
+	if address == "" || address == types.ZeroAddressString {
+		return
+	}
+	binding := types.BridgeBinding{
+		BridgeEthereumAddress: address,
+		GravityId:             k.GetGravityID(ctx),
+		BoundHeight:           uint64(ctx.BlockHeight()),
+	}
+	if current, found := k.GetBridgeBinding(ctx); found &&
+		strings.EqualFold(current.BridgeEthereumAddress, binding.BridgeEthereumAddress) &&
+		current.GravityId == binding.GravityId {
+		return
+	}
+	k.setBridgeBinding(ctx, binding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeBound,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, binding.BridgeEthereumAddress),
+			sdk.NewAttribute(types.AttributeKeyGravityID, binding.GravityId),
+		),
+	)
+}
+
+// BindBridgeIfUnbound binds the chain once the bridge address is first set, later changes of the params only rebind
+// the bridge through governance
+func (k Keeper) BindBridgeIfUnbound(ctx sdk.Context) {
+	if _, found := k.GetBridgeBinding(ctx); !found {
+		k.BindBridge(ctx)
+	}
+}
+
+// BridgeBindingMatchesParams returns false if the params name another deployment than the bound one, e.g. after
+// they were mis-set outside of governance. The claims of that deployment are rejected until governance rebinds
+func (k Keeper) BridgeBindingMatchesParams(ctx sdk.Context) bool {
+	binding, found := k.GetBridgeBinding(ctx)
+	if !found {
+		return true
+	}
+	var address string
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeEthereumAddress, &address)
+	return strings.EqualFold(binding.BridgeEthereumAddress, address) && binding.GravityId == k.GetGravityID(ctx)
+}
+
+// CheckClaimBridgeContract rejects a claim read from another Gravity.sol contract than the bound one, so that the
+// events of another deployment can not be attested. Claims which do not report their bridge contract are accepted
+func (k Keeper) CheckClaimBridgeContract(ctx sdk.Context, claim types.EthereumClaim) error {
+	contract := claim.GetBridgeContract()
+	if contract == "" {
+		return nil
+	}
+	binding, found := k.GetBridgeBinding(ctx)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalid, "claim of bridge contract %s, no bridge is bound", contract)
+	}
+	if !strings.EqualFold(contract, binding.BridgeEthereumAddress) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "claim of bridge contract %s, the chain is bound to %s",
+			contract, binding.BridgeEthereumAddress)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that claims of another bridge contract than the bound one are rejected, that mis-set params do not move the
+// binding while a governance change of the bridge address does, and that the binding survives a genesis export
+//nolint: exhaustivestruct
+func TestBridgeBinding(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	bridge := k.GetParams(ctx).BridgeEthereumAddress
+	other := "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+
+	claim := func(orchestrator sdk.AccAddress, contract string) error {
+		_, err := msgServer.SendToCosmosClaim(sdk.WrapSDKContext(ctx), &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    1,
+			TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Amount:         sdk.NewInt(100),
+			EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   orchestrator.String(),
+			BridgeContract: contract,
+		})
+		return err
+	}
+
+	// a claim naming its bridge contract is rejected until a bridge is bound
+	_, found := k.GetBridgeBinding(ctx)
+	require.False(t, found)
+	require.Error(t, claim(OrchAddrs[0], bridge))
+
+	k.BindBridgeIfUnbound(ctx)
+	binding, found := k.GetBridgeBinding(ctx)
+	require.True(t, found)
+	assert.Equal(t, types.BridgeBinding{
+		BridgeEthereumAddress: bridge,
+		GravityId:             k.GetGravityID(ctx),
+		BoundHeight:           uint64(ctx.BlockHeight()),
+	}, binding)
+
+	require.Error(t, claim(OrchAddrs[0], other))
+	require.NoError(t, claim(OrchAddrs[0], bridge[:2]+strings.ToUpper(bridge[2:])))
+	require.NoError(t, claim(OrchAddrs[1], ""))
+
+	// params mis-set outside of governance leave the binding as it is
+	params := k.GetParams(ctx)
+	params.BridgeEthereumAddress = other
+	k.SetParams(ctx, params)
+	k.BindBridgeIfUnbound(ctx)
+	res, err := NewQueryServerImpl(k).BridgeBinding(sdk.WrapSDKContext(ctx), &types.QueryBridgeBindingRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &binding, res.Binding)
+	assert.False(t, res.MatchesParams)
+	require.Error(t, claim(OrchAddrs[2], other))
+
+	// a governance change of the bridge address rebinds the bridge
+	handler := NewCriticalParamChangeProposalHandler(k, func(ctx sdk.Context, content govtypes.Content) error {
+		for _, change := range content.(*paramproposal.ParameterChangeProposal).Changes {
+			if err := k.paramSpace.Update(ctx, []byte(change.Key), []byte(change.Value)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	params.CriticalParamChangeDelay = 0
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	change := paramproposal.NewParamChange(types.DefaultParamspace, string(types.ParamsStoreKeyBridgeEthereumAddress), `"`+other+`"`)
+	require.NoError(t, handler(ctx, paramproposal.NewParameterChangeProposal("t", "d", []paramproposal.ParamChange{change})))
+	binding, found = k.GetBridgeBinding(ctx)
+	require.True(t, found)
+	assert.Equal(t, other, binding.BridgeEthereumAddress)
+	assert.Equal(t, uint64(ctx.BlockHeight()), binding.BoundHeight)
+	assert.True(t, k.BridgeBindingMatchesParams(ctx))
+	require.NoError(t, claim(OrchAddrs[2], other))
+
+	genesis := ExportGenesis(ctx, k)
+	require.Equal(t, &binding, genesis.BridgeBinding)
+	imported := CreateTestEnv(t)
+	InitGenesis(imported.Context, imported.GravityKeeper, genesis)
+	importedBinding, found := imported.GravityKeeper.GetBridgeBinding(imported.Context)
+	require.True(t, found)
+	assert.Equal(t, binding, importedBinding)
+}
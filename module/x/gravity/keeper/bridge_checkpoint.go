@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// UpdateBridgeCheckpoint writes the bridge checkpoint of the current block: the last observed event with its
+// Ethereum height and block hash, and the checkpoint of the last valset observed on Ethereum. It is written every
+// block, even if the bridge did not progress, so that the store proves the state of the bridge at every height
+func (k Keeper) UpdateBridgeCheckpoint(ctx sdk.Context) {
+	checkpoint := types.BridgeCheckpoint{
+		Height:           uint64(ctx.BlockHeight()),
+		EventNonce:       k.GetLastObservedEventNonce(ctx),
+		EthereumHeight:   k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight,
+		ValsetNonce:      0,
+		ValsetCheckpoint: nil,
+	}
+	if hash := k.GetObservedBlockHash(ctx, checkpoint.EventNonce); hash != nil {
+		checkpoint.EthereumBlockHash = hash.BlockHash
+	}
+
+	if valset := k.GetLastObservedValset(ctx); valset != nil {
+		checkpoint.ValsetNonce = valset.Nonce
+		// the valset checkpoint is only recomputed once another valset is observed
+		if last := k.GetBridgeCheckpoint(ctx); last != nil && last.ValsetNonce == valset.Nonce && len(last.ValsetCheckpoint) > 0 {
+			checkpoint.ValsetCheckpoint = last.ValsetCheckpoint
+		} else {
+			checkpoint.ValsetCheckpoint = valset.GetCheckpoint(k.GetGravityID(ctx))
+		}
+	}
+
+	ctx.KVStore(k.storeKey).Set([]byte(types.BridgeCheckpointKey), k.cdc.MustMarshal(&checkpoint))
+}
+
+// GetBridgeCheckpoint returns the bridge checkpoint written at the end of the last block, or nil before the first
+// block
+func (k Keeper) GetBridgeCheckpoint(ctx sdk.Context) *types.BridgeCheckpoint {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.BridgeCheckpointKey))
+	if len(bz) == 0 {
+		return nil
+	}
+	var checkpoint types.BridgeCheckpoint
+	k.cdc.MustUnmarshal(bz, &checkpoint)
+	return &checkpoint
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the bridge checkpoint follows the last observed event and valset
+func TestUpdateBridgeCheckpoint(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	require.Nil(t, k.GetBridgeCheckpoint(ctx))
+	k.UpdateBridgeCheckpoint(ctx)
+	checkpoint := k.GetBridgeCheckpoint(ctx)
+	require.NotNil(t, checkpoint)
+	require.Equal(t, uint64(ctx.BlockHeight()), checkpoint.Height)
+	require.Empty(t, checkpoint.EthereumBlockHash)
+	require.Empty(t, checkpoint.ValsetCheckpoint)
+
+	blockHash := "0x2b7d9f1a3c5e7b9d1f3a5c7e9b1d3f5a7c9e1b3d5f7a9c1e3b5d7f9a1c3e5b7d"
+	k.setLastObservedEventNonce(ctx, 3)
+	k.SetLastObservedEthereumBlockHeight(ctx, 1200)
+	k.SetObservedBlockHash(ctx, types.ObservedBlockHash{EventNonce: 3, EthereumHeight: 1200, BlockHash: blockHash})
+	valset := types.Valset{
+		Nonce:        2,
+		Members:      []types.BridgeValidator{{Power: 4294967295, EthereumAddress: EthAddrs[0].String()}},
+		Height:       10,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+	}
+	k.SetLastObservedValset(ctx, valset)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.UpdateBridgeCheckpoint(ctx)
+	require.Equal(t, types.BridgeCheckpoint{
+		Height:            uint64(ctx.BlockHeight()),
+		EventNonce:        3,
+		EthereumHeight:    1200,
+		EthereumBlockHash: blockHash,
+		ValsetNonce:       2,
+		ValsetCheckpoint:  valset.GetCheckpoint(k.GetGravityID(ctx)),
+	}, *k.GetBridgeCheckpoint(ctx))
+}
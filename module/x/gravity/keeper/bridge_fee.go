@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetBridgeFeeExchangeRate returns the governance rate at which a bridge fee paid in feeDenom is
+// converted into tokenDenom, or false if fees for tokenDenom may not be paid in feeDenom
+func (k Keeper) GetBridgeFeeExchangeRate(ctx sdk.Context, feeDenom string, tokenDenom string) (sdk.Dec, bool) {
+	for _, rate := range k.GetParams(ctx).BridgeFeeExchangeRates {
+		if rate.FeeDenom == feeDenom && rate.TokenDenom == tokenDenom {
+			return rate.Rate, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// ExchangeBridgeFee makes sure the bridge fee of a SendToEth is paid in the denom being sent, since
+// that is the token the fee is paid out in on Ethereum. A fee in another whitelisted denom is
+// exchanged at the governance rate with the community pool: the sender funds the community pool with
+// the fee, but the FeeBurnShare burned, and receives the converted amount of the sent denom, which is
+// returned to be used as the fee
+func (k Keeper) ExchangeBridgeFee(ctx sdk.Context, sender sdk.AccAddress, fee sdk.Coin, tokenDenom string) (sdk.Coin, error) {
+	if fee.Denom == tokenDenom {
+		return fee, nil
+	}
+	rate, found := k.GetBridgeFeeExchangeRate(ctx, fee.Denom, tokenDenom)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalid, "bridge fee may not be paid in %s when sending %s", fee.Denom, tokenDenom)
+	}
+	exchanged := sdk.NewCoin(tokenDenom, fee.Amount.ToDec().Mul(rate).TruncateInt())
+	if exchanged.IsZero() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalid, "bridge fee %s is worth no %s", fee, tokenDenom)
+	}
+
+	burned, err := k.burnFees(ctx, sender, sdk.NewCoins(fee))
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(err, "unable to pay bridge fee")
+	}
+	if funded := sdk.NewCoins(fee).Sub(burned); !funded.IsZero() {
+		if err := k.DistKeeper.FundCommunityPool(ctx, funded, sender); err != nil {
+			return sdk.Coin{}, sdkerrors.Wrap(err, "unable to pay bridge fee")
+		}
+	}
+	if err := k.DistKeeper.DistributeFromFeePool(ctx, sdk.NewCoins(exchanged), sender); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(err, "unable to exchange bridge fee")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeFeeExchanged,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeExchanged, exchanged.String()),
+		),
+	)
+
+	return exchanged, nil
+}
+
+// PayBatchRelayFees pays out the relay fees of an executed batch, per denom, from the fees account.
+// The relayer is the Ethereum address that submitted the batch, when it is the delegate key of a
+// validator the RelayerFeeShare of the fees is allocated to the validator through the distribution module,
+// so that the validator keeps its commission and its delegators are paid their share, and the rest goes to
+// the community pool. Relayers the chain can not map to a Cosmos account, or an unreported relayer,
+// leave all the fees to the community pool. The FeeBurnShare of the fees is burned before they are split.
+// Only the relay fees of the transactions set in the tx success bitmap are paid, those of the failed
+// transactions stay escrowed as they go back to the pool
+func (k Keeper) PayBatchRelayFees(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64, relayer *types.EthAddress, txSuccessBitmap []byte) {
+	batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
+	if batch == nil {
+		return
+	}
+	executed, _, err := batch.SplitBySuccess(txSuccessBitmap)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "paying the relay fees of batch %s %d", tokenContract.GetAddress(), nonce))
+	}
+	fees := types.TransfersRelayFees(executed)
+	if fees.IsZero() {
+		return
+	}
+
+	relayerAddr := ""
+	var validator *stakingtypes.Validator
+	if relayer != nil {
+		relayerAddr = relayer.GetAddress()
+		if val, found := k.GetValidatorByEthAddress(ctx, *relayer); found {
+			validator = &val
+		}
+	}
+	burned, err := k.burnFees(ctx, k.accountKeeper.GetModuleAddress(types.FeesAccountName), fees)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "unable to burn relay fees"))
+	}
+	remaining := fees.Sub(burned)
+	relayerFees, communityFees := sdk.NewCoins(), remaining
+	if validator != nil {
+		relayerFees = relayerFeeShare(remaining, k.GetParams(ctx).RelayerFeeShare)
+		communityFees = remaining.Sub(relayerFees)
+	}
+	recipient := k.DistKeeper.GetDistributionAccount(ctx).GetAddress().String()
+	if validator != nil {
+		recipient = k.payValidatorRewards(ctx, *validator, types.FeesAccountName, relayerFees)
+	}
+	if !communityFees.IsZero() {
+		if err := k.DistKeeper.FundCommunityPool(ctx, communityFees, k.accountKeeper.GetModuleAddress(types.FeesAccountName)); err != nil {
+			panic(sdkerrors.Wrap(err, "unable to send relay fees to the community pool"))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchRelayFeesPaid,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract.GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(nonce)),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayerAddr),
+			sdk.NewAttribute(types.AttributeKeyRelayFeesRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyRelayFees, fees.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPoolFees, communityFees.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnedFees, burned.String()),
+		),
+	)
+}
+
+// payValidatorRewards pays rewards accrued to a validator from a module account. They are allocated to the validator
+// through the distribution module, which splits them between its commission and its delegators the way it splits
+// the block rewards. A validator the distribution module does not track, e.g. one of the provider chain of a
+// consumer chain, is paid to its operator account instead. It returns the address the rewards went to, the
+// operator address of the validator when they were allocated to it
+// WARNING: Do not make this function public
+func (k Keeper) payValidatorRewards(ctx sdk.Context, validator stakingtypes.Validator, fromModule string, rewards sdk.Coins) string {
+	operator := validator.GetOperator()
+	// the distribution module starts the reward periods of the validators it tracks at 1 on their creation
+	if k.DistKeeper.GetValidatorCurrentRewards(ctx, operator).Period == 0 {
+		if !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, fromModule, sdk.AccAddress(operator), rewards); err != nil {
+				panic(sdkerrors.Wrapf(err, "unable to pay the rewards of validator %s", operator))
+			}
+		}
+		return sdk.AccAddress(operator).String()
+	}
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, fromModule, distrtypes.ModuleName, rewards); err != nil {
+			panic(sdkerrors.Wrapf(err, "unable to pay the rewards of validator %s", operator))
+		}
+		k.DistKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+	}
+	return operator.String()
+}
+
+// relayerFeeShare returns the share of every coin of fees paid to the relayer, truncated so the
+// remainder always goes to the community pool
+func relayerFeeShare(fees sdk.Coins, share sdk.Dec) sdk.Coins {
+	paid := sdk.NewCoins()
+	for _, fee := range fees {
+		paid = paid.Add(sdk.NewCoin(fee.Denom, share.MulInt(fee.Amount).TruncateInt()))
+	}
+	return paid
+}
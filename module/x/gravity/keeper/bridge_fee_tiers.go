@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+const (
+	// BridgeFeeTierFastBlocks is the number of blocks the transfers paying the fast tier fee were batched within
+	BridgeFeeTierFastBlocks = 10
+	// BridgeFeeTierNormalBlocks is the number of blocks the transfers paying the normal tier fee were batched within
+	BridgeFeeTierNormalBlocks = 100
+	// BridgeFeeTierSlowBlocks is the number of blocks the transfers paying the slow tier fee were batched within
+	BridgeFeeTierSlowBlocks = 1000
+	// BridgeFeeTierWindow is the number of batches the fee tier moving averages are computed over
+	BridgeFeeTierWindow = 20
+)
+
+/////////////////////////////
+//    BRIDGE FEE TIERS     //
+/////////////////////////////
+
+// GetBridgeFeeTiers returns the bridge fee tiers of a token, or nil if no transfer of this token has been batched
+// within the blocks of a tier yet
+func (k Keeper) GetBridgeFeeTiers(ctx sdk.Context, tokenContract types.EthAddress) *types.BridgeFeeTiers {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetBridgeFeeTiersKey(tokenContract)))
+	if len(bz) == 0 {
+		return nil
+	}
+	var tiers types.BridgeFeeTiers
+	k.cdc.MustUnmarshal(bz, &tiers)
+	return &tiers
+}
+
+// SetBridgeFeeTiers stores the bridge fee tiers of a token
+func (k Keeper) SetBridgeFeeTiers(ctx sdk.Context, tiers types.BridgeFeeTiers) {
+	contract, err := types.NewEthAddress(tiers.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid token contract in bridge fee tiers"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetBridgeFeeTiersKey(*contract)), k.cdc.MustMarshal(&tiers))
+}
+
+// IterateBridgeFeeTiers iterates over the bridge fee tiers of every token
+func (k Keeper) IterateBridgeFeeTiers(ctx sdk.Context, cb func(key []byte, tiers types.BridgeFeeTiers) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BridgeFeeTiersKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var tiers types.BridgeFeeTiers
+		k.cdc.MustUnmarshal(iter.Value(), &tiers)
+		// cb returns true to stop early
+		if cb(iter.Key(), tiers) {
+			break
+		}
+	}
+}
+
+// GetAllBridgeFeeTiers returns the bridge fee tiers of every token
+func (k Keeper) GetAllBridgeFeeTiers(ctx sdk.Context) (out []types.BridgeFeeTiers) {
+	k.IterateBridgeFeeTiers(ctx, func(_ []byte, tiers types.BridgeFeeTiers) bool {
+		out = append(out, tiers)
+		return false
+	})
+	return
+}
+
+// recordBridgeFeeTiers folds a new batch into the moving averages of the fee tiers of its token. The sample of a
+// tier is the lowest fee of the transfers of the batch which entered the pool at most the blocks of the tier ago,
+// a tier without such a transfer is left as is
+func (k Keeper) recordBridgeFeeTiers(ctx sdk.Context, tokenContract types.EthAddress, txs []*types.InternalOutgoingTransferTx) {
+	tiers := k.GetBridgeFeeTiers(ctx, tokenContract)
+	if tiers == nil {
+		tiers = &types.BridgeFeeTiers{
+			TokenContract: tokenContract.GetAddress(),
+			Fast:          types.BridgeFeeTier{Blocks: BridgeFeeTierFastBlocks, Fee: sdk.ZeroInt(), Samples: 0},
+			Normal:        types.BridgeFeeTier{Blocks: BridgeFeeTierNormalBlocks, Fee: sdk.ZeroInt(), Samples: 0},
+			Slow:          types.BridgeFeeTier{Blocks: BridgeFeeTierSlowBlocks, Fee: sdk.ZeroInt(), Samples: 0},
+		}
+	}
+
+	current := uint64(ctx.BlockHeight())
+	recorded := false
+	for _, tier := range []*types.BridgeFeeTier{&tiers.Fast, &tiers.Normal, &tiers.Slow} {
+		var lowest *sdk.Int
+		for _, tx := range txs {
+			entered, found := k.getPoolEntryHeight(ctx, tx.Id)
+			if !found || current < entered || current-entered > tier.Blocks {
+				continue
+			}
+			if lowest == nil || tx.Erc20Fee.Amount.LT(*lowest) {
+				lowest = &tx.Erc20Fee.Amount
+			}
+		}
+		if lowest == nil {
+			continue
+		}
+		tier.Samples++
+		window := tier.Samples
+		if window > BridgeFeeTierWindow {
+			window = BridgeFeeTierWindow
+		}
+		tier.Fee = tier.Fee.MulRaw(int64(window - 1)).Add(*lowest).QuoRaw(int64(window))
+		recorded = true
+	}
+	if recorded {
+		k.SetBridgeFeeTiers(ctx, *tiers)
+	}
+}
+
+// getPoolEntryHeight returns the height at which a transfer first entered the pool
+func (k Keeper) getPoolEntryHeight(ctx sdk.Context, id uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetPoolEntryHeightKey(id)))
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return types.UInt64FromBytes(bz), true
+}
+
+// setPoolEntryHeight records the current height as the height at which a transfer entered the pool, unless it
+// already entered it before and is returned from a canceled batch
+func (k Keeper) setPoolEntryHeight(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(types.GetPoolEntryHeightKey(id))
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, types.UInt64Bytes(uint64(ctx.BlockHeight())))
+}
+
+// deletePoolEntryHeight removes the pool entry height of a transfer which left the pool for good
+func (k Keeper) deletePoolEntryHeight(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.GetPoolEntryHeightKey(id)))
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// The actions of the steps of a route to Ethereum
+const (
+	BridgeRouteActionIBCTransfer = "ibc_transfer"
+	BridgeRouteActionSendToEth   = "send_to_eth"
+	BridgeRouteActionBatchRelay  = "batch_relay"
+)
+
+// GetBridgeRoute plans how denom, held on this chain, reaches ethDest: a denom paired with an ERC20 by this bridge is
+// sent with MsgSendToEth, an IBC voucher of a denom bridged by another chain has to go back through its IBC path to
+// that chain first. The route is blocked for a blacklisted destination, a paused token or an inactive bridge. The
+// screening of the sender is not known without it and is not checked
+func (k Keeper) GetBridgeRoute(ctx sdk.Context, denom string, ethDest types.EthAddress) types.QueryBridgeRouteResponse {
+	route := types.QueryBridgeRouteResponse{Origin: types.VoucherOrigin{Denom: denom, BaseDenom: denom}}
+	origin, err := k.GetVoucherOrigin(ctx, denom)
+	if err != nil {
+		route.Reason = err.Error()
+		return route
+	}
+	route.Origin = origin
+
+	_, tokenContract, err := k.DenomToERC20Lookup(ctx, denom)
+	if err != nil {
+		route.Steps = []types.BridgeRouteStep{{
+			Action:      BridgeRouteActionIBCTransfer,
+			Denom:       denom,
+			Description: fmt.Sprintf("send back through %s to the chain whose bridge minted %s", origin.IbcPath, origin.BaseDenom),
+		}}
+		route.Reason = fmt.Sprintf("%s is bridged by the chain at the end of %s, not by this chain", origin.BaseDenom, origin.IbcPath)
+		return route
+	}
+
+	route.Steps = []types.BridgeRouteStep{
+		{
+			Action:      BridgeRouteActionSendToEth,
+			Denom:       denom,
+			Description: fmt.Sprintf("add %s to the pool with MsgSendToEth, paying the bridge fee in one of the fee denoms", denom),
+		},
+		{
+			Action:      BridgeRouteActionBatchRelay,
+			Denom:       tokenContract.GetAddress(),
+			Description: fmt.Sprintf("a relayer submits the batch of the transfer, Gravity.sol sends %s to %s", tokenContract.GetAddress(), ethDest.GetAddress()),
+		},
+	}
+	route.FeeDenoms = []string{denom}
+	for _, rate := range k.GetParams(ctx).BridgeFeeExchangeRates {
+		if rate.TokenDenom == denom {
+			route.FeeDenoms = append(route.FeeDenoms, rate.FeeDenom)
+		}
+	}
+	route.FeeTiers = k.GetBridgeFeeTiers(ctx, *tokenContract)
+
+	switch {
+	case k.IsOnBlacklist(ctx, ethDest):
+		route.Reason = fmt.Sprintf("%s is blacklisted", ethDest.GetAddress())
+	case k.IsWithdrawalPaused(ctx, *tokenContract):
+		route.Reason = fmt.Sprintf("withdrawals of %s are paused", tokenContract.GetAddress())
+	case k.GetContractReceiverPolicy(ctx) == types.CONTRACT_RECEIVER_POLICY_BLOCK && k.IsContractReceiver(ctx, ethDest):
+		route.Reason = fmt.Sprintf("%s is a contract which can not receive withdrawals", ethDest.GetAddress())
+	case !k.IsBridgeActive(ctx):
+		route.Reason = "the bridge is not active, no batch is built"
+	default:
+		route.Routable = true
+	}
+	return route
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that a bridged denom is routed to Ethereum with its fee denoms, that the IBC voucher of a denom bridged by
+// another chain is routed back through IBC, and that a blacklisted destination or a paused token block the route
+func TestGetBridgeRoute(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	contract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	voucher := types.GravityDenomPrefix + contract
+	foreignVoucher := ibctransfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: voucher}
+	k.SetDenomTraceSource(denomTraces{foreignVoucher.Hash().String(): foreignVoucher})
+	ethDest, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.BridgeFeeExchangeRates = []types.BridgeFeeExchangeRate{{FeeDenom: "stake", TokenDenom: voucher, Rate: sdk.NewDec(2)}}
+	k.SetParams(ctx, params)
+
+	route := k.GetBridgeRoute(ctx, voucher, *ethDest)
+	require.True(t, route.Routable, route.Reason)
+	assert.Equal(t, contract, route.Origin.Erc20)
+	require.Len(t, route.Steps, 2)
+	assert.Equal(t, BridgeRouteActionSendToEth, route.Steps[0].Action)
+	assert.Equal(t, BridgeRouteActionBatchRelay, route.Steps[1].Action)
+	assert.Equal(t, contract, route.Steps[1].Denom)
+	assert.Equal(t, []string{voucher, "stake"}, route.FeeDenoms)
+	assert.Nil(t, route.FeeTiers)
+
+	route = k.GetBridgeRoute(ctx, foreignVoucher.IBCDenom(), *ethDest)
+	assert.False(t, route.Routable)
+	assert.NotEmpty(t, route.Reason)
+	require.Len(t, route.Steps, 1)
+	assert.Equal(t, BridgeRouteActionIBCTransfer, route.Steps[0].Action)
+	assert.Equal(t, "transfer/channel-1", route.Origin.IbcPath)
+
+	route = k.GetBridgeRoute(ctx, "unknown", *ethDest)
+	assert.False(t, route.Routable)
+	assert.NotEmpty(t, route.Reason)
+	assert.Empty(t, route.Steps)
+
+	params.EthereumBlacklist = []string{ethDest.GetAddress()}
+	k.SetParams(ctx, params)
+	route = k.GetBridgeRoute(ctx, voucher, *ethDest)
+	assert.False(t, route.Routable)
+	assert.Contains(t, route.Reason, "blacklisted")
+	assert.Len(t, route.Steps, 2)
+
+	params.EthereumBlacklist = nil
+	params.WithdrawalPausedTokens = []string{contract}
+	k.SetParams(ctx, params)
+	route = k.GetBridgeRoute(ctx, voucher, *ethDest)
+	assert.False(t, route.Routable)
+	assert.Contains(t, route.Reason, "paused")
+
+	_, err = NewQueryServerImpl(k).BridgeRoute(sdk.WrapSDKContext(ctx), &types.QueryBridgeRouteRequest{Denom: voucher, EthDest: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetUsageEpoch returns the epoch of the bridge usage at the height of ctx, or zero if the UsageEpochLength param
+// disables the bridge usage. Epoch 1 starts at height 0
+func (k Keeper) GetUsageEpoch(ctx sdk.Context) uint64 {
+	length := k.GetParams(ctx).UsageEpochLength
+	if length == 0 {
+		return 0
+	}
+	return uint64(ctx.BlockHeight())/length + 1
+}
+
+// recordWithdrawalUsage adds the executed transfers of a batch of tokenContract to the bridge usage of their senders
+// and notifies the bridge hooks
+// WARNING: Do not make this function public
+func (k Keeper) recordWithdrawalUsage(ctx sdk.Context, tokenContract types.EthAddress, executed []*types.InternalOutgoingTransferTx) {
+	if len(executed) == 0 {
+		return
+	}
+	_, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+	epoch := k.GetUsageEpoch(ctx)
+	for _, tx := range executed {
+		amount := sdk.NewCoin(denom, tx.Erc20Token.Amount)
+		if epoch != 0 {
+			usage := k.getBridgeUsage(ctx, epoch, tx.Sender)
+			usage.Sent = usage.Sent.Add(amount)
+			k.setBridgeUsage(ctx, usage)
+		}
+		k.bridgeHooks.AfterWithdrawalExecuted(ctx, tx.Sender, amount)
+	}
+}
+
+// recordDepositUsage adds the deposits credited to receiver to its bridge usage and notifies the bridge hooks
+// WARNING: Do not make this function public
+func (k Keeper) recordDepositUsage(ctx sdk.Context, receiver sdk.AccAddress, coins sdk.Coins) {
+	if epoch := k.GetUsageEpoch(ctx); epoch != 0 {
+		usage := k.getBridgeUsage(ctx, epoch, receiver)
+		usage.Received = usage.Received.Add(coins...)
+		k.setBridgeUsage(ctx, usage)
+	}
+	k.bridgeHooks.AfterDepositCredited(ctx, receiver, coins)
+}
+
+// getBridgeUsage returns the bridge usage of address in epoch, empty if it bridged nothing
+func (k Keeper) getBridgeUsage(ctx sdk.Context, epoch uint64, address sdk.AccAddress) types.BridgeUsage {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.GetBridgeUsageKey(epoch, address)))
+	if bz == nil {
+		return types.BridgeUsage{Epoch: epoch, Address: address.String(), Sent: sdk.NewCoins(), Received: sdk.NewCoins()}
+	}
+	var usage types.BridgeUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// setBridgeUsage stores the bridge usage of an account in an epoch
+// WARNING: Do not make this function public
+func (k Keeper) setBridgeUsage(ctx sdk.Context, usage types.BridgeUsage) {
+	address, err := sdk.AccAddressFromBech32(usage.Address)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid bridge usage address %s", usage.Address))
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.GetBridgeUsageKey(usage.Epoch, address)), k.cdc.MustMarshal(&usage))
+}
+
+// GetBridgeUsage returns the bridge usage of address in epoch, or false if it bridged nothing in the epoch
+func (k Keeper) GetBridgeUsage(ctx sdk.Context, epoch uint64, address sdk.AccAddress) (types.BridgeUsage, bool) {
+	if !ctx.KVStore(k.storeKey).Has([]byte(types.GetBridgeUsageKey(epoch, address))) {
+		return types.BridgeUsage{}, false
+	}
+	return k.getBridgeUsage(ctx, epoch, address), true
+}
+
+// IterateBridgeUsage iterates the bridge usage by epoch and address, from epoch on
+func (k Keeper) IterateBridgeUsage(ctx sdk.Context, epoch uint64, cb func(key []byte, usage types.BridgeUsage) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BridgeUsageKey))
+	iter := prefixStore.Iterator(types.UInt64Bytes(epoch), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var usage types.BridgeUsage
+		k.cdc.MustUnmarshal(iter.Value(), &usage)
+		if cb(iter.Key(), usage) {
+			break
+		}
+	}
+}
+
+// GetEpochBridgeUsage returns the bridge usage of every account which bridged coins in epoch
+func (k Keeper) GetEpochBridgeUsage(ctx sdk.Context, epoch uint64) (out []types.BridgeUsage) {
+	k.IterateBridgeUsage(ctx, epoch, func(_ []byte, usage types.BridgeUsage) bool {
+		if usage.Epoch != epoch {
+			return true
+		}
+		out = append(out, usage)
+		return false
+	})
+	return
+}
+
+// PruneBridgeUsage deletes the bridge usage of the epochs older than the UsageEpochsRetained, the current epoch
+// included
+func (k Keeper) PruneBridgeUsage(ctx sdk.Context, params types.Params) {
+	epoch := k.GetUsageEpoch(ctx)
+	if params.UsageEpochsRetained == 0 || epoch <= params.UsageEpochsRetained {
+		return
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BridgeUsageKey))
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(epoch-params.UsageEpochsRetained+1))
+	var pruned [][]byte
+	for ; iter.Valid(); iter.Next() {
+		pruned = append(pruned, iter.Key())
+	}
+	iter.Close()
+	for _, key := range pruned {
+		prefixStore.Delete(key)
+	}
+}
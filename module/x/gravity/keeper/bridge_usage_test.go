@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// countingBridgeHooks counts the withdrawals and deposits the bridge hooks are notified of
+type countingBridgeHooks struct {
+	withdrawals sdk.Coins
+	deposits    sdk.Coins
+}
+
+func (h *countingBridgeHooks) AfterWithdrawalExecuted(_ sdk.Context, _ sdk.AccAddress, amount sdk.Coin) {
+	h.withdrawals = h.withdrawals.Add(amount)
+}
+
+func (h *countingBridgeHooks) AfterDepositCredited(_ sdk.Context, _ sdk.AccAddress, amount sdk.Coins) {
+	h.deposits = h.deposits.Add(amount...)
+}
+
+// Tests that the withdrawals and deposits are added to the usage of their account in the current epoch, that the
+// hooks are notified even when the usage is not recorded, and that the epochs past the retained ones are pruned
+func TestBridgeUsage(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(5)
+	hooks := &countingBridgeHooks{}
+	input.GravityKeeper.SetBridgeHooks(hooks)
+	require.Equal(t, hooks, input.GravityKeeper.AttestationHandler.(AttestationHandler).keeper.bridgeHooks)
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver          = RandomAccAddress()
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr)
+	require.NoError(t, err)
+	withdrawal := token.GravityCoin()
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(withdrawal.Denom, 40))
+	executed := []*types.InternalOutgoingTransferTx{{Id: 1, Sender: mySender, Erc20Token: token}}
+
+	// the usage epochs are disabled, only the hooks are notified
+	input.GravityKeeper.recordWithdrawalUsage(ctx, token.Contract, executed)
+	require.Zero(t, input.GravityKeeper.GetUsageEpoch(ctx))
+	require.Equal(t, sdk.NewCoins(withdrawal), hooks.withdrawals)
+	require.Empty(t, input.GravityKeeper.GetEpochBridgeUsage(ctx, 0))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.UsageEpochLength = 10
+	params.UsageEpochsRetained = 2
+	input.GravityKeeper.SetParams(ctx, params)
+
+	require.Equal(t, uint64(1), input.GravityKeeper.GetUsageEpoch(ctx))
+	input.GravityKeeper.recordWithdrawalUsage(ctx, token.Contract, executed)
+	input.GravityKeeper.recordWithdrawalUsage(ctx, token.Contract, executed)
+	input.GravityKeeper.recordDepositUsage(ctx, mySender, deposit)
+	ctx = ctx.WithBlockHeight(15)
+	require.Equal(t, uint64(2), input.GravityKeeper.GetUsageEpoch(ctx))
+	input.GravityKeeper.recordDepositUsage(ctx, myReceiver, deposit)
+
+	usage, found := input.GravityKeeper.GetBridgeUsage(ctx, 1, mySender)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(withdrawal.Add(withdrawal)), usage.Sent)
+	require.Equal(t, deposit, usage.Received)
+	_, found = input.GravityKeeper.GetBridgeUsage(ctx, 1, myReceiver)
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(withdrawal.Denom, withdrawal.Amount.MulRaw(3))), hooks.withdrawals)
+	require.Equal(t, deposit.Add(deposit...), hooks.deposits)
+
+	// epoch 0 queries the current epoch
+	res, err := NewQueryServerImpl(input.GravityKeeper).BridgeUsage(sdk.WrapSDKContext(ctx), &types.QueryBridgeUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Epoch)
+	require.Len(t, res.Usage, 1)
+	require.Equal(t, myReceiver.String(), res.Usage[0].Address)
+	res, err = NewQueryServerImpl(input.GravityKeeper).BridgeUsage(sdk.WrapSDKContext(ctx), &types.QueryBridgeUsageRequest{Epoch: 1, Address: myReceiver.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Usage)
+
+	// the current epoch and the one before it are retained
+	input.GravityKeeper.PruneBridgeUsage(ctx, params)
+	require.Len(t, input.GravityKeeper.GetEpochBridgeUsage(ctx, 1), 1)
+	ctx = ctx.WithBlockHeight(25)
+	input.GravityKeeper.PruneBridgeUsage(ctx, params)
+	require.Empty(t, input.GravityKeeper.GetEpochBridgeUsage(ctx, 1))
+	require.Len(t, input.GravityKeeper.GetEpochBridgeUsage(ctx, 2), 1)
+
+	exported := ExportGenesis(ctx, input.GravityKeeper)
+	require.Len(t, exported.BridgeUsage, 1)
+	require.Equal(t, uint64(2), exported.BridgeUsage[0].Epoch)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/onomyprotocol/arc/module/eth/chain"
+	"github.com/onomyprotocol/arc/module/eth/contracts"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetBuildInfo returns the version of this binary and the bridge it was built for: the EVM chain of its port, the
+// Gravity.sol ABI it encodes calls for, and the version of the gravity protos and claim encoding it serves. Two nodes
+// with the same build info run the same bridge code
+func GetBuildInfo() types.BuildInfo {
+	info := version.NewInfo()
+	abiHash := sha256.Sum256([]byte(contracts.GravityMetaData.ABI))
+	return types.BuildInfo{
+		Name:                 info.Name,
+		AppName:              info.AppName,
+		Version:              info.Version,
+		GitCommit:            info.GitCommit,
+		BuildTags:            info.BuildTags,
+		GoVersion:            info.GoVersion,
+		BridgeTarget:         chain.Current().Name,
+		ContractAbiHash:      hex.EncodeToString(abiHash[:]),
+		ProtoPackage:         strings.TrimSuffix(proto.MessageName(&types.BuildInfo{}), ".BuildInfo"),
+		ClaimEncodingVersion: types.ClaimEncodingVersion,
+	}
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the build info names the bridge target of the configured port, the Gravity.sol ABI, the gravity proto
+// package and the claim encoding version, and that the query returns it
+func TestBuildInfo(t *testing.T) {
+	input := CreateTestEnv(t)
+	info := GetBuildInfo()
+	assert.Equal(t, "ethereum", info.BridgeTarget)
+	assert.Len(t, info.ContractAbiHash, 64)
+	assert.Equal(t, "gravity.v1", info.ProtoPackage)
+	assert.Equal(t, uint64(types.ClaimEncodingVersion), info.ClaimEncodingVersion)
+
+	res, err := NewQueryServerImpl(input.GravityKeeper).BuildInfo(sdk.WrapSDKContext(input.Context), &types.QueryBuildInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, info, res.BuildInfo)
+}
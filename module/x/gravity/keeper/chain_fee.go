@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetMinChainFeeBasisPoints returns the smallest chain fee of a MsgSendToEth in basis points of its amount, zero if
+// transfers can leave without a chain fee
+func (k Keeper) GetMinChainFeeBasisPoints(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MinChainFeeBasisPoints
+}
+
+// GetRequiredChainFee returns the smallest chain fee a MsgSendToEth of amount pays, in the denom of amount and
+// rounded down
+func (k Keeper) GetRequiredChainFee(ctx sdk.Context, amount sdk.Coin) sdk.Coin {
+	basisPoints := sdk.NewIntFromUint64(k.GetMinChainFeeBasisPoints(ctx))
+	return sdk.NewCoin(amount.Denom, amount.Amount.Mul(basisPoints).QuoRaw(10000))
+}
+
+// payChainFee sends the chain fee of a MsgSendToEth of amount from sender to the fee collector, to be distributed to
+// the stakers, or fails if it is below the required chain fee. A nil chain fee pays nothing
+func (k Keeper) payChainFee(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, chainFee *sdk.Coin) error {
+	paid := sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	if chainFee != nil {
+		if chainFee.Denom != amount.Denom {
+			return sdkerrors.Wrapf(types.ErrInvalid, "chain fee %s is not in the denom of the amount %s", chainFee, amount)
+		}
+		paid = *chainFee
+	}
+	if required := k.GetRequiredChainFee(ctx, amount); paid.IsLT(required) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "chain fee %s is below the required %s", paid, required)
+	}
+	if paid.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, sdk.NewCoins(paid))
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that a MsgSendToEth pays at least the required chain fee in the denom of its amount to the fee collector
+func TestChainFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+
+	var (
+		sender       = RandomAccAddress()
+		receiver     = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		_, denom     = RandomEthAddress()
+		feeCollector = input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	)
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, vouchers))
+
+	params := k.GetParams(ctx)
+	params.MinChainFeeBasisPoints = 25
+	k.SetParams(ctx, params)
+
+	// 25 basis points of 1000 is 2.5, rounded down
+	res, err := NewQueryServerImpl(k).RequiredChainFee(sdk.WrapSDKContext(ctx), &types.QueryRequiredChainFeeRequest{Amount: "1000" + denom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 2), res.ChainFee)
+	require.Equal(t, uint64(25), res.MinChainFeeBasisPoints)
+	_, err = NewQueryServerImpl(k).RequiredChainFee(sdk.WrapSDKContext(ctx), &types.QueryRequiredChainFeeRequest{Amount: "1000"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	send := func(chainFee *sdk.Coin) error {
+		msg := &types.MsgSendToEth{
+			Sender:    sender.String(),
+			EthDest:   receiver,
+			Amount:    sdk.NewInt64Coin(denom, 1000),
+			BridgeFee: sdk.NewInt64Coin(denom, 1),
+			ChainFee:  chainFee,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := msgServer.SendToEth(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+	require.ErrorIs(t, send(nil), types.ErrInvalid)
+	chainFee := sdk.NewInt64Coin(denom, 1)
+	require.ErrorIs(t, send(&chainFee), types.ErrInvalid)
+	chainFee = sdk.NewInt64Coin("stake", 2)
+	require.Error(t, send(&chainFee))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+
+	chainFee = sdk.NewInt64Coin(denom, 2)
+	require.NoError(t, send(&chainFee))
+	require.Equal(t, chainFee, input.BankKeeper.GetBalance(ctx, feeCollector, denom))
+	require.Equal(t, int64(10000-1000-1-2), input.BankKeeper.GetBalance(ctx, sender, denom).Amount.Int64())
+
+	// without a minimum a transfer can leave without a chain fee
+	params.MinChainFeeBasisPoints = 0
+	k.SetParams(ctx, params)
+	require.NoError(t, send(nil))
+	require.Equal(t, chainFee, input.BankKeeper.GetBalance(ctx, feeCollector, denom))
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetContractReceiverPolicy returns what happens to the withdrawals to the ContractReceivers
+func (k Keeper) GetContractReceiverPolicy(ctx sdk.Context) types.ContractReceiverPolicy {
+	return k.GetParams(ctx).ContractReceiverPolicy
+}
+
+// IsContractReceiver returns whether dest is listed in the ContractReceivers param as a contract known to lose the
+// ERC20 transfers sent to it
+func (k Keeper) IsContractReceiver(ctx sdk.Context, dest types.EthAddress) bool {
+	for _, receiver := range k.GetParams(ctx).ContractReceivers {
+		contract, err := types.NewEthAddress(receiver)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid contract receiver %s in params", receiver))
+		}
+		if contract.GetAddress() == dest.GetAddress() {
+			return true
+		}
+	}
+	return false
+}
+
+// checkContractReceiver applies the ContractReceiverPolicy to a withdrawal to dest entering the pool, it returns an
+// error if the withdrawal is blocked and emits a contract_receiver event if its sender is only warned
+func (k Keeper) checkContractReceiver(ctx sdk.Context, sender sdk.AccAddress, dest types.EthAddress) error {
+	policy := k.GetContractReceiverPolicy(ctx)
+	if policy == types.CONTRACT_RECEIVER_POLICY_ALLOW || !k.IsContractReceiver(ctx, dest) {
+		return nil
+	}
+	if policy == types.CONTRACT_RECEIVER_POLICY_BLOCK {
+		return sdkerrors.Wrapf(types.ErrContractReceiver, "%s can not receive withdrawals", dest.GetAddress())
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeContractReceiver,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyEthDest, dest.GetAddress()),
+	))
+	return nil
+}
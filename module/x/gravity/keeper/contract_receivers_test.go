@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the withdrawals to a listed contract enter the pool while the policy allows them, enter it with a
+// contract_receiver event while it warns and are rejected while it blocks, and that other receivers are not affected
+func TestContractReceiverPolicy(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myContract, _       = types.NewEthAddress("0x2C7dA26A0fe4aD0f2d79dD5aE6f1c7e6C6d5E2a1")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	funds := sdk.NewCoins(token.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, funds))
+	amount := sdk.NewInt64Coin(token.GravityCoin().Denom, 100)
+	fee := sdk.NewInt64Coin(token.GravityCoin().Denom, 1)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.ContractReceivers = []string{myContract.GetAddress()}
+	input.GravityKeeper.SetParams(ctx, params)
+	require.True(t, input.GravityKeeper.IsContractReceiver(ctx, *myContract))
+	require.False(t, input.GravityKeeper.IsContractReceiver(ctx, *myReceiver))
+
+	contractReceiverEvents := func(ctx sdk.Context) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeContractReceiver {
+				count++
+			}
+		}
+		return count
+	}
+
+	allowCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, err = input.GravityKeeper.AddToOutgoingPool(allowCtx, mySender, *myContract, amount, fee)
+	require.NoError(t, err)
+	require.Zero(t, contractReceiverEvents(allowCtx))
+
+	params.ContractReceiverPolicy = types.CONTRACT_RECEIVER_POLICY_WARN
+	input.GravityKeeper.SetParams(ctx, params)
+	warnCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, err = input.GravityKeeper.AddToOutgoingPool(warnCtx, mySender, *myContract, amount, fee)
+	require.NoError(t, err)
+	require.Equal(t, 1, contractReceiverEvents(warnCtx))
+	warnCtx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = input.GravityKeeper.AddToOutgoingPool(warnCtx, mySender, *myReceiver, amount, fee)
+	require.NoError(t, err)
+	require.Zero(t, contractReceiverEvents(warnCtx))
+
+	params.ContractReceiverPolicy = types.CONTRACT_RECEIVER_POLICY_BLOCK
+	input.GravityKeeper.SetParams(ctx, params)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myContract, amount, fee)
+	require.ErrorIs(t, err, types.ErrContractReceiver)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+	require.NoError(t, err)
+
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 4)
+}
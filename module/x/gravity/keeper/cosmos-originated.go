@@ -46,6 +46,15 @@ func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, t
 // This will return an error if it cant parse the denom as a gravity denom, and then also can't find the denom
 // in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets.
 func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, *types.EthAddress, error) {
+	// Native ETH is sent back to Ethereum as WETH
+	if denom == types.NativeEthDenom {
+		weth, found := k.GetWethContract(ctx)
+		if !found {
+			return false, nil, sdkerrors.Wrap(types.ErrInvalid, "native ETH can not be sent to Ethereum without a weth contract")
+		}
+		return false, weth, nil
+	}
+
 	// First try parsing the ERC20 out of the denom
 	tc1, err := types.GravityDenomToERC20(denom)
 
@@ -60,6 +69,11 @@ func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, *types.
 		return true, tc2, nil
 	}
 
+	// The zero address is no ERC20, native ETH is bridged as NativeEthDenom
+	if tc1.GetAddress() == types.ZeroAddressString {
+		return false, nil, sdkerrors.Wrapf(types.ErrInvalid, "%s is not an ERC20, native ETH is bridged as %s", denom, types.NativeEthDenom)
+	}
+
 	// This is an ethereum-originated asset
 	return false, tc1, nil
 }
@@ -96,6 +110,11 @@ func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, tokenContract types.EthAddre
 		return true, dn1
 	}
 
+	// Native ETH, deposited as is or wrapped, has a canonical denom
+	if k.isNativeEthContract(ctx, tokenContract) {
+		return false, types.NativeEthDenom
+	}
+
 	// If it is not in there, it is not a cosmos originated token, turn the ERC20 into a gravity denom
 	return false, types.GravityDenom(tokenContract)
 }
@@ -117,3 +136,34 @@ func (k Keeper) IterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 		}
 	}
 }
+
+// setERC20DeployedRejection records why the ERC20 deployment of claim was not paired with its denom
+func (k Keeper) setERC20DeployedRejection(ctx sdk.Context, claim *types.MsgERC20DeployedClaim, reason error) {
+	rejection := types.ERC20DeployedRejection{
+		EventNonce:    claim.EventNonce,
+		CosmosDenom:   claim.CosmosDenom,
+		TokenContract: claim.TokenContract,
+		Reason:        reason.Error(),
+		BlockHeight:   uint64(ctx.BlockHeight()),
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetERC20DeployedRejectionKey(claim.EventNonce)), k.cdc.MustMarshal(&rejection))
+}
+
+// GetERC20DeployedRejections returns the rejected ERC20 deployments of denom by event nonce, or all of
+// them when denom is empty
+func (k Keeper) GetERC20DeployedRejections(ctx sdk.Context, denom string) []types.ERC20DeployedRejection {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ERC20DeployedRejectionKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var rejections []types.ERC20DeployedRejection
+	for ; iter.Valid(); iter.Next() {
+		var rejection types.ERC20DeployedRejection
+		k.cdc.MustUnmarshal(iter.Value(), &rejection)
+		if denom == "" || rejection.CosmosDenom == denom {
+			rejections = append(rejections, rejection)
+		}
+	}
+	return rejections
+}
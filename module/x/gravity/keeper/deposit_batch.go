@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// depositBatchKey is the context key of the depositBatch of the attestations observed by an EndBlock
+type depositBatchKey struct{}
+
+// depositBatch accumulates the deposits unlocked by the attestations observed by an EndBlock, so that the vouchers
+// are minted in a single operation and every receiver is paid once instead of once per deposit
+type depositBatch struct {
+	// mint are the Ethereum originated vouchers to mint
+	mint sdk.Coins
+	// receivers are the deposit receivers in the order of their first deposit, outputs their deposits
+	receivers []sdk.AccAddress
+	outputs   map[string]sdk.Coins
+}
+
+// depositBatchFromContext returns the depositBatch of ctx, nil outside of ObserveDeposits
+func depositBatchFromContext(ctx sdk.Context) *depositBatch {
+	batch, _ := ctx.Value(depositBatchKey{}).(*depositBatch)
+	return batch
+}
+
+// add records coins deposited to receiver, minted tells whether they are vouchers still to be minted
+func (b *depositBatch) add(receiver sdk.AccAddress, coins sdk.Coins, minted bool) {
+	if minted {
+		b.mint = b.mint.Add(coins...)
+	}
+	if _, ok := b.outputs[receiver.String()]; !ok {
+		b.receivers = append(b.receivers, receiver)
+	}
+	b.outputs[receiver.String()] = b.outputs[receiver.String()].Add(coins...)
+}
+
+// ObserveDeposits calls observe with a context in which the deposits of the attestations it observes are aggregated,
+// then mints their vouchers with a single MintCoins and sends every receiver its deposits, which reduces the bank
+// store writes and events during deposit storms
+func (k Keeper) ObserveDeposits(ctx sdk.Context, observe func(ctx sdk.Context)) {
+	batch := &depositBatch{mint: sdk.NewCoins(), outputs: make(map[string]sdk.Coins)}
+	observe(ctx.WithValue(depositBatchKey{}, batch))
+	k.sendDepositBatch(ctx, batch)
+}
+
+// sendDepositBatch mints the vouchers of batch and sends the deposits to their receivers, it panics because a
+// failure means the deposits observed on Ethereum are lost
+func (k Keeper) sendDepositBatch(ctx sdk.Context, batch *depositBatch) {
+	if !batch.mint.IsZero() {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, batch.mint); err != nil {
+			panic(sdkerrors.Wrapf(err, "unable to mint the deposited vouchers %s", batch.mint))
+		}
+	}
+	for _, receiver := range batch.receivers {
+		coins := batch.outputs[receiver.String()]
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+			panic(sdkerrors.Wrapf(err, "unable to send the deposits %s to %s", coins, receiver))
+		}
+		k.recordDepositUsage(ctx, receiver, coins)
+	}
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetInvalidReceiverPolicy returns what happens to the observed deposits whose receiver can not receive them
+func (k Keeper) GetInvalidReceiverPolicy(ctx sdk.Context) types.InvalidReceiverPolicy {
+	return k.GetParams(ctx).InvalidReceiverPolicy
+}
+
+// IsBlockedModuleReceiver returns true if receiver is the account of a module whose account can not receive deposits,
+// which is every module but the AllowedReceiverModules while the BlockModuleAccountReceivers param is set. The module
+// accounts blocked by the bank module can not receive deposits regardless
+func (k Keeper) IsBlockedModuleReceiver(ctx sdk.Context, receiver sdk.AccAddress) bool {
+	params := k.GetParams(ctx)
+	if !params.BlockModuleAccountReceivers {
+		return false
+	}
+	account, ok := k.accountKeeper.GetAccount(ctx, receiver).(authtypes.ModuleAccountI)
+	if !ok {
+		return false
+	}
+	for _, module := range params.AllowedReceiverModules {
+		if module == account.GetName() {
+			return false
+		}
+	}
+	return true
+}
+
+// DepositRefundFee returns the bridge fee deducted from a deposit of tokenContract refunded to its Ethereum sender,
+// the slow bridge fee tier of the token so that the refund is batched like the other transfers, or zero if no
+// transfer of the token has been batched yet
+func (k Keeper) DepositRefundFee(ctx sdk.Context, tokenContract types.EthAddress) sdk.Int {
+	tiers := k.GetBridgeFeeTiers(ctx, tokenContract)
+	if tiers == nil || tiers.Slow.Fee.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return tiers.Slow.Fee
+}
+
+// refundDeposit sends a deposit of coin, escrowed by the fromAccount module account, back to its Ethereum sender by
+// adding a transfer from fromAccount to the pool. The DepositRefundFee is deducted from the amount and paid as the
+// bridge fee of the transfer, native ETH deposits as WETH. Deposits swapped by Erc20ToDenomPermanentSwap, not covering
+// the fee, of withdrawal paused tokens and of senders which can not receive a batch can not be refunded. It returns the
+// id and the bridge fee of the transfer
+// WARNING: Do not make this function public
+func (k Keeper) refundDeposit(
+	ctx sdk.Context,
+	fromAccount string,
+	sender types.EthAddress,
+	tokenContract types.EthAddress,
+	coin sdk.Coin,
+) (uint64, sdk.Coin, error) {
+	// native ETH is refunded wrapped, the Gravity contract can only send ERC20s
+	if tokenContract.GetAddress() == types.ZeroAddressString {
+		weth, found := k.GetWethContract(ctx)
+		if !found {
+			return 0, sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalid, "native ETH can not be refunded without a weth contract")
+		}
+		tokenContract = *weth
+	}
+	if _, denom := k.ERC20ToDenomLookup(ctx, tokenContract); denom != coin.Denom {
+		return 0, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalid, "%s is not bridged as %s", coin.Denom, tokenContract.GetAddress())
+	}
+	if k.IsWithdrawalPaused(ctx, tokenContract) {
+		return 0, sdk.Coin{}, sdkerrors.Wrapf(types.ErrTokenPaused, "withdrawals of %s", tokenContract.GetAddress())
+	}
+	if k.InvalidSendToEthAddress(ctx, sender, tokenContract) {
+		return 0, sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalid, "ethereum sender is invalid or blacklisted")
+	}
+	fee := sdk.NewCoin(coin.Denom, k.DepositRefundFee(ctx, tokenContract))
+	if !coin.Amount.GT(fee.Amount) {
+		return 0, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalid, "%s does not cover the refund bridge fee %s", coin, fee)
+	}
+
+	// the unbatched pool escrows both the amount and the bridge fee of its transfers
+	k.moveEscrow(ctx, fromAccount, types.UnbatchedPoolAccountName, sdk.NewCoins(coin))
+	refunder := k.accountKeeper.GetModuleAddress(fromAccount)
+	txID, err := k.storeOutgoingTx(ctx, refunder, sender, tokenContract, coin.Sub(fee), fee, nil, 0)
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+	return txID, fee, nil
+}
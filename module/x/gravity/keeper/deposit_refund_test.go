@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestRefundDepositDeductsFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender            = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	sender, err := types.NewEthAddress(mySender)
+	require.NoError(t, err)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr)
+	require.NoError(t, err)
+	coin := token.GravityCoin()
+
+	// without batched transfers of the token nothing is deducted
+	require.True(t, gk.DepositRefundFee(ctx, *tokenContract).IsZero())
+
+	gk.SetBridgeFeeTiers(ctx, types.BridgeFeeTiers{
+		TokenContract: myTokenContractAddr,
+		Fast:          types.BridgeFeeTier{Blocks: BridgeFeeTierFastBlocks, Fee: sdk.NewInt(30)},
+		Normal:        types.BridgeFeeTier{Blocks: BridgeFeeTierNormalBlocks, Fee: sdk.NewInt(20)},
+		Slow:          types.BridgeFeeTier{Blocks: BridgeFeeTierSlowBlocks, Fee: sdk.NewInt(10)},
+	})
+	require.Equal(t, sdk.NewInt(10), gk.DepositRefundFee(ctx, *tokenContract))
+
+	// a deposit not covering the fee is not refunded
+	small := sdk.NewCoin(coin.Denom, sdk.NewInt(10))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(small)))
+	_, _, err = gk.refundDeposit(ctx, types.ModuleName, *sender, *tokenContract, small)
+	require.Error(t, err)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)))
+	txID, fee, err := gk.refundDeposit(ctx, types.ModuleName, *sender, *tokenContract, coin)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoin(coin.Denom, sdk.NewInt(10)), fee)
+	tx, err := gk.GetUnbatchedTxById(ctx, txID)
+	require.NoError(t, err)
+	assert.Equal(t, mySender, tx.DestAddress.GetAddress())
+	assert.Equal(t, sdk.NewInt(90), tx.Erc20Token.Amount)
+	assert.Equal(t, sdk.NewInt(10), tx.Erc20Fee.Amount)
+	unbatchedAcc := input.AccountKeeper.GetModuleAddress(types.UnbatchedPoolAccountName)
+	assert.Equal(t, coin, input.BankKeeper.GetBalance(ctx, unbatchedAcc, coin.Denom))
+}
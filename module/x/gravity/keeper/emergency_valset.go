@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// EmergencyValsetTimelock is the number of blocks between an emergency valset proposal passing and its valset being
+// stored for the validators to sign, a later proposal may supersede it in the meantime
+const EmergencyValsetTimelock = 14400
+
+/////////////////////////////
+//    EMERGENCY VALSETS    //
+/////////////////////////////
+
+// HandleEmergencyValsetProposal schedules the valset of the proposal to be stored once the EmergencyValsetTimelock has
+// passed, superseding an emergency valset still waiting for its timelock
+func (k Keeper) HandleEmergencyValsetProposal(ctx sdk.Context, p *types.EmergencyValsetProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	for i, member := range p.Members {
+		addr, err := types.NewEthAddress(member.EthereumAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "member %d", i)
+		}
+		if k.IsOnBlacklist(ctx, *addr) {
+			return sdkerrors.Wrapf(types.ErrInvalid, "member %d %s is blacklisted", i, addr.GetAddress())
+		}
+	}
+
+	activation := uint64(ctx.BlockHeight()) + EmergencyValsetTimelock
+	// the nonce is only assigned once the valset is stored
+	pending := types.Valset{
+		Nonce:        0,
+		Members:      p.Members,
+		Height:       activation,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddress().GetAddress(),
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.PendingEmergencyValsetKey), k.cdc.MustMarshal(&pending))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEmergencyValsetScheduled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, fmt.Sprint(activation)),
+		),
+	)
+	return nil
+}
+
+// GetPendingEmergencyValset returns the emergency valset waiting for its timelock, its height is the block from which
+// it is stored and its nonce is unset. Note this value is not saved and loaded in genesis
+func (k Keeper) GetPendingEmergencyValset(ctx sdk.Context) *types.Valset {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.PendingEmergencyValsetKey))
+	if bz == nil {
+		return nil
+	}
+	var valset types.Valset
+	k.cdc.MustUnmarshal(bz, &valset)
+	return &valset
+}
+
+// GetLastEmergencyValsetNonce returns the nonce of the last emergency valset stored, zero if there was none
+func (k Keeper) GetLastEmergencyValsetNonce(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.LastEmergencyValsetNonceKey))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// StoreDueEmergencyValset stores the pending emergency valset as the latest valset once its timelock has passed,
+// it returns the stored valset or nil
+func (k Keeper) StoreDueEmergencyValset(ctx sdk.Context) *types.Valset {
+	valset := k.GetPendingEmergencyValset(ctx)
+	if valset == nil || uint64(ctx.BlockHeight()) < valset.Height {
+		return nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.PendingEmergencyValsetKey))
+
+	members, err := types.BridgeValidators(valset.Members).ToInternal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid pending emergency valset"))
+	}
+	emergency, err := types.NewValset(k.GetLatestValsetNonce(ctx)+1, uint64(ctx.BlockHeight()), *members,
+		valset.RewardAmount, types.ZeroAddress())
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid pending emergency valset"))
+	}
+	k.StoreValset(ctx, *emergency)
+	k.SetLatestValsetNonce(ctx, emergency.Nonce)
+	store.Set([]byte(types.LastEmergencyValsetNonceKey), types.UInt64Bytes(emergency.Nonce))
+	k.SetPastEthSignatureCheckpoint(ctx, emergency.GetCheckpoint(k.GetGravityID(ctx)))
+
+	bridgeAddr := k.GetBridgeContractAddress(ctx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEmergencyValsetStored,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, bridgeAddr.GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyValsetNonce, fmt.Sprint(emergency.Nonce)),
+		),
+	)
+	return emergency
+}
+
+// IsEmergencyValsetUnobserved returns true while the latest valset is an emergency valset not yet observed on
+// Ethereum, valsets generated from the validator set would replace it before it reaches the contract
+func (k Keeper) IsEmergencyValsetUnobserved(ctx sdk.Context) bool {
+	nonce := k.GetLastEmergencyValsetNonce(ctx)
+	if nonce == 0 || k.GetLatestValsetNonce(ctx) != nonce {
+		return false
+	}
+	observed := k.GetLastObservedValset(ctx)
+	return observed == nil || observed.Nonce < nonce
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// recordERC20Provenance counts an observed deposit of an Ethereum originated ERC20 in its provenance, the first
+// deposit of the token records its depositor, receiver and heights
+// WARNING: Do not make this function public
+func (k Keeper) recordERC20Provenance(ctx sdk.Context, tokenContract types.EthAddress, claim *types.MsgSendToCosmosClaim) {
+	provenance, found := k.GetERC20Provenance(ctx, tokenContract)
+	if !found {
+		provenance = types.ERC20Provenance{
+			TokenContract:       tokenContract.GetAddress(),
+			FirstDepositor:      claim.EthereumSender,
+			FirstReceiver:       claim.CosmosReceiver,
+			FirstEventNonce:     claim.EventNonce,
+			FirstEthereumHeight: claim.BlockHeight,
+			FirstHeight:         uint64(ctx.BlockHeight()),
+			DepositCount:        0,
+			TotalDeposited:      sdk.ZeroInt(),
+		}
+	}
+	provenance.DepositCount++
+	// the total is informational, it saturates instead of failing the deposit
+	if total, err := types.AddUint256(provenance.TotalDeposited, claim.Amount); err == nil {
+		provenance.TotalDeposited = total
+	}
+	k.setERC20Provenance(ctx, provenance)
+}
+
+// GetERC20Provenance returns the provenance of an Ethereum originated ERC20, if a deposit of it was observed
+func (k Keeper) GetERC20Provenance(ctx sdk.Context, tokenContract types.EthAddress) (types.ERC20Provenance, bool) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.GetERC20ProvenanceKey(tokenContract)))
+	if bz == nil {
+		return types.ERC20Provenance{}, false
+	}
+	var provenance types.ERC20Provenance
+	k.cdc.MustUnmarshal(bz, &provenance)
+	return provenance, true
+}
+
+// setERC20Provenance stores the provenance of an ERC20
+// WARNING: Do not make this function public
+func (k Keeper) setERC20Provenance(ctx sdk.Context, provenance types.ERC20Provenance) {
+	tokenContract, err := types.NewEthAddress(provenance.TokenContract)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.GetERC20ProvenanceKey(*tokenContract)), k.cdc.MustMarshal(&provenance))
+}
+
+// GetERC20Provenances returns the provenance of every ERC20 a deposit of which was observed, by token contract
+func (k Keeper) GetERC20Provenances(ctx sdk.Context) (out []types.ERC20Provenance) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ERC20ProvenanceKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var provenance types.ERC20Provenance
+		k.cdc.MustUnmarshal(iter.Value(), &provenance)
+		out = append(out, provenance)
+	}
+	return
+}
@@ -77,8 +77,8 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 
 	params := k.GetParams(ctx)
 	if !val.IsJailed() {
-		k.StakingKeeper.Jail(ctx, cons)
-		k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBadEthSignature)
+		k.ValidatorSet.Jail(ctx, cons)
+		k.ValidatorSet.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBadEthSignature)
 	}
 
 	return nil
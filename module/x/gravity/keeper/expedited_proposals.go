@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// IsExpeditedProposal returns true if the proposal content responds to a bridge emergency: unhalting the bridge,
+// publishing an emergency valset, or a parameter change only setting bridge_active or the paused tokens
+func IsExpeditedProposal(content govtypes.Content) bool {
+	switch content := content.(type) {
+	case *types.UnhaltBridgeProposal, *types.EmergencyValsetProposal:
+		return true
+	case *paramproposal.ParameterChangeProposal:
+		if len(content.Changes) == 0 {
+			return false
+		}
+		for _, change := range content.Changes {
+			if change.Subspace != types.DefaultParamspace {
+				return false
+			}
+			switch change.Key {
+			case string(types.ParamStoreBridgeActive),
+				string(types.ParamStoreDepositPausedTokens),
+				string(types.ParamStoreWithdrawalPausedTokens):
+			default:
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// CheckExpeditedProposals emits an expedited_quorum_reached event for the expedited proposals which have been voting
+// for the expedited voting period and pass the tally with the expedited quorum, once per proposal. The gov module of
+// this SDK version can not end a voting period early, so the gov store is left untouched and the proposals keep voting
+// until their regular voting end time
+func (k Keeper) CheckExpeditedProposals(ctx sdk.Context, params types.Params) {
+	if k.govKeeper == nil {
+		return
+	}
+	k.pruneExpeditedQuorumReached(ctx)
+	if params.ExpeditedVotingPeriod == 0 {
+		return
+	}
+	period := time.Duration(params.ExpeditedVotingPeriod) * time.Second
+	now := ctx.BlockTime()
+
+	k.govKeeper.IterateProposals(ctx, func(proposal govtypes.Proposal) bool {
+		if proposal.Status != govtypes.StatusVotingPeriod || now.Before(proposal.VotingStartTime.Add(period)) ||
+			k.HasExpeditedQuorumReached(ctx, proposal.ProposalId) || !IsExpeditedProposal(proposal.GetContent()) {
+			return false
+		}
+		if !k.passesExpeditedTally(ctx, proposal, params.ExpeditedQuorum) {
+			return false
+		}
+		k.setExpeditedQuorumReached(ctx, proposal.ProposalId)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpeditedQuorumReached,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprint(proposal.ProposalId)),
+			),
+		)
+		return false
+	})
+}
+
+// HasExpeditedQuorumReached returns true if the proposal passed the expedited tally during its voting period
+func (k Keeper) HasExpeditedQuorumReached(ctx sdk.Context, proposalID uint64) bool {
+	return ctx.KVStore(k.storeKey).Has([]byte(types.GetExpeditedQuorumReachedKey(proposalID)))
+}
+
+// setExpeditedQuorumReached records that the proposal passed the expedited tally
+func (k Keeper) setExpeditedQuorumReached(ctx sdk.Context, proposalID uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.GetExpeditedQuorumReachedKey(proposalID)), []byte{1})
+}
+
+// pruneExpeditedQuorumReached removes the records of the proposals which left their voting period
+func (k Keeper) pruneExpeditedQuorumReached(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExpeditedQuorumReachedKey))
+	iter := prefixStore.Iterator(nil, nil)
+	var ended [][]byte
+	for ; iter.Valid(); iter.Next() {
+		proposal, found := k.govKeeper.GetProposal(ctx, types.UInt64FromBytes(iter.Key()))
+		if !found || proposal.Status != govtypes.StatusVotingPeriod {
+			ended = append(ended, append([]byte(nil), iter.Key()...))
+		}
+	}
+	iter.Close()
+	for _, key := range ended {
+		prefixStore.Delete(key)
+	}
+}
+
+// passesExpeditedTally tallies a proposal the way governance will at the end of its voting period, with the quorum
+// raised to the expedited quorum. The gov tally deletes the votes, so it runs in a discarded cache context
+func (k Keeper) passesExpeditedTally(ctx sdk.Context, proposal govtypes.Proposal, quorum sdk.Dec) bool {
+	cacheCtx, _ := ctx.CacheContext()
+	tallyParams := k.govKeeper.GetTallyParams(cacheCtx)
+	if quorum.GT(tallyParams.Quorum) {
+		tallyParams.Quorum = quorum
+		k.govKeeper.SetTallyParams(cacheCtx, tallyParams)
+	}
+	passes, _, _ := k.govKeeper.Tally(cacheCtx, proposal)
+	return passes
+}
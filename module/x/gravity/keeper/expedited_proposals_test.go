@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests which proposals take the expedited track
+func TestIsExpeditedProposal(t *testing.T) {
+	pause := paramproposal.NewParamChange(types.DefaultParamspace, string(types.ParamStoreDepositPausedTokens), `[]`)
+	halt := paramproposal.NewParamChange(types.DefaultParamspace, string(types.ParamStoreBridgeActive), `false`)
+	other := paramproposal.NewParamChange(types.DefaultParamspace, string(types.ParamStoreRelayerFeeShare), `"0.5"`)
+	staking := paramproposal.NewParamChange("staking", "MaxValidators", `10`)
+
+	require.True(t, IsExpeditedProposal(&types.UnhaltBridgeProposal{}))
+	require.True(t, IsExpeditedProposal(&types.EmergencyValsetProposal{}))
+	require.True(t, IsExpeditedProposal(paramproposal.NewParameterChangeProposal("pause", "pause", []paramproposal.ParamChange{pause, halt})))
+	require.False(t, IsExpeditedProposal(paramproposal.NewParameterChangeProposal("pause", "pause", []paramproposal.ParamChange{pause, other})))
+	require.False(t, IsExpeditedProposal(paramproposal.NewParameterChangeProposal("staking", "staking", []paramproposal.ParamChange{staking})))
+	require.False(t, IsExpeditedProposal(&types.AirdropProposal{}))
+	require.False(t, IsExpeditedProposal(govtypes.NewTextProposal("text", "text")))
+}
+
+// Tests that an expedited proposal is reported once it reaches the expedited quorum after the expedited voting period,
+// that other proposals are not, and that the gov store is left untouched
+func TestCheckExpeditedProposals(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	params.ExpeditedVotingPeriod = 3600
+	params.ExpeditedQuorum = sdk.NewDecWithPrec(5, 1)
+
+	submit := func(content govtypes.Content) govtypes.Proposal {
+		proposal, err := input.GovKeeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+		input.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+		proposal, _ = input.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		return proposal
+	}
+	vote := func(proposal govtypes.Proposal, voters int) {
+		for _, val := range ValAddrs[:voters] {
+			require.NoError(t, input.GovKeeper.AddVote(ctx, proposal.ProposalId, sdk.AccAddress(val), govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+		}
+	}
+	check := func(ctx sdk.Context) int {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		k.CheckExpeditedProposals(ctx, params)
+		return countEvents(ctx, types.EventTypeExpeditedQuorumReached)
+	}
+	unhalt := submit(&types.UnhaltBridgeProposal{Title: "unhalt", Description: "unhalt", TargetNonce: 1})
+	airdrop := submit(&types.AirdropProposal{Title: "airdrop", Description: "airdrop", Denom: "stake"})
+	vote(unhalt, 3)
+	vote(airdrop, 5)
+
+	// nothing is reported before the expedited voting period
+	ctx = ctx.WithBlockTime(unhalt.VotingStartTime.Add(time.Minute))
+	require.Equal(t, 0, check(ctx))
+
+	// 60% of the power voted, but the expedited quorum is 70%
+	ctx = ctx.WithBlockTime(unhalt.VotingStartTime.Add(2 * time.Hour))
+	params.ExpeditedQuorum = sdk.NewDecWithPrec(7, 1)
+	require.Equal(t, 0, check(ctx))
+	// the votes were only tallied in a discarded context
+	require.Len(t, input.GovKeeper.GetVotes(ctx, unhalt.ProposalId), 3)
+
+	// the unhalt is reported once, the airdrop is not an emergency
+	params.ExpeditedQuorum = sdk.NewDecWithPrec(5, 1)
+	require.Equal(t, 1, check(ctx))
+	require.True(t, k.HasExpeditedQuorumReached(ctx, unhalt.ProposalId))
+	require.False(t, k.HasExpeditedQuorumReached(ctx, airdrop.ProposalId))
+	require.Equal(t, 0, check(ctx))
+
+	// the proposals keep their regular voting period
+	proposal, _ := input.GovKeeper.GetProposal(ctx, unhalt.ProposalId)
+	require.Equal(t, unhalt.VotingEndTime, proposal.VotingEndTime)
+	proposal, _ = input.GovKeeper.GetProposal(ctx, airdrop.ProposalId)
+	require.Equal(t, airdrop.VotingEndTime, proposal.VotingEndTime)
+
+	// the record is removed once the proposal left its voting period
+	proposal, _ = input.GovKeeper.GetProposal(ctx, unhalt.ProposalId)
+	proposal.Status = govtypes.StatusPassed
+	input.GovKeeper.SetProposal(ctx, proposal)
+	require.Equal(t, 0, check(ctx))
+	require.False(t, k.HasExpeditedQuorumReached(ctx, unhalt.ProposalId))
+}
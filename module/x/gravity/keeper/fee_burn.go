@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetFeeBurnShare returns the share of the collected bridge fees in the FeeBurnDenoms which is burned
+func (k Keeper) GetFeeBurnShare(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).FeeBurnShare
+}
+
+// GetFeeBurnDenoms returns the denoms of the collected bridge fees the FeeBurnShare is burned of
+func (k Keeper) GetFeeBurnDenoms(ctx sdk.Context) []string {
+	return k.GetParams(ctx).FeeBurnDenoms
+}
+
+// feeBurnShare returns the FeeBurnShare of the coins of fees in the FeeBurnDenoms, truncated so that the rest of
+// the fees is never less than the share the params leave to it
+func (k Keeper) feeBurnShare(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	burned := sdk.NewCoins()
+	share := k.GetFeeBurnShare(ctx)
+	if share.IsZero() {
+		return burned
+	}
+	for _, denom := range k.GetFeeBurnDenoms(ctx) {
+		if amount := fees.AmountOf(denom); amount.IsPositive() {
+			burned = burned.Add(sdk.NewCoin(denom, share.MulInt(amount).TruncateInt()))
+		}
+	}
+	return burned
+}
+
+// burnFees burns the FeeBurnShare of the fees collected from the account from, which may be a module account, and
+// adds it to the burned fees. It returns the fees burned, the rest stays with from
+// WARNING: Do not make this function public
+func (k Keeper) burnFees(ctx sdk.Context, from sdk.AccAddress, fees sdk.Coins) (sdk.Coins, error) {
+	burned := k.feeBurnShare(ctx, fees)
+	if burned.IsZero() {
+		return burned, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, burned); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to collect the burned fees")
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to burn the fees")
+	}
+	for _, coin := range burned {
+		k.setBurnedFees(ctx, k.GetBurnedFees(ctx).AmountOf(coin.Denom).Add(coin.Amount), coin.Denom)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeesBurned,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnedFees, burned.String()),
+		),
+	)
+	return burned, nil
+}
+
+// GetBurnedFees returns the total of the collected bridge fees burned since genesis
+func (k Keeper) GetBurnedFees(ctx sdk.Context) sdk.Coins {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BurnedFeesKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	burned := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid burned fees of %s", iter.Key()))
+		}
+		burned = burned.Add(sdk.NewCoin(string(iter.Key()), amount))
+	}
+	return burned
+}
+
+// setBurnedFees stores the total of the fees burned in denom
+// WARNING: Do not make this function public
+func (k Keeper) setBurnedFees(ctx sdk.Context, amount sdk.Int, denom string) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid burned fees of %s", denom))
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.GetBurnedFeesKey(denom)), bz)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the share of an exchanged bridge fee in a burn denom is burned and counted in the burned fees while the
+// rest funds the community pool, and that the fees in other denoms are not burned
+func TestFeeBurn(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	voucher, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	tokenDenom := voucher.GravityCoin().Denom
+
+	funder := RandomAccAddress()
+	input.AccountKeeper.NewAccountWithAddress(ctx, funder)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(voucher.GravityCoin(), sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, sdk.NewCoins(voucher.GravityCoin())))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, input.DistKeeper.FundCommunityPool(ctx, sdk.NewCoins(voucher.GravityCoin()), funder))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgeFeeExchangeRates = []types.BridgeFeeExchangeRate{
+		{FeeDenom: "stake", TokenDenom: tokenDenom, Rate: sdk.NewDecWithPrec(25, 1)},
+	}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// nothing is burned until the params set a share and its denoms
+	_, err = input.GravityKeeper.ExchangeBridgeFee(ctx, mySender, sdk.NewInt64Coin("stake", 10), tokenDenom)
+	require.NoError(t, err)
+	require.True(t, input.GravityKeeper.GetBurnedFees(ctx).IsZero())
+
+	params.FeeBurnShare = sdk.NewDecWithPrec(5, 1)
+	params.FeeBurnDenoms = []string{"stake"}
+	input.GravityKeeper.SetParams(ctx, params)
+	supply := input.BankKeeper.GetSupply(ctx, "stake")
+	communityPool := input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake")
+
+	fee, err := input.GravityKeeper.ExchangeBridgeFee(ctx, mySender, sdk.NewInt64Coin("stake", 11), tokenDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(tokenDenom, 27), fee)
+	require.Equal(t, sdk.NewInt64Coin("stake", 79), input.BankKeeper.GetBalance(ctx, mySender, "stake"))
+	require.Equal(t, supply.SubAmount(sdk.NewInt(5)), input.BankKeeper.GetSupply(ctx, "stake"))
+	require.Equal(t, communityPool.Add(sdk.NewDec(6)), input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), input.GravityKeeper.GetBurnedFees(ctx))
+
+	// the fees in other denoms are left alone and the totals add up
+	burned, err := input.GravityKeeper.burnFees(ctx, mySender, sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin(tokenDenom, 20)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), input.GravityKeeper.GetBurnedFees(ctx))
+	require.Equal(t, sdk.NewInt64Coin(tokenDenom, 52), input.BankKeeper.GetBalance(ctx, mySender, tokenDenom))
+
+	res, err := NewQueryServerImpl(input.GravityKeeper).BurnedFees(sdk.WrapSDKContext(ctx), &types.QueryBurnedFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), res.BurnedFees)
+	require.Equal(t, params.FeeBurnShare, res.FeeBurnShare)
+	require.Equal(t, params.FeeBurnDenoms, res.FeeBurnDenoms)
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// AttestForkDetected adds the vote of the validator of the claim's orchestrator to the attestation of the reorg it
+// reports and tries to observe it. Fork claims carry no event nonce, they are tallied on their own as soon as they
+// are made, so that the bridge halts in the block the fork is attested to
+func (k Keeper) AttestForkDetected(ctx sdk.Context, claim *types.MsgForkDetectedClaim) (*types.ForkAttestation, error) {
+	orchestrator, err := sdk.AccAddressFromBech32(claim.Orchestrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid orchestrator address")
+	}
+	val, found := k.GetOrchestratorValidator(ctx, orchestrator)
+	if !found {
+		panic("Could not find ValAddr for delegate key, should be checked by now")
+	}
+	valAddr := val.GetOperator()
+	if err := sdk.VerifyAddressFormat(valAddr); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid orchestrator validator address")
+	}
+
+	att := k.GetForkAttestation(ctx, claim.EthereumHeight, claim.ObservedBlockHash, claim.ConflictingBlockHash)
+	if att == nil {
+		att = &types.ForkAttestation{
+			EthereumHeight:       claim.EthereumHeight,
+			ObservedBlockHash:    strings.ToLower(claim.ObservedBlockHash),
+			ConflictingBlockHash: strings.ToLower(claim.ConflictingBlockHash),
+			Votes:                []string{},
+			Observed:             false,
+			Height:               uint64(ctx.BlockHeight()),
+		}
+	}
+	for _, vote := range att.Votes {
+		if vote == valAddr.String() {
+			return nil, sdkerrors.Wrapf(types.ErrDuplicate, "%s already reported this fork", valAddr)
+		}
+	}
+	att.Votes = append(att.Votes, valAddr.String())
+
+	k.tryForkAttestation(ctx, att)
+	k.SetForkAttestation(ctx, *att)
+	return att, nil
+}
+
+// tryForkAttestation observes a fork attestation once its voters hold the attestation threshold of the power and
+// halts the bridge, like a governance vote setting BridgeActive to false would. Only governance can resume it
+func (k Keeper) tryForkAttestation(ctx sdk.Context, att *types.ForkAttestation) {
+	if att.Observed {
+		return
+	}
+	totalPower := k.ValidatorSet.GetLastTotalPower(ctx)
+	requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
+	attestationPower := sdk.NewInt(0)
+	for _, validator := range att.Votes {
+		val, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			panic(err)
+		}
+		attestationPower = attestationPower.Add(sdk.NewInt(k.ValidatorSet.GetLastValidatorPower(ctx, val)))
+	}
+	if attestationPower.LT(requiredPower) {
+		return
+	}
+
+	att.Observed = true
+	k.paramSpace.Set(ctx, types.ParamStoreBridgeActive, false)
+	k.logger(ctx).Error("Ethereum fork observed, halting the bridge",
+		"ethereum height", att.EthereumHeight,
+		"observed block hash", att.ObservedBlockHash,
+		"conflicting block hash", att.ConflictingBlockHash,
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForkDetected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(att.EthereumHeight)),
+			sdk.NewAttribute(types.AttributeKeyObservedBlockHash, att.ObservedBlockHash),
+			sdk.NewAttribute(types.AttributeKeyConflictingBlockHash, att.ConflictingBlockHash),
+		),
+	)
+}
+
+// SetForkAttestation stores a fork attestation
+func (k Keeper) SetForkAttestation(ctx sdk.Context, att types.ForkAttestation) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(types.GetForkAttestationKey(att.EthereumHeight, att.ObservedBlockHash, att.ConflictingBlockHash))
+	store.Set(key, k.cdc.MustMarshal(&att))
+}
+
+// GetForkAttestation returns the attestation of the reorg replacing observedBlockHash with conflictingBlockHash at
+// ethereumHeight, or nil if no orchestrator reported it
+func (k Keeper) GetForkAttestation(
+	ctx sdk.Context,
+	ethereumHeight uint64,
+	observedBlockHash string,
+	conflictingBlockHash string,
+) *types.ForkAttestation {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetForkAttestationKey(ethereumHeight, observedBlockHash, conflictingBlockHash)))
+	if len(bz) == 0 {
+		return nil
+	}
+	var att types.ForkAttestation
+	k.cdc.MustUnmarshal(bz, &att)
+	return &att
+}
+
+// IterateForkAttestations iterates over the fork attestations by ascending Ethereum height
+func (k Keeper) IterateForkAttestations(ctx sdk.Context, cb func([]byte, types.ForkAttestation) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ForkAttestationKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var att types.ForkAttestation
+		k.cdc.MustUnmarshal(iter.Value(), &att)
+		// cb returns true to stop early
+		if cb(iter.Key(), att) {
+			break
+		}
+	}
+}
+
+// GetForkAttestations returns every fork attestation
+func (k Keeper) GetForkAttestations(ctx sdk.Context) (out []types.ForkAttestation) {
+	k.IterateForkAttestations(ctx, func(_ []byte, att types.ForkAttestation) bool {
+		out = append(out, att)
+		return false
+	})
+	return
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestForkDetectedClaimHaltsBridge(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(pk)
+
+	var (
+		observed    = "0x1c9e7f0a6b3d4c2e8f5a9b0d7e6c3f2a1b4d8e9f0c7a6b5d3e2f1a0b9c8d7e6f"
+		conflicting = "0x8A4F2E1D0C9B8A7F6E5D4C3B2A1F0E9D8C7B6A5F4E3D2C1B0A9F8E7D6C5B07D2"
+	)
+	claim := func(orch sdk.AccAddress) *types.MsgForkDetectedClaim {
+		return &types.MsgForkDetectedClaim{
+			EthereumHeight:       1234,
+			ObservedBlockHash:    observed,
+			ConflictingBlockHash: conflicting,
+			Orchestrator:         orch.String(),
+		}
+	}
+
+	// three of the five validators do not reach the attestation threshold
+	for _, orch := range OrchAddrs[:3] {
+		_, err := msgServer.ForkDetectedClaim(sdk.WrapSDKContext(ctx), claim(orch))
+		require.NoError(t, err)
+	}
+	require.True(t, pk.GetParams(ctx).BridgeActive)
+	att := pk.GetForkAttestation(ctx, 1234, observed, conflicting)
+	require.NotNil(t, att)
+	assert.Len(t, att.Votes, 3)
+	assert.False(t, att.Observed)
+
+	// a validator reports a fork once
+	_, err := msgServer.ForkDetectedClaim(sdk.WrapSDKContext(ctx), claim(OrchAddrs[0]))
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// the fourth vote observes the fork and halts the bridge
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ForkDetectedClaim(sdk.WrapSDKContext(ctx), claim(OrchAddrs[3]))
+	require.NoError(t, err)
+	require.False(t, pk.GetParams(ctx).BridgeActive)
+	require.False(t, pk.IsBridgeActive(ctx))
+	att = pk.GetForkAttestation(ctx, 1234, observed, conflicting)
+	require.True(t, att.Observed)
+	assert.Equal(t, "0x8a4f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b07d2", att.ConflictingBlockHash)
+	detected := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeForkDetected {
+			detected++
+		}
+	}
+	assert.Equal(t, 1, detected)
+
+	// the fork attestation is exported with the genesis
+	genesis := ExportGenesis(ctx, pk)
+	require.Equal(t, []types.ForkAttestation{*att}, genesis.ForkAttestations)
+}
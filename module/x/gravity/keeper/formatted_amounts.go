@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetTokenDecimals returns the decimals of an ERC20, the exponent of the display unit in the metadata of its denom.
+// It returns false when the denom has no such metadata.
+func (k Keeper) GetTokenDecimals(ctx sdk.Context, contract types.EthAddress) (uint32, bool) {
+	_, denom := k.ERC20ToDenomLookup(ctx, contract)
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0, false
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent, true
+		}
+	}
+	return 0, false
+}
+
+// tokenDecimals are the decimals of a token, known is false when they could not be looked up
+type tokenDecimals struct {
+	decimals uint32
+	known    bool
+}
+
+// amountFormatter formats the amounts of query responses, looking up the decimals of every token once
+type amountFormatter struct {
+	k        ReadOnlyKeeper
+	ctx      sdk.Context
+	decimals map[string]tokenDecimals
+}
+
+func (k Keeper) newAmountFormatter(ctx sdk.Context) *amountFormatter {
+	return &amountFormatter{k: k, ctx: ctx, decimals: make(map[string]tokenDecimals)}
+}
+
+// format formats an amount, with no decimals when the token contract is invalid
+func (f *amountFormatter) format(token types.ERC20Token) types.FormattedAmount {
+	cached, ok := f.decimals[token.Contract]
+	if !ok {
+		if contract, err := types.NewEthAddress(token.Contract); err == nil {
+			cached.decimals, cached.known = f.k.GetTokenDecimals(f.ctx, *contract)
+		}
+		f.decimals[token.Contract] = cached
+	}
+	return types.NewFormattedAmount(token, cached.decimals, cached.known)
+}
+
+// transfers formats the amounts and fees of outgoing transfers
+func (f *amountFormatter) transfers(txs []types.OutgoingTransferTx) []types.FormattedTransfer {
+	formatted := make([]types.FormattedTransfer, 0, len(txs))
+	for _, tx := range txs {
+		formatted = append(formatted, types.FormattedTransfer{
+			Id:     tx.Id,
+			Amount: f.format(tx.Erc20Token),
+			Fee:    f.format(tx.Erc20Fee),
+		})
+	}
+	return formatted
+}
+
+// batches formats the amounts and fees of the transfers of batches
+func (f *amountFormatter) batches(batches []types.OutgoingTxBatch) []types.FormattedTransfer {
+	formatted := []types.FormattedTransfer{}
+	for _, batch := range batches {
+		formatted = append(formatted, f.transfers(batch.Transactions)...)
+	}
+	return formatted
+}
+
+// deposits formats the amounts of the deposits claimed by attestations
+func (f *amountFormatter) deposits(attestations []types.Attestation) []types.FormattedDeposit {
+	formatted := []types.FormattedDeposit{}
+	for _, att := range attestations {
+		claim, err := f.k.UnpackAttestationClaim(&att)
+		if err != nil {
+			continue
+		}
+		if deposit, ok := claim.(*types.MsgSendToCosmosClaim); ok {
+			formatted = append(formatted, types.FormattedDeposit{
+				EventNonce: deposit.EventNonce,
+				Amount:     f.format(types.ERC20Token{Contract: deposit.TokenContract, Amount: deposit.Amount}),
+			})
+		}
+	}
+	return formatted
+}
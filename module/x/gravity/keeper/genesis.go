@@ -21,6 +21,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetLastSlashedLogicCallBlock(ctx, data.GravityNonces.LastSlashedLogicCallBlock)
 	k.setID(ctx, data.GravityNonces.LastTxPoolId, []byte(types.KeyLastTXPoolID))
 	k.setID(ctx, data.GravityNonces.LastBatchId, []byte(types.KeyLastOutgoingBatchID))
+	k.setID(ctx, data.GravityNonces.LastRecurringSendId, []byte(types.KeyLastRecurringSendToEthID))
 
 	// reset valsets in state
 	highest := uint64(0)
@@ -55,7 +56,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 
 	// reset logic calls in state
 	for _, call := range data.LogicCalls {
-		k.SetOutgoingLogicCall(ctx, call)
+		k.setOutgoingLogicCall(ctx, call)
 	}
 
 	// reset logic call confirmations in state
@@ -64,6 +65,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetLogicCallConfirm(ctx, &conf)
 	}
 
+	// reset logic call deposits in state, the escrowed funds are part of the module balance
+	for _, deposit := range data.LogicCallDeposits {
+		k.setLogicCallDeposit(ctx, deposit)
+	}
+
 	// reset pool transactions in state
 	for _, tx := range data.UnbatchedTransfers {
 		intTx, err := tx.ToInternal()
@@ -75,6 +81,94 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
+	// reset scheduled transactions in state
+	for _, tx := range data.ScheduledTransfers {
+		intTx, err := tx.Transfer.ToInternal()
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid scheduled tx: %v", tx))
+		}
+		k.setScheduledTX(ctx, tx.ExecuteAfterHeight, intTx)
+	}
+
+	// reset recurring sends in state, their escrows are part of the unbatched pool balance
+	for _, send := range data.RecurringSends {
+		k.setRecurringSendToEth(ctx, send)
+	}
+
+	// reset held deposits in state, their amounts are the held deposits account balance
+	for _, deposit := range data.HeldDeposits {
+		k.setHeldDeposit(ctx, deposit)
+	}
+
+	// reset the fork attestations in state
+	for _, att := range data.ForkAttestations {
+		k.SetForkAttestation(ctx, att)
+	}
+
+	// reset the segment of observed block hashes in state
+	for _, hash := range data.ObservedBlockHashes {
+		k.SetObservedBlockHash(ctx, hash)
+	}
+
+	// reset the self bridge limits in state
+	for _, limit := range data.SelfBridgeLimits {
+		address, err := sdk.AccAddressFromBech32(limit.Address)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid self bridge limit address %s", limit.Address))
+		}
+		k.setSelfBridgeLimit(ctx, address, limit)
+	}
+
+	// reset the metadata of the proposals submitted with it
+	for _, metadata := range data.ProposalMetadata {
+		k.SetGravityProposalMetadata(ctx, metadata)
+	}
+
+	// reset the executed batches and the batch archive
+	for _, executed := range data.ExecutedBatches {
+		k.SetExecutedBatch(ctx, executed)
+	}
+	for _, archived := range data.ArchivedBatches {
+		k.SetArchivedBatch(ctx, archived)
+	}
+
+	// reset the changes of critical params waiting for their apply height
+	for _, change := range data.PendingParamChanges {
+		k.setPendingParamChange(ctx, change)
+	}
+
+	// restore the bridge binding, a genesis without one is bound to its params
+	if data.BridgeBinding != nil {
+		k.setBridgeBinding(ctx, *data.BridgeBinding)
+	} else {
+		k.BindBridge(ctx)
+	}
+
+	// reset the provenance of the Ethereum originated ERC20s
+	for _, provenance := range data.Erc20Provenances {
+		k.setERC20Provenance(ctx, provenance)
+	}
+
+	// reset the total of the burned fees
+	for _, burned := range data.BurnedFees {
+		k.setBurnedFees(ctx, burned.Amount, burned.Denom)
+	}
+
+	// reset the bridge usage of the retained epochs
+	for _, usage := range data.BridgeUsage {
+		k.setBridgeUsage(ctx, usage)
+	}
+
+	// reset the deposits waiting to be forwarded over IBC
+	for _, forward := range data.PendingIbcAutoForwards {
+		k.setPendingIbcAutoForward(ctx, forward)
+	}
+
+	// reset the relay packages of the relayable valsets
+	for _, relayPackage := range data.ValsetRelayPackages {
+		k.setValsetRelayPackage(ctx, relayPackage)
+	}
+
 	// reset attestations in state
 	for _, att := range data.Attestations {
 		att := att
@@ -83,8 +177,12 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 			panic("couldn't cast to claim")
 		}
 
+		// attestations exported before they recorded their claim hash version were hashed with the first version
+		if att.ClaimHashVersion == 0 {
+			att.ClaimHashVersion = 1
+		}
 		// TODO: block height?
-		hash, err := claim.ClaimHash()
+		hash, err := claim.ClaimHash(att.ClaimHashVersion)
 		if err != nil {
 			panic(fmt.Errorf("error when computing ClaimHash for %v", hash))
 		}
@@ -169,6 +267,13 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
+	// on a fresh chain store the bootstrap valset computed from the genesis validator powers and
+	// delegate keys, this runs after genutil so the gentx validators are already bonded. The Ethereum
+	// contract can then be deployed against a checkpoint which is deterministic and available at height 1, unless
+	// fewer than MinBridgeValidators validators registered their delegate keys
+	if k.GetLatestValset(ctx) == nil && len(k.ValidatorSet.GetBondedValidatorsByPower(ctx)) > 0 && k.HasMinBridgeValidators(ctx) {
+		k.SetValsetRequest(ctx)
+	}
 }
 
 func hasDuplicates(d []types.MsgSetOrchestratorAddress) bool {
@@ -199,8 +304,34 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		delegates          = k.GetDelegateKeys(ctx)
 		erc20ToDenoms      = []types.ERC20ToDenom{}
 		unbatchedTransfers = k.GetUnbatchedTransactions(ctx)
+		callDeposits       = k.GetLogicCallDeposits(ctx)
+		scheduledTransfers = k.GetScheduledTransactions(ctx)
+		recurringSends     = k.GetRecurringSendsToEth(ctx)
+		heldDeposits       = k.GetHeldDeposits(ctx, "")
+		forkAttestations   = k.GetForkAttestations(ctx)
+		blockHashes        = k.GetObservedBlockHashes(ctx)
+		selfBridgeLimits   = k.GetSelfBridgeLimits(ctx)
+		proposalMetadata   = k.GetAllGravityProposalMetadata(ctx)
+		executedBatches    = k.GetExecutedBatches(ctx)
+		archivedBatches    = k.GetArchivedBatches(ctx)
+		paramChanges       = k.GetPendingParamChanges(ctx)
+		bridgeBinding      *types.BridgeBinding
+		erc20Provenances   = k.GetERC20Provenances(ctx)
+		burnedFees         = k.GetBurnedFees(ctx)
+		bridgeUsage        []types.BridgeUsage
+		pendingForwards    = k.GetPendingIbcAutoForwards(ctx, 0)
+		relayPackages      = k.GetValsetRelayPackages(ctx)
 	)
 
+	if binding, found := k.GetBridgeBinding(ctx); found {
+		bridgeBinding = &binding
+	}
+
+	k.IterateBridgeUsage(ctx, 0, func(_ []byte, usage types.BridgeUsage) bool {
+		bridgeUsage = append(bridgeUsage, usage)
+		return false
+	})
+
 	// export valset confirmations from state
 	for _, vs := range valsets {
 		// TODO: set height = 0?
@@ -250,16 +381,34 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 			LastSlashedLogicCallBlock: k.GetLastSlashedLogicCallBlock(ctx),
 			LastTxPoolId:              k.getID(ctx, []byte(types.KeyLastTXPoolID)),
 			LastBatchId:               k.getID(ctx, []byte(types.KeyLastOutgoingBatchID)),
+			LastRecurringSendId:       k.getID(ctx, []byte(types.KeyLastRecurringSendToEthID)),
 		},
-		Valsets:            valsets,
-		ValsetConfirms:     vsconfs,
-		Batches:            extBatches,
-		BatchConfirms:      batchconfs,
-		LogicCalls:         calls,
-		LogicCallConfirms:  callconfs,
-		Attestations:       attestations,
-		DelegateKeys:       delegates,
-		Erc20ToDenoms:      erc20ToDenoms,
-		UnbatchedTransfers: unbatchedTxs,
+		Valsets:                valsets,
+		ValsetConfirms:         vsconfs,
+		Batches:                extBatches,
+		BatchConfirms:          batchconfs,
+		LogicCalls:             calls,
+		LogicCallConfirms:      callconfs,
+		Attestations:           attestations,
+		DelegateKeys:           delegates,
+		Erc20ToDenoms:          erc20ToDenoms,
+		UnbatchedTransfers:     unbatchedTxs,
+		LogicCallDeposits:      callDeposits,
+		ScheduledTransfers:     scheduledTransfers,
+		RecurringSends:         recurringSends,
+		HeldDeposits:           heldDeposits,
+		ForkAttestations:       forkAttestations,
+		ObservedBlockHashes:    blockHashes,
+		SelfBridgeLimits:       selfBridgeLimits,
+		ProposalMetadata:       proposalMetadata,
+		ExecutedBatches:        executedBatches,
+		ArchivedBatches:        archivedBatches,
+		PendingParamChanges:    paramChanges,
+		BridgeBinding:          bridgeBinding,
+		Erc20Provenances:       erc20Provenances,
+		BurnedFees:             burnedFees,
+		BridgeUsage:            bridgeUsage,
+		PendingIbcAutoForwards: pendingForwards,
+		ValsetRelayPackages:    relayPackages,
 	}
 }
@@ -173,3 +173,31 @@ func exportImport(t *testing.T, input *TestInput) {
 	require.Empty(t, batches)
 	InitGenesis(input.Context, input.GravityKeeper, genesisState)
 }
+
+// Tests that a fresh chain stores the first valset from the genesis validators and delegate keys
+func TestInitGenesisBootstrapValset(t *testing.T) {
+	input, ctx := SetupTestChain(t, []uint64{1000000000, 1000000000, 1000000000}, false)
+	require.Nil(t, input.GravityKeeper.GetLatestValset(ctx))
+
+	genesisState := types.DefaultGenesisState()
+	validators := input.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	for i, val := range validators {
+		genesisState.DelegateKeys = append(genesisState.DelegateKeys, types.MsgSetOrchestratorAddress{
+			Validator:    val.GetOperator().String(),
+			Orchestrator: OrchAddrs[i].String(),
+			EthAddress:   EthAddrs[i].String(),
+		})
+	}
+	InitGenesis(ctx, input.GravityKeeper, *genesisState)
+
+	valset := input.GravityKeeper.GetLatestValset(ctx)
+	require.NotNil(t, valset)
+	require.Equal(t, uint64(1), valset.Nonce)
+	require.Equal(t, uint64(ctx.BlockHeight()), valset.Height)
+	require.Len(t, valset.Members, len(validators))
+
+	// without bonded validators there is nothing to bootstrap
+	input = CreateTestEnv(t)
+	InitGenesis(input.Context, input.GravityKeeper, *types.DefaultGenesisState())
+	require.Nil(t, input.GravityKeeper.GetLatestValset(input.Context))
+}
@@ -36,6 +36,26 @@ func RegisterProposalTypes() {
 		govtypes.RegisterProposalType(types.ProposalTypeAirdrop)
 		govtypes.RegisterProposalTypeCodec(&types.AirdropProposal{}, airdrop)
 	}
+	recoverStranded := "gravity/RecoverStrandedFunds"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(recoverStranded, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeRecoverStrandedFunds)
+		govtypes.RegisterProposalTypeCodec(&types.RecoverStrandedFundsProposal{}, recoverStranded)
+	}
+	emergencyValset := "gravity/EmergencyValset"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(emergencyValset, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeEmergencyValset)
+		govtypes.RegisterProposalTypeCodec(&types.EmergencyValsetProposal{}, emergencyValset)
+	}
+	releaseHeld := "gravity/ReleaseHeldDeposits"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(releaseHeld, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeReleaseHeldDeposits)
+		govtypes.RegisterProposalTypeCodec(&types.ReleaseHeldDepositsProposal{}, releaseHeld)
+	}
+	refundHeld := "gravity/RefundHeldDeposits"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(refundHeld, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeRefundHeldDeposits)
+		govtypes.RegisterProposalTypeCodec(&types.RefundHeldDepositsProposal{}, refundHeld)
+	}
 }
 
 func NewGravityProposalHandler(k Keeper) govtypes.Handler {
@@ -47,6 +67,14 @@ func NewGravityProposalHandler(k Keeper) govtypes.Handler {
 			return k.HandleAirdropProposal(ctx, c)
 		case *types.IBCMetadataProposal:
 			return k.HandleIBCMetadataProposal(ctx, c)
+		case *types.RecoverStrandedFundsProposal:
+			return k.HandleRecoverStrandedFundsProposal(ctx, c)
+		case *types.EmergencyValsetProposal:
+			return k.HandleEmergencyValsetProposal(ctx, c)
+		case *types.ReleaseHeldDepositsProposal:
+			return k.HandleReleaseHeldDepositsProposal(ctx, c)
+		case *types.RefundHeldDepositsProposal:
+			return k.HandleRefundHeldDepositsProposal(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized Gravity proposal content type: %T", c)
@@ -58,53 +86,99 @@ func NewGravityProposalHandler(k Keeper) govtypes.Handler {
 
 // In the event the bridge is halted and governance has decided to reset oracle
 // history, we roll back oracle history and reset the parameters
-func (k Keeper) HandleUnhaltBridgeProposal(ctx sdk.Context, p *types.UnhaltBridgeProposal) error {
-	ctx.Logger().Info("Gov vote passed: Resetting oracle history", "nonce", p.TargetNonce)
-	pruneAttestationsAfterNonce(ctx, k, p.TargetNonce)
-	return nil
+// GetActiveGravityProposals returns the governance proposals in their deposit or voting period whose content is one
+// of the gravity proposal types, oldest first
+func (k Keeper) GetActiveGravityProposals(ctx sdk.Context) (govtypes.Proposals, error) {
+	if k.govKeeper == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "governance keeper not set")
+	}
+	proposals := govtypes.Proposals{}
+	k.govKeeper.IterateProposals(ctx, func(proposal govtypes.Proposal) bool {
+		if proposal.Status != govtypes.StatusDepositPeriod && proposal.Status != govtypes.StatusVotingPeriod {
+			return false
+		}
+		if content := proposal.GetContent(); content != nil && content.ProposalRoute() == types.RouterKey {
+			proposals = append(proposals, proposal)
+		}
+		return false
+	})
+	return proposals, nil
 }
 
-// Iterate over all attestations currently being voted on in order of nonce
-// and prune those that are older than nonceCutoff
-func pruneAttestationsAfterNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64) {
-	// Decide on the most recent nonce we can actually roll back to
+// HandleUnhaltBridgeProposal deletes the attestations after the target nonce and resets the last event nonce of the
+// validators which voted on them, so that the validators re-observe the events after it once an Ethereum fork or an
+// orchestrator bug made them disagree. The observed events were applied and can not be rolled back, the proposal
+// fails if its target nonce is before the last observed event nonce
+func (k Keeper) HandleUnhaltBridgeProposal(ctx sdk.Context, p *types.UnhaltBridgeProposal) error {
 	lastObserved := k.GetLastObservedEventNonce(ctx)
-	if nonceCutoff < lastObserved || nonceCutoff == 0 {
-		ctx.Logger().Error("Attempted to reset to a nonce before the last \"observed\" event, which is not allowed", "lastObserved", lastObserved, "nonce", nonceCutoff)
-		return
+	if p.TargetNonce < lastObserved {
+		return sdkerrors.Wrapf(types.ErrInvalid, "target nonce %d is before the last observed event nonce %d",
+			p.TargetNonce, lastObserved)
 	}
+	ctx.Logger().Info("Gov vote passed: Resetting oracle history", "nonce", p.TargetNonce)
+	deleted, reset := pruneAttestationsAfterNonce(ctx, k, p.TargetNonce)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeUnhalted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyTargetNonce, fmt.Sprint(p.TargetNonce)),
+			sdk.NewAttribute(types.AttributeKeyLastObservedNonce, fmt.Sprint(lastObserved)),
+			sdk.NewAttribute(types.AttributeKeyAttestationsDeleted, fmt.Sprint(deleted)),
+			sdk.NewAttribute(types.AttributeKeyValidatorsReset, fmt.Sprint(reset)),
+		),
+	)
+	return nil
+}
 
+// pruneAttestationsAfterNonce deletes the attestations after nonceCutoff, with their place in the apply queue, and
+// resets the last event nonce of the validators which voted on them to nonceCutoff. It emits an event for every
+// deleted attestation and reset validator, and returns how many there were
+func pruneAttestationsAfterNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64) (deleted int, reset int) {
 	// Get relevant event nonces
 	attmap, keys := k.GetAttestationMapping(ctx)
 
 	// Discover all affected validators whose LastEventNonce must be reset to nonceCutoff
-
-	numValidators := len(k.StakingKeeper.GetBondedValidatorsByPower(ctx))
-	// void and setMember are necessary for sets to work
-	type void struct{}
-	var setMember void
-	// Initialize a Set of validators
-	affectedValidatorsSet := make(map[string]void, numValidators)
+	affectedValidatorsSet := make(map[string]struct{})
+	var affectedValidators []string
 
 	// Delete all reverted attestations, keeping track of the validators who attested to any of them
 	for _, nonce := range keys {
+		if nonce <= nonceCutoff {
+			continue
+		}
 		for _, att := range attmap[nonce] {
-			// we delete all attestations earlier than the cutoff event nonce
-			if nonce > nonceCutoff {
-				ctx.Logger().Info(fmt.Sprintf("Deleting attestation at height %v", att.Height))
-				for _, vote := range att.Votes {
-					if _, ok := affectedValidatorsSet[vote]; !ok { // if set does not contain vote
-						affectedValidatorsSet[vote] = setMember // add key to set
-					}
+			ctx.Logger().Info(fmt.Sprintf("Deleting attestation at height %v", att.Height))
+			for _, vote := range att.Votes {
+				if _, ok := affectedValidatorsSet[vote]; !ok {
+					affectedValidatorsSet[vote] = struct{}{}
+					affectedValidators = append(affectedValidators, vote)
 				}
+			}
 
-				k.DeleteAttestation(ctx, att)
+			claim, err := k.UnpackAttestationClaim(&att)
+			if err != nil {
+				panic(sdkerrors.Wrap(err, "invalid attestation deleted by bridge reset"))
 			}
+			k.DeleteAttestation(ctx, att)
+			deleted++
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAttestationDeleted,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nonce)),
+					sdk.NewAttribute(types.AttributeKeyAttestationType, claim.GetType().String()),
+					sdk.NewAttribute(types.AttributeKeyVotes, fmt.Sprint(len(att.Votes))),
+				),
+			)
 		}
+		// a deleted attestation waiting for the nonces before it must not block the one re-observed in its place
+		ctx.KVStore(k.storeKey).Delete([]byte(types.GetAttestationApplyQueueKey(nonce)))
 	}
 
-	// Reset the last event nonce for all validators affected by history deletion
-	for vote := range affectedValidatorsSet {
+	// Reset the last event nonce for all validators affected by history deletion, in the order they were found so
+	// that the events are deterministic
+	for _, vote := range affectedValidators {
 		val, err := sdk.ValAddressFromBech32(vote)
 		if err != nil {
 			panic(sdkerrors.Wrap(err, "invalid validator address affected by bridge reset"))
@@ -113,8 +187,18 @@ func pruneAttestationsAfterNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64)
 		if valLastNonce > nonceCutoff {
 			ctx.Logger().Info("Resetting validator's last event nonce due to bridge unhalt", "validator", vote, "lastEventNonce", valLastNonce, "resetNonce", nonceCutoff)
 			k.SetLastEventNonceByValidator(ctx, val, nonceCutoff)
+			reset++
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeValidatorEventNonceReset,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyValidator, vote),
+					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(valLastNonce)),
+				),
+			)
 		}
 	}
+	return deleted, reset
 }
 
 // Allows governance to deploy an airdrop to a provided list of addresses
@@ -233,3 +317,81 @@ func (k Keeper) HandleIBCMetadataProposal(ctx sdk.Context, p *types.IBCMetadataP
 
 	return nil
 }
+
+// handles a governance proposal moving stranded funds out of the gravity module account to the community pool,
+// only funds reported by GetStrandedModuleFunds can be moved, if the proposal asks for more nothing is moved
+func (k Keeper) HandleRecoverStrandedFundsProposal(ctx sdk.Context, p *types.RecoverStrandedFundsProposal) error {
+	ctx.Logger().Info("Gov vote passed: Recovering stranded funds", "amount", p.Amount)
+
+	if !p.Amount.IsValid() || p.Amount.Empty() {
+		ctx.Logger().Info("invalid amount for stranded funds proposal", "amount", p.Amount)
+		return sdkerrors.Wrap(types.ErrInvalid, "Invalid recovery amount")
+	}
+
+	stranded, err := k.GetStrandedModuleFunds(ctx)
+	if err != nil {
+		ctx.Logger().Error("Module balance invariant broken, not recovering stranded funds", "error", err)
+		return err
+	}
+	if !stranded.IsAllGTE(p.Amount) {
+		ctx.Logger().Info("Stranded funds recovery exceeds the stranded balance", "amount", p.Amount, "stranded", stranded)
+		return sdkerrors.Wrapf(types.ErrInvalid, "Only %s is stranded in the module account", stranded)
+	}
+
+	modAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	return k.DistKeeper.FundCommunityPool(ctx, p.Amount, modAcc)
+}
+
+// handles a governance proposal sending held deposits to their Cosmos receivers, if any of them can not be released
+// none is
+func (k Keeper) HandleReleaseHeldDepositsProposal(ctx sdk.Context, p *types.ReleaseHeldDepositsProposal) error {
+	ctx.Logger().Info("Gov vote passed: Releasing held deposits", "nonces", p.EventNonces)
+
+	for _, nonce := range p.EventNonces {
+		if err := k.ReleaseHeldDeposit(ctx, nonce); err != nil {
+			ctx.Logger().Info("Held deposit can not be released", "nonce", nonce, "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// handles a governance proposal sending held deposits back to their Ethereum senders, if any of them can not be
+// refunded none is
+func (k Keeper) HandleRefundHeldDepositsProposal(ctx sdk.Context, p *types.RefundHeldDepositsProposal) error {
+	ctx.Logger().Info("Gov vote passed: Refunding held deposits", "nonces", p.EventNonces)
+
+	for _, nonce := range p.EventNonces {
+		if _, err := k.RefundHeldDeposit(ctx, nonce); err != nil {
+			ctx.Logger().Info("Held deposit can not be refunded", "nonce", nonce, "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// GetStrandedModuleFunds returns the part of the module account balance which is provably not backing anything,
+// these are funds which were sent directly to the module address rather than escrowed by the bridge. The balance
+// escrowed for unbatched transactions, unobserved batches, logic call deposits and held deposits is held by the sub-pool accounts,
+// and the Cosmos originated tokens which have an ERC20 representation are held against the vouchers in circulation
+// on Ethereum, neither is stranded. An error is returned if a sub-pool holds less than it escrows, in which case the
+// module balance invariant is broken and nothing can be considered stranded
+func (k Keeper) GetStrandedModuleFunds(ctx sdk.Context) (sdk.Coins, error) {
+	expectedBals := k.expectedSubPoolBalances(ctx)
+	actualBals := k.GetSubPoolBalances(ctx)
+	for _, name := range types.SubPoolAccountNames {
+		if !actualBals[name].IsAllGTE(expectedBals[name]) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "%s holds %s but escrows %s", name, actualBals[name], expectedBals[name])
+		}
+	}
+
+	modAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	stranded := sdk.NewCoins()
+	for _, held := range k.bankKeeper.GetAllBalances(ctx, modAcc) {
+		if _, backsVouchers := k.GetCosmosOriginatedERC20(ctx, held.Denom); backsVouchers {
+			continue
+		}
+		stranded = stranded.Add(held)
+	}
+	return stranded, nil
+}
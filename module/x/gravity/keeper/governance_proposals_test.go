@@ -3,9 +3,11 @@ package keeper
 import (
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -155,3 +157,332 @@ func TestIBCMetadataProposal(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+//nolint: exhaustivestruct
+func TestRecoverStrandedFundsProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		cosmosTokenContract = "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	voucherDenom := allVouchersToken.GravityCoin().Denom
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	// escrow vouchers in the pool, these are never stranded
+	amount := sdk.NewCoin(voucherDenom, sdk.NewInt(100))
+	fee := sdk.NewCoin(voucherDenom, sdk.NewInt(2))
+	_, err = gk.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+	require.NoError(t, err)
+	stranded, err := gk.GetStrandedModuleFunds(ctx)
+	require.NoError(t, err)
+	require.True(t, stranded.Empty())
+
+	// vouchers and unrelated tokens sent directly to the module address are stranded
+	strandedVouchers := sdk.NewCoin(voucherDenom, sdk.NewInt(50))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, mySender, types.ModuleName, sdk.NewCoins(strandedVouchers)))
+	strandedStake := sdk.NewInt64Coin("stake", 1000)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(strandedStake)))
+	// cosmos originated tokens back the ERC20 vouchers on Ethereum and are never stranded
+	cosmosContract, err := types.NewEthAddress(cosmosTokenContract)
+	require.NoError(t, err)
+	gk.setCosmosOriginatedDenomToERC20(ctx, "grav", *cosmosContract)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("grav", 500))))
+
+	expectedStranded := sdk.NewCoins(strandedVouchers, strandedStake)
+	stranded, err = gk.GetStrandedModuleFunds(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedStranded, stranded)
+
+	proposal := types.RecoverStrandedFundsProposal{
+		Title:       "test tile",
+		Description: "test description",
+		Amount:      expectedStranded,
+	}
+	tooMuch := proposal
+	tooMuch.Amount = sdk.NewCoins(sdk.NewCoin(voucherDenom, sdk.NewInt(51)))
+	backingVouchers := proposal
+	backingVouchers.Amount = sdk.NewCoins(sdk.NewInt64Coin("grav", 1))
+	empty := proposal
+	empty.Amount = sdk.NewCoins()
+
+	require.Error(t, gk.HandleRecoverStrandedFundsProposal(ctx, &tooMuch))
+	require.Error(t, gk.HandleRecoverStrandedFundsProposal(ctx, &backingVouchers))
+	require.Error(t, gk.HandleRecoverStrandedFundsProposal(ctx, &empty))
+
+	require.NoError(t, gk.HandleRecoverStrandedFundsProposal(ctx, &proposal))
+	feePool := gk.DistKeeper.GetFeePool(ctx)
+	assert.Equal(t, sdk.NewDecCoinsFromCoins(expectedStranded...), feePool.CommunityPool)
+	stranded, err = gk.GetStrandedModuleFunds(ctx)
+	require.NoError(t, err)
+	require.True(t, stranded.Empty())
+	modAcc := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	assert.True(t, input.BankKeeper.GetBalance(ctx, modAcc, voucherDenom).IsZero())
+	poolAcc := input.AccountKeeper.GetModuleAddress(types.UnbatchedPoolAccountName)
+	assert.Equal(t, sdk.NewInt(102), input.BankKeeper.GetBalance(ctx, poolAcc, voucherDenom).Amount)
+}
+
+//nolint: exhaustivestruct
+func TestHeldDepositsProposals(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		myReceiver          = RandomAccAddress()
+		mySender            = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr)
+	require.NoError(t, err)
+	coin := token.GravityCoin()
+
+	// hold two deposits of the same token
+	for _, nonce := range []uint64{1, 2} {
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)))
+		gk.holdDeposit(ctx, &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  myTokenContractAddr,
+			Amount:         coin.Amount,
+			EthereumSender: mySender,
+			CosmosReceiver: myReceiver.String(),
+		}, coin, types.HELD_DEPOSIT_REASON_SENDER_BLACKLISTED)
+	}
+	require.Len(t, gk.GetHeldDeposits(ctx, myReceiver.String()), 2)
+	require.Empty(t, gk.GetHeldDeposits(ctx, RandomAccAddress().String()))
+	heldAcc := input.AccountKeeper.GetModuleAddress(types.HeldDepositsAccountName)
+	require.Equal(t, int64(200), input.BankKeeper.GetBalance(ctx, heldAcc, coin.Denom).Amount.Int64())
+
+	// unknown deposits can neither be released nor refunded
+	unknown := []uint64{3}
+	require.Error(t, gk.HandleReleaseHeldDepositsProposal(ctx, &types.ReleaseHeldDepositsProposal{EventNonces: unknown}))
+	require.Error(t, gk.HandleRefundHeldDepositsProposal(ctx, &types.RefundHeldDepositsProposal{EventNonces: unknown}))
+
+	// the first deposit is released to its receiver
+	require.NoError(t, gk.HandleReleaseHeldDepositsProposal(ctx, &types.ReleaseHeldDepositsProposal{EventNonces: []uint64{1}}))
+	require.Nil(t, gk.GetHeldDeposit(ctx, 1))
+	require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, myReceiver, coin.Denom))
+
+	// the second is refunded to its sender without bridge fee, but not while the token withdrawals are paused
+	params := gk.GetParams(ctx)
+	params.WithdrawalPausedTokens = []string{myTokenContractAddr}
+	gk.SetParams(ctx, params)
+	refund := types.RefundHeldDepositsProposal{EventNonces: []uint64{2}}
+	require.ErrorIs(t, gk.HandleRefundHeldDepositsProposal(ctx, &refund), types.ErrTokenPaused)
+	params.WithdrawalPausedTokens = []string{}
+	gk.SetParams(ctx, params)
+	require.NoError(t, gk.HandleRefundHeldDepositsProposal(ctx, &refund))
+	require.Nil(t, gk.GetHeldDeposit(ctx, 2))
+	unbatched := gk.GetUnbatchedTransactions(ctx)
+	require.Len(t, unbatched, 1)
+	assert.Equal(t, mySender, unbatched[0].DestAddress.GetAddress())
+	assert.Equal(t, coin.Amount, unbatched[0].Erc20Token.Amount)
+	assert.True(t, unbatched[0].Erc20Fee.Amount.IsZero())
+	assert.True(t, input.BankKeeper.GetBalance(ctx, heldAcc, coin.Denom).IsZero())
+	assert.Empty(t, gk.GetHeldDeposits(ctx, ""))
+}
+
+//nolint: exhaustivestruct
+func TestGetActiveGravityProposals(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	storeProposal := func(id uint64, content govtypes.Content, status govtypes.ProposalStatus) {
+		proposal, err := govtypes.NewProposal(content, id, ctx.BlockTime(), ctx.BlockTime())
+		require.NoError(t, err)
+		proposal.Status = status
+		input.GovKeeper.SetProposal(ctx, proposal)
+	}
+	unhalt := &types.UnhaltBridgeProposal{Title: "unhalt", Description: "unhalt", TargetNonce: 1}
+	storeProposal(1, unhalt, govtypes.StatusVotingPeriod)
+	storeProposal(2, govtypes.NewTextProposal("text", "not gravity"), govtypes.StatusVotingPeriod)
+	storeProposal(3, &types.RecoverStrandedFundsProposal{Title: "recover", Description: "recover"}, govtypes.StatusPassed)
+	storeProposal(4, &types.RecoverStrandedFundsProposal{Title: "recover", Description: "recover"}, govtypes.StatusDepositPeriod)
+
+	proposals, err := input.GravityKeeper.GetActiveGravityProposals(ctx)
+	require.NoError(t, err)
+	require.Len(t, proposals, 2)
+	require.Equal(t, uint64(1), proposals[0].ProposalId)
+	require.Equal(t, unhalt, proposals[0].GetContent())
+	require.Equal(t, uint64(4), proposals[1].ProposalId)
+
+	res, err := NewQueryServerImpl(input.GravityKeeper).GravityProposals(sdk.WrapSDKContext(ctx), &types.QueryGravityProposalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, proposals, govtypes.Proposals(res.Proposals))
+}
+
+//nolint: exhaustivestruct
+func TestEmergencyValsetProposal(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	gk.SetValsetRequest(ctx)
+	latestNonce := gk.GetLatestValsetNonce(ctx)
+
+	rescue := []types.BridgeValidator{
+		{Power: 2863311530, EthereumAddress: EthAddrs[0].String()},
+		{Power: 1431655765, EthereumAddress: EthAddrs[1].String()},
+	}
+	proposal := &types.EmergencyValsetProposal{Title: "rescue", Description: "rescue", Members: rescue}
+	require.NoError(t, proposal.ValidateBasic())
+
+	lowPower := *proposal
+	lowPower.Members = []types.BridgeValidator{{Power: types.EthereumSignaturePowerThreshold, EthereumAddress: EthAddrs[0].String()}}
+	require.Error(t, lowPower.ValidateBasic())
+	duplicate := *proposal
+	duplicate.Members = []types.BridgeValidator{rescue[0], rescue[0]}
+	require.Error(t, duplicate.ValidateBasic())
+	zeroAddress := *proposal
+	zeroAddress.Members = []types.BridgeValidator{rescue[0], {Power: 1431655765, EthereumAddress: types.ZeroAddressString}}
+	require.Error(t, zeroAddress.ValidateBasic())
+	overflow := *proposal
+	overflow.Members = []types.BridgeValidator{rescue[0], {Power: 2863311530, EthereumAddress: EthAddrs[1].String()}}
+	require.Error(t, overflow.ValidateBasic())
+
+	params := gk.GetParams(ctx)
+	params.EthereumBlacklist = []string{EthAddrs[1].String()}
+	gk.SetParams(ctx, params)
+	require.Error(t, gk.HandleEmergencyValsetProposal(ctx, proposal))
+	params.EthereumBlacklist = nil
+	gk.SetParams(ctx, params)
+
+	// a later proposal supersedes the one waiting for its timelock
+	superseded := *proposal
+	superseded.Members = []types.BridgeValidator{{Power: 4294967295, EthereumAddress: EthAddrs[2].String()}}
+	require.NoError(t, gk.HandleEmergencyValsetProposal(ctx, &superseded))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, NewGravityProposalHandler(gk)(ctx, proposal))
+	pending := gk.GetPendingEmergencyValset(ctx)
+	require.NotNil(t, pending)
+	require.Equal(t, uint64(ctx.BlockHeight())+EmergencyValsetTimelock, pending.Height)
+	require.Equal(t, rescue, pending.Members)
+
+	// nothing is stored before the timelock passed
+	ctx = ctx.WithBlockHeight(int64(pending.Height) - 1)
+	require.Nil(t, gk.StoreDueEmergencyValset(ctx))
+	require.False(t, gk.IsEmergencyValsetUnobserved(ctx))
+
+	ctx = ctx.WithBlockHeight(int64(pending.Height))
+	emergency := gk.StoreDueEmergencyValset(ctx)
+	require.NotNil(t, emergency)
+	require.Equal(t, latestNonce+1, emergency.Nonce)
+	require.Equal(t, latestNonce+1, gk.GetLatestValsetNonce(ctx))
+	require.Equal(t, latestNonce+1, gk.GetLastEmergencyValsetNonce(ctx))
+	require.Equal(t, emergency, gk.GetValset(ctx, emergency.Nonce))
+	require.Nil(t, gk.GetPendingEmergencyValset(ctx))
+	require.True(t, gk.IsEmergencyValsetUnobserved(ctx))
+
+	gk.SetLastObservedValset(ctx, *emergency)
+	require.False(t, gk.IsEmergencyValsetUnobserved(ctx))
+}
+
+// Tests that gravity proposals submitted through the gravity Msg service reach governance with their metadata
+func TestSubmitGravityProposal(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	msgServer := NewMsgServerImpl(input.GravityKeeper)
+	proposer := AccAddrs[0]
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(TestingStakeParams.BondDenom, 1000))
+
+	unhalt := &types.UnhaltBridgeProposal{Title: "unhalt", Description: "unhalt", TargetNonce: 1}
+	msg, err := types.NewMsgSubmitGravityProposal(proposer, unhalt, deposit, "ipfs://unhalt")
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	res, err := msgServer.SubmitGravityProposal(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	proposal, found := input.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.True(t, found)
+	require.Equal(t, unhalt, proposal.GetContent())
+	require.Equal(t, govtypes.StatusDepositPeriod, proposal.Status)
+	require.Equal(t, deposit, proposal.TotalDeposit)
+	require.Equal(t, &types.GravityProposalMetadata{ProposalId: res.ProposalId, Metadata: "ipfs://unhalt"},
+		input.GravityKeeper.GetGravityProposalMetadata(ctx, res.ProposalId))
+
+	// a proposal without metadata stores none
+	msg, err = types.NewMsgSubmitGravityProposal(proposer, unhalt, sdk.Coins{}, "")
+	require.NoError(t, err)
+	res, err = msgServer.SubmitGravityProposal(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Nil(t, input.GravityKeeper.GetGravityProposalMetadata(ctx, res.ProposalId))
+	require.Len(t, input.GravityKeeper.GetAllGravityProposalMetadata(ctx), 1)
+
+	// only gravity proposals are accepted
+	msg, err = types.NewMsgSubmitGravityProposal(proposer, govtypes.NewTextProposal("text", "not gravity"), deposit, "")
+	require.NoError(t, err)
+	require.ErrorIs(t, msg.ValidateBasic(), govtypes.ErrInvalidProposalType)
+}
+
+//nolint: exhaustivestruct
+func TestUnhaltBridgeProposal(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	tokenContract, _ := RandomEthAddress()
+
+	// the validators in voters claim a deposit of amount at nonce
+	attest := func(nonce uint64, amount int64, voters ...int) *types.Attestation {
+		var att *types.Attestation
+		for _, i := range voters {
+			claim := &types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				BlockHeight:    nonce,
+				TokenContract:  tokenContract,
+				Amount:         sdk.NewInt(amount),
+				EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+				CosmosReceiver: AccAddrs[0].String(),
+				Orchestrator:   OrchAddrs[i].String(),
+			}
+			anyClaim, err := codectypes.NewAnyWithValue(claim)
+			require.NoError(t, err)
+			att, err = k.Attest(ctx, claim, anyClaim)
+			require.NoError(t, err)
+			if !att.Observed {
+				k.TryAttestation(ctx, att)
+			}
+		}
+		return att
+	}
+	attest(1, 10, 0, 1, 2, 3, 4)
+	attest(2, 10, 0, 1, 2, 3, 4)
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(ctx))
+
+	// the validators disagree on the events from nonce 3
+	attest(3, 10, 0, 1)
+	attest(3, 20, 2, 3)
+	att := attest(4, 10, 0, 1)
+	claim, err := k.UnpackAttestationClaim(att)
+	require.NoError(t, err)
+	hash, err := claim.ClaimHash(att.ClaimHashVersion)
+	require.NoError(t, err)
+	k.queueAttestation(ctx, 4, hash, att)
+
+	require.Error(t, (&types.UnhaltBridgeProposal{Title: "unhalt", Description: "unhalt"}).ValidateBasic())
+	require.ErrorIs(t, k.HandleUnhaltBridgeProposal(ctx, &types.UnhaltBridgeProposal{TargetNonce: 1}), types.ErrInvalid)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.HandleUnhaltBridgeProposal(ctx, &types.UnhaltBridgeProposal{TargetNonce: 2}))
+	attestations, nonces := k.GetAttestationMapping(ctx)
+	require.Equal(t, []uint64{1, 2}, nonces)
+	require.Len(t, attestations[2], 1)
+	require.False(t, k.IsAttestationQueued(ctx, 4))
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(ctx))
+	for i, val := range ValAddrs {
+		require.Equal(t, uint64(2), k.GetLastEventNonceByValidator(ctx, val), "validator %d", i)
+	}
+	require.Equal(t, 3, countEvents(ctx, types.EventTypeAttestationDeleted))
+	require.Equal(t, 4, countEvents(ctx, types.EventTypeValidatorEventNonceReset))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeBridgeUnhalted))
+
+	// the validators can observe the events after the target nonce again
+	attest(3, 20, 0, 1, 2, 3, 4)
+	require.Equal(t, uint64(3), k.GetLastObservedEventNonce(ctx))
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// SubmitGravityProposal submits a gravity proposal to governance with the initial deposit of the proposer, the way
+// the gov MsgSubmitProposal does, and keeps its metadata. It returns the id of the proposal
+func (k Keeper) SubmitGravityProposal(
+	ctx sdk.Context, proposer sdk.AccAddress, content govtypes.Content, initialDeposit sdk.Coins, metadata string,
+) (uint64, error) {
+	if k.govKeeper == nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "governance keeper not set")
+	}
+	proposal, err := k.govKeeper.SubmitProposal(ctx, content)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := k.govKeeper.AddDeposit(ctx, proposal.ProposalId, proposer, initialDeposit); err != nil {
+		return 0, err
+	}
+	if metadata != "" {
+		k.SetGravityProposalMetadata(ctx, types.GravityProposalMetadata{ProposalId: proposal.ProposalId, Metadata: metadata})
+	}
+	return proposal.ProposalId, nil
+}
+
+// SetGravityProposalMetadata stores the metadata of a proposal
+func (k Keeper) SetGravityProposalMetadata(ctx sdk.Context, metadata types.GravityProposalMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetGravityProposalMetadataKey(metadata.ProposalId)), k.cdc.MustMarshal(&metadata))
+}
+
+// GetGravityProposalMetadata returns the metadata of a proposal, or nil if it was not submitted with metadata
+func (k Keeper) GetGravityProposalMetadata(ctx sdk.Context, proposalID uint64) *types.GravityProposalMetadata {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.GetGravityProposalMetadataKey(proposalID)))
+	if len(bz) == 0 {
+		return nil
+	}
+	var metadata types.GravityProposalMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return &metadata
+}
+
+// IterateGravityProposalMetadata iterates over the stored proposal metadata by ascending proposal id
+func (k Keeper) IterateGravityProposalMetadata(ctx sdk.Context, cb func([]byte, types.GravityProposalMetadata) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GravityProposalMetadataKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var metadata types.GravityProposalMetadata
+		k.cdc.MustUnmarshal(iter.Value(), &metadata)
+		// cb returns true to stop early
+		if cb(iter.Key(), metadata) {
+			break
+		}
+	}
+}
+
+// GetAllGravityProposalMetadata returns the metadata of every proposal submitted with it
+func (k Keeper) GetAllGravityProposalMetadata(ctx sdk.Context) (out []types.GravityProposalMetadata) {
+	k.IterateGravityProposalMetadata(ctx, func(_ []byte, metadata types.GravityProposalMetadata) bool {
+		out = append(out, metadata)
+		return false
+	})
+	return
+}
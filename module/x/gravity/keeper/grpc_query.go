@@ -2,37 +2,36 @@ package keeper
 
 import (
 	"context"
-
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
 
-var _ types.QueryServer = Keeper{
-	StakingKeeper:      nil,
-	storeKey:           nil,
-	paramSpace:         paramstypes.Subspace{},
-	cdc:                nil,
-	bankKeeper:         &bankkeeper.BaseKeeper{},
-	SlashingKeeper:     nil,
-	AttestationHandler: nil,
+type queryServer struct {
+	queryKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the gravity QueryServer interface serving the queries from the
+// ReadOnlyKeeper of the provided Keeper
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{queryKeeper: keeper}
 }
 
+var _ types.QueryServer = queryServer{}
+
 const QUERY_ATTESTATIONS_LIMIT uint64 = 1000
 
 // Params queries the params of the gravity module
-func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
-	var params types.Params
-	k.paramSpace.GetParamSet(sdk.UnwrapSDKContext(c), &params)
-	return &types.QueryParamsResponse{Params: params}, nil
+func (k queryServer) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(c))}, nil
 }
 
 // CurrentValset queries the CurrentValset of the gravity module
-func (k Keeper) CurrentValset(
+func (k queryServer) CurrentValset(
 	c context.Context,
 	req *types.QueryCurrentValsetRequest) (*types.QueryCurrentValsetResponse, error) {
 	vs, err := k.GetCurrentValset(sdk.UnwrapSDKContext(c))
@@ -43,25 +42,25 @@ func (k Keeper) CurrentValset(
 }
 
 // ValsetRequest queries the ValsetRequest of the gravity module
-func (k Keeper) ValsetRequest(
+func (k queryServer) ValsetRequest(
 	c context.Context,
 	req *types.QueryValsetRequestRequest) (*types.QueryValsetRequestResponse, error) {
 	return &types.QueryValsetRequestResponse{Valset: k.GetValset(sdk.UnwrapSDKContext(c), req.Nonce)}, nil
 }
 
 // ValsetConfirm queries the ValsetConfirm of the gravity module
-func (k Keeper) ValsetConfirm(
+func (k queryServer) ValsetConfirm(
 	c context.Context,
 	req *types.QueryValsetConfirmRequest) (*types.QueryValsetConfirmResponse, error) {
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+		return nil, invalidArgumentError("address", err)
 	}
 	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(sdk.UnwrapSDKContext(c), req.Nonce, addr)}, nil
 }
 
 // ValsetConfirmsByNonce queries the ValsetConfirmsByNonce of the gravity module
-func (k Keeper) ValsetConfirmsByNonce(
+func (k queryServer) ValsetConfirmsByNonce(
 	c context.Context,
 	req *types.QueryValsetConfirmsByNonceRequest) (*types.QueryValsetConfirmsByNonceResponse, error) {
 	confirms := k.GetValsetConfirms(sdk.UnwrapSDKContext(c), req.Nonce)
@@ -72,7 +71,7 @@ func (k Keeper) ValsetConfirmsByNonce(
 const maxValsetRequestsReturned = 5
 
 // LastValsetRequests queries the LastValsetRequests of the gravity module
-func (k Keeper) LastValsetRequests(
+func (k queryServer) LastValsetRequests(
 	c context.Context,
 	req *types.QueryLastValsetRequestsRequest) (*types.QueryLastValsetRequestsResponse, error) {
 	valReq := k.GetValsets(sdk.UnwrapSDKContext(c))
@@ -87,12 +86,12 @@ func (k Keeper) LastValsetRequests(
 }
 
 // LastPendingValsetRequestByAddr queries the LastPendingValsetRequestByAddr of the gravity module
-func (k Keeper) LastPendingValsetRequestByAddr(
+func (k queryServer) LastPendingValsetRequestByAddr(
 	c context.Context,
 	req *types.QueryLastPendingValsetRequestByAddrRequest) (*types.QueryLastPendingValsetRequestByAddrResponse, error) {
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+		return nil, invalidArgumentError("address", err)
 	}
 
 	var pendingValsetReq []types.Valset
@@ -116,19 +115,19 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 }
 
 // BatchFees queries the batch fees from unbatched pool
-func (k Keeper) BatchFees(
+func (k queryServer) BatchFees(
 	c context.Context,
 	req *types.QueryBatchFeeRequest) (*types.QueryBatchFeeResponse, error) {
 	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c), OutgoingTxBatchSize)}, nil
 }
 
 // LastPendingBatchRequestByAddr queries the LastPendingBatchRequestByAddr of the gravity module
-func (k Keeper) LastPendingBatchRequestByAddr(
+func (k queryServer) LastPendingBatchRequestByAddr(
 	c context.Context,
 	req *types.QueryLastPendingBatchRequestByAddrRequest) (*types.QueryLastPendingBatchRequestByAddrResponse, error) {
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+		return nil, invalidArgumentError("address", err)
 	}
 
 	var pendingBatchReq types.InternalOutgoingTxBatches
@@ -145,18 +144,19 @@ func (k Keeper) LastPendingBatchRequestByAddr(
 
 	if found {
 		ref := pendingBatchReq.ToExternalArray()
-		return &types.QueryLastPendingBatchRequestByAddrResponse{Batch: ref}, nil
+		formatted := k.newAmountFormatter(sdk.UnwrapSDKContext(c)).batches(ref)
+		return &types.QueryLastPendingBatchRequestByAddrResponse{Batch: ref, FormattedTransfers: formatted}, nil
 	} else {
 		return &types.QueryLastPendingBatchRequestByAddrResponse{Batch: nil}, nil
 	}
 }
 
-func (k Keeper) LastPendingLogicCallByAddr(
+func (k queryServer) LastPendingLogicCallByAddr(
 	c context.Context,
 	req *types.QueryLastPendingLogicCallByAddrRequest) (*types.QueryLastPendingLogicCallByAddrResponse, error) {
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+		return nil, invalidArgumentError("address", err)
 	}
 
 	var pendingLogicReq []types.OutgoingLogicCall
@@ -182,19 +182,23 @@ func (k Keeper) LastPendingLogicCallByAddr(
 const MaxResults = 100 // todo: impl pagination
 
 // OutgoingTxBatches queries the OutgoingTxBatches of the gravity module
-func (k Keeper) OutgoingTxBatches(
+func (k queryServer) OutgoingTxBatches(
 	c context.Context,
 	req *types.QueryOutgoingTxBatchesRequest) (*types.QueryOutgoingTxBatchesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
 	var batches []types.OutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
 		batches = append(batches, batch.ToExternal())
 		return len(batches) == MaxResults
 	})
-	return &types.QueryOutgoingTxBatchesResponse{Batches: batches}, nil
+	return &types.QueryOutgoingTxBatchesResponse{
+		Batches:            batches,
+		FormattedTransfers: k.newAmountFormatter(ctx).batches(batches),
+	}, nil
 }
 
 // OutgoingLogicCalls queries the OutgoingLogicCalls of the gravity module
-func (k Keeper) OutgoingLogicCalls(
+func (k queryServer) OutgoingLogicCalls(
 	c context.Context,
 	req *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
 	var calls []types.OutgoingLogicCall
@@ -205,29 +209,72 @@ func (k Keeper) OutgoingLogicCalls(
 	return &types.QueryOutgoingLogicCallsResponse{Calls: calls}, nil
 }
 
+// BatchRelayLatency queries the moving average relay latency of executed batches by token
+func (k queryServer) BatchRelayLatency(
+	c context.Context,
+	req *types.QueryBatchRelayLatencyRequest) (*types.QueryBatchRelayLatencyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.TokenContract == "" {
+		return &types.QueryBatchRelayLatencyResponse{Latencies: k.GetBatchRelayLatencies(ctx)}, nil
+	}
+	contract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, invalidArgumentError("token_contract", err)
+	}
+	var latencies []types.BatchRelayLatency
+	if latency := k.GetBatchRelayLatency(ctx, *contract); latency != nil {
+		latencies = append(latencies, *latency)
+	}
+	return &types.QueryBatchRelayLatencyResponse{Latencies: latencies}, nil
+}
+
+// BridgeFeeTiers queries the fast, normal and slow bridge fee suggestions by token
+func (k queryServer) BridgeFeeTiers(
+	c context.Context,
+	req *types.QueryBridgeFeeTiersRequest) (*types.QueryBridgeFeeTiersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.TokenContract == "" {
+		return &types.QueryBridgeFeeTiersResponse{FeeTiers: k.GetAllBridgeFeeTiers(ctx)}, nil
+	}
+	contract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, invalidArgumentError("token_contract", err)
+	}
+	var feeTiers []types.BridgeFeeTiers
+	if tiers := k.GetBridgeFeeTiers(ctx, *contract); tiers != nil {
+		feeTiers = append(feeTiers, *tiers)
+	}
+	return &types.QueryBridgeFeeTiersResponse{FeeTiers: feeTiers}, nil
+}
+
 // BatchRequestByNonce queries the BatchRequestByNonce of the gravity module
-func (k Keeper) BatchRequestByNonce(
+func (k queryServer) BatchRequestByNonce(
 	c context.Context,
 	req *types.QueryBatchRequestByNonceRequest) (*types.QueryBatchRequestByNonceResponse, error) {
 	addr, err := types.NewEthAddress(req.ContractAddress)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+		return nil, invalidArgumentError("contract_address", err)
 	}
-	foundBatch := k.GetOutgoingTXBatch(sdk.UnwrapSDKContext(c), *addr, req.Nonce)
+	ctx := sdk.UnwrapSDKContext(c)
+	foundBatch := k.GetOutgoingTXBatch(ctx, *addr, req.Nonce)
 	if foundBatch == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find tx batch")
+		return nil, k.batchNotFoundError(ctx, *addr, req.Nonce)
 	}
-	return &types.QueryBatchRequestByNonceResponse{Batch: foundBatch.ToExternal()}, nil
+	batch := foundBatch.ToExternal()
+	return &types.QueryBatchRequestByNonceResponse{
+		Batch:              batch,
+		FormattedTransfers: k.newAmountFormatter(ctx).transfers(batch.Transactions),
+	}, nil
 }
 
 // BatchConfirms returns the batch confirmations by nonce and token contract
-func (k Keeper) BatchConfirms(
+func (k queryServer) BatchConfirms(
 	c context.Context,
 	req *types.QueryBatchConfirmsRequest) (*types.QueryBatchConfirmsResponse, error) {
 	var confirms []types.MsgConfirmBatch
 	contract, err := types.NewEthAddress(req.ContractAddress)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "invalid contract address in request")
+		return nil, invalidArgumentError("contract_address", err)
 	}
 	k.IterateBatchConfirmByNonceAndTokenContract(sdk.UnwrapSDKContext(c),
 		req.Nonce, *contract, func(_ []byte, c types.MsgConfirmBatch) bool {
@@ -237,8 +284,27 @@ func (k Keeper) BatchConfirms(
 	return &types.QueryBatchConfirmsResponse{Confirms: confirms}, nil
 }
 
+// BatchCalldata returns the submitBatch call arguments of a batch that is ready to be relayed
+func (k queryServer) BatchCalldata(
+	c context.Context,
+	req *types.QueryBatchCalldataRequest) (*types.QueryBatchCalldataResponse, error) {
+	contract, err := types.NewEthAddress(req.ContractAddress)
+	if err != nil {
+		return nil, invalidArgumentError("contract_address", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetOutgoingTXBatch(ctx, *contract, req.Nonce) == nil {
+		return nil, k.batchNotFoundError(ctx, *contract, req.Nonce)
+	}
+	calldata, err := k.GetBatchCalldata(ctx, *contract, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBatchCalldataResponse{Calldata: *calldata}, nil
+}
+
 // LogicConfirms returns the Logic confirmations by nonce and token contract
-func (k Keeper) LogicConfirms(
+func (k queryServer) LogicConfirms(
 	c context.Context,
 	req *types.QueryLogicConfirmsRequest) (*types.QueryLogicConfirmsResponse, error) {
 	var confirms []types.MsgConfirmLogicCall
@@ -253,18 +319,19 @@ func (k Keeper) LogicConfirms(
 
 // LastEventNonceByAddr returns the last event nonce for the given validator address,
 // this allows eth oracles to figure out where they left off
-func (k Keeper) LastEventNonceByAddr(
+func (k queryServer) LastEventNonceByAddr(
 	c context.Context,
 	req *types.QueryLastEventNonceByAddrRequest) (*types.QueryLastEventNonceByAddrResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	var ret types.QueryLastEventNonceByAddrResponse
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.Address)
+		return nil, invalidArgumentError("address", err)
 	}
 	validator, found := k.GetOrchestratorValidator(ctx, addr)
 	if !found {
-		return nil, sdkerrors.Wrap(types.ErrUnknown, "address")
+		return nil, notFoundError(QueryErrorReasonOrchestratorNotFound,
+			fmt.Sprintf("%s is not the orchestrator of a validator", req.Address), nil)
 	}
 	if err := sdk.VerifyAddressFormat(validator.GetOperator()); err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid validator address")
@@ -275,7 +342,7 @@ func (k Keeper) LastEventNonceByAddr(
 }
 
 // DenomToERC20 queries the Cosmos Denom that maps to an Ethereum ERC20
-func (k Keeper) DenomToERC20(
+func (k queryServer) DenomToERC20(
 	c context.Context,
 	req *types.QueryDenomToERC20Request) (*types.QueryDenomToERC20Response, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -288,13 +355,13 @@ func (k Keeper) DenomToERC20(
 }
 
 // ERC20ToDenom queries the ERC20 contract that maps to an Ethereum ERC20 if any
-func (k Keeper) ERC20ToDenom(
+func (k queryServer) ERC20ToDenom(
 	c context.Context,
 	req *types.QueryERC20ToDenomRequest) (*types.QueryERC20ToDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	ethAddr, err := types.NewEthAddress(req.Erc20)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(err, "invalid Erc20 in request: %s", req.Erc20)
+		return nil, invalidArgumentError("erc20", err)
 	}
 	cosmosOriginated, name := k.ERC20ToDenomLookup(ctx, *ethAddr)
 	var ret types.QueryERC20ToDenomResponse
@@ -304,8 +371,200 @@ func (k Keeper) ERC20ToDenom(
 	return &ret, nil
 }
 
+// ERC20DeployedRejections queries why observed ERC20 deployments were not paired with their denom
+func (k queryServer) ERC20DeployedRejections(
+	c context.Context,
+	req *types.QueryERC20DeployedRejectionsRequest) (*types.QueryERC20DeployedRejectionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryERC20DeployedRejectionsResponse{Rejections: k.GetERC20DeployedRejections(ctx, req.Denom)}, nil
+}
+
+// StoreMetrics queries the number and approximate size of the entries in the store by kind
+func (k queryServer) StoreMetrics(
+	c context.Context,
+	req *types.QueryStoreMetricsRequest) (*types.QueryStoreMetricsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryStoreMetricsResponse{Metrics: k.GetStoreMetrics(ctx)}, nil
+}
+
+// GravityProposals queries the governance proposals touching the bridge which are still in their deposit or voting period
+func (k queryServer) GravityProposals(
+	c context.Context,
+	req *types.QueryGravityProposalsRequest) (*types.QueryGravityProposalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	proposals, err := k.GetActiveGravityProposals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryGravityProposalsResponse{Proposals: proposals}, nil
+}
+
+// TotalValueLocked queries the amount locked by the bridge of every bridged token and their aggregate
+func (k queryServer) TotalValueLocked(
+	c context.Context,
+	req *types.QueryTotalValueLockedRequest) (*types.QueryTotalValueLockedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	tokens, total := k.GetTotalValueLocked(ctx)
+	return &types.QueryTotalValueLockedResponse{Tokens: tokens, Total: total}, nil
+}
+
+// DelegateKeyCoverage queries the percentage of the bonded power whose validators registered their delegate keys and
+// the validators which did not
+func (k queryServer) DelegateKeyCoverage(
+	c context.Context,
+	req *types.QueryDelegateKeyCoverageRequest) (*types.QueryDelegateKeyCoverageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	registeredPower, totalPower, unregistered := k.GetDelegateKeyCoverage(ctx)
+	percentage := sdk.ZeroDec()
+	if totalPower.IsPositive() {
+		percentage = registeredPower.ToDec().MulInt64(100).QuoInt(totalPower)
+	}
+	return &types.QueryDelegateKeyCoverageResponse{
+		RegisteredPower:        registeredPower,
+		TotalPower:             totalPower,
+		RegisteredPercentage:   percentage,
+		UnregisteredValidators: unregistered,
+	}, nil
+}
+
+// ModuleVersions queries the consensus version of every module in state and in the binary serving the query
+func (k queryServer) ModuleVersions(
+	c context.Context,
+	req *types.QueryModuleVersionsRequest) (*types.QueryModuleVersionsResponse, error) {
+	versions, pending, err := k.GetModuleVersions(sdk.UnwrapSDKContext(c))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryModuleVersionsResponse{ModuleVersions: versions, MigrationsPending: pending}, nil
+}
+
+// HeldDeposits queries the deposits held until governance releases or refunds them, for one or all Cosmos receivers
+func (k queryServer) HeldDeposits(
+	c context.Context,
+	req *types.QueryHeldDepositsRequest) (*types.QueryHeldDepositsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryHeldDepositsResponse{HeldDeposits: k.GetHeldDeposits(ctx, req.CosmosReceiver)}, nil
+}
+
+// ForkAttestations queries the Ethereum reorgs reported by the orchestrators
+func (k queryServer) ForkAttestations(
+	c context.Context,
+	req *types.QueryForkAttestationsRequest) (*types.QueryForkAttestationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryForkAttestationsResponse{ForkAttestations: k.GetForkAttestations(ctx)}, nil
+}
+
+// ObservedBlockHashes queries the Ethereum block hashes attested to with the most recent observed events
+func (k queryServer) ObservedBlockHashes(
+	c context.Context,
+	req *types.QueryObservedBlockHashesRequest) (*types.QueryObservedBlockHashesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryObservedBlockHashesResponse{ObservedBlockHashes: k.GetObservedBlockHashes(ctx)}, nil
+}
+
+// BridgeCheckpoint queries the bridge checkpoint written at the end of the last block
+func (k queryServer) BridgeCheckpoint(
+	c context.Context,
+	req *types.QueryBridgeCheckpointRequest) (*types.QueryBridgeCheckpointResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBridgeCheckpointResponse{BridgeCheckpoint: k.GetBridgeCheckpoint(ctx)}, nil
+}
+
+// MsgDescriptors queries the gravity Msg types with their fields and validation rules
+func (k queryServer) MsgDescriptors(
+	c context.Context,
+	req *types.QueryMsgDescriptorsRequest) (*types.QueryMsgDescriptorsResponse, error) {
+	descriptors, err := types.MsgDescriptors()
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryMsgDescriptorsResponse{MsgDescriptors: descriptors}, nil
+}
+
+// SelfBridgeLimit queries the limit an account set on the coins it sends to Ethereum
+func (k queryServer) SelfBridgeLimit(
+	c context.Context,
+	req *types.QuerySelfBridgeLimitRequest) (*types.QuerySelfBridgeLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, invalidArgumentError("address", err)
+	}
+	return &types.QuerySelfBridgeLimitResponse{SelfBridgeLimit: k.GetSelfBridgeLimit(ctx, address)}, nil
+}
+
+// AppModules queries the stores mounted by the binary serving the query, its module versions and the optional modules
+// it includes
+func (k queryServer) AppModules(
+	c context.Context,
+	req *types.QueryAppModulesRequest) (*types.QueryAppModulesResponse, error) {
+	stores, versions, optional, err := k.GetAppModules(sdk.UnwrapSDKContext(c))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAppModulesResponse{Stores: stores, ModuleVersions: versions, OptionalModules: optional}, nil
+}
+
+// EarliestNeededValset queries the valset with the lowest nonce which can not be pruned yet
+func (k queryServer) EarliestNeededValset(
+	c context.Context,
+	req *types.QueryEarliestNeededValsetRequest) (*types.QueryEarliestNeededValsetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEarliestNeededValsetResponse{Valset: k.GetEarliestNeededValset(ctx, k.GetParams(ctx))}, nil
+}
+
+// BootstrapInfo queries everything an orchestrator needs on startup: the params and bridge identity, the last observed
+// state, the delegate keys of the orchestrator and the current valset
+func (k queryServer) BootstrapInfo(
+	c context.Context,
+	req *types.QueryBootstrapInfoRequest) (*types.QueryBootstrapInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	orchestrator, err := sdk.AccAddressFromBech32(req.OrchestratorAddress)
+	if err != nil {
+		return nil, invalidArgumentError("orchestrator_address", err)
+	}
+	currentValset, err := k.GetCurrentValset(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := k.GetParams(ctx)
+	res := types.QueryBootstrapInfoResponse{
+		Params:                 params,
+		BridgeEthereumAddress:  params.BridgeEthereumAddress,
+		GravityId:              params.GravityId,
+		LastObservedEthHeight:  k.GetLastObservedEthereumBlockHeight(ctx),
+		LastObservedEventNonce: k.GetLastObservedEventNonce(ctx),
+		LastObservedValset:     k.GetLastObservedValset(ctx),
+		CurrentValset:          currentValset,
+	}
+	validator, found := k.GetOrchestratorValidator(ctx, orchestrator)
+	if !found {
+		return &res, nil
+	}
+	res.Registered = true
+	res.ValidatorAddress = validator.GetOperator().String()
+	if ethAddress, found := k.GetEthAddressByValidator(ctx, validator.GetOperator()); found {
+		res.EthAddress = ethAddress.GetAddress()
+	}
+	res.LastEventNonce = k.GetLastEventNonceByValidator(ctx, validator.GetOperator())
+	return &res, nil
+}
+
+// ExecutedBatch queries a batch whose execution was observed, from the executed batches or the batch archive
+func (k queryServer) ExecutedBatch(
+	c context.Context,
+	req *types.QueryExecutedBatchRequest) (*types.QueryExecutedBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	contract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, invalidArgumentError("token_contract", err)
+	}
+	executed, archived := k.GetExecutedBatch(ctx, *contract, req.Nonce)
+	return &types.QueryExecutedBatchResponse{ExecutedBatch: executed, Archived: archived}, nil
+}
+
 // GetAttestations queries the attestation map
-func (k Keeper) GetAttestations(
+func (k queryServer) GetAttestations(
 	c context.Context,
 	req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -315,17 +574,20 @@ func (k Keeper) GetAttestations(
 	}
 	attestations := k.GetMostRecentAttestations(ctx, limit)
 
-	return &types.QueryAttestationsResponse{Attestations: attestations}, nil
+	return &types.QueryAttestationsResponse{
+		Attestations:      attestations,
+		FormattedDeposits: k.newAmountFormatter(ctx).deposits(attestations),
+	}, nil
 }
 
-func (k Keeper) GetDelegateKeyByValidator(
+func (k queryServer) GetDelegateKeyByValidator(
 	c context.Context,
 	req *types.QueryDelegateKeysByValidatorAddress) (*types.QueryDelegateKeysByValidatorAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	keys := k.GetDelegateKeys(ctx)
 	reqValidator, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
 	if err != nil {
-		return nil, err
+		return nil, invalidArgumentError("validator_address", err)
 	}
 	for _, key := range keys {
 		keyValidator, err := sdk.ValAddressFromBech32(key.Validator)
@@ -338,17 +600,17 @@ func (k Keeper) GetDelegateKeyByValidator(
 		}
 	}
 
-	return nil, sdkerrors.Wrap(types.ErrInvalid, "No validator")
+	return nil, notFoundError(QueryErrorReasonDelegateKeysNotFound, "no delegate keys", nil)
 }
 
-func (k Keeper) GetDelegateKeyByOrchestrator(
+func (k queryServer) GetDelegateKeyByOrchestrator(
 	c context.Context,
 	req *types.QueryDelegateKeysByOrchestratorAddress) (*types.QueryDelegateKeysByOrchestratorAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	keys := k.GetDelegateKeys(ctx)
 	reqOrchestrator, err := sdk.AccAddressFromBech32(req.OrchestratorAddress)
 	if err != nil {
-		return nil, err
+		return nil, invalidArgumentError("orchestrator_address", err)
 	}
 	for _, key := range keys {
 		keyOrchestrator, err := sdk.AccAddressFromBech32(key.Orchestrator)
@@ -361,16 +623,16 @@ func (k Keeper) GetDelegateKeyByOrchestrator(
 		}
 
 	}
-	return nil, sdkerrors.Wrap(types.ErrInvalid, "No validator")
+	return nil, notFoundError(QueryErrorReasonDelegateKeysNotFound, "no delegate keys", nil)
 }
 
-func (k Keeper) GetDelegateKeyByEth(
+func (k queryServer) GetDelegateKeyByEth(
 	c context.Context,
 	req *types.QueryDelegateKeysByEthAddress) (*types.QueryDelegateKeysByEthAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	keys := k.GetDelegateKeys(ctx)
 	if err := types.ValidateEthAddress(req.EthAddress); err != nil {
-		return nil, sdkerrors.Wrap(err, "invalid eth address")
+		return nil, invalidArgumentError("eth_address", err)
 	}
 	for _, key := range keys {
 		if req.EthAddress == key.EthAddress {
@@ -381,10 +643,10 @@ func (k Keeper) GetDelegateKeyByEth(
 		}
 	}
 
-	return nil, sdkerrors.Wrap(types.ErrInvalid, "No validator")
+	return nil, notFoundError(QueryErrorReasonDelegateKeysNotFound, "no delegate keys", nil)
 }
 
-func (k Keeper) GetPendingSendToEth(
+func (k queryServer) GetPendingSendToEth(
 	c context.Context,
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -407,6 +669,189 @@ func (k Keeper) GetPendingSendToEth(
 			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
 		}
 	}
+	formatter := k.newAmountFormatter(ctx)
+	res.FormattedTransfers = append(formatter.transfers(res.TransfersInBatches), formatter.transfers(res.UnbatchedTransfers)...)
 
 	return &res, nil
 }
+
+// GravityProposalMetadata queries the metadata a governance proposal was submitted with through
+// MsgSubmitGravityProposal
+func (k queryServer) GravityProposalMetadata(
+	c context.Context,
+	req *types.QueryGravityProposalMetadataRequest) (*types.QueryGravityProposalMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryGravityProposalMetadataResponse{Metadata: k.GetGravityProposalMetadata(ctx, req.ProposalId)}, nil
+}
+
+// VoucherOrigin traces a gravity voucher, a cosmos originated denom bridged to Ethereum or an IBC voucher of either,
+// back to its ERC20
+func (k queryServer) VoucherOrigin(
+	c context.Context,
+	req *types.QueryVoucherOriginRequest) (*types.QueryVoucherOriginResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	origin, err := k.GetVoucherOrigin(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVoucherOriginResponse{Origin: origin}, nil
+}
+
+// PendingParamChanges queries the changes of critical params passed by governance which did not apply yet
+func (k queryServer) PendingParamChanges(
+	c context.Context,
+	req *types.QueryPendingParamChangesRequest) (*types.QueryPendingParamChangesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPendingParamChangesResponse{Changes: k.GetPendingParamChanges(ctx)}, nil
+}
+
+// BridgeRoute queries how a denom held on this chain reaches an Ethereum destination, its steps and fees
+func (k queryServer) BridgeRoute(
+	c context.Context,
+	req *types.QueryBridgeRouteRequest) (*types.QueryBridgeRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ethDest, err := types.NewEthAddress(req.EthDest)
+	if err != nil {
+		return nil, invalidArgumentError("eth_dest", err)
+	}
+	route := k.GetBridgeRoute(ctx, req.Denom, *ethDest)
+	return &route, nil
+}
+
+// BridgeBinding queries the Gravity.sol deployment the chain is bound to and whether the params still name it
+func (k queryServer) BridgeBinding(
+	c context.Context,
+	req *types.QueryBridgeBindingRequest) (*types.QueryBridgeBindingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryBridgeBindingResponse{MatchesParams: k.BridgeBindingMatchesParams(ctx)}
+	if binding, found := k.GetBridgeBinding(ctx); found {
+		res.Binding = &binding
+	}
+	return res, nil
+}
+
+// StateProofKey queries the key of a gravity state entry in the gravity store, with its value at the queried height.
+// The key and height are then queried with a proof over ABCI at types.StateProofPath
+func (k queryServer) StateProofKey(
+	c context.Context,
+	req *types.QueryStateProofKeyRequest) (*types.QueryStateProofKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	key, err := req.StateProofKey()
+	if err != nil {
+		return nil, invalidArgumentError("entry", err)
+	}
+	value := k.getStoreValue(ctx, key)
+	return &types.QueryStateProofKeyResponse{
+		StoreName: types.StoreKey,
+		Key:       key,
+		Value:     value,
+		Found:     value != nil,
+		Height:    ctx.BlockHeight(),
+	}, nil
+}
+
+// ERC20Provenances queries the first observed deposits of Ethereum originated ERC20s, of one token if it is set
+func (k queryServer) ERC20Provenances(
+	c context.Context,
+	req *types.QueryERC20ProvenancesRequest) (*types.QueryERC20ProvenancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.TokenContract == "" {
+		return &types.QueryERC20ProvenancesResponse{Provenances: k.GetERC20Provenances(ctx)}, nil
+	}
+	tokenContract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, invalidArgumentError("token_contract", err)
+	}
+	res := &types.QueryERC20ProvenancesResponse{}
+	if provenance, found := k.GetERC20Provenance(ctx, *tokenContract); found {
+		res.Provenances = []types.ERC20Provenance{provenance}
+	}
+	return res, nil
+}
+
+// BuildInfo returns the version of the binary serving the query and the bridge it was built for
+func (k queryServer) BuildInfo(
+	c context.Context,
+	req *types.QueryBuildInfoRequest) (*types.QueryBuildInfoResponse, error) {
+	return &types.QueryBuildInfoResponse{BuildInfo: GetBuildInfo()}, nil
+}
+
+// BurnedFees queries the total of the collected bridge fees burned since genesis and the share of them burned
+func (k queryServer) BurnedFees(
+	c context.Context,
+	req *types.QueryBurnedFeesRequest) (*types.QueryBurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBurnedFeesResponse{
+		BurnedFees:    k.GetBurnedFees(ctx),
+		FeeBurnShare:  k.GetFeeBurnShare(ctx),
+		FeeBurnDenoms: k.GetFeeBurnDenoms(ctx),
+	}, nil
+}
+
+// BridgeUsage queries the volume the accounts bridged in an epoch, the current one if it is zero, of one account if
+// its address is set
+func (k queryServer) BridgeUsage(
+	c context.Context,
+	req *types.QueryBridgeUsageRequest) (*types.QueryBridgeUsageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	epoch := req.Epoch
+	if epoch == 0 {
+		epoch = k.GetUsageEpoch(ctx)
+	}
+	res := &types.QueryBridgeUsageResponse{Epoch: epoch}
+	if req.Address == "" {
+		res.Usage = k.GetEpochBridgeUsage(ctx, epoch)
+		return res, nil
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid address")
+	}
+	if usage, found := k.GetBridgeUsage(ctx, epoch, address); found {
+		res.Usage = []types.BridgeUsage{usage}
+	}
+	return res, nil
+}
+
+// PendingIbcAutoForwards queries the deposits waiting to be forwarded over IBC
+func (k queryServer) PendingIbcAutoForwards(
+	c context.Context,
+	req *types.QueryPendingIbcAutoForwardsRequest) (*types.QueryPendingIbcAutoForwardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPendingIbcAutoForwardsResponse{
+		PendingIbcAutoForwards: k.GetPendingIbcAutoForwards(ctx, req.Limit),
+	}, nil
+}
+
+// ValsetRelayPackage queries the relay package of a valset, it is not found while the valset is not relayable, once
+// it was pruned or if the nonce is above the latest valset nonce
+func (k queryServer) ValsetRelayPackage(
+	c context.Context,
+	req *types.QueryValsetRelayPackageRequest) (*types.QueryValsetRelayPackageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	relayPackage := k.GetValsetRelayPackage(ctx, req.Nonce)
+	if relayPackage == nil {
+		return nil, notFoundError(QueryErrorReasonValsetRelayPackageNotFound,
+			fmt.Sprintf("no relay package for valset %d", req.Nonce),
+			map[string]string{
+				QueryErrorMetadataRequestedNonce: strconv.FormatUint(req.Nonce, 10),
+				QueryErrorMetadataLatestNonce:    strconv.FormatUint(k.GetLatestValsetNonce(ctx), 10),
+			})
+	}
+	return &types.QueryValsetRelayPackageResponse{RelayPackage: *relayPackage}, nil
+}
+
+// RequiredChainFee queries the smallest chain fee a MsgSendToEth of an amount pays
+func (k queryServer) RequiredChainFee(
+	c context.Context,
+	req *types.QueryRequiredChainFeeRequest) (*types.QueryRequiredChainFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	amount, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, invalidArgumentError("amount", err)
+	}
+	return &types.QueryRequiredChainFeeResponse{
+		ChainFee:               k.GetRequiredChainFee(ctx, amount),
+		MinChainFeeBasisPoints: k.GetMinChainFeeBasisPoints(ctx),
+	}, nil
+}
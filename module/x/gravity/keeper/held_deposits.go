@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// holdDeposit credits the deposit of claim, whose coins are held by the ModuleName account, to the held deposits
+// account instead of its receiver and records it until it is released or refunded
+// WARNING: Do not make this function public
+func (k Keeper) holdDeposit(ctx sdk.Context, claim *types.MsgSendToCosmosClaim, coin sdk.Coin, reason types.HeldDepositReason) {
+	k.moveEscrow(ctx, types.ModuleName, types.HeldDepositsAccountName, sdk.NewCoins(coin))
+	k.setHeldDeposit(ctx, types.HeldDeposit{
+		EventNonce:     claim.EventNonce,
+		EthereumSender: claim.EthereumSender,
+		CosmosReceiver: claim.CosmosReceiver,
+		TokenContract:  claim.TokenContract,
+		Amount:         coin,
+		Reason:         reason,
+		BlockHeight:    uint64(ctx.BlockHeight()),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositHeld,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.String()),
+		),
+	)
+}
+
+// setHeldDeposit stores a held deposit, its amount must already be held by the held deposits account
+// WARNING: Do not make this function public
+func (k Keeper) setHeldDeposit(ctx sdk.Context, deposit types.HeldDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(types.GetHeldDepositKey(deposit.EventNonce))
+	if store.Has(key) {
+		panic(fmt.Sprintf("Can not overwrite held deposit %d", deposit.EventNonce))
+	}
+	store.Set(key, k.cdc.MustMarshal(&deposit))
+}
+
+// deleteHeldDeposit deletes a held deposit
+// WARNING: Do not make this function public
+func (k Keeper) deleteHeldDeposit(ctx sdk.Context, eventNonce uint64) {
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetHeldDepositKey(eventNonce)))
+}
+
+// GetHeldDeposit returns the held deposit of the event eventNonce, or nil if there is none
+func (k Keeper) GetHeldDeposit(ctx sdk.Context, eventNonce uint64) *types.HeldDeposit {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.GetHeldDepositKey(eventNonce)))
+	if len(bz) == 0 {
+		return nil
+	}
+	var deposit types.HeldDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return &deposit
+}
+
+// IterateHeldDeposits iterates over the held deposits by ascending event nonce
+func (k Keeper) IterateHeldDeposits(ctx sdk.Context, cb func([]byte, types.HeldDeposit) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.HeldDepositKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.HeldDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		// cb returns true to stop early
+		if cb(iter.Key(), deposit) {
+			break
+		}
+	}
+}
+
+// GetHeldDeposits returns the held deposits to cosmosReceiver, or all of them if cosmosReceiver is empty
+func (k Keeper) GetHeldDeposits(ctx sdk.Context, cosmosReceiver string) (out []types.HeldDeposit) {
+	k.IterateHeldDeposits(ctx, func(_ []byte, deposit types.HeldDeposit) bool {
+		if cosmosReceiver == "" || deposit.CosmosReceiver == cosmosReceiver {
+			out = append(out, deposit)
+		}
+		return false
+	})
+	return
+}
+
+// ReleaseHeldDeposits releases the deposits held because their token was deposit paused once the token is removed
+// from the DepositPausedTokens param, and the deposits held because of the supply cap of their token once they fit
+// under the cap, in the order of their event nonces. The deposits over a cap keep the later deposits of their denom
+// held. A deposit which can not be released stays held for governance
+func (k Keeper) ReleaseHeldDeposits(ctx sdk.Context) {
+	var resumed []types.HeldDeposit
+	k.IterateHeldDeposits(ctx, func(_ []byte, deposit types.HeldDeposit) bool {
+		switch deposit.Reason {
+		case types.HELD_DEPOSIT_REASON_TOKEN_PAUSED:
+			tokenContract, err := types.NewEthAddress(deposit.TokenContract)
+			if err != nil {
+				panic(sdkerrors.Wrapf(err, "invalid token contract in held deposit %d", deposit.EventNonce))
+			}
+			if !k.IsDepositPaused(ctx, *tokenContract) {
+				resumed = append(resumed, deposit)
+			}
+		case types.HELD_DEPOSIT_REASON_SUPPLY_CAP_EXCEEDED:
+			resumed = append(resumed, deposit)
+		}
+		return false
+	})
+
+	capped := make(map[string]bool)
+	for _, deposit := range resumed {
+		if deposit.Reason == types.HELD_DEPOSIT_REASON_SUPPLY_CAP_EXCEEDED {
+			denom := deposit.Amount.Denom
+			if capped[denom] || k.exceedsSupplyCap(ctx, denom, k.bankKeeper.GetSupply(ctx, denom).Amount, deposit.Amount.Amount) {
+				capped[denom] = true
+				continue
+			}
+		}
+		xCtx, commit := ctx.CacheContext()
+		if err := k.ReleaseHeldDeposit(xCtx, deposit.EventNonce); err != nil {
+			k.logger(ctx).Error("held deposit release failed", "nonce", deposit.EventNonce, "cause", err.Error())
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	}
+}
+
+// ReleaseHeldDeposit sends the held deposit of the event eventNonce to its Cosmos receiver
+func (k Keeper) ReleaseHeldDeposit(ctx sdk.Context, eventNonce uint64) error {
+	deposit := k.GetHeldDeposit(ctx, eventNonce)
+	if deposit == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "held deposit %d", eventNonce)
+	}
+	receiverAddress, err := types.IBCAddressFromBech32(deposit.CosmosReceiver)
+	if err != nil {
+		return sdkerrors.Wrap(err, "cosmos receiver")
+	}
+	receiver, err := types.GetNativePrefixedAccAddress(receiverAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "cosmos receiver")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.HeldDepositsAccountName, receiver, sdk.NewCoins(deposit.Amount)); err != nil {
+		return sdkerrors.Wrapf(err, "unable to send %s to %s", deposit.Amount, receiver)
+	}
+	k.deleteHeldDeposit(ctx, eventNonce)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHeldDepositReleased,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+		),
+	)
+	return nil
+}
+
+// RefundHeldDeposit sends the held deposit of the event eventNonce back to its Ethereum sender through the pool, the
+// DepositRefundFee of its token is deducted from the amount
+func (k Keeper) RefundHeldDeposit(ctx sdk.Context, eventNonce uint64) (uint64, error) {
+	deposit := k.GetHeldDeposit(ctx, eventNonce)
+	if deposit == nil {
+		return 0, sdkerrors.Wrapf(types.ErrUnknown, "held deposit %d", eventNonce)
+	}
+	tokenContract, err := types.NewEthAddress(deposit.TokenContract)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "token contract")
+	}
+	sender, err := types.NewEthAddress(deposit.EthereumSender)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "ethereum sender")
+	}
+	txID, fee, err := k.refundDeposit(ctx, types.HeldDepositsAccountName, *sender, *tokenContract, deposit.Amount)
+	if err != nil {
+		return 0, err
+	}
+	k.deleteHeldDeposit(ctx, eventNonce)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHeldDepositRefunded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, fee.String()),
+		),
+	)
+	return txID, nil
+}
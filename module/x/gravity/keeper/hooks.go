@@ -34,6 +34,22 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress,
 
 }
 
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ sdk.Dec) {
+
+	// Slashing for downtime or double signing is always followed by jailing and, for double signing, tombstoning,
+	// which removes the whole power of the validator from the next valset. Until that valset is submitted to Ethereum
+	// the key of the removed validator remains in the checkpoint, so if the validator holds a large enough share
+	// of the bridge power we request a new valset in the endblocker of this same block, after staking has removed
+	// the validator from the bonded set. The request is not made here as the current valset would still include the
+	// validator at this point.
+
+	threshold := h.k.GetParams(ctx).ValsetRequestSlashPowerThreshold
+	if h.k.GetValidatorLatestValsetPowerShare(ctx, valAddr).GT(threshold) {
+		h.k.SetLastSlashValsetRequestBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	}
+
+}
+
 func (h Hooks) BeforeDelegationCreated(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 }
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)           {}
@@ -42,7 +58,6 @@ func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValA
 
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {}
-func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {}
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 }
 func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
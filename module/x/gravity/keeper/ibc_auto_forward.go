@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetIbcAutoForwardTimeout returns the seconds after which the IBC transfer of a forwarded deposit times out, zero if
+// the forwarding is disabled
+func (k Keeper) GetIbcAutoForwardTimeout(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).IbcAutoForwardTimeout
+}
+
+// ibcAutoForwardChannel returns the IBC channel a deposit to receiver is forwarded over, or false if receiver is a
+// local account, the chain of its prefix has no channel or the forwarding is disabled
+func (k Keeper) ibcAutoForwardChannel(ctx sdk.Context, receiver string) (string, bool) {
+	params := k.GetParams(ctx)
+	if params.IbcAutoForwardTimeout == 0 {
+		return "", false
+	}
+	hrp, err := types.GetPrefixFromBech32(receiver)
+	if err != nil || hrp == sdk.GetConfig().GetBech32AccountAddrPrefix() {
+		return "", false
+	}
+	for _, channel := range params.IbcAutoForwardChannels {
+		if channel.Bech32Prefix == hrp {
+			return channel.SourceChannel, true
+		}
+	}
+	return "", false
+}
+
+// queueIbcAutoForward queues a deposit credited to the local account of its foreign receiver to be forwarded
+// WARNING: Do not make this function public
+func (k Keeper) queueIbcAutoForward(ctx sdk.Context, forward types.PendingIbcAutoForward) {
+	k.setPendingIbcAutoForward(ctx, forward)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIbcAutoForwardQueued,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForeignReceiver, forward.ForeignReceiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, forward.Token.String()),
+			sdk.NewAttribute(types.AttributeKeyIbcChannel, forward.IbcChannel),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(forward.EventNonce)),
+		),
+	)
+}
+
+// setPendingIbcAutoForward stores a deposit waiting to be forwarded
+// WARNING: Do not make this function public
+func (k Keeper) setPendingIbcAutoForward(ctx sdk.Context, forward types.PendingIbcAutoForward) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.GetPendingIbcAutoForwardKey(forward.EventNonce)), k.cdc.MustMarshal(&forward))
+}
+
+// GetPendingIbcAutoForwards returns up to limit of the deposits waiting to be forwarded in event nonce order, all of
+// them if limit is zero
+func (k Keeper) GetPendingIbcAutoForwards(ctx sdk.Context, limit uint64) []types.PendingIbcAutoForward {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingIbcAutoForwardKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	var forwards []types.PendingIbcAutoForward
+	for ; iter.Valid() && (limit == 0 || uint64(len(forwards)) < limit); iter.Next() {
+		var forward types.PendingIbcAutoForward
+		k.cdc.MustUnmarshal(iter.Value(), &forward)
+		forwards = append(forwards, forward)
+	}
+	return forwards
+}
+
+// ExecuteIbcAutoForwards sends up to forwardsToClear of the pending forwards over IBC from the local accounts they
+// were credited to. A forward failing to be sent is dropped with an ibc_auto_forward_failed event, its deposit stays
+// on the local account, as does a forward timing out or rejected by the other chain once IBC refunds it
+func (k Keeper) ExecuteIbcAutoForwards(ctx sdk.Context, forwardsToClear uint64) error {
+	if k.ibcTransferKeeper == nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "no ibc transfer keeper")
+	}
+	timeout := k.GetIbcAutoForwardTimeout(ctx)
+	if timeout == 0 {
+		return sdkerrors.Wrap(types.ErrInvalid, "ibc auto forwarding is disabled")
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Duration(timeout) * time.Second).UnixNano())
+
+	for _, forward := range k.GetPendingIbcAutoForwards(ctx, forwardsToClear) {
+		ctx.KVStore(k.storeKey).Delete([]byte(types.GetPendingIbcAutoForwardKey(forward.EventNonce)))
+		sender, err := types.IBCAddressFromBech32(forward.ForeignReceiver)
+		if err == nil {
+			// the transfer is sent in a cache context so that a failing one leaves nothing behind
+			xCtx, commit := ctx.CacheContext()
+			err = k.ibcTransferKeeper.SendTransfer(xCtx, ibctransfertypes.PortID, forward.IbcChannel, forward.Token,
+				sender, forward.ForeignReceiver, clienttypes.ZeroHeight(), timeoutTimestamp)
+			if err == nil {
+				commit()
+				ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+			}
+		}
+		eventType := types.EventTypeIbcAutoForward
+		if err != nil {
+			eventType = types.EventTypeIbcAutoForwardFailed
+			k.logger(ctx).Info("ibc auto forward failed",
+				"cause", err.Error(),
+				"nonce", fmt.Sprint(forward.EventNonce),
+			)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyForeignReceiver, forward.ForeignReceiver),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, forward.Token.String()),
+				sdk.NewAttribute(types.AttributeKeyIbcChannel, forward.IbcChannel),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(forward.EventNonce)),
+			),
+		)
+	}
+	return nil
+}
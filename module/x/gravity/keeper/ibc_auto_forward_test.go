@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// recordingTransferKeeper records the IBC transfers sent, failing the ones over failChannel
+type recordingTransferKeeper struct {
+	failChannel string
+	transfers   []recordedTransfer
+}
+
+type recordedTransfer struct {
+	channel          string
+	token            sdk.Coin
+	sender           sdk.AccAddress
+	receiver         string
+	timeoutTimestamp uint64
+}
+
+func (k *recordingTransferKeeper) SendTransfer(
+	_ sdk.Context,
+	_, sourceChannel string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+	receiver string,
+	_ clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	if sourceChannel == k.failChannel {
+		return errors.New("channel closed")
+	}
+	k.transfers = append(k.transfers, recordedTransfer{sourceChannel, token, sender, receiver, timeoutTimestamp})
+	return nil
+}
+
+// Tests that the deposits to the accounts of a chain with an auto forward channel are credited to the local account
+// and queued, and that executing the forwards sends them over IBC in event nonce order, dropping the failing ones
+func TestIbcAutoForwards(t *testing.T) {
+	input := CreateTestEnv(t)
+	transfers := &recordingTransferKeeper{failChannel: "channel-9"}
+	input.GravityKeeper.SetIbcTransferKeeper(transfers)
+	ctx := input.Context.WithBlockTime(time.Unix(1_700_000_000, 0))
+	k := input.GravityKeeper
+
+	params := k.GetParams(ctx)
+	params.IbcAutoForwardChannels = []types.IbcAutoForwardChannel{
+		{Bech32Prefix: "osmo", SourceChannel: "channel-0"},
+		{Bech32Prefix: "juno", SourceChannel: "channel-9"},
+	}
+	params.IbcAutoForwardTimeout = 600
+	k.SetParams(ctx, params)
+
+	var (
+		osmoAccount         = RandomAccAddress()
+		junoAccount         = RandomAccAddress()
+		localAccount        = RandomAccAddress()
+		stargazeAccount     = RandomAccAddress()
+		tokenETHAddr, denom = RandomEthAddress()
+	)
+	osmoReceiver, err := sdk.Bech32ifyAddressBytes("osmo", osmoAccount)
+	require.NoError(t, err)
+	junoReceiver, err := sdk.Bech32ifyAddressBytes("juno", junoAccount)
+	require.NoError(t, err)
+	stargazeReceiver, err := sdk.Bech32ifyAddressBytes("stars", stargazeAccount)
+	require.NoError(t, err)
+
+	for i, receiver := range []string{osmoReceiver, localAccount.String(), stargazeReceiver, junoReceiver} {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     uint64(i + 1),
+			BlockHeight:    uint64(i + 1),
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(int64(100 * (i + 1))),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: receiver,
+			Orchestrator:   RandomAccAddress().String(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+
+	// every deposit is credited locally, only the ones to chains with a channel are queued
+	require.Equal(t, int64(100), input.BankKeeper.GetBalance(ctx, osmoAccount, denom).Amount.Int64())
+	require.Equal(t, int64(300), input.BankKeeper.GetBalance(ctx, stargazeAccount, denom).Amount.Int64())
+	res, err := NewQueryServerImpl(k).PendingIbcAutoForwards(sdk.WrapSDKContext(ctx), &types.QueryPendingIbcAutoForwardsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingIbcAutoForward{
+		{ForeignReceiver: osmoReceiver, Token: sdk.NewInt64Coin(denom, 100), IbcChannel: "channel-0", EventNonce: 1},
+		{ForeignReceiver: junoReceiver, Token: sdk.NewInt64Coin(denom, 400), IbcChannel: "channel-9", EventNonce: 4},
+	}, res.PendingIbcAutoForwards)
+	require.Equal(t, res.PendingIbcAutoForwards, ExportGenesis(ctx, k).PendingIbcAutoForwards)
+
+	msgServer := NewMsgServerImpl(k)
+	_, err = msgServer.ExecuteIbcAutoForwards(sdk.WrapSDKContext(ctx), types.NewMsgExecuteIbcAutoForwards(localAccount, 1))
+	require.NoError(t, err)
+	require.Equal(t, []recordedTransfer{{
+		channel:          "channel-0",
+		token:            sdk.NewInt64Coin(denom, 100),
+		sender:           osmoAccount,
+		receiver:         osmoReceiver,
+		timeoutTimestamp: uint64(ctx.BlockTime().Add(600 * time.Second).UnixNano()),
+	}}, transfers.transfers)
+	require.Len(t, k.GetPendingIbcAutoForwards(ctx, 0), 1)
+
+	// a forward failing to be sent is dropped, its deposit stays on the local account
+	failCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ExecuteIbcAutoForwards(sdk.WrapSDKContext(failCtx), types.NewMsgExecuteIbcAutoForwards(localAccount, 5))
+	require.NoError(t, err)
+	require.Len(t, transfers.transfers, 1)
+	require.Empty(t, k.GetPendingIbcAutoForwards(ctx, 0))
+	require.Equal(t, int64(400), input.BankKeeper.GetBalance(ctx, junoAccount, denom).Amount.Int64())
+	failed := 0
+	for _, event := range failCtx.EventManager().Events() {
+		if event.Type == types.EventTypeIbcAutoForwardFailed {
+			failed++
+		}
+	}
+	require.Equal(t, 1, failed)
+
+	// disabling the forwarding stops queueing the deposits
+	params.IbcAutoForwardTimeout = 0
+	k.SetParams(ctx, params)
+	_, found := k.ibcAutoForwardChannel(ctx, osmoReceiver)
+	require.False(t, found)
+	_, err = msgServer.ExecuteIbcAutoForwards(sdk.WrapSDKContext(ctx), types.NewMsgExecuteIbcAutoForwards(localAccount, 1))
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
@@ -158,7 +158,7 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 	checkImbalancedModule(t, ctx, input.GravityKeeper, input.BankKeeper, mySender, voucherCoins[1])
 
 	// Simulate one batch being relayed and observed
-	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, batches[1].TokenContract, batches[1].BatchNonce)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, batches[1].TokenContract, batches[1].BatchNonce, nil)
 	// The module should be balanced with the batch now being observed + one leftover unbatched tx still in the pool
 	checkInvariant(t, ctx, input.GravityKeeper, true)
 	checkImbalancedModule(t, ctx, input.GravityKeeper, input.BankKeeper, mySender, voucherCoins[0])
@@ -177,10 +177,11 @@ func checkInvariant(t *testing.T, ctx sdk.Context, k Keeper, succeed bool) {
 }
 
 func checkImbalancedModule(t *testing.T, ctx sdk.Context, gravityKeeper Keeper, bankKeeper bankkeeper.BaseKeeper, sender sdk.AccAddress, coins sdk.Coins) {
-	// Imbalance the module
-	bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins)
-	checkInvariant(t, ctx, gravityKeeper, false)
-	// Rebalance the module
-	bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins)
-
+	// Imbalance the module account and every sub-pool in turn
+	for _, name := range append([]string{types.ModuleName}, types.SubPoolAccountNames...) {
+		require.NoError(t, bankKeeper.SendCoinsFromAccountToModule(ctx, sender, name, coins))
+		checkInvariant(t, ctx, gravityKeeper, false)
+		// Rebalance the module
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, name, sender, coins))
+	}
 }
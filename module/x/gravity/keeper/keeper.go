@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -10,8 +11,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -21,7 +24,7 @@ import (
 )
 
 // Check that our expected keeper types are implemented
-var _ types.StakingKeeper = (*stakingkeeper.Keeper)(nil)
+var _ types.ValidatorSetSource = (*stakingkeeper.Keeper)(nil)
 var _ types.SlashingKeeper = (*slashingkeeper.Keeper)(nil)
 var _ types.DistributionKeeper = (*distrkeeper.Keeper)(nil)
 
@@ -34,14 +37,47 @@ type Keeper struct {
 	// NOTE: If you add anything to this struct, add a nil check to ValidateMembers below!
 	cdc            codec.BinaryCodec // The wire codec for binary encoding/decoding.
 	bankKeeper     *bankkeeper.BaseKeeper
-	StakingKeeper  *stakingkeeper.Keeper
 	SlashingKeeper *slashingkeeper.Keeper
 	DistKeeper     *distrkeeper.Keeper
 	accountKeeper  *authkeeper.AccountKeeper
 
+	// ValidatorSet is the validator set securing the bridge, the local staking keeper unless the chain is secured
+	// by the validators of another chain
+	ValidatorSet types.ValidatorSetSource
+
 	AttestationHandler interface {
 		Handle(sdk.Context, types.Attestation, types.EthereumClaim) error
 	}
+
+	// screeningKeeper may veto transfers to Ethereum, it accepts all of them unless set with SetScreeningKeeper
+	screeningKeeper types.ScreeningKeeper
+
+	// bridgeHooks are notified of the bridged coins, they ignore them unless set with SetBridgeHooks
+	bridgeHooks types.BridgeHooks
+
+	// attestationHooks are notified of the applied attestations, they ignore them unless set with SetAttestationHooks
+	attestationHooks types.AttestationHooks
+
+	// govKeeper is set after construction with SetGovKeeper, as the governance router depends on this keeper
+	govKeeper *govkeeper.Keeper
+
+	// denomTraces is set after construction with SetDenomTraceSource, the IBC transfer keeper is created after this one
+	denomTraces types.DenomTraceSource
+
+	// ibcTransferKeeper is set after construction with SetIbcTransferKeeper, the deposits can not be forwarded to
+	// other chains without it
+	ibcTransferKeeper types.IbcTransferKeeper
+
+	// stateModuleVersions and binaryModuleVersions are set with SetModuleVersions, the binary versions are only known
+	// once the module manager holding this keeper exists
+	stateModuleVersions  types.ModuleVersionSource
+	binaryModuleVersions func() module.VersionMap
+
+	// mountedStores are set with SetMountedStores, they are the stores of the app rather than state
+	mountedStores []types.MountedStore
+
+	// storeMetricsTelemetry is a node setting, not state, reporting the store metrics as telemetry
+	storeMetricsTelemetry bool
 }
 
 // Check for nil members
@@ -49,8 +85,8 @@ func (k Keeper) ValidateMembers() {
 	if k.bankKeeper == nil {
 		panic("Nil bankKeeper!")
 	}
-	if k.StakingKeeper == nil {
-		panic("Nil StakingKeeper!")
+	if k.ValidatorSet == nil {
+		panic("Nil ValidatorSet!")
 	}
 	if k.SlashingKeeper == nil {
 		panic("Nil SlashingKeeper!")
@@ -61,15 +97,25 @@ func (k Keeper) ValidateMembers() {
 	if k.accountKeeper == nil {
 		panic("Nil accountKeeper!")
 	}
+	if k.screeningKeeper == nil {
+		panic("Nil screeningKeeper!")
+	}
+	if k.bridgeHooks == nil {
+		panic("Nil bridgeHooks!")
+	}
+	if k.attestationHooks == nil {
+		panic("Nil attestationHooks!")
+	}
 }
 
-// NewKeeper returns a new instance of the gravity keeper
+// NewKeeper returns a new instance of the gravity keeper, validatorSet is the staking keeper on a chain securing
+// itself
 func NewKeeper(
 	storeKey sdk.StoreKey,
 	paramSpace paramtypes.Subspace,
 	cdc codec.BinaryCodec,
 	bankKeeper *bankkeeper.BaseKeeper,
-	stakingKeeper *stakingkeeper.Keeper,
+	validatorSet types.ValidatorSetSource,
 	slashingKeeper *slashingkeeper.Keeper,
 	distKeeper *distrkeeper.Keeper,
 	accKeeper *authkeeper.AccountKeeper,
@@ -85,10 +131,13 @@ func NewKeeper(
 
 		cdc:                cdc,
 		bankKeeper:         bankKeeper,
-		StakingKeeper:      stakingKeeper,
+		ValidatorSet:       validatorSet,
 		SlashingKeeper:     slashingKeeper,
 		DistKeeper:         distKeeper,
 		accountKeeper:      accKeeper,
+		screeningKeeper:    types.NoopScreeningKeeper{},
+		bridgeHooks:        types.NoopBridgeHooks{},
+		attestationHooks:   types.NoopAttestationHooks{},
 		AttestationHandler: nil,
 	}
 	attestationHandler := AttestationHandler{
@@ -104,6 +153,87 @@ func NewKeeper(
 	return k
 }
 
+// SetScreeningKeeper replaces the default screening, which accepts every transfer to Ethereum. It must be called before
+// the keeper is copied into the module
+func (k *Keeper) SetScreeningKeeper(screeningKeeper types.ScreeningKeeper) {
+	if screeningKeeper == nil {
+		panic("Nil screeningKeeper!")
+	}
+	k.screeningKeeper = screeningKeeper
+}
+
+// SetBridgeHooks sets the hooks notified of the coins the bridge moves for the accounts. It must be called before the
+// keeper is copied into the module
+func (k *Keeper) SetBridgeHooks(bridgeHooks types.BridgeHooks) {
+	if bridgeHooks == nil {
+		panic("Nil bridgeHooks!")
+	}
+	k.bridgeHooks = bridgeHooks
+	// the attestation handler credits the deposits through the keeper it was created with
+	if handler, ok := k.AttestationHandler.(AttestationHandler); ok {
+		handler.keeper.bridgeHooks = bridgeHooks
+	}
+}
+
+// SetAttestationHooks sets the hooks notified of the attestations applied to the state. It must be called before the
+// keeper is copied into the module
+func (k *Keeper) SetAttestationHooks(attestationHooks types.AttestationHooks) {
+	if attestationHooks == nil {
+		panic("Nil attestationHooks!")
+	}
+	k.attestationHooks = attestationHooks
+}
+
+// SetGovKeeper sets the governance keeper, which is created after this keeper because its router holds the gravity
+// proposal handler. It must be called before the keeper is copied into the module
+func (k *Keeper) SetGovKeeper(govKeeper *govkeeper.Keeper) {
+	k.govKeeper = govKeeper
+}
+
+// SetDenomTraceSource sets the source of the IBC denom traces, used to trace IBC vouchers back to their ERC20. It
+// must be called before the keeper is copied into the module
+func (k *Keeper) SetDenomTraceSource(denomTraces types.DenomTraceSource) {
+	k.denomTraces = denomTraces
+}
+
+// SetIbcTransferKeeper sets the keeper sending the deposits forwarded to other chains over IBC. It must be called
+// before the keeper is copied into the module
+func (k *Keeper) SetIbcTransferKeeper(ibcTransferKeeper types.IbcTransferKeeper) {
+	k.ibcTransferKeeper = ibcTransferKeeper
+	// the attestation handler queues the forwards through the keeper it was created with
+	if handler, ok := k.AttestationHandler.(AttestationHandler); ok {
+		handler.keeper.ibcTransferKeeper = ibcTransferKeeper
+	}
+}
+
+// SetModuleVersions sets the sources of the consensus versions of the modules in state and in this binary, reported
+// by the ModuleVersions query. It must be called before the keeper is copied into the module
+func (k *Keeper) SetModuleVersions(stateVersions types.ModuleVersionSource, binaryVersions func() module.VersionMap) {
+	k.stateModuleVersions = stateVersions
+	k.binaryModuleVersions = binaryVersions
+}
+
+// SetMountedStores sets the stores mounted by the app, reported by the AppModules query. It must be called before the
+// keeper is copied into the module
+func (k *Keeper) SetMountedStores(
+	kvKeys map[string]*sdk.KVStoreKey,
+	transientKeys map[string]*sdk.TransientStoreKey,
+	memoryKeys map[string]*sdk.MemoryStoreKey,
+) {
+	stores := make([]types.MountedStore, 0, len(kvKeys)+len(transientKeys)+len(memoryKeys))
+	for name := range kvKeys {
+		stores = append(stores, types.MountedStore{Name: name, Kind: "kv"})
+	}
+	for name := range transientKeys {
+		stores = append(stores, types.MountedStore{Name: name, Kind: "transient"})
+	}
+	for name := range memoryKeys {
+		stores = append(stores, types.MountedStore{Name: name, Kind: "memory"})
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Name < stores[j].Name })
+	k.mountedStores = stores
+}
+
 /////////////////////////////
 //       PARAMETERS        //
 /////////////////////////////
@@ -119,6 +249,12 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
+// IsBridgeActive returns whether the bridge processes the claims from Ethereum and creates batches, this requires both
+// the BridgeActive param and the MinBridgeValidators registered validators
+func (k Keeper) IsBridgeActive(ctx sdk.Context) bool {
+	return k.GetParams(ctx).BridgeActive && k.HasMinBridgeValidators(ctx)
+}
+
 // GetBridgeContractAddress returns the bridge contract address on ETH
 func (k Keeper) GetBridgeContractAddress(ctx sdk.Context) *types.EthAddress {
 	var a string
@@ -316,6 +452,29 @@ func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64) (out [
 //       Parameters        //
 /////////////////////////////
 
+// OverlapsConsensusDowntime returns true if the DOWNTIME_OVERLAP_POLICY_SKIP_BRIDGE policy is set and the validator
+// missed consensus blocks in the slashing module's current window, or was jailed for downtime within the last
+// signedWindow blocks. Such a validator is already penalised by the slashing module and should not also be slashed
+// for the bridge signatures it missed in that window. The slashing module records jailing as a time, so the
+// bridge window is converted to a duration with the AverageBlockTime param
+func (k Keeper) OverlapsConsensusDowntime(ctx sdk.Context, consAddr sdk.ConsAddress, signedWindow uint64) bool {
+	params := k.GetParams(ctx)
+	if params.DowntimeOverlapPolicy != types.DOWNTIME_OVERLAP_POLICY_SKIP_BRIDGE {
+		return false
+	}
+	info, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return false
+	}
+	if info.MissedBlocksCounter > 0 {
+		return true
+	}
+	// only the end of the jailing is recorded, validators that were never jailed have a zero time here
+	jailedAt := info.JailedUntil.Add(-k.SlashingKeeper.DowntimeJailDuration(ctx))
+	windowStart := ctx.BlockTime().Add(-time.Duration(signedWindow*params.AverageBlockTime) * time.Millisecond)
+	return jailedAt.After(windowStart)
+}
+
 // prefixRange turns a prefix into a (start, end) range. The start is the given prefix value and
 // the end is calculated by adding 1 bit to the start value. Nil is not allowed as prefix.
 //
@@ -70,7 +70,7 @@ func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (
 			MinSelfDelegation: sdk.Int{},
 		}, false
 	}
-	validator, found = k.StakingKeeper.GetValidator(ctx, valAddr)
+	validator, found = k.ValidatorSet.GetValidator(ctx, valAddr)
 	if !found {
 		return stakingtypes.Validator{
 			OperatorAddress: "",
@@ -141,6 +141,22 @@ func (k Keeper) GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddre
 	return addr, true
 }
 
+// GetDelegateKeyCoverage returns the power of the bonded validators which registered their delegate keys and the
+// power of all the bonded validators, along with the operator addresses of those which did not register them
+func (k Keeper) GetDelegateKeyCoverage(ctx sdk.Context) (registeredPower sdk.Int, totalPower sdk.Int, unregistered []string) {
+	registeredPower, totalPower = sdk.ZeroInt(), sdk.ZeroInt()
+	for _, validator := range k.ValidatorSet.GetBondedValidatorsByPower(ctx) {
+		power := sdk.NewInt(k.ValidatorSet.GetLastValidatorPower(ctx, validator.GetOperator()))
+		totalPower = totalPower.Add(power)
+		if _, found := k.GetEthAddressByValidator(ctx, validator.GetOperator()); found {
+			registeredPower = registeredPower.Add(power)
+		} else {
+			unregistered = append(unregistered, validator.GetOperator().String())
+		}
+	}
+	return registeredPower, totalPower, unregistered
+}
+
 // GetValidatorByEthAddress returns the validator for a given eth address
 func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddress) (validator stakingtypes.Validator, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -179,7 +195,7 @@ func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddre
 			MinSelfDelegation: sdk.Int{},
 		}, false
 	}
-	validator, found = k.StakingKeeper.GetValidator(ctx, valAddr)
+	validator, found = k.ValidatorSet.GetValidator(ctx, valAddr)
 	if !found {
 		return stakingtypes.Validator{
 			OperatorAddress: "",
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
@@ -26,14 +27,39 @@ func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, inv
 		InvalidationId:       invalidationID,
 		InvalidationNonce:    invalidationNonce,
 		Block:                0,
+		GasLimit:             0,
 	}
 	k.cdc.MustUnmarshal(store.Get([]byte(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))), &call)
 	return &call
 }
 
-// SetOutogingLogicCall sets an outgoing logic call, panics if one already exists at this
-// index, since we collect signatures over logic calls no mutation can be valid
+// ValidateOutgoingLogicCall checks that the gas limit and the payload of a logic call are within the
+// LogicCallMaxGasLimit and LogicCallMaxPayloadSize params, zero params leave them uncapped
+func (k Keeper) ValidateOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCall) error {
+	params := k.GetParams(ctx)
+	maxGasLimit, maxPayloadSize := params.LogicCallMaxGasLimit, params.LogicCallMaxPayloadSize
+	if maxGasLimit != 0 && call.GasLimit > maxGasLimit {
+		return sdkerrors.Wrapf(types.ErrInvalid, "logic call gas limit %d over the maximum of %d", call.GasLimit, maxGasLimit)
+	}
+	if maxPayloadSize != 0 && uint64(len(call.Payload)) > maxPayloadSize {
+		return sdkerrors.Wrapf(types.ErrInvalid, "logic call payload of %d bytes over the maximum of %d", len(call.Payload), maxPayloadSize)
+	}
+	return nil
+}
+
+// SetOutogingLogicCall schedules an outgoing logic call, panics if it is not valid under ValidateOutgoingLogicCall
+// or if one already exists at this index, since we collect signatures over logic calls no mutation can be valid
 func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCall) {
+	if err := k.ValidateOutgoingLogicCall(ctx, call); err != nil {
+		panic(err)
+	}
+	k.setOutgoingLogicCall(ctx, call)
+}
+
+// setOutgoingLogicCall stores an outgoing logic call without validating it against the current params, so that the
+// logic calls scheduled before the params changed can be imported from genesis
+// WARNING: Do not make this function public
+func (k Keeper) setOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCall) {
 	store := ctx.KVStore(k.storeKey)
 
 	// Store checkpoint to prove that this logic call actually happened
@@ -47,6 +73,36 @@ func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCa
 		k.cdc.MustMarshal(&call))
 }
 
+// SetOutgoingLogicCallWithDeposit sets an outgoing logic call sponsored by a fee deposit, the deposit is
+// escrowed from the sponsor, which may be a module account, and refunded to it once the execution of the
+// logic call is observed or the logic call times out
+func (k Keeper) SetOutgoingLogicCallWithDeposit(
+	ctx sdk.Context,
+	call types.OutgoingLogicCall,
+	sponsor sdk.AccAddress,
+	deposit sdk.Coins) error {
+	if err := sdk.VerifyAddressFormat(sponsor); err != nil {
+		return sdkerrors.Wrap(err, "invalid sponsor")
+	}
+	if !deposit.IsValid() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "invalid logic call deposit %s", deposit)
+	}
+	if err := k.ValidateOutgoingLogicCall(ctx, call); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsor, types.FeesAccountName, deposit); err != nil {
+		return sdkerrors.Wrap(err, "unable to escrow logic call deposit")
+	}
+	k.SetOutgoingLogicCall(ctx, call)
+	k.setLogicCallDeposit(ctx, types.LogicCallDeposit{
+		InvalidationId:    call.InvalidationId,
+		InvalidationNonce: call.InvalidationNonce,
+		Sponsor:           sponsor.String(),
+		Amount:            deposit,
+	})
+	return nil
+}
+
 // DeleteOutgoingLogicCall deletes outgoing logic calls
 func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
 	ctx.KVStore(k.storeKey).Delete([]byte(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce)))
@@ -84,6 +140,7 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 	}
 	// Delete batch since it is finished
 	k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
+	k.refundLogicCallDeposit(ctx, call.InvalidationId, call.InvalidationNonce)
 
 	// a consuming application will have to watch for this event and act on it
 	batchEvent := sdk.NewEvent(
@@ -96,6 +153,97 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 	return nil
 }
 
+// OutgoingLogicCallExecuted is run when the Cosmos chain detects that a logic call has been executed on
+// Ethereum, it refunds the sponsor deposit and deletes the logic call along with its confirmations
+func (k Keeper) OutgoingLogicCallExecuted(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	k.refundLogicCallDeposit(ctx, invalidationID, invalidationNonce)
+	for _, confirm := range k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, invalidationNonce) {
+		orchestrator, err := sdk.AccAddressFromBech32(confirm.Orchestrator)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid orchestrator in stored logic call confirm"))
+		}
+		k.DeleteLogicCallConfirm(ctx, invalidationID, invalidationNonce, orchestrator)
+	}
+	k.DeleteOutgoingLogicCall(ctx, invalidationID, invalidationNonce)
+}
+
+/////////////////////////////
+//   LOGIC CALL DEPOSITS   //
+/////////////////////////////
+
+// GetLogicCallDeposit returns the sponsor deposit of a logic call, or nil if it has none
+func (k Keeper) GetLogicCallDeposit(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) *types.LogicCallDeposit {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetLogicCallDepositKey(invalidationID, invalidationNonce)))
+	if len(bz) == 0 {
+		return nil
+	}
+	var deposit types.LogicCallDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return &deposit
+}
+
+// setLogicCallDeposit stores the sponsor deposit of a logic call, the funds must already be escrowed
+func (k Keeper) setLogicCallDeposit(ctx sdk.Context, deposit types.LogicCallDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(types.GetLogicCallDepositKey(deposit.InvalidationId, deposit.InvalidationNonce))
+	if store.Has(key) {
+		panic("Can not overwrite logic call deposit")
+	}
+	store.Set(key, k.cdc.MustMarshal(&deposit))
+}
+
+// IterateLogicCallDeposits iterates over the sponsor deposits of all logic calls
+func (k Keeper) IterateLogicCallDeposits(ctx sdk.Context, cb func([]byte, types.LogicCallDeposit) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyLogicCallDeposit))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.LogicCallDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		// cb returns true to stop early
+		if cb(iter.Key(), deposit) {
+			break
+		}
+	}
+}
+
+// GetLogicCallDeposits returns the sponsor deposits of all logic calls
+func (k Keeper) GetLogicCallDeposits(ctx sdk.Context) (out []types.LogicCallDeposit) {
+	k.IterateLogicCallDeposits(ctx, func(_ []byte, deposit types.LogicCallDeposit) bool {
+		out = append(out, deposit)
+		return false
+	})
+	return
+}
+
+// refundLogicCallDeposit returns the escrowed deposit of a logic call to its sponsor, if it has one
+func (k Keeper) refundLogicCallDeposit(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	deposit := k.GetLogicCallDeposit(ctx, invalidationID, invalidationNonce)
+	if deposit == nil {
+		return
+	}
+	sponsor, err := sdk.AccAddressFromBech32(deposit.Sponsor)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid sponsor in stored logic call deposit"))
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeesAccountName, sponsor, deposit.Amount); err != nil {
+		panic(sdkerrors.Wrap(err, "unable to refund logic call deposit"))
+	}
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetLogicCallDepositKey(invalidationID, invalidationNonce)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLogicCallDepositRefunded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyInvalidationID, fmt.Sprint(invalidationID)),
+			sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(invalidationNonce)),
+			sdk.NewAttribute(types.AttributeKeyLogicCallSponsor, deposit.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyLogicCallDeposit, deposit.Amount.String()),
+		),
+	)
+}
+
 /////////////////////////////
 //       LOGICCONFIRMS     //
 /////////////////////////////
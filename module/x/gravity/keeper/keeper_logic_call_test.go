@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// test that logic call deposits are escrowed and refunded on both execution and time out
+func TestLogicCallDepositLifecycle(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	sponsor := RandomAccAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 300))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	input.AccountKeeper.NewAccountWithAddress(ctx, sponsor)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsor, funds))
+
+	newCall := func(nonce uint64) types.OutgoingLogicCall {
+		return types.OutgoingLogicCall{
+			Transfers:            []types.ERC20Token{},
+			Fees:                 []types.ERC20Token{},
+			LogicContractAddress: "0x510ab76899430424d209a6c9a5b9951fb8a6f47d",
+			Payload:              []byte("payload"),
+			Timeout:              10000,
+			InvalidationId:       []byte("invalidation id"),
+			InvalidationNonce:    nonce,
+			Block:                0,
+		}
+	}
+
+	executed := newCall(1)
+	require.NoError(t, k.SetOutgoingLogicCallWithDeposit(ctx, executed, sponsor, deposit))
+	timedOut := newCall(2)
+	require.NoError(t, k.SetOutgoingLogicCallWithDeposit(ctx, timedOut, sponsor, deposit))
+	require.Equal(t, sdk.NewInt(400), input.BankKeeper.GetBalance(ctx, sponsor, "stake").Amount)
+	require.Len(t, k.GetLogicCallDeposits(ctx), 2)
+	_, broken := ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the sponsor can not escrow more than it holds
+	require.Error(t, k.SetOutgoingLogicCallWithDeposit(ctx, newCall(3), sponsor, funds))
+	require.Len(t, k.GetOutgoingLogicCalls(ctx), 2)
+
+	k.OutgoingLogicCallExecuted(ctx, executed.InvalidationId, executed.InvalidationNonce)
+	require.Nil(t, k.GetLogicCallDeposit(ctx, executed.InvalidationId, executed.InvalidationNonce))
+	require.Equal(t, sdk.NewInt(700), input.BankKeeper.GetBalance(ctx, sponsor, "stake").Amount)
+	require.Len(t, k.GetOutgoingLogicCalls(ctx), 1)
+
+	require.NoError(t, k.CancelOutgoingLogicCall(ctx, timedOut.InvalidationId, timedOut.InvalidationNonce))
+	require.Nil(t, k.GetLogicCallDeposit(ctx, timedOut.InvalidationId, timedOut.InvalidationNonce))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, sponsor, "stake").Amount)
+	require.Len(t, k.GetOutgoingLogicCalls(ctx), 0)
+	require.Equal(t, 2, countEvents(ctx, types.EventTypeLogicCallDepositRefunded))
+	_, broken = ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+// test that logic calls over the gas limit or payload size caps can not be scheduled, and that the calls scheduled
+// before the caps were lowered are still imported from genesis
+func TestLogicCallLimits(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	params := k.GetParams(ctx)
+	params.LogicCallMaxGasLimit = 1_000_000
+	params.LogicCallMaxPayloadSize = 8
+	k.SetParams(ctx, params)
+
+	call := types.OutgoingLogicCall{
+		Transfers:            []types.ERC20Token{},
+		Fees:                 []types.ERC20Token{},
+		LogicContractAddress: "0x510ab76899430424d209a6c9a5b9951fb8a6f47d",
+		Payload:              []byte("payload"),
+		Timeout:              10000,
+		InvalidationId:       []byte("invalidation id"),
+		InvalidationNonce:    1,
+		GasLimit:             1_000_000,
+	}
+	require.NoError(t, k.ValidateOutgoingLogicCall(ctx, call))
+	k.SetOutgoingLogicCall(ctx, call)
+	require.Equal(t, uint64(1_000_000), k.GetOutgoingLogicCall(ctx, call.InvalidationId, 1).GasLimit)
+
+	overGas := call
+	overGas.InvalidationNonce = 2
+	overGas.GasLimit = 1_000_001
+	require.ErrorIs(t, k.ValidateOutgoingLogicCall(ctx, overGas), types.ErrInvalid)
+	require.Panics(t, func() { k.SetOutgoingLogicCall(ctx, overGas) })
+
+	overPayload := call
+	overPayload.InvalidationNonce = 3
+	overPayload.Payload = []byte("long payload")
+	sponsor := RandomAccAddress()
+	require.ErrorIs(t, k.SetOutgoingLogicCallWithDeposit(ctx, overPayload, sponsor, sdk.NewCoins()), types.ErrInvalid)
+	require.Len(t, k.GetOutgoingLogicCalls(ctx), 1)
+
+	// the gas limit is returned by the queries but does not change the signed checkpoint
+	res, err := NewQueryServerImpl(k).OutgoingLogicCalls(sdk.WrapSDKContext(ctx), &types.QueryOutgoingLogicCallsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000_000), res.Calls[0].GasLimit)
+	noHint := call
+	noHint.GasLimit = 0
+	require.Equal(t, noHint.GetCheckpoint(k.GetGravityID(ctx)), call.GetCheckpoint(k.GetGravityID(ctx)))
+
+	// lowering the caps leaves the scheduled calls exportable and importable
+	params.LogicCallMaxGasLimit = 500_000
+	k.SetParams(ctx, params)
+	genesis := ExportGenesis(ctx, k)
+	imported := CreateTestEnv(t)
+	InitGenesis(imported.Context, imported.GravityKeeper, genesis)
+	require.Equal(t, genesis.LogicCalls, imported.GravityKeeper.GetOutgoingLogicCalls(imported.Context))
+}
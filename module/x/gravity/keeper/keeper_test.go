@@ -8,6 +8,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -103,6 +107,114 @@ func TestCurrentValsetNormalization(t *testing.T) {
 	}
 }
 
+// providerValidatorSet stands in for the validator set of a provider chain, only the first size validators of the
+// local staking keeper are bonded in it
+type providerValidatorSet struct {
+	stakingkeeper.Keeper
+	size int
+}
+
+func (p providerValidatorSet) GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator {
+	return p.Keeper.GetBondedValidatorsByPower(ctx)[:p.size]
+}
+
+// Tests that the valsets are built from the validator set source rather than the local staking keeper
+func TestValidatorSetSource(t *testing.T) {
+	const minStake = 1000000
+	input, ctx := SetupTestChain(t, []uint64{minStake, minStake, minStake, minStake}, true)
+	valset, err := input.GravityKeeper.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.Len(t, valset.Members, 4)
+
+	input.GravityKeeper.ValidatorSet = providerValidatorSet{Keeper: input.StakingKeeper, size: 2}
+	valset, err = input.GravityKeeper.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	members, err := types.BridgeValidators(valset.Members).ToInternal()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2147483648, 2147483648}, members.GetPowers())
+}
+
+// Tests that the tokens delegated by the synthetic delegation modules are not counted in the valset power
+func TestSyntheticDelegationPower(t *testing.T) {
+	const minStake = 1000000
+	input, ctx := SetupTestChain(t, []uint64{minStake, minStake}, true)
+	validator, found := input.StakingKeeper.GetValidator(ctx, input.StakingKeeper.GetBondedValidatorsByPower(ctx)[0].GetOperator())
+	require.True(t, found)
+	ethAddr, found := input.GravityKeeper.GetEthAddressByValidator(ctx, validator.GetOperator())
+	require.True(t, found)
+
+	// a liquid staking module doubles the stake of the first validator
+	wrapper := authtypes.NewModuleAddress("stakeibc")
+	stake := sdk.NewCoins(sdk.NewInt64Coin(TestingStakeParams.BondDenom, minStake))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, stake))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, wrapper, stake))
+	_, err := input.StakingKeeper.Delegate(ctx, wrapper, sdk.NewInt(minStake), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	powerOf := func() uint64 {
+		valset, err := input.GravityKeeper.GetCurrentValset(ctx)
+		require.NoError(t, err)
+		for _, member := range valset.Members {
+			if member.EthereumAddress == ethAddr.GetAddress() {
+				return member.Power
+			}
+		}
+		panic("validator not in valset")
+	}
+	assert.Equal(t, uint64(2863311530), powerOf())
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.SyntheticDelegationModules = []string{"stakeibc"}
+	input.GravityKeeper.SetParams(ctx, params)
+	assert.Equal(t, uint64(2147483648), powerOf())
+}
+
+func TestCapValidatorPowers(t *testing.T) {
+	specs := map[string]struct {
+		srcPowers []uint64
+		maxShare  sdk.Dec
+		expPowers []uint64
+	}{
+		"disabled": {
+			srcPowers: []uint64{900, 100},
+			maxShare:  sdk.OneDec(),
+			expPowers: []uint64{900, 100},
+		},
+		"one capped": {
+			srcPowers: []uint64{600, 200, 200},
+			maxShare:  sdk.NewDecWithPrec(5, 1),
+			expPowers: []uint64{500, 250, 250},
+		},
+		"excess shared proportionally": {
+			srcPowers: []uint64{500, 300, 200},
+			maxShare:  sdk.NewDecWithPrec(4, 1),
+			expPowers: []uint64{400, 360, 240},
+		},
+		"excess lifts another validator above the cap": {
+			srcPowers: []uint64{700, 250, 50},
+			maxShare:  sdk.NewDecWithPrec(3, 1),
+			expPowers: []uint64{333, 333, 333},
+		},
+		"too few validators": {
+			srcPowers: []uint64{150, 50},
+			maxShare:  sdk.NewDecWithPrec(3, 1),
+			expPowers: []uint64{100, 100},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			validators := make(types.InternalBridgeValidators, len(spec.srcPowers))
+			for i, power := range spec.srcPowers {
+				validators[i] = &types.InternalBridgeValidator{Power: power, EthereumAddress: types.ZeroAddress()}
+			}
+			capValidatorPowers(validators, spec.maxShare)
+			assert.Equal(t, spec.expPowers, validators.GetPowers())
+		})
+	}
+}
+
 //nolint: exhaustivestruct
 func TestAttestationIterator(t *testing.T) {
 	input := CreateTestEnv(t)
@@ -133,9 +245,9 @@ func TestAttestationIterator(t *testing.T) {
 		CosmosReceiver: AccAddrs[0].String(),
 		Orchestrator:   AccAddrs[0].String(),
 	}
-	hash1, err := dep1.ClaimHash()
+	hash1, err := dep1.ClaimHash(types.ClaimEncodingVersion)
 	require.NoError(t, err)
-	hash2, err := dep2.ClaimHash()
+	hash2, err := dep2.ClaimHash(types.ClaimEncodingVersion)
 	require.NoError(t, err)
 
 	input.GravityKeeper.SetAttestation(ctx, dep1.EventNonce, hash1, att1)
@@ -195,6 +307,21 @@ func TestDelegateKeys(t *testing.T) {
 	}
 }
 
+// Tests that the delegate key coverage reports the power of the validators which registered their keys
+func TestDelegateKeyCoverage(t *testing.T) {
+	const minStake = 1000000
+	input, ctx := SetupTestChain(t, []uint64{3 * minStake, minStake}, false)
+	validators := input.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, validators[0].GetOperator(), types.ZeroAddress())
+
+	res, err := NewQueryServerImpl(input.GravityKeeper).DelegateKeyCoverage(sdk.WrapSDKContext(ctx), &types.QueryDelegateKeyCoverageRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(3), res.RegisteredPower)
+	assert.Equal(t, sdk.NewInt(4), res.TotalPower)
+	assert.Equal(t, sdk.NewDec(75), res.RegisteredPercentage)
+	assert.Equal(t, []string{validators[1].GetOperator().String()}, res.UnregisteredValidators)
+}
+
 //nolint: exhaustivestruct
 func TestLastSlashedValsetNonce(t *testing.T) {
 	input, ctx := SetupFiveValChain(t)
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
@@ -197,6 +199,48 @@ func (k Keeper) GetLastUnBondingBlockHeight(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
+// SetLastSlashValsetRequestBlockHeight sets the last block height in which the slashing of a validator holding
+// more than the ValsetRequestSlashPowerThreshold requested a new valset. Note this value is not saved and loaded
+// in genesis and is reset to zero on chain upgrade.
+func (k Keeper) SetLastSlashValsetRequestBlockHeight(ctx sdk.Context, blockHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.LastSlashValsetRequestBlockHeight), types.UInt64Bytes(blockHeight))
+}
+
+// GetLastSlashValsetRequestBlockHeight returns the last block height in which a slash requested a new valset,
+// returns zero if not set, this is not saved or loaded in genesis and is reset to zero on chain upgrade
+func (k Keeper) GetLastSlashValsetRequestBlockHeight(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get([]byte(types.LastSlashValsetRequestBlockHeight))
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// GetValidatorLatestValsetPowerShare returns the share of the latest valset power held by the given validator,
+// zero is returned if there is no valset yet or the validator is not a member of the latest valset
+func (k Keeper) GetValidatorLatestValsetPowerShare(ctx sdk.Context, val sdk.ValAddress) sdk.Dec {
+	latest := k.GetLatestValset(ctx)
+	ethAddr, found := k.GetEthAddressByValidator(ctx, val)
+	if latest == nil || !found {
+		return sdk.ZeroDec()
+	}
+	total := uint64(0)
+	power := uint64(0)
+	for _, member := range latest.Members {
+		total += member.Power
+		if strings.EqualFold(member.EthereumAddress, ethAddr.GetAddress()) {
+			power = member.Power
+		}
+	}
+	if total == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(power)).QuoInt(sdk.NewIntFromUint64(total))
+}
+
 // GetUnSlashedValsets returns all the "ready-to-slash" unslashed validator sets in state (valsets at least signedValsetsWindow blocks old)
 func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64) (out []*types.Valset) {
 	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
@@ -230,6 +274,22 @@ func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedVa
 	}
 }
 
+// HasMinBridgeValidators returns whether at least MinBridgeValidators bonded validators registered their delegate
+// keys, no valsets are created before they did
+func (k Keeper) HasMinBridgeValidators(ctx sdk.Context) bool {
+	min := k.GetParams(ctx).MinBridgeValidators
+	if min == 0 {
+		return true
+	}
+	registered := uint64(0)
+	for _, validator := range k.ValidatorSet.GetBondedValidatorsByPower(ctx) {
+		if _, found := k.GetEthAddressByValidator(ctx, validator.GetOperator()); found {
+			registered++
+		}
+	}
+	return registered >= min
+}
+
 // GetCurrentValset gets powers from the store and normalizes them
 // into an integer percentage with a resolution of uint32 Max meaning
 // a given validators 'gravity power' is computed as
@@ -252,14 +312,20 @@ func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedVa
 // update the validator set again and the bridge and all its' funds are lost.
 // For this reason we exclude validators with unset eth keys from validator sets
 //
+// The normalized power of a single validator is capped at the MaxValsetPowerShare param, see capValidatorPowers
+//
+// The delegations of the module accounts listed in the SyntheticDelegationModules param, held on behalf of liquid
+// staking wrappers, are not counted in the power of a validator, a validator left without power is excluded
+//
 // The function is intended to return what the valset would look like if you made one now
 // you should call this function, evaluate if you want to save this new valset, and discard
 // it or save
 func (k Keeper) GetCurrentValset(ctx sdk.Context) (types.Valset, error) {
-	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	validators := k.ValidatorSet.GetBondedValidatorsByPower(ctx)
 	if len(validators) == 0 {
 		return types.Valset{}, types.ErrNoValidators
 	}
+	params := k.GetParams(ctx)
 	// allocate enough space for all validators, but len zero, we then append
 	// so that we have an array with extra capacity but the correct length depending
 	// on how many validators have keys set.
@@ -273,7 +339,13 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) (types.Valset, error) {
 			return types.Valset{}, sdkerrors.Wrap(err, types.ErrInvalidValAddress.Error())
 		}
 
-		p := sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val))
+		p := sdk.NewInt(k.ValidatorSet.GetLastValidatorPower(ctx, val))
+		if len(params.SyntheticDelegationModules) > 0 {
+			p = p.SubRaw(k.syntheticDelegationPower(ctx, validator, params.SyntheticDelegationModules))
+			if !p.IsPositive() {
+				continue
+			}
+		}
 
 		if ethAddr, found := k.GetEthAddressByValidator(ctx, val); found {
 			bv := types.BridgeValidator{Power: p.Uint64(), EthereumAddress: ethAddr.GetAddress()}
@@ -289,9 +361,10 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) (types.Valset, error) {
 	for i := range bridgeValidators {
 		bridgeValidators[i].Power = normalizeValidatorPower(bridgeValidators[i].Power, totalPower)
 	}
+	capValidatorPowers(bridgeValidators, params.MaxValsetPowerShare)
 
 	// get the reward from the params store
-	reward := k.GetParams(ctx).ValsetReward
+	reward := params.ValsetReward
 	var rewardToken *types.EthAddress
 	var rewardAmount sdk.Int
 	if !reward.IsValid() || reward.IsZero() {
@@ -323,6 +396,77 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) (types.Valset, error) {
 	return *valset, nil
 }
 
+// capValidatorPowers caps the normalized power of every validator at maxShare of the total normalized power, the
+// excess is redistributed over the validators below the cap in proportion to their power. Redistributing may lift
+// more validators above the cap, so this repeats until none is. If the validators are too few to meet the cap they
+// all get the same power
+func capValidatorPowers(validators []*types.InternalBridgeValidator, maxShare sdk.Dec) {
+	if len(validators) == 0 || maxShare.GTE(sdk.OneDec()) {
+		return
+	}
+	total := uint64(0)
+	for _, v := range validators {
+		total += v.Power
+	}
+	limit := maxShare.MulInt(sdk.NewIntFromUint64(total)).TruncateInt().Uint64()
+	if limit*uint64(len(validators)) < total {
+		limit = total / uint64(len(validators))
+	}
+
+	capped := make([]bool, len(validators))
+	for {
+		remaining, uncappedPower := total, uint64(0)
+		for i, v := range validators {
+			if capped[i] {
+				remaining -= limit
+			} else {
+				uncappedPower += v.Power
+			}
+		}
+		changed := false
+		for i, v := range validators {
+			if !capped[i] && scaleValidatorPower(v.Power, remaining, uncappedPower) > limit {
+				capped[i] = true
+				changed = true
+			}
+		}
+		if !changed {
+			for i, v := range validators {
+				if capped[i] {
+					v.Power = limit
+				} else {
+					v.Power = scaleValidatorPower(v.Power, remaining, uncappedPower)
+				}
+			}
+			return
+		}
+	}
+}
+
+// scaleValidatorPower returns power * remaining / uncappedPower, using BigInt operations to avoid overflow errors
+func scaleValidatorPower(power uint64, remaining uint64, uncappedPower uint64) uint64 {
+	if uncappedPower == 0 {
+		return 0
+	}
+	scaled := new(big.Int).SetUint64(power)
+	scaled.Mul(scaled, new(big.Int).SetUint64(remaining))
+	scaled.Quo(scaled, new(big.Int).SetUint64(uncappedPower))
+	return scaled.Uint64()
+}
+
+// syntheticDelegationPower returns the consensus power of the tokens delegated to validator by the module accounts
+// of modules
+func (k Keeper) syntheticDelegationPower(ctx sdk.Context, validator stakingtypes.Validator, modules []string) int64 {
+	tokens := sdk.ZeroDec()
+	for _, module := range modules {
+		delegation, found := k.ValidatorSet.GetDelegation(ctx, authtypes.NewModuleAddress(module), validator.GetOperator())
+		if found {
+			tokens = tokens.Add(validator.TokensFromShares(delegation.Shares))
+		}
+	}
+	return sdk.TokensToConsensusPower(tokens.TruncateInt(), sdk.DefaultPowerReduction)
+}
+
 // normalizeValidatorPower scales rawPower with respect to totalValidatorPower to take a value between 0 and 2^32
 // Uses BigInt operations to avoid overflow errors
 // Example: rawPower = max (2^63 - 1), totalValidatorPower = 1 validator: (2^63 - 1)
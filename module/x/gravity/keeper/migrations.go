@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the funds escrowed by the module account into the sub-pool accounts
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.MoveEscrowsToSubPools(ctx)
+}
+
+// Migrate2to3 moves the attestations under the hashes of the canonical claim encoding
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.RekeyAttestations(ctx)
+}
+
+// Migrate3to4 sets the params added since version 1 to their default value, the claim hash version params to the
+// first version and moves the attestations under keys holding their claim hash version
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.setDefaultParams(ctx,
+		types.ParamStoreBatchRelayLatencySLA,
+		types.ParamStoreBridgeFeeExchangeRates,
+		types.ParamStoreMaxOutgoingBatchesPerToken,
+		types.ParamStoreValsetRequestSlashPowerThreshold,
+		types.ParamStoreDowntimeOverlapPolicy,
+		types.ParamStoreEscalateBatchSigningPenalties,
+		types.ParamStoreUpgradeGuardDepositThresholds,
+		types.ParamStoreUpgradeGuardBlocksUpgrades,
+		types.ParamStoreAttestationCatchUpLag,
+		types.ParamStoreRelayerFeeShare,
+		types.ParamStoreSyntheticDelegationModules,
+		types.ParamStoreMaxValsetPowerShare,
+		types.ParamStoreMinBridgeValidators,
+		types.ParamStoreDepositPausedTokens,
+		types.ParamStoreWithdrawalPausedTokens,
+		types.ParamStoreInvalidReceiverPolicy,
+		types.ParamStoreExpeditedVotingPeriod,
+		types.ParamStoreExpeditedQuorum,
+		types.ParamStoreExecutedBatchRetention,
+		types.ParamStoreWethContract,
+		types.ParamStoreTokenSupplyCaps,
+		types.ParamStoreSupplyCapPolicy,
+		types.ParamStoreCriticalParamChangeDelay,
+		types.ParamStoreBlockModuleAccountReceivers,
+		types.ParamStoreAllowedReceiverModules,
+		types.ParamStoreContractReceivers,
+		types.ParamStoreContractReceiverPolicy,
+		types.ParamStoreFeeBurnShare,
+		types.ParamStoreFeeBurnDenoms,
+		types.ParamStoreUsageEpochLength,
+		types.ParamStoreUsageEpochsRetained,
+		types.ParamStoreIbcAutoForwardChannels,
+		types.ParamStoreIbcAutoForwardTimeout,
+		types.ParamStoreLogicCallMaxGasLimit,
+		types.ParamStoreLogicCallMaxPayloadSize,
+		types.ParamStoreMinChainFeeBasisPoints,
+	)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreClaimHashVersion, uint64(1))
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreClaimHashVersionEthereumHeight, uint64(0))
+	return m.keeper.RekeyAttestations(ctx)
+}
+
+// setDefaultParams sets the params with the given keys to their value in DefaultParams, keeping the ones already set.
+// GetParams panics on a param missing from the store, so every param added to Params has to be set by a migration
+func (m Migrator) setDefaultParams(ctx sdk.Context, keys ...[]byte) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		for _, key := range keys {
+			if bytes.Equal(pair.Key, key) && !m.keeper.paramSpace.Has(ctx, key) {
+				m.keeper.paramSpace.Set(ctx, key, pair.Value)
+			}
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+/////////////////////////////
+//   MISBEHAVIOR COUNTERS  //
+/////////////////////////////
+
+// GetMissedBatchSignatures returns the number of batch signatures the validator missed since it last signed a batch,
+// it is only tracked when the EscalateBatchSigningPenalties param is set. Note this value is not saved and loaded in genesis
+func (k Keeper) GetMissedBatchSignatures(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetMissedBatchSignaturesKey(val)))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// IncrementMissedBatchSignatures counts a batch signature missed by the validator and returns the new count
+func (k Keeper) IncrementMissedBatchSignatures(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	missed := k.GetMissedBatchSignatures(ctx, val) + 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetMissedBatchSignaturesKey(val)), types.UInt64Bytes(missed))
+	return missed
+}
+
+// ResetMissedBatchSignatures forgets the batch signatures missed by the validator, once it signs a batch again
+func (k Keeper) ResetMissedBatchSignatures(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.GetMissedBatchSignaturesKey(val)))
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// SendToEthFromModule adds a transfer of amount and the bridge fee, both in the denom of amount, from the account of
+// moduleName to the pool, so that other modules can withdraw to Ethereum without routing a MsgSendToEth. The coins
+// are escrowed from the module account like those of a MsgSendToEth and, since module accounts can not receive coins,
+// the transfer can only be cancelled through CancelSendToEthFromModule. Returns the id of the transfer
+func (k Keeper) SendToEthFromModule(
+	ctx sdk.Context,
+	moduleName string,
+	dest types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+) (uint64, error) {
+	sender, err := k.moduleAddress(moduleName)
+	if err != nil {
+		return 0, err
+	}
+	if err := dest.ValidateBasic(); err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid eth dest")
+	}
+	_, erc20, err := k.DenomToERC20Lookup(ctx, amount.Denom)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid denom")
+	}
+	if k.InvalidSendToEthAddress(ctx, dest, *erc20) {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "destination address is invalid or blacklisted")
+	}
+
+	txID, err := k.AddToOutgoingPool(ctx, sender, dest, amount, fee)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "could not add to outgoing pool")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeModuleSendToEth,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySenderModule, moduleName),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+			sdk.NewAttribute(types.AttributeKeyEthDest, dest.GetAddress()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, fee.String()),
+		),
+	)
+	return txID, nil
+}
+
+// CancelSendToEthFromModule removes an unbatched transfer sent by SendToEthFromModule from the pool and refunds its
+// amount and bridge fee to the account of moduleName
+func (k Keeper) CancelSendToEthFromModule(ctx sdk.Context, moduleName string, txID uint64) error {
+	sender, err := k.moduleAddress(moduleName)
+	if err != nil {
+		return err
+	}
+	return k.removeFromOutgoingPool(ctx, txID, sender, func(fromModule string, refund sdk.Coins) error {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, fromModule, moduleName, refund)
+	})
+}
+
+// moduleAddress returns the address of the account of moduleName, or an error if the app has no such module account
+func (k Keeper) moduleAddress(moduleName string) (sdk.AccAddress, error) {
+	address := k.accountKeeper.GetModuleAddress(moduleName)
+	if address == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no module account %s", moduleName)
+	}
+	return address, nil
+}
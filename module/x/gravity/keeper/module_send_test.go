@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that a module withdraws from its module account into the pool and is refunded to it on cancellation, which
+// the refunds to accounts can not do
+func TestSendToEthFromModule(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	funds := sdk.NewCoins(token.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, govtypes.ModuleName, funds))
+	govAddress := input.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	amount := sdk.NewInt64Coin(token.GravityCoin().Denom, 100)
+	fee := sdk.NewInt64Coin(token.GravityCoin().Denom, 2)
+
+	_, err = k.SendToEthFromModule(ctx, "unknown", *myReceiver, amount, fee)
+	require.ErrorIs(t, err, types.ErrInvalid)
+	_, err = k.SendToEthFromModule(ctx, govtypes.ModuleName, types.ZeroAddress(), amount, fee)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	txID, err := k.SendToEthFromModule(ctx, govtypes.ModuleName, *myReceiver, amount, fee)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(898), input.BankKeeper.GetBalance(ctx, govAddress, amount.Denom).Amount)
+	unbatched := k.GetUnbatchedTransactions(ctx)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, txID, unbatched[0].Id)
+	require.Equal(t, govAddress, unbatched[0].Sender)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeModuleSendToEth))
+
+	// the module account is refused as the recipient of an account refund, only its module can cancel the transfer
+	xCtx, _ := ctx.CacheContext()
+	require.Error(t, k.RemoveFromOutgoingPoolAndRefund(xCtx, txID, govAddress))
+	xCtx, _ = ctx.CacheContext()
+	require.Error(t, k.CancelSendToEthFromModule(xCtx, types.ModuleName, txID))
+	require.NoError(t, k.CancelSendToEthFromModule(ctx, govtypes.ModuleName, txID))
+	require.Empty(t, k.GetUnbatchedTransactions(ctx))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, govAddress, amount.Denom).Amount)
+}
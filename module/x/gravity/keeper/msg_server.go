@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -45,7 +46,7 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 	_, foundExistingEthAddress := k.GetEthAddressByValidator(ctx, val)
 
 	// ensure that the validator exists
-	if k.Keeper.StakingKeeper.Validator(ctx, val) == nil {
+	if k.Keeper.ValidatorSet.Validator(ctx, val) == nil {
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
 	} else if foundExistingOrchestratorKey || foundExistingEthAddress {
 		return nil, sdkerrors.Wrap(types.ErrResetDelegateKeys, val.String())
@@ -103,6 +104,7 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 		return nil, sdkerrors.Wrap(types.ErrDuplicate, "signature duplicate")
 	}
 	key := k.SetValsetConfirm(ctx, *msg)
+	k.TryMarkValsetRelayable(ctx, msg.Nonce)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -135,7 +137,35 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 		return nil, sdkerrors.Wrap(err, "destination address is invalid or blacklisted")
 	}
 
-	txID, err := k.AddToOutgoingPool(ctx, sender, *dest, msg.Amount, msg.BridgeFee)
+	fee, err := k.ExchangeBridgeFee(ctx, sender, msg.BridgeFee, msg.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid bridge fee")
+	}
+
+	// everything leaving the account counts against its limit, the bridge fee in the denom it was paid in
+	outgoing := sdk.NewCoins(msg.Amount).Add(msg.BridgeFee)
+	if msg.RelayFee != nil {
+		outgoing = outgoing.Add(*msg.RelayFee)
+	}
+	if msg.ChainFee != nil {
+		outgoing = outgoing.Add(*msg.ChainFee)
+	}
+	if err := k.spendSelfBridgeLimit(ctx, sender, outgoing); err != nil {
+		return nil, err
+	}
+
+	if err := k.payChainFee(ctx, sender, msg.Amount, msg.ChainFee); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid chain fee")
+	}
+
+	var txID uint64
+	if msg.ExecuteAfterHeight > uint64(ctx.BlockHeight()) {
+		txID, err = k.ScheduleToOutgoingPool(ctx, sender, *dest, msg.Amount, fee, msg.RelayFee, msg.ExecuteAfterHeight)
+	} else if msg.RelayFee != nil {
+		txID, err = k.AddToOutgoingPoolWithRelayFee(ctx, sender, *dest, msg.Amount, fee, *msg.RelayFee)
+	} else {
+		txID, err = k.AddToOutgoingPool(ctx, sender, *dest, msg.Amount, fee)
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not add to outgoing pool")
 	}
@@ -209,6 +239,7 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
 	}
 	key := k.SetBatchConfirm(ctx, msg)
+	k.TryMarkBatchRelayable(ctx, *contract, msg.Nonce)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -276,7 +307,7 @@ func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator
 	}
 
 	// return an error if the validator isn't in the active set
-	val := k.StakingKeeper.Validator(ctx, validator.GetOperator())
+	val := k.ValidatorSet.Validator(ctx, validator.GetOperator())
 	if val == nil || !val.IsBonded() {
 		return sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in active set")
 	}
@@ -287,12 +318,16 @@ func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator
 // claimHandlerCommon is an internal function that provides common code for processing claims once they are
 // translated from the message to the Ethereum claim interface
 func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, msg types.EthereumClaim) error {
+	if err := k.CheckClaimBridgeContract(ctx, msg); err != nil {
+		return err
+	}
+
 	// Add the claim to the store
-	_, err := k.Attest(ctx, msg, msgAny)
+	att, err := k.Attest(ctx, msg, msgAny)
 	if err != nil {
 		return sdkerrors.Wrap(err, "create attestation")
 	}
-	hash, err := msg.ClaimHash()
+	hash, err := msg.ClaimHash(att.ClaimHashVersion)
 	if err != nil {
 		return sdkerrors.Wrap(err, "unable to compute claim hash")
 	}
@@ -303,14 +338,16 @@ func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, m
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, string(msg.GetType())),
 			// TODO: maybe return something better here? is this the right string representation?
-			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(msg.GetEventNonce(), hash))),
+			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(msg.GetEventNonce(), att.ClaimHashVersion, hash))),
 		),
 	)
 
 	return nil
 }
 
-// confirmHandlerCommon is an internal function that provides common code for processing claim messages
+// confirmHandlerCommon is an internal function that provides common code for processing confirm messages, it
+// verifies the signature recovers to the Ethereum key registered by the orchestrator's validator over the given
+// checkpoint. Relayers read confirms straight from the store so a confirm that fails here must never be stored
 func (k msgServer) confirmHandlerCommon(ctx sdk.Context, ethAddress string, orchestrator string, signature string, checkpoint []byte) error {
 	sigBytes, err := hex.DecodeString(signature)
 	if err != nil {
@@ -339,7 +376,7 @@ func (k msgServer) confirmHandlerCommon(ctx sdk.Context, ethAddress string, orch
 		return sdkerrors.Wrap(types.ErrEmpty, "no eth address set for validator")
 	}
 
-	if *ethAddressFromStore != *submittedEthAddress {
+	if !strings.EqualFold(ethAddressFromStore.GetAddress(), submittedEthAddress.GetAddress()) {
 		return sdkerrors.Wrap(types.ErrInvalid, "submitted eth address does not match delegate eth address")
 	}
 
@@ -457,6 +494,29 @@ func (k msgServer) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpda
 	return &types.MsgValsetUpdatedClaimResponse{}, nil
 }
 
+// ForkDetectedClaim handles claims reporting an Ethereum reorg deeper than the confirmation depth of the orchestrator
+func (k msgServer) ForkDetectedClaim(c context.Context, msg *types.MsgForkDetectedClaim) (*types.MsgForkDetectedClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Could not check orchestrator validator in set")
+	}
+	if _, err := k.AttestForkDetected(ctx, msg); err != nil {
+		return nil, sdkerrors.Wrap(err, "create fork attestation")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.GetType().String()),
+			sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(msg.EthereumHeight)),
+		),
+	)
+
+	return &types.MsgForkDetectedClaimResponse{}, nil
+}
+
 func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendToEth) (*types.MsgCancelSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -495,3 +555,153 @@ func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgS
 
 	return &types.MsgSubmitBadSignatureEvidenceResponse{}, err
 }
+
+// UnjailValidator wraps the slashing module's unjail, checking that a validator jailed for missing bridge signatures
+// has caught up with them first
+func (k msgServer) UnjailValidator(c context.Context, msg *types.MsgUnjailValidator) (*types.MsgUnjailValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	val, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid validator address")
+	}
+	if err := k.UnjailCaughtUpValidator(ctx, val); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyUnjailedValidator, msg.Validator),
+		),
+	)
+
+	return &types.MsgUnjailValidatorResponse{}, nil
+}
+
+// CreateRecurringSendToEth handles MsgCreateRecurringSendToEth
+func (k msgServer) CreateRecurringSendToEth(c context.Context, msg *types.MsgCreateRecurringSendToEth) (*types.MsgCreateRecurringSendToEthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender")
+	}
+	dest, err := types.NewEthAddress(msg.EthDest)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid eth dest")
+	}
+	_, erc20, err := k.DenomToERC20Lookup(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid denom")
+	}
+	if k.InvalidSendToEthAddress(ctx, *dest, *erc20) {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "destination address is invalid or blacklisted")
+	}
+
+	// the whole escrow leaves the account now, so it counts against the limit at once
+	escrow, err := recurringSendEscrow(types.RecurringSendToEth{Amount: msg.Amount, BridgeFee: msg.BridgeFee, Remaining: msg.Count})
+	if err != nil {
+		return nil, err
+	}
+	if err := k.spendSelfBridgeLimit(ctx, sender, sdk.NewCoins(escrow)); err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.CreateRecurringSendToEth(ctx, sender, *dest, msg.Amount, msg.BridgeFee, msg.Interval, msg.Count)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not create recurring send")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyRecurringSendID, fmt.Sprint(id)),
+		),
+	)
+
+	return &types.MsgCreateRecurringSendToEthResponse{Id: id}, nil
+}
+
+// CancelRecurringSendToEth handles MsgCancelRecurringSendToEth
+func (k msgServer) CancelRecurringSendToEth(c context.Context, msg *types.MsgCancelRecurringSendToEth) (*types.MsgCancelRecurringSendToEthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.CancelRecurringSendToEth(ctx, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyRecurringSendID, fmt.Sprint(msg.Id)),
+		),
+	)
+
+	return &types.MsgCancelRecurringSendToEthResponse{}, nil
+}
+
+// SetSelfBridgeLimit handles MsgSetSelfBridgeLimit
+func (k msgServer) SetSelfBridgeLimit(c context.Context, msg *types.MsgSetSelfBridgeLimit) (*types.MsgSetSelfBridgeLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender")
+	}
+	activationHeight := k.Keeper.SetSelfBridgeLimit(ctx, sender, msg.Limit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, fmt.Sprint(activationHeight)),
+		),
+	)
+
+	return &types.MsgSetSelfBridgeLimitResponse{ActivationHeight: activationHeight}, nil
+}
+
+// SubmitGravityProposal handles MsgSubmitGravityProposal
+func (k msgServer) SubmitGravityProposal(c context.Context, msg *types.MsgSubmitGravityProposal) (*types.MsgSubmitGravityProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid proposer")
+	}
+	proposalID, err := k.Keeper.SubmitGravityProposal(ctx, proposer, msg.GetContent(), msg.InitialDeposit, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprint(proposalID)),
+		),
+	)
+
+	return &types.MsgSubmitGravityProposalResponse{ProposalId: proposalID}, nil
+}
+
+// ExecuteIbcAutoForwards handles MsgExecuteIbcAutoForwards
+func (k msgServer) ExecuteIbcAutoForwards(c context.Context, msg *types.MsgExecuteIbcAutoForwards) (*types.MsgExecuteIbcAutoForwardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.Keeper.ExecuteIbcAutoForwards(ctx, msg.ForwardsToClear); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Executor),
+		),
+	)
+
+	return &types.MsgExecuteIbcAutoForwardsResponse{}, nil
+}
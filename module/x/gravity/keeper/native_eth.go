@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetWethContract returns the WETH contract native ETH deposits are wrapped into, false if the WethContract param
+// is not set
+func (k Keeper) GetWethContract(ctx sdk.Context) (*types.EthAddress, bool) {
+	weth := k.GetParams(ctx).WethContract
+	if weth == "" {
+		return nil, false
+	}
+	contract, err := types.NewEthAddress(weth)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid weth contract %s in params", weth))
+	}
+	return contract, true
+}
+
+// isNativeEthContract returns whether deposits of tokenContract are native ETH, reported by the Gravity contract with
+// the zero address or wrapped into the WETH contract
+func (k Keeper) isNativeEthContract(ctx sdk.Context, tokenContract types.EthAddress) bool {
+	if tokenContract.GetAddress() == types.ZeroAddressString {
+		return true
+	}
+	weth, found := k.GetWethContract(ctx)
+	return found && weth.GetAddress() == tokenContract.GetAddress()
+}
+
+// setNativeEthMetadata sets the bank metadata of the native ETH vouchers unless governance already set one
+func (k Keeper) setNativeEthMetadata(ctx sdk.Context) {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, types.NativeEthDenom); found {
+		return
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, types.NativeEthMetadata())
+}
+
+// nativeEthLockedAs returns the token native ETH is locked as by the Gravity contract, the WETH contract once set
+// and the zero address before
+func (k Keeper) nativeEthLockedAs(ctx sdk.Context) *types.EthAddress {
+	if weth, found := k.GetWethContract(ctx); found {
+		return weth
+	}
+	zero := types.ZeroAddress()
+	return &zero
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// ObservedBlockHashSegmentLength is the number of observed events whose Ethereum block hash is kept
+const ObservedBlockHashSegmentLength = 1000
+
+// recordObservedBlockHash records the Ethereum block hash of an observed claim and prunes the hashes which fell out
+// of the segment. The hash is only recorded if it was part of the claim hash, otherwise the validators did not
+// attest to it and it is just the hash reported by the first voter
+func (k Keeper) recordObservedBlockHash(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
+	if att.ClaimHashVersion < 2 || claim.GetBlockHash() == "" {
+		return
+	}
+	k.SetObservedBlockHash(ctx, types.ObservedBlockHash{
+		EventNonce:     claim.GetEventNonce(),
+		EthereumHeight: claim.GetBlockHeight(),
+		BlockHash:      strings.ToLower(claim.GetBlockHash()),
+	})
+
+	if claim.GetEventNonce() <= ObservedBlockHashSegmentLength {
+		return
+	}
+	cutoff := claim.GetEventNonce() - ObservedBlockHashSegmentLength
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ObservedBlockHashKey))
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(cutoff+1))
+	var pruned [][]byte
+	for ; iter.Valid(); iter.Next() {
+		pruned = append(pruned, iter.Key())
+	}
+	iter.Close()
+	for _, key := range pruned {
+		prefixStore.Delete(key)
+	}
+}
+
+// SetObservedBlockHash stores the Ethereum block hash of an observed event
+func (k Keeper) SetObservedBlockHash(ctx sdk.Context, hash types.ObservedBlockHash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetObservedBlockHashKey(hash.EventNonce)), k.cdc.MustMarshal(&hash))
+}
+
+// GetObservedBlockHash returns the Ethereum block hash of the observed event with the nonce, or nil if it is not
+// in the segment
+func (k Keeper) GetObservedBlockHash(ctx sdk.Context, eventNonce uint64) *types.ObservedBlockHash {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.GetObservedBlockHashKey(eventNonce)))
+	if len(bz) == 0 {
+		return nil
+	}
+	var hash types.ObservedBlockHash
+	k.cdc.MustUnmarshal(bz, &hash)
+	return &hash
+}
+
+// IterateObservedBlockHashes iterates over the segment of observed block hashes by ascending event nonce
+func (k Keeper) IterateObservedBlockHashes(ctx sdk.Context, cb func([]byte, types.ObservedBlockHash) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ObservedBlockHashKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var hash types.ObservedBlockHash
+		k.cdc.MustUnmarshal(iter.Value(), &hash)
+		// cb returns true to stop early
+		if cb(iter.Key(), hash) {
+			break
+		}
+	}
+}
+
+// GetObservedBlockHashes returns the segment of observed block hashes
+func (k Keeper) GetObservedBlockHashes(ctx sdk.Context) (out []types.ObservedBlockHash) {
+	k.IterateObservedBlockHashes(ctx, func(_ []byte, hash types.ObservedBlockHash) bool {
+		out = append(out, hash)
+		return false
+	})
+	return
+}
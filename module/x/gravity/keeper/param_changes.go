@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// criticalParamKeys are the params orchestrators and relayers configure themselves against, a passed change of one of
+// them waits for the CriticalParamChangeDelay before it applies. The delay is critical itself so that it can not be
+// lowered in the same proposal
+var criticalParamKeys = [][]byte{
+	types.ParamsStoreKeySignedValsetsWindow,
+	types.ParamsStoreKeySignedBatchesWindow,
+	types.ParamsStoreKeySignedLogicCallsWindow,
+	types.ParamStoreUnbondSlashingValsetsWindow,
+	types.ParamsStoreSlashFractionValset,
+	types.ParamsStoreSlashFractionBatch,
+	types.ParamStoreSlashFractionBadEthSignature,
+	types.ParamsStoreKeyBridgeEthereumAddress,
+	types.ParamStoreCriticalParamChangeDelay,
+}
+
+// IsCriticalParam returns true if a passed change of the gravity param key is delayed by the CriticalParamChangeDelay
+func IsCriticalParam(key string) bool {
+	for _, critical := range criticalParamKeys {
+		if key == string(critical) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCriticalParamChangeDelay returns the blocks a passed change of a critical param waits before it applies
+func (k Keeper) GetCriticalParamChangeDelay(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).CriticalParamChangeDelay
+}
+
+// NewCriticalParamChangeProposalHandler wraps the parameter change proposal handler so that the changes of critical
+// gravity params are kept as pending param changes until the CriticalParamChangeDelay has passed, the other changes
+// of the proposal apply right away. A change of the bridge address or gravity id rebinds the bridge once it applies
+func NewCriticalParamChangeProposalHandler(k Keeper, next govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		p, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return next(ctx, content)
+		}
+		delay := k.GetCriticalParamChangeDelay(ctx)
+		if delay == 0 {
+			return k.applyParamChanges(ctx, next, content, p.Changes)
+		}
+
+		var immediate []paramproposal.ParamChange
+		var delayed []paramproposal.ParamChange
+		for _, change := range p.Changes {
+			if change.Subspace == types.DefaultParamspace && IsCriticalParam(change.Key) {
+				delayed = append(delayed, change)
+			} else {
+				immediate = append(immediate, change)
+			}
+		}
+		if len(delayed) == 0 {
+			return k.applyParamChanges(ctx, next, content, p.Changes)
+		}
+		if len(immediate) > 0 {
+			proposal := paramproposal.NewParameterChangeProposal(p.Title, p.Description, immediate)
+			if err := k.applyParamChanges(ctx, next, proposal, immediate); err != nil {
+				return err
+			}
+		}
+		for _, change := range delayed {
+			if err := k.scheduleParamChange(ctx, change.Key, change.Value, uint64(ctx.BlockHeight())+delay); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// applyParamChanges applies the changes of a proposal with the parameter change proposal handler and rebinds the
+// bridge if one of them changed the bridge address or gravity id
+// WARNING: Do not make this function public
+func (k Keeper) applyParamChanges(
+	ctx sdk.Context, next govtypes.Handler, content govtypes.Content, changes []paramproposal.ParamChange) error {
+	if err := next(ctx, content); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if change.Subspace == types.DefaultParamspace && isBindingParam(change.Key) {
+			k.BindBridge(ctx)
+			break
+		}
+	}
+	return nil
+}
+
+// scheduleParamChange validates a change of a critical param against the current params and keeps it until
+// applyHeight, a later change of the same param passed in the same block replaces it
+// WARNING: Do not make this function public
+func (k Keeper) scheduleParamChange(ctx sdk.Context, key string, value string, applyHeight uint64) error {
+	// the change is validated the way the params keeper would apply it, in a discarded context
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.paramSpace.Update(cacheCtx, []byte(key), []byte(value)); err != nil {
+		return sdkerrors.Wrapf(err, "param %s", key)
+	}
+	k.setPendingParamChange(ctx, types.PendingParamChange{
+		Key:          key,
+		Value:        value,
+		PassedHeight: uint64(ctx.BlockHeight()),
+		ApplyHeight:  applyHeight,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChangePending,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyParamKey, key),
+			sdk.NewAttribute(types.AttributeKeyParamValue, value),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, fmt.Sprint(applyHeight)),
+		),
+	)
+	return nil
+}
+
+// ApplyPendingParamChanges applies the pending param changes whose apply height was reached, by ascending apply
+// height. A change which no longer validates, e.g. after an upgrade changed the validation of its param, is dropped
+func (k Keeper) ApplyPendingParamChanges(ctx sdk.Context) {
+	var due []types.PendingParamChange
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingParamChangeKey))
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(uint64(ctx.BlockHeight())+1))
+	for ; iter.Valid(); iter.Next() {
+		var change types.PendingParamChange
+		k.cdc.MustUnmarshal(iter.Value(), &change)
+		due = append(due, change)
+	}
+	iter.Close()
+
+	for _, change := range due {
+		k.deletePendingParamChange(ctx, change)
+		xCtx, commit := ctx.CacheContext()
+		if err := k.paramSpace.Update(xCtx, []byte(change.Key), []byte(change.Value)); err != nil {
+			k.logger(ctx).Error("pending param change dropped", "key", change.Key, "value", change.Value, "cause", err.Error())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeParamChangeDropped,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyParamKey, change.Key),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				),
+			)
+			continue
+		}
+		commit()
+		if isBindingParam(change.Key) {
+			k.BindBridge(ctx)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamChangeApplied,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyParamKey, change.Key),
+				sdk.NewAttribute(types.AttributeKeyParamValue, change.Value),
+			),
+		)
+	}
+}
+
+// setPendingParamChange stores a pending param change by apply height and key
+// WARNING: Do not make this function public
+func (k Keeper) setPendingParamChange(ctx sdk.Context, change types.PendingParamChange) {
+	key := []byte(types.GetPendingParamChangeKey(change.ApplyHeight, change.Key))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&change))
+}
+
+// deletePendingParamChange deletes a pending param change
+// WARNING: Do not make this function public
+func (k Keeper) deletePendingParamChange(ctx sdk.Context, change types.PendingParamChange) {
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetPendingParamChangeKey(change.ApplyHeight, change.Key)))
+}
+
+// GetPendingParamChanges returns the pending param changes by ascending apply height
+func (k Keeper) GetPendingParamChanges(ctx sdk.Context) []types.PendingParamChange {
+	var changes []types.PendingParamChange
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingParamChangeKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var change types.PendingParamChange
+		k.cdc.MustUnmarshal(iter.Value(), &change)
+		changes = append(changes, change)
+	}
+	return changes
+}
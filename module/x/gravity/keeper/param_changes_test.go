@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that a passed change of a critical param is kept pending until the critical param change delay has passed,
+// while the other changes of the proposal apply right away, and that pending changes survive a genesis export
+func TestCriticalParamChangeDelay(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	params.CriticalParamChangeDelay = 10
+	k.SetParams(ctx, params)
+
+	var forwarded []govtypes.Content
+	handler := NewCriticalParamChangeProposalHandler(k, func(_ sdk.Context, content govtypes.Content) error {
+		forwarded = append(forwarded, content)
+		return nil
+	})
+
+	window := paramproposal.NewParamChange(types.DefaultParamspace, string(types.ParamsStoreKeySignedValsetsWindow), `"20000"`)
+	expedited := paramproposal.NewParamChange(types.DefaultParamspace, string(types.ParamStoreExpeditedVotingPeriod), `"600"`)
+	require.NoError(t, handler(ctx, paramproposal.NewParameterChangeProposal("t", "d", []paramproposal.ParamChange{window, expedited})))
+	require.Len(t, forwarded, 1)
+	assert.Equal(t, []paramproposal.ParamChange{expedited}, forwarded[0].(*paramproposal.ParameterChangeProposal).Changes)
+
+	passedHeight := uint64(ctx.BlockHeight())
+	expected := []types.PendingParamChange{{
+		Key:          window.Key,
+		Value:        window.Value,
+		PassedHeight: passedHeight,
+		ApplyHeight:  passedHeight + 10,
+	}}
+	require.Equal(t, expected, k.GetPendingParamChanges(ctx))
+	res, err := NewQueryServerImpl(k).PendingParamChanges(sdk.WrapSDKContext(ctx), &types.QueryPendingParamChangesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, expected, res.Changes)
+
+	// an invalid critical change fails the proposal instead of being dropped later
+	invalid := paramproposal.NewParamChange(types.DefaultParamspace, string(types.ParamsStoreKeyBridgeEthereumAddress), `"invalid"`)
+	require.Error(t, handler(ctx, paramproposal.NewParameterChangeProposal("t", "d", []paramproposal.ParamChange{invalid})))
+
+	genesis := ExportGenesis(ctx, k)
+	assert.Equal(t, expected, genesis.PendingParamChanges)
+
+	ctx = ctx.WithBlockHeight(int64(passedHeight) + 9)
+	k.ApplyPendingParamChanges(ctx)
+	assert.Equal(t, params.SignedValsetsWindow, k.GetParams(ctx).SignedValsetsWindow)
+	assert.Len(t, k.GetPendingParamChanges(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(int64(passedHeight) + 10)
+	k.ApplyPendingParamChanges(ctx)
+	assert.Equal(t, uint64(20000), k.GetParams(ctx).SignedValsetsWindow)
+	assert.Empty(t, k.GetPendingParamChanges(ctx))
+
+	// without a delay the whole proposal is forwarded
+	params = k.GetParams(ctx)
+	params.CriticalParamChangeDelay = 0
+	k.SetParams(ctx, params)
+	forwarded = nil
+	proposal := paramproposal.NewParameterChangeProposal("t", "d", []paramproposal.ParamChange{window})
+	require.NoError(t, handler(ctx, proposal))
+	assert.Equal(t, []govtypes.Content{proposal}, forwarded)
+	assert.Empty(t, k.GetPendingParamChanges(ctx))
+
+	imported := CreateTestEnv(t)
+	InitGenesis(imported.Context, imported.GravityKeeper, genesis)
+	assert.Equal(t, expected, imported.GravityKeeper.GetPendingParamChanges(imported.Context))
+}
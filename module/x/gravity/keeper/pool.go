@@ -12,6 +12,12 @@ import (
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
 
+// OutgoingTxPoolInsertionGas is charged for every transaction added to the pool. The EndBlocker
+// deletes pool entries, or puts them back into the pool, once the attestation of their batch is
+// observed, and no transaction pays for that work, so the sender pays for it upfront. It is
+// consumed in the message handler like the store gas, so simulating the transaction estimates it.
+const OutgoingTxPoolInsertionGas = 5000
+
 // AddToOutgoingPool creates a transaction and adds it to the pool, returns the id of the unbatched transaction
 // - checks a counterpart denominator exists for the given voucher type
 // - burns the voucher for transfer amount and fees
@@ -24,26 +30,125 @@ func (k Keeper) AddToOutgoingPool(
 	amount sdk.Coin,
 	fee sdk.Coin,
 ) (uint64, error) {
+	return k.addToOutgoingPool(ctx, sender, counterpartReceiver, amount, fee, nil, 0)
+}
+
+// AddToOutgoingPoolWithRelayFee is AddToOutgoingPool for a transaction that also carries a relay fee,
+// the relay fee is held by the module and paid to the relayer once the transaction's batch is executed
+func (k Keeper) AddToOutgoingPoolWithRelayFee(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+	relayFee sdk.Coin,
+) (uint64, error) {
+	if err := types.ValidateRelayFee(relayFee); err != nil {
+		return 0, err
+	}
+	return k.addToOutgoingPool(ctx, sender, counterpartReceiver, amount, fee, &relayFee, 0)
+}
+
+// ScheduleToOutgoingPool is AddToOutgoingPool for a transaction which only enters the pool, and becomes
+// eligible for batching, once executeAfterHeight is reached. The funds are locked immediately and the
+// transaction can be cancelled while it waits. The relay fee is optional
+func (k Keeper) ScheduleToOutgoingPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+	relayFee *sdk.Coin,
+	executeAfterHeight uint64,
+) (uint64, error) {
+	if relayFee != nil {
+		if err := types.ValidateRelayFee(*relayFee); err != nil {
+			return 0, err
+		}
+	}
+	return k.addToOutgoingPool(ctx, sender, counterpartReceiver, amount, fee, relayFee, executeAfterHeight)
+}
+
+func (k Keeper) addToOutgoingPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+	relayFee *sdk.Coin,
+	executeAfterHeight uint64,
+) (uint64, error) {
+	tokenContract, err := k.checkOutgoingTx(ctx, sender, counterpartReceiver, amount, fee)
+	if err != nil {
+		return 0, err
+	}
+
+	// lock coins in the unbatched pool
+	totalInVouchers := sdk.Coins{amount.Add(fee)}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.UnbatchedPoolAccountName, totalInVouchers); err != nil {
+		return 0, err
+	}
+	// hold the relay fee in the fees account until the batch is executed
+	if relayFee != nil {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.FeesAccountName, sdk.NewCoins(*relayFee)); err != nil {
+			return 0, sdkerrors.Wrap(err, "relay fee")
+		}
+	}
+
+	return k.storeOutgoingTx(ctx, sender, counterpartReceiver, *tokenContract, amount, fee, relayFee, executeAfterHeight)
+}
+
+// checkOutgoingTx checks the arguments of a transaction entering the pool and passes it to the screening
+// keeper, it returns the ERC20 the amount is sent as
+func (k Keeper) checkOutgoingTx(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+) (*types.EthAddress, error) {
 	if ctx.IsZero() || sdk.VerifyAddressFormat(sender) != nil || counterpartReceiver.ValidateBasic() != nil ||
 		!amount.IsValid() || !fee.IsValid() || fee.Denom != amount.Denom {
-		return 0, sdkerrors.Wrap(types.ErrInvalid, "arguments")
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	// the amount and fee are locked together and transferred together by Gravity.sol
+	if _, err := types.AddUint256(amount.Amount, fee.Amount); err != nil {
+		return nil, sdkerrors.Wrap(err, "amount and fee")
+	}
+	ctx.GasMeter().ConsumeGas(OutgoingTxPoolInsertionGas, "outgoing tx pool insertion")
+	if err := k.screeningKeeper.ScreenSendToEth(ctx, sender, counterpartReceiver, amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrScreened, err.Error())
 	}
-	totalAmount := amount.Add(fee)
-	totalInVouchers := sdk.Coins{totalAmount}
 
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
 	// If there is, lock the coins.
 
-	_, tokenContract, err := k.DenomToERC20Lookup(ctx, totalAmount.Denom)
+	_, tokenContract, err := k.DenomToERC20Lookup(ctx, amount.Denom)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-
-	// lock coins in module
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
-		return 0, err
+	if k.IsWithdrawalPaused(ctx, *tokenContract) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPaused, "withdrawals of %s", tokenContract.GetAddress())
 	}
+	if err := k.checkContractReceiver(ctx, sender, counterpartReceiver); err != nil {
+		return nil, err
+	}
+	return tokenContract, nil
+}
 
+// storeOutgoingTx creates a transaction whose amount, bridge fee and relay fee are already locked and adds
+// it to the pool, or holds it back until its execute after height
+// WARNING: Do not make this function public
+func (k Keeper) storeOutgoingTx(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	tokenContract types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+	relayFee *sdk.Coin,
+	executeAfterHeight uint64,
+) (uint64, error) {
 	// get next tx id from keeper
 	nextID := k.autoIncrementID(ctx, []byte(types.KeyLastTXPoolID))
 
@@ -70,10 +175,13 @@ func (k Keeper) AddToOutgoingPool(
 	if err != nil { // This should never happen since all the components are validated
 		panic(sdkerrors.Wrap(err, "unable to create InternalOutgoingTransferTx"))
 	}
+	outgoing.RelayFee = relayFee
 
-	// add a second index with the fee
-	err = k.addUnbatchedTX(ctx, outgoing)
-	if err != nil {
+	// add a second index with the fee, or hold the transaction back until its execute after height
+	scheduled := executeAfterHeight > uint64(ctx.BlockHeight())
+	if scheduled {
+		k.setScheduledTX(ctx, executeAfterHeight, outgoing)
+	} else if err := k.addUnbatchedTX(ctx, outgoing); err != nil {
 		panic(err)
 	}
 
@@ -88,6 +196,9 @@ func (k Keeper) AddToOutgoingPool(
 		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(nextID))),
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
 	)
+	if scheduled {
+		poolEvent = poolEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyExecuteAfterHeight, fmt.Sprint(executeAfterHeight)))
+	}
 	ctx.EventManager().EmitEvent(poolEvent)
 
 	return nextID, nil
@@ -95,15 +206,33 @@ func (k Keeper) AddToOutgoingPool(
 
 // RemoveFromOutgoingPoolAndRefund
 // - checks that the provided tx actually exists
-// - deletes the unbatched tx from the pool
+// - deletes the unbatched or scheduled tx from the pool
 // - issues the tokens back to the sender
 func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, sender sdk.AccAddress) error {
+	return k.removeFromOutgoingPool(ctx, txId, sender, func(fromModule string, refund sdk.Coins) error {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, fromModule, sender, refund)
+	})
+}
+
+// removeFromOutgoingPool is RemoveFromOutgoingPoolAndRefund with the refunds of the escrowed coins held by
+// fromModule made through refund, so that a module account sender can be refunded
+func (k Keeper) removeFromOutgoingPool(
+	ctx sdk.Context,
+	txId uint64,
+	sender sdk.AccAddress,
+	refund func(fromModule string, coins sdk.Coins) error,
+) error {
 	if ctx.IsZero() || txId < 1 || sdk.VerifyAddressFormat(sender) != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, "arguments")
 	}
 	// check that we actually have a tx with that id and what it's details are
 	tx, err := k.GetUnbatchedTxById(ctx, txId)
-	if err != nil {
+	scheduled := k.GetScheduledTxById(ctx, txId)
+	if scheduled != nil {
+		if tx, err = scheduled.Transfer.ToInternal(); err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid scheduled tx %d in store", txId))
+		}
+	} else if err != nil {
 		return sdkerrors.Wrapf(err, "unknown transaction with id %d from sender %s", txId, sender.String())
 	}
 
@@ -120,25 +249,31 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 	}
 
 	// delete this tx from the pool
-	err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, txId)
-	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
-	}
-	// Make sure the tx was removed
-	oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
-	if oldTx != nil || oldTxErr == nil {
-		return sdkerrors.Wrapf(types.ErrInvalid, "tx with id %d was not fully removed from the pool, a duplicate must exist", txId)
+	if scheduled != nil {
+		k.removeScheduledTX(ctx, scheduled.ExecuteAfterHeight, txId)
+	} else {
+		err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, txId)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
+		}
+		// Make sure the tx was removed
+		oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
+		if oldTx != nil || oldTxErr == nil {
+			return sdkerrors.Wrapf(types.ErrInvalid, "tx with id %d was not fully removed from the pool, a duplicate must exist", txId)
+		}
 	}
+	k.deletePoolEntryHeight(ctx, txId)
 
-	// Calculate refund
-	totalToRefund := tx.Erc20Token.GravityCoin()
-	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount)
-	totalToRefundCoins := sdk.NewCoins(totalToRefund)
-
-	// Perform refund
-	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, totalToRefundCoins); err != nil {
+	// Perform refund, of the amount and fee in the denom they were sent in, and of the relay fee
+	totalToRefund := sdk.NewCoins(k.transferEscrow(ctx, tx))
+	if err = refund(types.UnbatchedPoolAccountName, totalToRefund); err != nil {
 		return sdkerrors.Wrap(err, "transfer vouchers")
 	}
+	if tx.RelayFee != nil {
+		if err = refund(types.FeesAccountName, sdk.NewCoins(*tx.RelayFee)); err != nil {
+			return sdkerrors.Wrap(err, "refund relay fee")
+		}
+	}
 
 	poolEvent := sdk.NewEvent(
 		types.EventTypeBridgeWithdrawCanceled,
@@ -168,6 +303,7 @@ func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTrans
 	}
 
 	store.Set(idxKey, bz)
+	k.setPoolEntryHeight(ctx, val.Id)
 	return err
 }
 
@@ -269,9 +405,9 @@ func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, prefixKey []byte,
 // GetBatchFeeByTokenType gets the fee the next batch of a given token type would
 // have if created right now. This info is both presented to relayers for the purpose of determining
 // when to request batches and also used by the batch creation process to decide not to create
-// a new batch (fees must be increasing)
+// a new batch (fees must be increasing). It stops at the batch size, leaving the totals of the pool at zero
 func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, tokenContractAddr types.EthAddress, maxElements uint) *types.BatchFees {
-	batchFee := types.BatchFees{Token: tokenContractAddr.GetAddress(), TotalFees: sdk.NewInt(0), TxCount: 0}
+	batchFee := types.BatchFees{Token: tokenContractAddr.GetAddress(), TotalFees: sdk.NewInt(0), TxCount: 0, RelayFees: sdk.NewCoins(), PoolTotalFees: sdk.NewInt(0)}
 
 	k.IterateUnbatchedTransactions(ctx, []byte(types.GetOutgoingTxPoolContractPrefix(tokenContractAddr)), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		if !k.IsOnBlacklist(ctx, *tx.DestAddress) {
@@ -280,6 +416,9 @@ func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, tokenContractAddr types.
 				panic(fmt.Errorf("unexpected fee contract %s when getting batch fees for contract %s", fee.Contract, tokenContractAddr))
 			}
 			batchFee.TotalFees = batchFee.TotalFees.Add(fee.Amount)
+			if tx.RelayFee != nil {
+				batchFee.RelayFees = batchFee.RelayFees.Add(*tx.RelayFee)
+			}
 			batchFee.TxCount += 1
 			return batchFee.TxCount == uint64(maxElements)
 		} else {
@@ -291,7 +430,8 @@ func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, tokenContractAddr types.
 	return &batchFee
 }
 
-// GetAllBatchFees creates a fee entry for every batch type currently in the store
+// GetAllBatchFees creates a fee entry for every batch type currently in the store, with the fees of the next batch of
+// up to maxElements transactions and of the whole pool of the token
 // this can be used by relayers to determine what batch types are desireable to request
 func (k Keeper) GetAllBatchFees(ctx sdk.Context, maxElements uint) (batchFees []types.BatchFees) {
 	batchFeesMap := k.createBatchFees(ctx, maxElements)
@@ -319,16 +459,28 @@ func (k Keeper) createBatchFees(ctx sdk.Context, maxElements uint) map[string]ty
 		feeAddrStr := tx.Erc20Fee.Contract.GetAddress()
 
 		if fees, ok := batchFeesMap[feeAddrStr]; ok {
+			fees.PoolTotalFees = fees.PoolTotalFees.Add(tx.Erc20Fee.Amount)
+			fees.PoolTxCount++
 			if fees.TxCount < uint64(maxElements) {
 				fees.TotalFees = batchFeesMap[feeAddrStr].TotalFees.Add(tx.Erc20Fee.Amount)
+				if tx.RelayFee != nil {
+					fees.RelayFees = fees.RelayFees.Add(*tx.RelayFee)
+				}
 				fees.TxCount = fees.TxCount + 1
-				batchFeesMap[feeAddrStr] = fees
 			}
+			batchFeesMap[feeAddrStr] = fees
 		} else {
+			relayFees := sdk.NewCoins()
+			if tx.RelayFee != nil {
+				relayFees = relayFees.Add(*tx.RelayFee)
+			}
 			batchFeesMap[feeAddrStr] = types.BatchFees{
-				Token:     feeAddrStr,
-				TotalFees: tx.Erc20Fee.Amount,
-				TxCount:   1,
+				Token:         feeAddrStr,
+				TotalFees:     tx.Erc20Fee.Amount,
+				TxCount:       1,
+				RelayFees:     relayFees,
+				PoolTotalFees: tx.Erc20Fee.Amount,
+				PoolTxCount:   1,
 			}
 		}
 
@@ -349,10 +501,14 @@ func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
 	return id
 }
 
-// gets a generic uint64 counter from the store, initializing to 1 if no value exists
+// gets a generic uint64 counter from the store, zero if no value exists, e.g. for a counter
+// added after the chain started
 func (k Keeper) getID(ctx sdk.Context, idKey []byte) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(idKey)
+	if len(bz) == 0 {
+		return 0
+	}
 	id := binary.BigEndian.Uint64(bz)
 	return id
 }
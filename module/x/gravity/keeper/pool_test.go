@@ -12,6 +12,136 @@ import (
 	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
 )
 
+// screenReceiver vetoes the transfers to a single receiver
+type screenReceiver struct {
+	receiver types.EthAddress
+}
+
+func (s screenReceiver) ScreenSendToEth(_ sdk.Context, _ sdk.AccAddress, receiver types.EthAddress, _ sdk.Coin) error {
+	if receiver == s.receiver {
+		return fmt.Errorf("receiver %s is sanctioned", receiver.GetAddress())
+	}
+	return nil
+}
+
+// Tests that a screening keeper vetoes transfers before they enter the pool
+func TestAddToOutgoingPoolScreening(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		sanctioned, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myReceiver, _       = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	funds := sdk.NewCoins(token.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, funds))
+	amount := sdk.NewInt64Coin(token.GravityCoin().Denom, 100)
+	fee := sdk.NewInt64Coin(token.GravityCoin().Denom, 1)
+
+	// the default screening accepts every transfer
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *sanctioned, amount, fee)
+	require.NoError(t, err)
+
+	input.GravityKeeper.SetScreeningKeeper(screenReceiver{receiver: *sanctioned})
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *sanctioned, amount, fee)
+	require.ErrorIs(t, err, types.ErrScreened)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+	require.NoError(t, err)
+
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 2)
+	require.Equal(t, sdk.NewInt(798), input.BankKeeper.GetBalance(ctx, mySender, amount.Denom).Amount)
+}
+
+// Tests that the transfers of a withdrawal paused token are rejected while the transfers in the pool wait for it
+func TestAddToOutgoingPoolWithdrawalPaused(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	funds := sdk.NewCoins(token.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, funds))
+	amount := sdk.NewInt64Coin(token.GravityCoin().Denom, 100)
+	fee := sdk.NewInt64Coin(token.GravityCoin().Denom, 1)
+
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+	require.NoError(t, err)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.WithdrawalPausedTokens = []string{myTokenContractAddr}
+	input.GravityKeeper.SetParams(ctx, params)
+	require.True(t, input.GravityKeeper.IsWithdrawalPaused(ctx, token.Contract))
+
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, token.Contract, OutgoingTxBatchSize)
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 1)
+
+	params.WithdrawalPausedTokens = []string{}
+	input.GravityKeeper.SetParams(ctx, params)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, token.Contract, OutgoingTxBatchSize)
+	require.NoError(t, err)
+}
+
+// Tests that scheduled transfers lock their funds but only enter the pool at their execute after height
+func TestScheduleToOutgoingPool(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(100)
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	denom := token.GravityCoin().Denom
+	funds := sdk.NewCoins(token.GravityCoin(), sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, funds))
+	amount := sdk.NewInt64Coin(denom, 100)
+	fee := sdk.NewInt64Coin(denom, 1)
+	relayFee := sdk.NewInt64Coin("stake", 10)
+
+	first, err := input.GravityKeeper.ScheduleToOutgoingPool(ctx, mySender, *myReceiver, amount, fee, &relayFee, 110)
+	require.NoError(t, err)
+	second, err := input.GravityKeeper.ScheduleToOutgoingPool(ctx, mySender, *myReceiver, amount, fee, nil, 105)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(798), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
+	require.Len(t, input.GravityKeeper.GetScheduledTransactions(ctx), 2)
+	require.Len(t, ExportGenesis(ctx, input.GravityKeeper).ScheduledTransfers, 2)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// nothing is released before the execute after height
+	input.GravityKeeper.ReleaseScheduledTransactions(ctx.WithBlockHeight(104))
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
+
+	ctx = ctx.WithBlockHeight(105)
+	input.GravityKeeper.ReleaseScheduledTransactions(ctx)
+	unbatched := input.GravityKeeper.GetUnbatchedTransactions(ctx)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, second, unbatched[0].Id)
+	require.Nil(t, input.GravityKeeper.GetScheduledTxById(ctx, second))
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// a scheduled transfer is refunded with its relay fee when cancelled
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, first, mySender))
+	require.Empty(t, input.GravityKeeper.GetScheduledTransactions(ctx))
+	require.Equal(t, sdk.NewInt(899), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, mySender, "stake").Amount)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+}
+
 // Tests that the pool is populated with the created transactions before any batch is created
 func TestAddToOutgoingPool(t *testing.T) {
 	input := CreateTestEnv(t)
@@ -258,6 +388,11 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 	assert.Equal(t, batchFees[0].TxCount, uint64(4))
 	assert.Equal(t, batchFees[1].TotalFees.BigInt(), big.NewInt(int64(500)))
 	assert.Equal(t, batchFees[1].TxCount, uint64(100))
+	// the whole pool is counted past the batch size
+	assert.Equal(t, batchFees[0].PoolTotalFees, batchFees[0].TotalFees)
+	assert.Equal(t, batchFees[0].PoolTxCount, uint64(4))
+	assert.Equal(t, batchFees[1].PoolTotalFees.BigInt(), big.NewInt(int64(550)))
+	assert.Equal(t, batchFees[1].PoolTxCount, uint64(110))
 
 }
 
@@ -896,3 +1031,56 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 		require.True(t, v)
 	}
 }
+
+// Tests that a bridge fee paid in a whitelisted denom is exchanged with the community pool
+func TestExchangeBridgeFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	voucher, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	tokenDenom := voucher.GravityCoin().Denom
+
+	// fund the community pool with the bridged token and the sender with the fee denom
+	funder := RandomAccAddress()
+	input.AccountKeeper.NewAccountWithAddress(ctx, funder)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(voucher.GravityCoin(), sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, sdk.NewCoins(voucher.GravityCoin())))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, input.DistKeeper.FundCommunityPool(ctx, sdk.NewCoins(voucher.GravityCoin()), funder))
+
+	// a fee in the sent denom is untouched
+	fee, err := input.GravityKeeper.ExchangeBridgeFee(ctx, mySender, sdk.NewInt64Coin(tokenDenom, 5), tokenDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(tokenDenom, 5), fee)
+
+	// a fee in a denom without a rate is rejected
+	_, err = input.GravityKeeper.ExchangeBridgeFee(ctx, mySender, sdk.NewInt64Coin("stake", 10), tokenDenom)
+	require.Error(t, err)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgeFeeExchangeRates = []types.BridgeFeeExchangeRate{
+		{FeeDenom: "stake", TokenDenom: tokenDenom, Rate: sdk.NewDecWithPrec(25, 1)},
+	}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// too small to be worth any of the sent denom
+	_, err = input.GravityKeeper.ExchangeBridgeFee(ctx, mySender, sdk.NewInt64Coin("stake", 0), tokenDenom)
+	require.Error(t, err)
+
+	fee, err = input.GravityKeeper.ExchangeBridgeFee(ctx, mySender, sdk.NewInt64Coin("stake", 10), tokenDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(tokenDenom, 25), fee)
+	require.Equal(t, sdk.NewInt64Coin("stake", 90), input.BankKeeper.GetBalance(ctx, mySender, "stake"))
+	require.Equal(t, sdk.NewInt64Coin(tokenDenom, 25), input.BankKeeper.GetBalance(ctx, mySender, tokenDenom))
+
+	// the community pool can not give out more than it holds
+	params.BridgeFeeExchangeRates[0].Rate = sdk.NewDec(100)
+	input.GravityKeeper.SetParams(ctx, params)
+	_, err = input.GravityKeeper.ExchangeBridgeFee(ctx, mySender, sdk.NewInt64Coin("stake", 90), tokenDenom)
+	require.Error(t, err)
+}
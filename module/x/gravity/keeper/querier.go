@@ -15,7 +15,7 @@ const (
 )
 
 // NewQuerier is the module level router for state queries
-func NewQuerier(keeper Keeper) sdk.Querier {
+func NewQuerier(keeper ReadOnlyKeeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
 		switch path[0] {
 
@@ -30,7 +30,7 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 	}
 }
 
-func queryCurrentValset(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+func queryCurrentValset(ctx sdk.Context, keeper ReadOnlyKeeper) ([]byte, error) {
 	valset, err := keeper.GetCurrentValset(ctx)
 	if err != nil {
 		return nil, err
@@ -43,7 +43,7 @@ func queryCurrentValset(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 	return res, nil
 }
 
-func queryGravityID(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+func queryGravityID(ctx sdk.Context, keeper ReadOnlyKeeper) ([]byte, error) {
 	gravityID := keeper.GetGravityID(ctx)
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, gravityID)
 	if err != nil {
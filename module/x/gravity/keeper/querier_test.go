@@ -9,6 +9,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -67,7 +68,7 @@ func TestQueryValsetConfirm(t *testing.T) {
 
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			got, err := k.ValsetConfirm(ctx, &spec.src)
+			got, err := NewQueryServerImpl(k).ValsetConfirm(ctx, &spec.src)
 			if spec.expErr {
 				require.Error(t, err)
 				return
@@ -140,7 +141,7 @@ func TestAllValsetConfirmsBynonce(t *testing.T) {
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			got, err := k.ValsetConfirmsByNonce(ctx, &types.QueryValsetConfirmsByNonceRequest{Nonce: spec.src.Nonce})
+			got, err := NewQueryServerImpl(k).ValsetConfirmsByNonce(ctx, &types.QueryValsetConfirmsByNonceRequest{Nonce: spec.src.Nonce})
 			if spec.expErr {
 				require.Error(t, err)
 				return
@@ -305,7 +306,7 @@ func TestLastValsetRequests(t *testing.T) {
 	k := input.GravityKeeper
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			got, err := k.LastValsetRequests(ctx, &types.QueryLastValsetRequestsRequest{})
+			got, err := NewQueryServerImpl(k).LastValsetRequests(ctx, &types.QueryLastValsetRequestsRequest{})
 			require.NoError(t, err)
 			assert.Equal(t, &spec.expResp, got)
 		})
@@ -473,7 +474,7 @@ func TestPendingValsetRequests(t *testing.T) {
 		t.Run(msg, func(t *testing.T) {
 			req := new(types.QueryLastPendingValsetRequestByAddrRequest)
 			req.Address = valAddr.String()
-			got, err := input.GravityKeeper.LastPendingValsetRequestByAddr(ctx, req)
+			got, err := NewQueryServerImpl(input.GravityKeeper).LastPendingValsetRequestByAddr(ctx, req)
 			require.NoError(t, err)
 			assert.Equal(t, &spec.expResp, got, got)
 		})
@@ -526,6 +527,10 @@ func TestLastPendingBatchRequest(t *testing.T) {
 					Block:         1235067,
 				},
 			},
+				FormattedTransfers: []types.FormattedTransfer{
+					formattedTransfer(testBatchContract, 2, 101, 3),
+					formattedTransfer(testBatchContract, 3, 102, 2),
+				},
 			},
 		},
 	}
@@ -539,7 +544,7 @@ func TestLastPendingBatchRequest(t *testing.T) {
 		t.Run(msg, func(t *testing.T) {
 			req := new(types.QueryLastPendingBatchRequestByAddrRequest)
 			req.Address = valAddr.String()
-			got, err := input.GravityKeeper.LastPendingBatchRequestByAddr(ctx, req)
+			got, err := NewQueryServerImpl(input.GravityKeeper).LastPendingBatchRequestByAddr(ctx, req)
 			require.NoError(t, err)
 			assert.Equal(t, &spec.expResp, got, got)
 		})
@@ -615,7 +620,7 @@ func TestQueryAllBatchConfirms(t *testing.T) {
 		Signature:     "signature",
 	})
 
-	batchConfirms, err := k.BatchConfirms(ctx, &types.QueryBatchConfirmsRequest{Nonce: 1, ContractAddress: tokenContract})
+	batchConfirms, err := NewQueryServerImpl(k).BatchConfirms(ctx, &types.QueryBatchConfirmsRequest{Nonce: 1, ContractAddress: tokenContract})
 	require.NoError(t, err)
 
 	expectedRes := types.QueryBatchConfirmsResponse{
@@ -682,11 +687,11 @@ func TestQueryLogicCalls(t *testing.T) {
 
 	require.Equal(t, call, *res)
 
-	_, err := k.OutgoingLogicCalls(ctx, &types.QueryOutgoingLogicCallsRequest{})
+	_, err := NewQueryServerImpl(k).OutgoingLogicCalls(ctx, &types.QueryOutgoingLogicCallsRequest{})
 	require.NoError(t, err)
 
 	var valAddr sdk.AccAddress = bytes.Repeat([]byte{byte(1)}, 20)
-	_, err = k.LastPendingLogicCallByAddr(ctx, &types.QueryLastPendingLogicCallByAddrRequest{Address: valAddr.String()})
+	_, err = NewQueryServerImpl(k).LastPendingLogicCallByAddr(ctx, &types.QueryLastPendingLogicCallByAddrRequest{Address: valAddr.String()})
 	require.NoError(t, err)
 
 	require.NoError(t, err)
@@ -768,11 +773,11 @@ func TestQueryBatch(t *testing.T) {
 	createTestBatch(t, input, mySender, 2)
 	sender := mySender.String()
 
-	batch, err := k.BatchRequestByNonce(ctx, &types.QueryBatchRequestByNonceRequest{Nonce: 1, ContractAddress: tokenContract})
+	batch, err := NewQueryServerImpl(k).BatchRequestByNonce(ctx, &types.QueryBatchRequestByNonceRequest{Nonce: 1, ContractAddress: tokenContract})
 	require.NoError(t, err)
 
 	expectedRes := types.QueryBatchRequestByNonceResponse{
-		types.OutgoingTxBatch{
+		Batch: types.OutgoingTxBatch{
 			BatchTimeout: 0,
 			Transactions: []types.OutgoingTransferTx{
 				{
@@ -806,6 +811,10 @@ func TestQueryBatch(t *testing.T) {
 			Block:         1234567,
 			TokenContract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 		},
+		FormattedTransfers: []types.FormattedTransfer{
+			formattedTransfer(testBatchContract, 2, 101, 3),
+			formattedTransfer(testBatchContract, 3, 102, 2),
+		},
 	}
 
 	// TODO: this test is failing on the empty representation of valset members
@@ -825,11 +834,11 @@ func TestLastBatchesRequest(t *testing.T) {
 	sender2 := mySender2.String()
 	sender3 := mySender3.String()
 
-	lastBatches, err := k.OutgoingTxBatches(ctx, &types.QueryOutgoingTxBatchesRequest{})
+	lastBatches, err := NewQueryServerImpl(k).OutgoingTxBatches(ctx, &types.QueryOutgoingTxBatchesRequest{})
 	require.NoError(t, err)
 
 	expectedRes := types.QueryOutgoingTxBatchesResponse{
-		[]types.OutgoingTxBatch{
+		Batches: []types.OutgoingTxBatch{
 			{
 				BatchTimeout: 0,
 				Transactions: []types.OutgoingTransferTx{
@@ -912,11 +921,30 @@ func TestLastBatchesRequest(t *testing.T) {
 				TokenContract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 			},
 		},
+		FormattedTransfers: []types.FormattedTransfer{
+			formattedTransfer(testBatchContract, 6, 101, 3),
+			formattedTransfer(testBatchContract, 7, 102, 2),
+			formattedTransfer(testBatchContract, 5, 100, 2),
+			formattedTransfer(testBatchContract, 2, 101, 3),
+			formattedTransfer(testBatchContract, 3, 102, 2),
+		},
 	}
 
 	assert.Equal(t, &expectedRes, lastBatches, "json is equal")
 }
 
+// testBatchContract is the token contract of the batches built by createTestBatch
+const testBatchContract = "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
+
+// formattedTransfer returns the formatted transfer of a token without denom metadata
+func formattedTransfer(contract string, id uint64, amount, fee int64) types.FormattedTransfer {
+	return types.FormattedTransfer{
+		Id:     id,
+		Amount: types.NewFormattedAmount(types.NewSDKIntERC20Token(sdk.NewInt(amount), contract), 0, false),
+		Fee:    types.NewFormattedAmount(types.NewSDKIntERC20Token(sdk.NewInt(fee), contract), 0, false),
+	}
+}
+
 //nolint: exhaustivestruct
 // tests setting and querying eth address and orchestrator addresses
 func TestQueryCurrentValset(t *testing.T) {
@@ -976,7 +1004,7 @@ func TestQueryERC20ToDenom(t *testing.T) {
 	k := input.GravityKeeper
 	input.GravityKeeper.setCosmosOriginatedDenomToERC20(sdkCtx, denom, *erc20)
 
-	queriedDenom, err := k.ERC20ToDenom(ctx, &types.QueryERC20ToDenomRequest{erc20.GetAddress()})
+	queriedDenom, err := NewQueryServerImpl(k).ERC20ToDenom(ctx, &types.QueryERC20ToDenomRequest{erc20.GetAddress()})
 	require.NoError(t, err)
 
 	assert.Equal(t, &response, queriedDenom)
@@ -999,7 +1027,7 @@ func TestQueryDenomToERC20(t *testing.T) {
 	k := input.GravityKeeper
 	input.GravityKeeper.setCosmosOriginatedDenomToERC20(sdkCtx, denom, *erc20)
 
-	queriedERC20, err := k.DenomToERC20(ctx, &types.QueryDenomToERC20Request{denom})
+	queriedERC20, err := NewQueryServerImpl(k).DenomToERC20(ctx, &types.QueryDenomToERC20Request{denom})
 	require.NoError(t, err)
 
 	assert.Equal(t, &response, queriedERC20)
@@ -1061,7 +1089,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 	require.NoError(t, err)
 
 	// Should receive 1 and 4 unbatched, 2 and 3 batched in response
-	response, err := k.GetPendingSendToEth(ctx, &types.QueryPendingSendToEth{mySender.String()})
+	response, err := NewQueryServerImpl(k).GetPendingSendToEth(ctx, &types.QueryPendingSendToEth{mySender.String()})
 	require.NoError(t, err)
 	expectedRes := types.QueryPendingSendToEthResponse{TransfersInBatches: []types.OutgoingTransferTx{
 		{
@@ -1120,7 +1148,81 @@ func TestQueryPendingSendToEth(t *testing.T) {
 				},
 			},
 		},
+		FormattedTransfers: []types.FormattedTransfer{
+			formattedTransfer(myTokenContractAddr, 2, 101, 3),
+			formattedTransfer(myTokenContractAddr, 3, 102, 2),
+			formattedTransfer(myTokenContractAddr, 1, 100, 2),
+			formattedTransfer(myTokenContractAddr, 4, 103, 1),
+		},
 	}
 
 	assert.Equal(t, &expectedRes, response, "json is equal")
 }
+
+//nolint: exhaustivestruct
+func TestGetTokenDecimals(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		cosmosERC20, _  = types.NewEthAddress("0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255")
+		voucherERC20, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	k.setCosmosOriginatedDenomToERC20(ctx, "uatom", *cosmosERC20)
+	input.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	})
+
+	decimals, known := k.GetTokenDecimals(ctx, *cosmosERC20)
+	require.True(t, known)
+	require.Equal(t, uint32(6), decimals)
+	_, known = k.GetTokenDecimals(ctx, *voucherERC20)
+	require.False(t, known)
+
+	formatter := k.newAmountFormatter(ctx)
+	formatted := formatter.format(types.NewSDKIntERC20Token(sdk.NewInt(1500000), cosmosERC20.GetAddress()))
+	require.Equal(t, types.FormattedAmount{Contract: cosmosERC20.GetAddress(), Raw: sdk.NewInt(1500000), Decimals: 6, Display: "1.5"}, formatted)
+	formatted = formatter.format(types.NewSDKIntERC20Token(sdk.NewInt(1500000), voucherERC20.GetAddress()))
+	require.Equal(t, "", formatted.Display)
+}
+
+// Tests that the bootstrap info of an orchestrator bundles its delegate keys and the last observed state, and that an
+// orchestrator which did not register delegate keys is reported as unregistered
+func TestQueryBootstrapInfo(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	k.SetLastObservedEthereumBlockHeight(ctx, 1234)
+	k.setLastObservedEventNonce(ctx, 7)
+	k.SetLastEventNonceByValidator(ctx, ValAddrs[0], 6)
+
+	res, err := NewQueryServerImpl(k).BootstrapInfo(sdk.WrapSDKContext(ctx), &types.QueryBootstrapInfoRequest{OrchestratorAddress: OrchAddrs[0].String()})
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	assert.Equal(t, params, res.Params)
+	assert.Equal(t, params.BridgeEthereumAddress, res.BridgeEthereumAddress)
+	assert.Equal(t, k.GetGravityID(ctx), res.GravityId)
+	assert.Equal(t, uint64(1234), res.LastObservedEthHeight.EthereumBlockHeight)
+	assert.Equal(t, uint64(7), res.LastObservedEventNonce)
+	assert.Nil(t, res.LastObservedValset)
+	assert.True(t, res.Registered)
+	assert.Equal(t, ValAddrs[0].String(), res.ValidatorAddress)
+	assert.Equal(t, EthAddrs[0].String(), res.EthAddress)
+	assert.Equal(t, uint64(6), res.LastEventNonce)
+	currentValset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, currentValset, res.CurrentValset)
+
+	res, err = NewQueryServerImpl(k).BootstrapInfo(sdk.WrapSDKContext(ctx), &types.QueryBootstrapInfoRequest{OrchestratorAddress: RandomAccAddress().String()})
+	require.NoError(t, err)
+	assert.False(t, res.Registered)
+	assert.Empty(t, res.ValidatorAddress)
+	assert.Len(t, res.CurrentValset.Members, 5)
+
+	_, err = NewQueryServerImpl(k).BootstrapInfo(sdk.WrapSDKContext(ctx), &types.QueryBootstrapInfoRequest{OrchestratorAddress: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// The reasons set in the ErrorInfo details of the NotFound errors of the gravity queries
+const (
+	QueryErrorReasonBatchNotFound              = "BATCH_NOT_FOUND"
+	QueryErrorReasonOrchestratorNotFound       = "ORCHESTRATOR_NOT_FOUND"
+	QueryErrorReasonDelegateKeysNotFound       = "DELEGATE_KEYS_NOT_FOUND"
+	QueryErrorReasonValsetRelayPackageNotFound = "VALSET_RELAY_PACKAGE_NOT_FOUND"
+)
+
+// The metadata keys of the ErrorInfo details of the NotFound errors of the gravity queries
+const (
+	QueryErrorMetadataRequestedNonce = "requested_nonce"
+	QueryErrorMetadataLatestNonce    = "latest_nonce"
+)
+
+// invalidArgumentError returns an InvalidArgument status for a request field which does not validate, the field is
+// named in the BadRequest details so that clients do not retry the request as it is
+func invalidArgumentError(field string, err error) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, err))
+	return statusWithDetails(st, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: err.Error()}},
+	})
+}
+
+// notFoundError returns a NotFound status with the reason and metadata in the ErrorInfo details, e.g. the requested
+// and latest available nonces so that clients can tell an entry which does not exist yet from one which is gone
+func notFoundError(reason string, msg string, metadata map[string]string) error {
+	st := status.New(codes.NotFound, msg)
+	return statusWithDetails(st, &errdetails.ErrorInfo{Reason: reason, Domain: types.ModuleName, Metadata: metadata})
+}
+
+// statusWithDetails returns the error of st with details, or without them if they can not be encoded
+func statusWithDetails(st *status.Status, details proto.Message) error {
+	if withDetails, err := st.WithDetails(details); err == nil {
+		return withDetails.Err()
+	}
+	return st.Err()
+}
+
+// batchNotFoundError returns the NotFound status of a batch, with the requested nonce and the nonce of the latest
+// batch built. A nonce above it may still be built, a nonce at most it was executed, pruned or is of another token
+func (k Keeper) batchNotFoundError(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) error {
+	return notFoundError(QueryErrorReasonBatchNotFound,
+		fmt.Sprintf("no batch %d of %s", nonce, tokenContract.GetAddress()),
+		map[string]string{
+			QueryErrorMetadataRequestedNonce: strconv.FormatUint(nonce, 10),
+			QueryErrorMetadataLatestNonce:    strconv.FormatUint(k.getID(ctx, []byte(types.KeyLastOutgoingBatchID)), 10),
+		})
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the queries return an InvalidArgument status naming the invalid field and a NotFound status with the
+// requested and latest nonces instead of generic errors
+func TestQueryErrorStatuses(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := sdk.WrapSDKContext(input.Context)
+	k := input.GravityKeeper
+	tokenContract := "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
+	createTestBatch(t, input, RandomAccAddress(), 2)
+
+	_, err := NewQueryServerImpl(k).BatchRequestByNonce(ctx, &types.QueryBatchRequestByNonceRequest{Nonce: 1, ContractAddress: "invalid"})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "contract_address", badRequest.FieldViolations[0].Field)
+
+	for _, query := range []func() error{
+		func() error {
+			_, err := NewQueryServerImpl(k).BatchRequestByNonce(ctx, &types.QueryBatchRequestByNonceRequest{Nonce: 5, ContractAddress: tokenContract})
+			return err
+		},
+		func() error {
+			_, err := NewQueryServerImpl(k).BatchCalldata(ctx, &types.QueryBatchCalldataRequest{Nonce: 5, ContractAddress: tokenContract})
+			return err
+		},
+	} {
+		st, ok := status.FromError(query())
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+		require.Len(t, st.Details(), 1)
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		assert.Equal(t, QueryErrorReasonBatchNotFound, info.Reason)
+		assert.Equal(t, types.ModuleName, info.Domain)
+		assert.Equal(t, map[string]string{QueryErrorMetadataRequestedNonce: "5", QueryErrorMetadataLatestNonce: "1"}, info.Metadata)
+	}
+
+	_, err = NewQueryServerImpl(k).GetDelegateKeyByEth(ctx, &types.QueryDelegateKeysByEthAddress{EthAddress: tokenContract})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = NewQueryServerImpl(k).LastEventNonceByAddr(ctx, &types.QueryLastEventNonceByAddrRequest{Address: RandomAccAddress().String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = NewQueryServerImpl(k).GetDelegateKeyByValidator(ctx, &types.QueryDelegateKeysByValidatorAddress{ValidatorAddress: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
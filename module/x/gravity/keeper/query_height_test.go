@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the pool, batch and attestation queries served from a committed version, as the baseapp does for a query
+// with a height, return the state of that version and not the latest one
+func TestQueriesAtHistoricalHeight(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	ms, ok := ctx.MultiStore().(*rootmulti.Store)
+	require.True(t, ok)
+	var (
+		mySender               = RandomAccAddress()
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	// the first version only has a transfer in the pool
+	amountToken, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr.GetAddress())
+	require.NoError(t, err)
+	feeToken, err := types.NewInternalERC20Token(sdk.NewInt(2), myTokenContractAddr.GetAddress())
+	require.NoError(t, err)
+	_, err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+	require.NoError(t, err)
+	poolVersion := ms.Commit().Version
+
+	// the second version has the transfer batched and an attestation
+	_, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+	require.NoError(t, err)
+	deposit := &types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  myTokenContractAddr.GetAddress(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: myReceiver.GetAddress(),
+		CosmosReceiver: mySender.String(),
+		Orchestrator:   mySender.String(),
+	}
+	claim, err := codectypes.NewAnyWithValue(deposit)
+	require.NoError(t, err)
+	hash, err := deposit.ClaimHash(types.ClaimEncodingVersion)
+	require.NoError(t, err)
+	k.SetAttestation(ctx, deposit.EventNonce, hash, &types.Attestation{Votes: []string{}, Claim: claim, ClaimHashVersion: types.ClaimEncodingVersion})
+	batchVersion := ms.Commit().Version
+
+	queryAt := func(version int64) sdk.Context {
+		cms, err := ms.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+		return ctx.WithMultiStore(cms).WithBlockHeight(version)
+	}
+
+	pending, err := NewQueryServerImpl(k).GetPendingSendToEth(sdk.WrapSDKContext(queryAt(poolVersion)), &types.QueryPendingSendToEth{SenderAddress: mySender.String()})
+	require.NoError(t, err)
+	assert.Len(t, pending.UnbatchedTransfers, 1)
+	assert.Empty(t, pending.TransfersInBatches)
+	batches, err := NewQueryServerImpl(k).OutgoingTxBatches(sdk.WrapSDKContext(queryAt(poolVersion)), &types.QueryOutgoingTxBatchesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, batches.Batches)
+	attestations, err := NewQueryServerImpl(k).GetAttestations(sdk.WrapSDKContext(queryAt(poolVersion)), &types.QueryAttestationsRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, attestations.Attestations)
+
+	pending, err = NewQueryServerImpl(k).GetPendingSendToEth(sdk.WrapSDKContext(queryAt(batchVersion)), &types.QueryPendingSendToEth{SenderAddress: mySender.String()})
+	require.NoError(t, err)
+	assert.Empty(t, pending.UnbatchedTransfers)
+	assert.Len(t, pending.TransfersInBatches, 1)
+	batches, err = NewQueryServerImpl(k).OutgoingTxBatches(sdk.WrapSDKContext(queryAt(batchVersion)), &types.QueryOutgoingTxBatchesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, batches.Batches, 1)
+	attestations, err = NewQueryServerImpl(k).GetAttestations(sdk.WrapSDKContext(queryAt(batchVersion)), &types.QueryAttestationsRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, attestations.Attestations, 1)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// ReadOnlyKeeper is the view of the gravity keeper with no method mutating the bridge state. It is what the query
+// server, the legacy querier and the modules only reading the bridge are given, so that a query writing to the
+// gravity store fails to compile instead of forking the nodes serving it
+type ReadOnlyKeeper interface {
+	// params
+	GetParams(ctx sdk.Context) types.Params
+	GetGravityID(ctx sdk.Context) string
+	IsBridgeActive(ctx sdk.Context) bool
+	GetBridgeBinding(ctx sdk.Context) (types.BridgeBinding, bool)
+	BridgeBindingMatchesParams(ctx sdk.Context) bool
+	GetPendingParamChanges(ctx sdk.Context) []types.PendingParamChange
+	GetModuleVersions(ctx sdk.Context) ([]types.ModuleConsensusVersion, bool, error)
+	GetAppModules(ctx sdk.Context) ([]types.MountedStore, []types.ModuleConsensusVersion, []types.OptionalModule, error)
+	GetActiveGravityProposals(ctx sdk.Context) (govtypes.Proposals, error)
+	GetGravityProposalMetadata(ctx sdk.Context, proposalID uint64) *types.GravityProposalMetadata
+	GetStoreMetrics(ctx sdk.Context) []types.StoreMetric
+
+	// valsets
+	GetCurrentValset(ctx sdk.Context) (types.Valset, error)
+	GetValset(ctx sdk.Context, nonce uint64) *types.Valset
+	GetValsets(ctx sdk.Context) []types.Valset
+	IterateValsets(ctx sdk.Context, cb func(key []byte, val *types.Valset) bool)
+	GetLatestValsetNonce(ctx sdk.Context) uint64
+	GetLastObservedValset(ctx sdk.Context) *types.Valset
+	GetEarliestNeededValset(ctx sdk.Context, params types.Params) *types.Valset
+	GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.AccAddress) *types.MsgValsetConfirm
+	GetValsetConfirms(ctx sdk.Context, nonce uint64) []types.MsgValsetConfirm
+	GetValsetRelayPackage(ctx sdk.Context, nonce uint64) *types.ValsetRelayPackage
+	GetBridgeCheckpoint(ctx sdk.Context) *types.BridgeCheckpoint
+
+	// orchestrators and validators
+	GetDelegateKeys(ctx sdk.Context) []types.MsgSetOrchestratorAddress
+	GetDelegateKeyCoverage(ctx sdk.Context) (registeredPower sdk.Int, totalPower sdk.Int, unregistered []string)
+	GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (validator stakingtypes.Validator, found bool)
+	GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddress) (ethAddress *types.EthAddress, found bool)
+	GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64
+	GetBridgeJailedHeight(ctx sdk.Context, val sdk.ValAddress) (height uint64, found bool)
+
+	// attestations
+	GetLastObservedEventNonce(ctx sdk.Context) uint64
+	GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastObservedEthereumBlockHeight
+	GetMostRecentAttestations(ctx sdk.Context, limit uint64) []types.Attestation
+	GetForkAttestations(ctx sdk.Context) []types.ForkAttestation
+	GetObservedBlockHashes(ctx sdk.Context) []types.ObservedBlockHash
+	UnpackAttestationClaim(att *types.Attestation) (types.EthereumClaim, error)
+
+	// tokens
+	DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, *types.EthAddress, error)
+	ERC20ToDenomLookup(ctx sdk.Context, tokenContract types.EthAddress) (bool, string)
+	GetTokenDecimals(ctx sdk.Context, contract types.EthAddress) (uint32, bool)
+	GetVoucherOrigin(ctx sdk.Context, denom string) (types.VoucherOrigin, error)
+	GetERC20Provenance(ctx sdk.Context, tokenContract types.EthAddress) (types.ERC20Provenance, bool)
+	GetERC20Provenances(ctx sdk.Context) []types.ERC20Provenance
+	GetERC20DeployedRejections(ctx sdk.Context, denom string) []types.ERC20DeployedRejection
+	GetTotalValueLocked(ctx sdk.Context) ([]types.TokenValueLocked, sdk.Coins)
+	GetHeldDeposits(ctx sdk.Context, cosmosReceiver string) []types.HeldDeposit
+	GetPendingIbcAutoForwards(ctx sdk.Context, limit uint64) []types.PendingIbcAutoForward
+
+	// transfers and fees
+	GetUnbatchedTransactions(ctx sdk.Context) []*types.InternalOutgoingTransferTx
+	GetAllBatchFees(ctx sdk.Context, maxElements uint) []types.BatchFees
+	GetBridgeFeeTiers(ctx sdk.Context, tokenContract types.EthAddress) *types.BridgeFeeTiers
+	GetAllBridgeFeeTiers(ctx sdk.Context) []types.BridgeFeeTiers
+	GetBridgeRoute(ctx sdk.Context, denom string, ethDest types.EthAddress) types.QueryBridgeRouteResponse
+	GetMinChainFeeBasisPoints(ctx sdk.Context) uint64
+	GetRequiredChainFee(ctx sdk.Context, amount sdk.Coin) sdk.Coin
+	GetFeeBurnShare(ctx sdk.Context) sdk.Dec
+	GetFeeBurnDenoms(ctx sdk.Context) []string
+	GetBurnedFees(ctx sdk.Context) sdk.Coins
+	GetSelfBridgeLimit(ctx sdk.Context, address sdk.AccAddress) *types.SelfBridgeLimit
+	GetUsageEpoch(ctx sdk.Context) uint64
+	GetBridgeUsage(ctx sdk.Context, epoch uint64, address sdk.AccAddress) (types.BridgeUsage, bool)
+	GetEpochBridgeUsage(ctx sdk.Context, epoch uint64) []types.BridgeUsage
+
+	// batches
+	GetOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) *types.InternalOutgoingTxBatch
+	GetOutgoingTxBatches(ctx sdk.Context) []types.InternalOutgoingTxBatch
+	IterateOutgoingTXBatches(ctx sdk.Context, cb func(key []byte, batch types.InternalOutgoingTxBatch) bool)
+	GetBatchConfirm(ctx sdk.Context, nonce uint64, tokenContract types.EthAddress, validator sdk.AccAddress) *types.MsgConfirmBatch
+	IterateBatchConfirmByNonceAndTokenContract(ctx sdk.Context, nonce uint64, tokenContract types.EthAddress, cb func([]byte, types.MsgConfirmBatch) bool)
+	GetBatchCalldata(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) (*types.SubmitBatchCalldata, error)
+	GetExecutedBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) (*types.ExecutedBatch, bool)
+	GetBatchRelayLatency(ctx sdk.Context, tokenContract types.EthAddress) *types.BatchRelayLatency
+	GetBatchRelayLatencies(ctx sdk.Context) []types.BatchRelayLatency
+
+	// logic calls
+	IterateOutgoingLogicCalls(ctx sdk.Context, cb func([]byte, types.OutgoingLogicCall) bool)
+	GetLogicCallConfirm(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64, val sdk.AccAddress) *types.MsgConfirmLogicCall
+	IterateLogicConfirmByInvalidationIDAndNonce(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64, cb func([]byte, *types.MsgConfirmLogicCall) bool)
+}
+
+var _ ReadOnlyKeeper = Keeper{}
+
+// queryKeeper is the ReadOnlyKeeper with the unexported reads the query server needs
+type queryKeeper interface {
+	ReadOnlyKeeper
+
+	getStoreValue(ctx sdk.Context, key []byte) []byte
+	newAmountFormatter(ctx sdk.Context) *amountFormatter
+	batchNotFoundError(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) error
+}
+
+var _ queryKeeper = Keeper{}
+
+// getStoreValue returns the raw value of key in the gravity store, nil if it is not set
+func (k Keeper) getStoreValue(ctx sdk.Context, key []byte) []byte {
+	return ctx.KVStore(k.storeKey).Get(key)
+}
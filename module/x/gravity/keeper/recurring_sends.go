@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// CreateRecurringSendToEth escrows count transfers of amount and fee in the unbatched pool and returns the id
+// of the recurring send. The EndBlocker adds the first transfer to the pool in the current block and the next
+// ones every interval blocks. The pool insertion gas of every transfer is paid upfront
+func (k Keeper) CreateRecurringSendToEth(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+	interval uint64,
+	count uint64,
+) (uint64, error) {
+	if interval == 0 || count == 0 || count > math.MaxUint64/OutgoingTxPoolInsertionGas {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	if _, err := k.checkOutgoingTx(ctx, sender, counterpartReceiver, amount, fee); err != nil {
+		return 0, err
+	}
+	ctx.GasMeter().ConsumeGas(OutgoingTxPoolInsertionGas*(count-1), "recurring outgoing tx pool insertions")
+
+	send := types.RecurringSendToEth{
+		Sender:     sender.String(),
+		EthDest:    counterpartReceiver.GetAddress(),
+		Amount:     amount,
+		BridgeFee:  fee,
+		Interval:   interval,
+		Remaining:  count,
+		NextHeight: uint64(ctx.BlockHeight()),
+	}
+	escrow, err := recurringSendEscrow(send)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.UnbatchedPoolAccountName, sdk.NewCoins(escrow)); err != nil {
+		return 0, sdkerrors.Wrap(err, "escrow")
+	}
+
+	send.Id = k.autoIncrementID(ctx, []byte(types.KeyLastRecurringSendToEthID))
+	k.setRecurringSendToEth(ctx, send)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecurringSendToEthCreated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRecurringSendID, fmt.Sprint(send.Id)),
+			sdk.NewAttribute(sdk.AttributeKeySender, send.Sender),
+			sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprint(send.Remaining)),
+		),
+	)
+	return send.Id, nil
+}
+
+// CancelRecurringSendToEth deletes a recurring send of sender and refunds the escrow of its remaining transfers,
+// the transfers already added to the pool are not affected
+func (k Keeper) CancelRecurringSendToEth(ctx sdk.Context, id uint64, sender sdk.AccAddress) error {
+	send := k.GetRecurringSendToEth(ctx, id)
+	if send == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "recurring send %d", id)
+	}
+	if send.Sender != sender.String() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "sender %s did not create recurring send %d", sender, id)
+	}
+	k.endRecurringSendToEth(ctx, *send, "cancelled")
+	return nil
+}
+
+// SendRecurringSendsToEth adds the due transfer of every recurring send to the pool. A recurring send whose
+// transfer can no longer enter the pool, e.g. because it is now screened, is ended and its escrow refunded
+func (k Keeper) SendRecurringSendsToEth(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RecurringSendToEthKey))
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(uint64(ctx.BlockHeight())+1))
+	var due []types.RecurringSendToEth
+	for ; iter.Valid(); iter.Next() {
+		var send types.RecurringSendToEth
+		k.cdc.MustUnmarshal(iter.Value(), &send)
+		due = append(due, send)
+	}
+	iter.Close()
+
+	for _, send := range due {
+		xCtx, commit := ctx.CacheContext()
+		txID, err := k.sendRecurringTransfer(xCtx, send)
+		if err != nil {
+			k.logger(ctx).Error("recurring send to eth failed", "id", send.Id, "cause", err.Error())
+			k.endRecurringSendToEth(ctx, send, err.Error())
+			continue
+		}
+		commit()
+
+		k.deleteRecurringSendToEth(ctx, send)
+		send.Remaining--
+		send.NextHeight = uint64(ctx.BlockHeight()) + send.Interval
+		if send.Remaining > 0 {
+			k.setRecurringSendToEth(ctx, send)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRecurringSendToEthSent,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyRecurringSendID, fmt.Sprint(send.Id)),
+				sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+				sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprint(send.Remaining)),
+			),
+		)
+	}
+}
+
+// sendRecurringTransfer adds a transfer of a recurring send to the pool, its amount and fee are already escrowed
+func (k Keeper) sendRecurringTransfer(ctx sdk.Context, send types.RecurringSendToEth) (uint64, error) {
+	sender, err := sdk.AccAddressFromBech32(send.Sender)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "sender")
+	}
+	dest, err := types.NewEthAddress(send.EthDest)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "eth dest")
+	}
+	tokenContract, err := k.checkOutgoingTx(ctx, sender, *dest, send.Amount, send.BridgeFee)
+	if err != nil {
+		return 0, err
+	}
+	if k.InvalidSendToEthAddress(ctx, *dest, *tokenContract) {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "destination address is invalid or blacklisted")
+	}
+	return k.storeOutgoingTx(ctx, sender, *dest, *tokenContract, send.Amount, send.BridgeFee, nil, 0)
+}
+
+// endRecurringSendToEth deletes a recurring send and refunds the escrow of its remaining transfers
+func (k Keeper) endRecurringSendToEth(ctx sdk.Context, send types.RecurringSendToEth, reason string) {
+	sender, err := sdk.AccAddressFromBech32(send.Sender)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid sender in stored recurring send %d", send.Id))
+	}
+	escrow, err := recurringSendEscrow(send)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid stored recurring send %d", send.Id))
+	}
+	k.deleteRecurringSendToEth(ctx, send)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.UnbatchedPoolAccountName, sender, sdk.NewCoins(escrow)); err != nil {
+		panic(sdkerrors.Wrapf(err, "unable to refund recurring send %d", send.Id))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecurringSendToEthEnded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRecurringSendID, fmt.Sprint(send.Id)),
+			sdk.NewAttribute(types.AttributeKeyRefund, escrow.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+// recurringSendEscrow returns the amount and bridge fees escrowed for the remaining transfers of a recurring send
+func recurringSendEscrow(send types.RecurringSendToEth) (sdk.Coin, error) {
+	perTransfer := send.Amount.Add(send.BridgeFee)
+	total := new(big.Int).Mul(perTransfer.Amount.BigInt(), new(big.Int).SetUint64(send.Remaining))
+	if total.BitLen() > 255 {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalid, "escrow overflow")
+	}
+	return sdk.NewCoin(perTransfer.Denom, sdk.NewIntFromBigInt(total)), nil
+}
+
+// setRecurringSendToEth stores a recurring send indexed by the height of its next transfer
+func (k Keeper) setRecurringSendToEth(ctx sdk.Context, send types.RecurringSendToEth) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetRecurringSendToEthKey(send.NextHeight, send.Id)), k.cdc.MustMarshal(&send))
+}
+
+func (k Keeper) deleteRecurringSendToEth(ctx sdk.Context, send types.RecurringSendToEth) {
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetRecurringSendToEthKey(send.NextHeight, send.Id)))
+}
+
+// GetRecurringSendToEth returns the recurring send with id, or nil if there is none
+func (k Keeper) GetRecurringSendToEth(ctx sdk.Context, id uint64) *types.RecurringSendToEth {
+	var found *types.RecurringSendToEth
+	k.IterateRecurringSendsToEth(ctx, func(_ []byte, send types.RecurringSendToEth) bool {
+		if send.Id == id {
+			found = &send
+			return true
+		}
+		return false
+	})
+	return found
+}
+
+// IterateRecurringSendsToEth iterates over the recurring sends by ascending height of their next transfer
+func (k Keeper) IterateRecurringSendsToEth(ctx sdk.Context, cb func([]byte, types.RecurringSendToEth) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RecurringSendToEthKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var send types.RecurringSendToEth
+		k.cdc.MustUnmarshal(iter.Value(), &send)
+		// cb returns true to stop early
+		if cb(iter.Key(), send) {
+			break
+		}
+	}
+}
+
+// GetRecurringSendsToEth returns all the recurring sends
+func (k Keeper) GetRecurringSendsToEth(ctx sdk.Context) (out []types.RecurringSendToEth) {
+	k.IterateRecurringSendsToEth(ctx, func(_ []byte, send types.RecurringSendToEth) bool {
+		out = append(out, send)
+		return false
+	})
+	return
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that a recurring send adds a transfer to the pool every interval blocks from its escrow
+func TestRecurringSendToEth(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(100)
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	denom := token.GravityCoin().Denom
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token.GravityCoin())))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.NewCoins(token.GravityCoin())))
+	amount := sdk.NewInt64Coin(denom, 100)
+	fee := sdk.NewInt64Coin(denom, 2)
+
+	// the escrow must cover every transfer
+	_, err = input.GravityKeeper.CreateRecurringSendToEth(ctx, mySender, *myReceiver, amount, fee, 10, 10)
+	require.Error(t, err)
+
+	id, err := input.GravityKeeper.CreateRecurringSendToEth(ctx, mySender, *myReceiver, amount, fee, 10, 3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(694), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	require.Len(t, ExportGenesis(ctx, input.GravityKeeper).RecurringSends, 1)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// the first transfer is sent in the block creating the recurring send
+	input.GravityKeeper.SendRecurringSendsToEth(ctx)
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 1)
+	require.Equal(t, uint64(2), input.GravityKeeper.GetRecurringSendToEth(ctx, id).Remaining)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	input.GravityKeeper.SendRecurringSendsToEth(ctx.WithBlockHeight(109))
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 1)
+	ctx = ctx.WithBlockHeight(110)
+	input.GravityKeeper.SendRecurringSendsToEth(ctx)
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 2)
+	require.Equal(t, uint64(120), input.GravityKeeper.GetRecurringSendToEth(ctx, id).NextHeight)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// only the sender may cancel, the escrow of the last transfer is refunded
+	require.Error(t, input.GravityKeeper.CancelRecurringSendToEth(ctx, id, RandomAccAddress()))
+	require.NoError(t, input.GravityKeeper.CancelRecurringSendToEth(ctx, id, mySender))
+	require.Nil(t, input.GravityKeeper.GetRecurringSendToEth(ctx, id))
+	require.Equal(t, sdk.NewInt(796), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 2)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// a transfer which can no longer enter the pool ends the recurring send
+	id, err = input.GravityKeeper.CreateRecurringSendToEth(ctx, mySender, *myReceiver, amount, fee, 10, 2)
+	require.NoError(t, err)
+	input.GravityKeeper.SetScreeningKeeper(screenReceiver{receiver: *myReceiver})
+	input.GravityKeeper.SendRecurringSendsToEth(ctx)
+	require.Nil(t, input.GravityKeeper.GetRecurringSendToEth(ctx, id))
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 2)
+	require.Equal(t, sdk.NewInt(796), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+/////////////////////////////
+//   RELAYABILITY MARKERS  //
+/////////////////////////////
+
+// GetValsetSignedPower returns the normalized power that has confirmed the given valset. Valset
+// updates are checked by the Gravity contract against the validator set currently on Ethereum,
+// so the confirms are counted against the last observed valset, or against the valset itself
+// when no valset update has been observed yet
+func (k Keeper) GetValsetSignedPower(ctx sdk.Context, valset types.Valset) uint64 {
+	signingSet := k.GetLastObservedValset(ctx)
+	if signingSet == nil {
+		signingSet = &valset
+	}
+	members, err := types.BridgeValidators(signingSet.Members).ToInternal()
+	if err != nil {
+		// an observed valset can carry members we consider invalid, nothing can be relayed then
+		return 0
+	}
+	signers := make(map[string]struct{})
+	for _, confirm := range k.GetValsetConfirms(ctx, valset.Nonce) {
+		signers[strings.ToLower(confirm.EthAddress)] = struct{}{}
+	}
+	return members.PowerOfSigners(signers)
+}
+
+// TryMarkValsetRelayable records the current block as the height the valset became relayable,
+// stores its relay package and emits an event, the first time its confirmed power crosses the
+// Ethereum signature threshold
+func (k Keeper) TryMarkValsetRelayable(ctx sdk.Context, nonce uint64) {
+	valset := k.GetValset(ctx, nonce)
+	if valset == nil || valset.RelayableSinceHeight != 0 {
+		return
+	}
+	power := k.GetValsetSignedPower(ctx, *valset)
+	if power < types.EthereumSignaturePowerThreshold {
+		return
+	}
+	valset.RelayableSinceHeight = uint64(ctx.BlockHeight())
+	k.setValsetRelayableSinceHeight(ctx, *valset)
+	k.setValsetRelayPackage(ctx, k.buildValsetRelayPackage(ctx, *valset))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValsetRelayable,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValsetNonce, fmt.Sprint(valset.Nonce)),
+			sdk.NewAttribute(types.AttributeKeyRelayableSinceHeight, fmt.Sprint(valset.RelayableSinceHeight)),
+			sdk.NewAttribute(types.AttributeKeySignedPower, fmt.Sprint(power)),
+		),
+	)
+}
+
+// TryMarkBatchRelayable records the current block as the height the batch became relayable
+// and emits an event, the first time its confirmed power crosses the Ethereum signature threshold
+func (k Keeper) TryMarkBatchRelayable(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) {
+	batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
+	if batch == nil || batch.RelayableSinceHeight != 0 {
+		return
+	}
+	power := k.GetBatchSignedPower(ctx, *batch)
+	if power < types.EthereumSignaturePowerThreshold {
+		return
+	}
+	batch.RelayableSinceHeight = uint64(ctx.BlockHeight())
+	k.setBatchRelayableSinceHeight(ctx, *batch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchRelayable,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, batch.TokenContract.GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(batch.BatchNonce)),
+			sdk.NewAttribute(types.AttributeKeyRelayableSinceHeight, fmt.Sprint(batch.RelayableSinceHeight)),
+			sdk.NewAttribute(types.AttributeKeySignedPower, fmt.Sprint(power)),
+		),
+	)
+}
+
+// setValsetRelayableSinceHeight overwrites a stored valset to record its relayable marker. Valsets
+// are otherwise immutable, this is safe only because the marker is not part of the checkpoint
+func (k Keeper) setValsetRelayableSinceHeight(ctx sdk.Context, valset types.Valset) {
+	key := []byte(types.GetValsetKey(valset.Nonce))
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic(sdkerrors.Wrapf(types.ErrInvalid, "no valset with nonce %d to mark relayable", valset.Nonce))
+	}
+	store.Set(key, k.cdc.MustMarshal(&valset))
+}
+
+// setBatchRelayableSinceHeight overwrites a stored batch to record its relayable marker. Batches
+// are otherwise immutable, this is safe only because the marker is not part of the checkpoint
+func (k Keeper) setBatchRelayableSinceHeight(ctx sdk.Context, batch types.InternalOutgoingTxBatch) {
+	key := []byte(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic(sdkerrors.Wrapf(types.ErrInvalid, "no batch with nonce %d to mark relayable", batch.BatchNonce))
+	}
+	externalBatch := batch.ToExternal()
+	store.Set(key, k.cdc.MustMarshal(&externalBatch))
+}
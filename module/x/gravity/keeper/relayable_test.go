@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+func countEvents(ctx sdk.Context, eventType string) (count int) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return
+}
+
+func TestBatchRelayableMarker(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	ctx = ctx.WithBlockHeight(100)
+	pk.SetValsetRequest(ctx)
+
+	batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+		BatchNonce:           1,
+		BatchTimeout:         0,
+		Transactions:         []types.OutgoingTransferTx{},
+		TokenContract:        TokenContractAddrs[0],
+		Block:                uint64(ctx.BlockHeight()),
+		RelayableSinceHeight: 0,
+	})
+	require.NoError(t, err)
+	pk.StoreBatch(ctx, *batch)
+	checkpoint := batch.GetCheckpoint(pk.GetGravityID(ctx))
+
+	// three of five equal validators is below the threshold
+	for i := 0; i < 3; i++ {
+		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: TokenContractAddrs[0],
+			EthSigner:     EthAddrs[i].String(),
+			Orchestrator:  OrchAddrs[i].String(),
+			Signature:     "",
+		})
+		pk.TryMarkBatchRelayable(ctx, batch.TokenContract, batch.BatchNonce)
+	}
+	require.Equal(t, uint64(0), pk.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce).RelayableSinceHeight)
+	require.Equal(t, 0, countEvents(ctx, types.EventTypeBatchRelayable))
+
+	ctx = ctx.WithBlockHeight(105)
+	pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: TokenContractAddrs[0],
+		EthSigner:     EthAddrs[3].String(),
+		Orchestrator:  OrchAddrs[3].String(),
+		Signature:     "",
+	})
+	pk.TryMarkBatchRelayable(ctx, batch.TokenContract, batch.BatchNonce)
+	stored := pk.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
+	require.Equal(t, uint64(105), stored.RelayableSinceHeight)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeBatchRelayable))
+	// the marker must not change what validators sign
+	require.Equal(t, checkpoint, stored.GetCheckpoint(pk.GetGravityID(ctx)))
+
+	// later confirms keep the original marker and emit nothing
+	ctx = ctx.WithBlockHeight(110)
+	pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: TokenContractAddrs[0],
+		EthSigner:     EthAddrs[4].String(),
+		Orchestrator:  OrchAddrs[4].String(),
+		Signature:     "",
+	})
+	pk.TryMarkBatchRelayable(ctx, batch.TokenContract, batch.BatchNonce)
+	require.Equal(t, uint64(105), pk.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce).RelayableSinceHeight)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeBatchRelayable))
+}
+
+// Tests that the signed power of a batch is counted against the last observed valset, which the Gravity contract
+// checks the signatures against, and not against a newer valset the contract does not hold yet
+func TestBatchSignedPowerLastObservedValset(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	ctx = ctx.WithBlockHeight(100)
+	pk.SetValsetRequest(ctx)
+
+	batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+		BatchNonce:           1,
+		BatchTimeout:         0,
+		Transactions:         []types.OutgoingTransferTx{},
+		TokenContract:        TokenContractAddrs[0],
+		Block:                uint64(ctx.BlockHeight()),
+		RelayableSinceHeight: 0,
+	})
+	require.NoError(t, err)
+	pk.StoreBatch(ctx, *batch)
+	pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: TokenContractAddrs[0],
+		EthSigner:     EthAddrs[0].String(),
+		Orchestrator:  OrchAddrs[0].String(),
+		Signature:     "",
+	})
+
+	// with no valset observed the latest valset is used, where one of five equal validators is below the threshold
+	latest := pk.GetLatestValset(ctx)
+	require.Equal(t, latest.Members[0].Power, pk.GetBatchSignedPower(ctx, *batch))
+
+	// the observed valset gives the signer most of the power
+	pk.SetLastObservedValset(ctx, types.Valset{
+		Nonce: 0,
+		Members: []types.BridgeValidator{
+			{Power: 3000000000, EthereumAddress: EthAddrs[0].String()},
+			{Power: 1294967295, EthereumAddress: EthAddrs[1].String()},
+		},
+		Height:       0,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  "",
+	})
+	require.Equal(t, uint64(3000000000), pk.GetBatchSignedPower(ctx, *batch))
+	pk.TryMarkBatchRelayable(ctx, batch.TokenContract, batch.BatchNonce)
+	require.Equal(t, uint64(100), pk.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce).RelayableSinceHeight)
+
+	// a valset with invalid members has no power instead of halting the chain
+	pk.SetLastObservedValset(ctx, types.Valset{
+		Nonce:        0,
+		Members:      []types.BridgeValidator{{Power: 4294967295, EthereumAddress: "invalid"}},
+		Height:       0,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  "",
+	})
+	require.Equal(t, uint64(0), pk.GetBatchSignedPower(ctx, *batch))
+}
+
+func TestValsetRelayableMarker(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	ctx = ctx.WithBlockHeight(100)
+	valset := pk.SetValsetRequest(ctx)
+	checkpoint := valset.GetCheckpoint(pk.GetGravityID(ctx))
+
+	for i := 0; i < 3; i++ {
+		pk.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+			Nonce:        valset.Nonce,
+			Orchestrator: OrchAddrs[i].String(),
+			EthAddress:   EthAddrs[i].String(),
+			Signature:    fmt.Sprintf("sig%d", i),
+		})
+		pk.TryMarkValsetRelayable(ctx, valset.Nonce)
+	}
+	require.Equal(t, uint64(0), pk.GetValset(ctx, valset.Nonce).RelayableSinceHeight)
+	require.Equal(t, 0, countEvents(ctx, types.EventTypeValsetRelayable))
+	_, err := NewQueryServerImpl(pk).ValsetRelayPackage(sdk.WrapSDKContext(ctx), &types.QueryValsetRelayPackageRequest{Nonce: valset.Nonce})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	ctx = ctx.WithBlockHeight(103)
+	pk.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: OrchAddrs[3].String(),
+		EthAddress:   EthAddrs[3].String(),
+		Signature:    "sig3",
+	})
+	pk.TryMarkValsetRelayable(ctx, valset.Nonce)
+	stored := pk.GetValset(ctx, valset.Nonce)
+	require.Equal(t, uint64(103), stored.RelayableSinceHeight)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeValsetRelayable))
+	require.Equal(t, checkpoint, stored.GetCheckpoint(pk.GetGravityID(ctx)))
+
+	// the relay package lists the members of the valset itself as no valset was observed, in checkpoint order
+	expected := types.ValsetRelayPackage{ValsetNonce: valset.Nonce, SigningValsetNonce: valset.Nonce, Height: 103}
+	for _, member := range valset.Members {
+		signature := ""
+		for i := 0; i < 4; i++ {
+			if member.EthereumAddress == EthAddrs[i].String() {
+				signature = fmt.Sprintf("sig%d", i)
+			}
+		}
+		expected.Signatures = append(expected.Signatures, types.RelaySignature{
+			EthereumAddress: member.EthereumAddress,
+			Power:           member.Power,
+			Signature:       signature,
+		})
+	}
+	res, err := NewQueryServerImpl(pk).ValsetRelayPackage(sdk.WrapSDKContext(ctx), &types.QueryValsetRelayPackageRequest{Nonce: valset.Nonce})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.RelayPackage)
+
+	// a later confirm does not change the package
+	pk.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: OrchAddrs[4].String(),
+		EthAddress:   EthAddrs[4].String(),
+		Signature:    "sig4",
+	})
+	pk.TryMarkValsetRelayable(ctx.WithBlockHeight(104), valset.Nonce)
+	require.Equal(t, expected, *pk.GetValsetRelayPackage(ctx, valset.Nonce))
+	require.Equal(t, []types.ValsetRelayPackage{expected}, ExportGenesis(ctx, pk).ValsetRelayPackages)
+	pk.DeleteValsetRelayPackage(ctx, valset.Nonce)
+	require.Nil(t, pk.GetValsetRelayPackage(ctx, valset.Nonce))
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// setScheduledTX holds back a transaction until executeAfterHeight, its funds must already be locked
+// WARNING: Do not make this function public
+func (k Keeper) setScheduledTX(ctx sdk.Context, executeAfterHeight uint64, tx *types.InternalOutgoingTransferTx) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(types.GetScheduledOutgoingTxKey(executeAfterHeight, tx.Id))
+	if store.Has(key) {
+		panic("Can not overwrite scheduled transaction")
+	}
+	scheduled := types.ScheduledOutgoingTransferTx{
+		ExecuteAfterHeight: executeAfterHeight,
+		Transfer:           tx.ToExternal(),
+	}
+	store.Set(key, k.cdc.MustMarshal(&scheduled))
+}
+
+// removeScheduledTX deletes a scheduled transaction
+// WARNING: Do not make this function public
+func (k Keeper) removeScheduledTX(ctx sdk.Context, executeAfterHeight uint64, txID uint64) {
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetScheduledOutgoingTxKey(executeAfterHeight, txID)))
+}
+
+// GetScheduledTxById returns the scheduled transaction with txID, or nil if there is none
+func (k Keeper) GetScheduledTxById(ctx sdk.Context, txID uint64) *types.ScheduledOutgoingTransferTx {
+	var found *types.ScheduledOutgoingTransferTx
+	k.IterateScheduledTransactions(ctx, func(_ []byte, tx types.ScheduledOutgoingTransferTx) bool {
+		if tx.Transfer.Id == txID {
+			found = &tx
+			return true
+		}
+		return false
+	})
+	return found
+}
+
+// IterateScheduledTransactions iterates over the scheduled transactions by ascending execute after height
+func (k Keeper) IterateScheduledTransactions(ctx sdk.Context, cb func([]byte, types.ScheduledOutgoingTransferTx) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ScheduledOutgoingTXKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var tx types.ScheduledOutgoingTransferTx
+		k.cdc.MustUnmarshal(iter.Value(), &tx)
+		// cb returns true to stop early
+		if cb(iter.Key(), tx) {
+			break
+		}
+	}
+}
+
+// GetScheduledTransactions returns all the scheduled transactions
+func (k Keeper) GetScheduledTransactions(ctx sdk.Context) (out []types.ScheduledOutgoingTransferTx) {
+	k.IterateScheduledTransactions(ctx, func(_ []byte, tx types.ScheduledOutgoingTransferTx) bool {
+		out = append(out, tx)
+		return false
+	})
+	return
+}
+
+// ReleaseScheduledTransactions moves the scheduled transactions whose execute after height is reached
+// into the pool, where they become eligible for batching
+func (k Keeper) ReleaseScheduledTransactions(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ScheduledOutgoingTXKey))
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(uint64(ctx.BlockHeight())+1))
+	var released []types.ScheduledOutgoingTransferTx
+	for ; iter.Valid(); iter.Next() {
+		var tx types.ScheduledOutgoingTransferTx
+		k.cdc.MustUnmarshal(iter.Value(), &tx)
+		released = append(released, tx)
+	}
+	iter.Close()
+
+	for _, tx := range released {
+		intTx, err := tx.Transfer.ToInternal()
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid scheduled tx %d in store", tx.Transfer.Id))
+		}
+		k.removeScheduledTX(ctx, tx.ExecuteAfterHeight, intTx.Id)
+		if err := k.addUnbatchedTX(ctx, intTx); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduledWithdrawalReleased,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(intTx.Id)),
+				sdk.NewAttribute(types.AttributeKeyExecuteAfterHeight, fmt.Sprint(tx.ExecuteAfterHeight)),
+			),
+		)
+	}
+}
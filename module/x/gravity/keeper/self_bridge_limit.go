@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// SelfBridgeLimitWindow is the number of blocks, a day of 6 second blocks, over which the coins an account sends to
+// Ethereum are counted against its self bridge limit. It is also the delay before a looser limit applies
+const SelfBridgeLimitWindow = 14400
+
+// SetSelfBridgeLimit sets the limit an account puts on the coins it sends to Ethereum and returns the height it
+// applies from. A limit at least as strict as the current one for every limited denom applies at once and drops the
+// pending limit, any other limit is pending for SelfBridgeLimitWindow blocks
+func (k Keeper) SetSelfBridgeLimit(ctx sdk.Context, address sdk.AccAddress, limit sdk.Coins) uint64 {
+	height := uint64(ctx.BlockHeight())
+	current := k.GetSelfBridgeLimit(ctx, address)
+	if current == nil {
+		current = &types.SelfBridgeLimit{
+			Address:       address.String(),
+			Limit:         sdk.Coins{},
+			PendingLimit:  sdk.Coins{},
+			PendingHeight: 0,
+			WindowStart:   height,
+			Spent:         sdk.Coins{},
+		}
+	}
+
+	activationHeight := height
+	if isStricterSelfBridgeLimit(current.Limit, limit) {
+		current.Limit = limit
+		current.PendingLimit = sdk.Coins{}
+		current.PendingHeight = 0
+	} else {
+		activationHeight = height + SelfBridgeLimitWindow
+		current.PendingLimit = limit
+		current.PendingHeight = activationHeight
+	}
+	k.setSelfBridgeLimit(ctx, address, *current)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSelfBridgeLimitSet,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+			sdk.NewAttribute(types.AttributeKeyLimit, limit.String()),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, fmt.Sprint(activationHeight)),
+		),
+	)
+	return activationHeight
+}
+
+// isStricterSelfBridgeLimit returns true if next limits every denom limited by current to at most the same amount
+func isStricterSelfBridgeLimit(current sdk.Coins, next sdk.Coins) bool {
+	for _, coin := range current {
+		amount := next.AmountOf(coin.Denom)
+		if !amount.IsPositive() || amount.GT(coin.Amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// spendSelfBridgeLimit counts coins sent to Ethereum by an account against its self bridge limit, failing with
+// ErrSelfBridgeLimitExceeded if they exceed what is left of it in the current window
+func (k Keeper) spendSelfBridgeLimit(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) error {
+	limit := k.GetSelfBridgeLimit(ctx, address)
+	if limit == nil || limit.Limit.Empty() {
+		return nil
+	}
+	spent := limit.Spent
+	for _, coin := range coins {
+		if limit.Limit.AmountOf(coin.Denom).IsPositive() {
+			spent = spent.Add(coin)
+		}
+	}
+	for _, max := range limit.Limit {
+		if spent.AmountOf(max.Denom).GT(max.Amount) {
+			return sdkerrors.Wrapf(
+				types.ErrSelfBridgeLimitExceeded,
+				"%s%s sent since height %d, limit is %s",
+				spent.AmountOf(max.Denom), max.Denom, limit.WindowStart, max,
+			)
+		}
+	}
+	limit.Spent = spent
+	k.setSelfBridgeLimit(ctx, address, *limit)
+	return nil
+}
+
+// GetSelfBridgeLimit returns the self bridge limit of an account as of the current block, with its pending limit
+// applied once due and its spending reset once its window is over, or nil if it set none
+func (k Keeper) GetSelfBridgeLimit(ctx sdk.Context, address sdk.AccAddress) *types.SelfBridgeLimit {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.GetSelfBridgeLimitKey(address)))
+	if len(bz) == 0 {
+		return nil
+	}
+	var limit types.SelfBridgeLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+
+	height := uint64(ctx.BlockHeight())
+	if limit.PendingHeight != 0 && limit.PendingHeight <= height {
+		limit.Limit = limit.PendingLimit
+		limit.PendingLimit = sdk.Coins{}
+		limit.PendingHeight = 0
+	}
+	if height >= limit.WindowStart+SelfBridgeLimitWindow {
+		limit.WindowStart = height
+		limit.Spent = sdk.Coins{}
+	}
+	return &limit
+}
+
+// setSelfBridgeLimit stores the self bridge limit of an account, it is deleted once the account is not limited
+func (k Keeper) setSelfBridgeLimit(ctx sdk.Context, address sdk.AccAddress, limit types.SelfBridgeLimit) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(types.GetSelfBridgeLimitKey(address))
+	if limit.Limit.Empty() && limit.PendingHeight == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&limit))
+}
+
+// IterateSelfBridgeLimits iterates over the stored self bridge limits
+func (k Keeper) IterateSelfBridgeLimits(ctx sdk.Context, cb func([]byte, types.SelfBridgeLimit) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SelfBridgeLimitKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var limit types.SelfBridgeLimit
+		k.cdc.MustUnmarshal(iter.Value(), &limit)
+		// cb returns true to stop early
+		if cb(iter.Key(), limit) {
+			break
+		}
+	}
+}
+
+// GetSelfBridgeLimits returns every stored self bridge limit
+func (k Keeper) GetSelfBridgeLimits(ctx sdk.Context) (out []types.SelfBridgeLimit) {
+	k.IterateSelfBridgeLimits(ctx, func(_ []byte, limit types.SelfBridgeLimit) bool {
+		out = append(out, limit)
+		return false
+	})
+	return
+}
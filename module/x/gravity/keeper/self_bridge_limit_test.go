@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that a stricter self bridge limit applies at once, a looser one only after the window, and that spending
+// over the limit fails until the window is over
+func TestSelfBridgeLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+	addr := AccAddrs[0]
+	height := uint64(ctx.BlockHeight())
+
+	require.Nil(t, k.GetSelfBridgeLimit(ctx, addr))
+	require.NoError(t, k.spendSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	// a first limit is stricter than none
+	require.Equal(t, height, k.SetSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, k.spendSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+	err := k.spendSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)))
+	require.ErrorIs(t, err, types.ErrSelfBridgeLimitExceeded)
+	// denoms without a limit are not counted
+	require.NoError(t, k.spendSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("other", 1000))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), k.GetSelfBridgeLimit(ctx, addr).Spent)
+
+	// a looser limit is pending for the window
+	require.Equal(t, height+SelfBridgeLimitWindow, k.SetSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))))
+	limit := k.GetSelfBridgeLimit(ctx, addr)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), limit.Limit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), limit.PendingLimit)
+	require.ErrorIs(t, k.spendSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))), types.ErrSelfBridgeLimitExceeded)
+
+	// once the window is over the pending limit applies and the spending is reset
+	ctx = ctx.WithBlockHeight(int64(height + SelfBridgeLimitWindow))
+	limit = k.GetSelfBridgeLimit(ctx, addr)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), limit.Limit)
+	require.Empty(t, limit.PendingLimit)
+	require.Empty(t, limit.Spent)
+	require.NoError(t, k.spendSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 150))))
+
+	// a stricter limit drops the pending one
+	k.SetSelfBridgeLimit(ctx, addr, sdk.Coins{})
+	require.Equal(t, uint64(ctx.BlockHeight()), k.SetSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 160))))
+	limit = k.GetSelfBridgeLimit(ctx, addr)
+	require.Equal(t, uint64(0), limit.PendingHeight)
+	require.ErrorIs(t, k.spendSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))), types.ErrSelfBridgeLimitExceeded)
+
+	// removing the limit takes the window, after which nothing is limited
+	require.Equal(t, uint64(ctx.BlockHeight())+SelfBridgeLimitWindow, k.SetSelfBridgeLimit(ctx, addr, sdk.Coins{}))
+	require.Len(t, k.GetSelfBridgeLimits(ctx), 1)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + SelfBridgeLimitWindow)
+	require.NoError(t, k.spendSelfBridgeLimit(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the proofs of the gravity store entries keyed by the state proof key query verify against the app hash
+// of the committed version, both for a present entry and for an absent one, and that a tampered value does not
+func TestStateProofs(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	ms, ok := ctx.MultiStore().(*rootmulti.Store)
+	require.True(t, ok)
+	contract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	tokenContract, err := types.NewEthAddress(contract)
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ualpha", *tokenContract)
+	commitID := ms.Commit()
+
+	prove := func(req types.QueryStateProofKeyRequest) types.StateProof {
+		keyRes, err := NewQueryServerImpl(k).StateProofKey(sdk.WrapSDKContext(ctx), &req)
+		require.NoError(t, err)
+		res := ms.Query(abci.RequestQuery{Path: "/" + types.StoreKey + "/key", Data: keyRes.Key, Height: commitID.Version, Prove: true})
+		require.Equal(t, uint32(0), res.Code, res.Log)
+		assert.Equal(t, keyRes.Value, res.Value)
+		return types.StateProof{StoreName: keyRes.StoreName, Key: keyRes.Key, Value: res.Value, Height: res.Height, Proof: res.ProofOps}
+	}
+
+	denomProof := prove(types.QueryStateProofKeyRequest{Entry: types.STATE_PROOF_ENTRY_DENOM_TO_ERC20, Denom: "ualpha"})
+	assert.Equal(t, []byte(contract), denomProof.Value)
+	require.NoError(t, types.VerifyStateProof(commitID.Hash, denomProof))
+	erc20Proof := prove(types.QueryStateProofKeyRequest{Entry: types.STATE_PROOF_ENTRY_ERC20_TO_DENOM, TokenContract: contract})
+	assert.Equal(t, []byte("ualpha"), erc20Proof.Value)
+	require.NoError(t, types.VerifyStateProof(commitID.Hash, erc20Proof))
+
+	batchProof := prove(types.QueryStateProofKeyRequest{Entry: types.STATE_PROOF_ENTRY_BATCH, TokenContract: contract, Nonce: 1})
+	assert.Empty(t, batchProof.Value)
+	require.NoError(t, types.VerifyStateProof(commitID.Hash, batchProof))
+
+	tampered := denomProof
+	tampered.Value = []byte("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	assert.Error(t, types.VerifyStateProof(commitID.Hash, tampered))
+	assert.Error(t, types.VerifyStateProof([]byte("other app hash"), denomProof))
+
+	_, err = NewQueryServerImpl(k).StateProofKey(sdk.WrapSDKContext(ctx), &types.QueryStateProofKeyRequest{Entry: types.STATE_PROOF_ENTRY_ATTESTATION})
+	assert.Error(t, err)
+	_, err = NewQueryServerImpl(k).StateProofKey(sdk.WrapSDKContext(ctx), &types.QueryStateProofKeyRequest{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// StoreMetricsTelemetryInterval is the number of blocks between two reports of the store metrics as telemetry
+const StoreMetricsTelemetryInterval = 100
+
+// storeMetricKinds are the kinds of state growing with the bridge traffic, by store prefix
+var storeMetricKinds = []struct {
+	kind   string
+	prefix string
+}{
+	{"attestations", types.OracleAttestationKey},
+	{"valsets", types.ValsetRequestKey},
+	{"valset_confirms", types.ValsetConfirmKey},
+	{"batches", types.OutgoingTXBatchKey},
+	{"batch_confirms", types.BatchConfirmKey},
+	{"logic_calls", types.KeyOutgoingLogicCall},
+	{"logic_call_confirms", types.KeyOutgoingLogicConfirm},
+	{"pool_txs", types.OutgoingTXPoolKey},
+	{"past_checkpoints", types.PastEthSignatureCheckpointKey},
+	{"executed_batches", types.ExecutedBatchKey},
+	{"archived_batches", types.ArchivedBatchKey},
+}
+
+// SetStoreMetricsTelemetry makes the EndBlocker report the store metrics as telemetry every
+// StoreMetricsTelemetryInterval blocks, it is meant for nodes which enabled telemetry
+func (k *Keeper) SetStoreMetricsTelemetry(enabled bool) {
+	k.storeMetricsTelemetry = enabled
+}
+
+// StoreMetricsTelemetry returns whether the store metrics are reported as telemetry
+func (k Keeper) StoreMetricsTelemetry() bool {
+	return k.storeMetricsTelemetry
+}
+
+// GetStoreMetrics counts the entries and their approximate size in bytes of every kind of state growing
+// with the bridge traffic, iterating the whole store so it is meant for queries and occasional reports
+func (k Keeper) GetStoreMetrics(ctx sdk.Context) []types.StoreMetric {
+	metrics := make([]types.StoreMetric, 0, len(storeMetricKinds))
+	for _, m := range storeMetricKinds {
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(m.prefix))
+		iter := prefixStore.Iterator(nil, nil)
+
+		metric := types.StoreMetric{Kind: m.kind}
+		for ; iter.Valid(); iter.Next() {
+			metric.Count++
+			metric.Bytes += uint64(len(m.prefix) + len(iter.Key()) + len(iter.Value()))
+		}
+		iter.Close()
+		metrics = append(metrics, metric)
+	}
+	return metrics
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the store metrics count the pool txs and batches as they are added
+func TestGetStoreMetrics(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	voucherDenom := token.GravityCoin().Denom
+	funds := sdk.NewCoins(token.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, funds))
+
+	metricsByKind := func() map[string]types.StoreMetric {
+		byKind := make(map[string]types.StoreMetric)
+		for _, metric := range input.GravityKeeper.GetStoreMetrics(ctx) {
+			byKind[metric.Kind] = metric
+		}
+		return byKind
+	}
+
+	before := metricsByKind()
+	require.Len(t, before, len(storeMetricKinds))
+	require.Zero(t, before["pool_txs"].Count)
+	require.Zero(t, before["pool_txs"].Bytes)
+
+	for _, fee := range []int64{1, 2, 3} {
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver,
+			sdk.NewInt64Coin(voucherDenom, 100), sdk.NewInt64Coin(voucherDenom, fee))
+		require.NoError(t, err)
+	}
+	metrics := metricsByKind()
+	require.Equal(t, uint64(3), metrics["pool_txs"].Count)
+	require.NotZero(t, metrics["pool_txs"].Bytes)
+	require.Equal(t, before["batches"], metrics["batches"])
+
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, token.Contract, 2)
+	require.NoError(t, err)
+	metrics = metricsByKind()
+	require.Equal(t, uint64(1), metrics["pool_txs"].Count)
+	require.Equal(t, before["batches"].Count+1, metrics["batches"].Count)
+	require.Greater(t, metrics["batches"].Bytes, before["batches"].Bytes)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// transferEscrow returns the amount and bridge fee escrowed for an outgoing transaction, in the denom
+// it was sent in
+func (k Keeper) transferEscrow(ctx sdk.Context, tx *types.InternalOutgoingTransferTx) sdk.Coin {
+	_, denom := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
+	return sdk.NewCoin(denom, tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
+}
+
+// transfersEscrow returns the amount and bridge fee escrowed for txs
+func (k Keeper) transfersEscrow(ctx sdk.Context, txs []*types.InternalOutgoingTransferTx) sdk.Coins {
+	escrow := sdk.NewCoins()
+	for _, tx := range txs {
+		escrow = escrow.Add(k.transferEscrow(ctx, tx))
+	}
+	return escrow
+}
+
+// moveEscrow moves escrowed coins between the module accounts of the gravity module, it panics
+// because a failure means the sub-pools no longer back the state they escrow for
+func (k Keeper) moveEscrow(ctx sdk.Context, from, to string, coins sdk.Coins) {
+	if coins.IsZero() {
+		return
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, from, to, coins); err != nil {
+		panic(sdkerrors.Wrapf(err, "unable to move %s from %s to %s", coins, from, to))
+	}
+}
+
+// expectedSubPoolBalances returns the balance every sub-pool account is expected to hold: the unbatched
+// pool holds the unbatched and scheduled transactions and the escrows of the recurring sends, the batches account the transactions of unobserved batches,
+// the fees account their relay fees and the logic call deposits and the held deposits account the held deposits
+func (k Keeper) expectedSubPoolBalances(ctx sdk.Context) map[string]sdk.Coins {
+	unbatched, batched, fees, held := sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
+
+	k.IterateUnbatchedTransactions(ctx, []byte(types.OutgoingTXPoolKey), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		unbatched = unbatched.Add(k.transferEscrow(ctx, tx))
+		if tx.RelayFee != nil {
+			fees = fees.Add(*tx.RelayFee)
+		}
+		return false
+	})
+	k.IterateScheduledTransactions(ctx, func(_ []byte, scheduled types.ScheduledOutgoingTransferTx) bool {
+		tx, err := scheduled.Transfer.ToInternal()
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid scheduled tx %d in store", scheduled.Transfer.Id))
+		}
+		unbatched = unbatched.Add(k.transferEscrow(ctx, tx))
+		if tx.RelayFee != nil {
+			fees = fees.Add(*tx.RelayFee)
+		}
+		return false
+	})
+	k.IterateRecurringSendsToEth(ctx, func(_ []byte, send types.RecurringSendToEth) bool {
+		escrow, err := recurringSendEscrow(send)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid stored recurring send %d", send.Id))
+		}
+		unbatched = unbatched.Add(escrow)
+		return false
+	})
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		batched = batched.Add(k.transfersEscrow(ctx, batch.Transactions)...)
+		fees = fees.Add(batch.RelayFees()...)
+		return false
+	})
+	k.IterateLogicCallDeposits(ctx, func(_ []byte, deposit types.LogicCallDeposit) bool {
+		fees = fees.Add(deposit.Amount...)
+		return false
+	})
+	k.IterateHeldDeposits(ctx, func(_ []byte, deposit types.HeldDeposit) bool {
+		held = held.Add(deposit.Amount)
+		return false
+	})
+
+	return map[string]sdk.Coins{
+		types.UnbatchedPoolAccountName: unbatched,
+		types.BatchesAccountName:       batched,
+		types.FeesAccountName:          fees,
+		types.HeldDepositsAccountName:  held,
+	}
+}
+
+// GetSubPoolBalances returns the balance of every sub-pool account
+func (k Keeper) GetSubPoolBalances(ctx sdk.Context) map[string]sdk.Coins {
+	balances := make(map[string]sdk.Coins, len(types.SubPoolAccountNames))
+	for _, name := range types.SubPoolAccountNames {
+		balances[name] = k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(name))
+	}
+	return balances
+}
+
+// MoveEscrowsToSubPools moves the funds escrowed by a module account predating the sub-pools into them,
+// the ModuleName account is left with the Cosmos originated tokens locked on Ethereum
+func (k Keeper) MoveEscrowsToSubPools(ctx sdk.Context) error {
+	modAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	expectedBals := k.expectedSubPoolBalances(ctx)
+	for _, name := range types.SubPoolAccountNames {
+		expected := expectedBals[name]
+		if held := k.bankKeeper.GetAllBalances(ctx, modAcc); !held.IsAllGTE(expected) {
+			return sdkerrors.Wrapf(types.ErrInvalid, "module holds %s but escrows %s for %s", held, expected, name)
+		}
+		k.moveEscrow(ctx, types.ModuleName, name, expected)
+	}
+	return nil
+}
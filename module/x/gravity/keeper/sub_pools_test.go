@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that the escrows move between the sub-pools as transactions are batched and executed
+func TestSubPoolEscrows(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	voucherDenom := token.GravityCoin().Denom
+	funds := sdk.NewCoins(token.GravityCoin(), sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, funds))
+
+	balanceOf := func(name string) sdk.Coins {
+		return input.BankKeeper.GetAllBalances(ctx, input.AccountKeeper.GetModuleAddress(name))
+	}
+
+	_, err = input.GravityKeeper.AddToOutgoingPoolWithRelayFee(ctx, mySender, *myReceiver,
+		sdk.NewInt64Coin(voucherDenom, 100), sdk.NewInt64Coin(voucherDenom, 5), sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 105)), balanceOf(types.UnbatchedPoolAccountName))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), balanceOf(types.FeesAccountName))
+	require.True(t, balanceOf(types.ModuleName).IsZero())
+
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, token.Contract, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.True(t, balanceOf(types.UnbatchedPoolAccountName).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 105)), balanceOf(types.BatchesAccountName))
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// the executed vouchers are burned and the relay fee paid out
+	input.GravityKeeper.PayBatchRelayFees(ctx, token.Contract, batch.BatchNonce, nil, nil)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, token.Contract, batch.BatchNonce, nil)
+	for _, name := range append([]string{types.ModuleName}, types.SubPoolAccountNames...) {
+		require.True(t, balanceOf(name).IsZero(), name)
+	}
+	require.Equal(t, sdk.NewInt(895), input.BankKeeper.GetSupply(ctx, voucherDenom).Amount)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+}
+
+// Tests that the migration moves the escrows held by the module account into the sub-pools
+func TestMoveEscrowsToSubPools(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	voucherDenom := token.GravityCoin().Denom
+	funds := sdk.NewCoins(token.GravityCoin(), sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, funds))
+
+	for _, fee := range []int64{1, 2, 3} {
+		_, err = input.GravityKeeper.AddToOutgoingPoolWithRelayFee(ctx, mySender, *myReceiver,
+			sdk.NewInt64Coin(voucherDenom, 100), sdk.NewInt64Coin(voucherDenom, fee), sdk.NewInt64Coin("stake", 10))
+		require.NoError(t, err)
+	}
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, token.Contract, 2)
+	require.NoError(t, err)
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+	expected := input.GravityKeeper.GetSubPoolBalances(ctx)
+
+	// before the sub-pools existed the module account escrowed everything
+	for _, name := range types.SubPoolAccountNames {
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, name, types.ModuleName, expected[name]))
+	}
+	checkInvariant(t, ctx, input.GravityKeeper, false)
+
+	require.NoError(t, NewMigrator(input.GravityKeeper).Migrate1to2(ctx))
+	require.Equal(t, expected, input.GravityKeeper.GetSubPoolBalances(ctx))
+	checkInvariant(t, ctx, input.GravityKeeper, true)
+
+	// a module account holding less than the escrows fails the migration
+	for _, name := range types.SubPoolAccountNames {
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, name, types.ModuleName, expected[name]))
+	}
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	require.Error(t, NewMigrator(input.GravityKeeper).Migrate1to2(ctx))
+}
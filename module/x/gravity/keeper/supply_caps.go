@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetSupplyCapPolicy returns what happens to the deposits which would exceed the supply cap of their token
+func (k Keeper) GetSupplyCapPolicy(ctx sdk.Context) types.SupplyCapPolicy {
+	return k.GetParams(ctx).SupplyCapPolicy
+}
+
+// GetTokenSupplyCap returns the cap of the TokenSupplyCaps param on the outstanding vouchers of denom, false if the
+// denom is not the voucher of a capped Ethereum originated token. Native ETH is capped by a cap on any token whose
+// deposits are credited as its voucher
+func (k Keeper) GetTokenSupplyCap(ctx sdk.Context, denom string) (sdk.Int, bool) {
+	for _, supplyCap := range k.GetParams(ctx).TokenSupplyCaps {
+		contract, err := types.NewEthAddress(supplyCap.Contract)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid capped token %s in params", supplyCap.Contract))
+		}
+		if isCosmosOriginated, capDenom := k.ERC20ToDenomLookup(ctx, *contract); !isCosmosOriginated && capDenom == denom {
+			return supplyCap.Amount, true
+		}
+	}
+	return sdk.Int{}, false
+}
+
+// exceedsSupplyCap returns whether crediting amount of denom to its receiver would take the outstanding vouchers of
+// denom over its supply cap. The outstanding vouchers are the supply, counting the vouchers of the deposit batch not
+// minted yet, but the vouchers held in the held deposits account
+func (k Keeper) exceedsSupplyCap(ctx sdk.Context, denom string, supply sdk.Int, amount sdk.Int) bool {
+	supplyCap, found := k.GetTokenSupplyCap(ctx, denom)
+	if !found {
+		return false
+	}
+	held := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.HeldDepositsAccountName), denom)
+	return supply.Sub(held.Amount).Add(amount).GT(supplyCap)
+}
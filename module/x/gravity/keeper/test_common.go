@@ -208,23 +208,29 @@ var (
 
 	// TestingGravityParams is a set of gravity params for testing
 	TestingGravityParams = types.Params{
-		GravityId:                    "testgravityid",
-		ContractSourceHash:           "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
-		BridgeEthereumAddress:        "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
-		BridgeChainId:                11,
-		SignedValsetsWindow:          10,
-		SignedBatchesWindow:          10,
-		SignedLogicCallsWindow:       10,
-		TargetBatchTimeout:           60001,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:           sdk.NewDecWithPrec(1, 2),
-		SlashFractionLogicCall:       sdk.Dec{},
-		UnbondSlashingValsetsWindow:  15,
-		SlashFractionBadEthSignature: sdk.NewDecWithPrec(1, 2),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
-		BridgeActive:                 true,
+		GravityId:                        "testgravityid",
+		ContractSourceHash:               "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
+		BridgeEthereumAddress:            "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		BridgeChainId:                    11,
+		SignedValsetsWindow:              10,
+		SignedBatchesWindow:              10,
+		SignedLogicCallsWindow:           10,
+		TargetBatchTimeout:               60001,
+		AverageBlockTime:                 5000,
+		AverageEthereumBlockTime:         15000,
+		SlashFractionValset:              sdk.NewDecWithPrec(1, 2),
+		SlashFractionBatch:               sdk.NewDecWithPrec(1, 2),
+		SlashFractionLogicCall:           sdk.Dec{},
+		UnbondSlashingValsetsWindow:      15,
+		SlashFractionBadEthSignature:     sdk.NewDecWithPrec(1, 2),
+		ValsetReward:                     sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		BridgeActive:                     true,
+		ValsetRequestSlashPowerThreshold: sdk.NewDecWithPrec(5, 2),
+		RelayerFeeShare:                  sdk.OneDec(),
+		MaxValsetPowerShare:              sdk.OneDec(),
+		ClaimHashVersion:                 types.ClaimEncodingVersion,
+		ExpeditedQuorum:                  sdk.NewDecWithPrec(5, 1),
+		FeeBurnShare:                     sdk.ZeroDec(),
 	}
 )
 
@@ -331,12 +337,21 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 }
 
 // SetupTestChain sets up a test environment with the provided validator voting weights
-func SetupTestChain(t *testing.T, weights []uint64, setDelegateAddresses bool) (TestInput, sdk.Context) {
+func SetupTestChain(t testing.TB, weights []uint64, setDelegateAddresses bool) (TestInput, sdk.Context) {
 	t.Helper()
-	input := CreateTestEnv(t)
+	return SetupTestChainWithDB(t, dbm.NewMemDB(), weights, setDelegateAddresses)
+}
 
-	// Set the params for our modules
+// SetupTestChainWithDB is SetupTestChain on the stores of db
+func SetupTestChainWithDB(t testing.TB, db dbm.DB, weights []uint64, setDelegateAddresses bool) (TestInput, sdk.Context) {
+	t.Helper()
+	input := CreateTestEnvWithDB(t, db)
+
+	// Set the params for our modules, every validator is bonded
 	TestingStakeParams.MaxValidators = 100
+	if len(weights) > int(TestingStakeParams.MaxValidators) {
+		TestingStakeParams.MaxValidators = uint32(len(weights))
+	}
 	input.StakingKeeper.SetParams(input.Context, TestingStakeParams)
 
 	// Initialize each of the validators
@@ -405,7 +420,14 @@ func SetupTestChain(t *testing.T, weights []uint64, setDelegateAddresses bool) (
 }
 
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
+	t.Helper()
+	return CreateTestEnvWithDB(t, dbm.NewMemDB())
+}
+
+// CreateTestEnvWithDB is CreateTestEnv on the stores of db, benchmarks pass a database on disk so that the committed
+// state is read as on a node
+func CreateTestEnvWithDB(t testing.TB, db dbm.DB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
@@ -419,18 +441,17 @@ func CreateTestEnv(t *testing.T) TestInput {
 	keyGov := sdk.NewKVStoreKey(govtypes.StoreKey)
 	keySlashing := sdk.NewKVStoreKey(slashingtypes.StoreKey)
 
-	// Initialize memory database and mount stores on it
-	db := dbm.NewMemDB()
+	// Mount the stores on the database, each under its own prefix as in the app
 	ms := store.NewCommitMultiStore(db)
-	ms.MountStoreWithDB(gravityKey, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyDistro, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
-	ms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(gravityKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyDistro, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, nil)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -481,6 +502,10 @@ func CreateTestEnv(t *testing.T) TestInput {
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
+		types.UnbatchedPoolAccountName: nil,
+		types.BatchesAccountName:       nil,
+		types.FeesAccountName:          nil,
+		types.HeldDepositsAccountName:  nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -556,9 +581,14 @@ func CreateTestEnv(t *testing.T) TestInput {
 
 	// Load default wasm config
 
+	// the gravity keeper is created below, the route reads it once a proposal is submitted
+	var k Keeper
 	govRouter := govtypes.NewRouter().
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(paramsKeeper)).
-		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler)
+		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+		AddRoute(types.RouterKey, func(ctx sdk.Context, content govtypes.Content) error {
+			return NewGravityProposalHandler(k)(ctx, content)
+		})
 
 	govKeeper := govkeeper.NewKeeper(
 		marshaler, keyGov, getSubspace(paramsKeeper, govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable()), accountKeeper, bankKeeper, stakingKeeper, govRouter,
@@ -575,8 +605,10 @@ func CreateTestEnv(t *testing.T) TestInput {
 		&stakingKeeper,
 		getSubspace(paramsKeeper, slashingtypes.ModuleName).WithKeyTable(slashingtypes.ParamKeyTable()),
 	)
+	slashingKeeper.SetParams(ctx, slashingtypes.DefaultParams())
 
-	k := NewKeeper(gravityKey, getSubspace(paramsKeeper, types.DefaultParamspace), marshaler, &bankKeeper, &stakingKeeper, &slashingKeeper, &distKeeper, &accountKeeper)
+	k = NewKeeper(gravityKey, getSubspace(paramsKeeper, types.DefaultParamspace), marshaler, &bankKeeper, &stakingKeeper, &slashingKeeper, &distKeeper, &accountKeeper)
+	k.SetGovKeeper(&govKeeper)
 
 	stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
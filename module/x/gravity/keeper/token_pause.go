@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// IsDepositPaused returns whether the deposits of tokenContract are held by the DepositPausedTokens param
+func (k Keeper) IsDepositPaused(ctx sdk.Context, tokenContract types.EthAddress) bool {
+	return containsToken(k.GetParams(ctx).DepositPausedTokens, tokenContract)
+}
+
+// IsWithdrawalPaused returns whether the withdrawals of tokenContract are rejected by the WithdrawalPausedTokens param
+func (k Keeper) IsWithdrawalPaused(ctx sdk.Context, tokenContract types.EthAddress) bool {
+	return containsToken(k.GetParams(ctx).WithdrawalPausedTokens, tokenContract)
+}
+
+// containsToken returns whether tokenContract is in tokens, which were validated as Ethereum addresses
+func containsToken(tokens []string, tokenContract types.EthAddress) bool {
+	for _, token := range tokens {
+		contract, err := types.NewEthAddress(token)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid paused token %s in params", token))
+		}
+		if contract.GetAddress() == tokenContract.GetAddress() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetTotalValueLocked returns the amount locked by the bridge of every bridged token. Every voucher of an Ethereum
+// originated token is backed by its ERC20 held by the Gravity contract, so its locked amount is the voucher supply.
+// A Cosmos originated token is locked in the gravity module account once its transfer to Ethereum is observed and in
+// the unbatched pool and batches accounts until then. Deposits swapped by Erc20ToDenomPermanentSwap are not included
+// as they can not be told apart from the rest of the supply of their denom.
+func (k Keeper) GetTotalValueLocked(ctx sdk.Context) ([]types.TokenValueLocked, sdk.Coins) {
+	tokens := []types.TokenValueLocked{}
+	total := sdk.NewCoins()
+
+	k.bankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		erc20, err := types.GravityDenomToERC20(supply.Denom)
+		if supply.Denom == types.NativeEthDenom {
+			// native ETH is locked as is until the contract wraps it
+			erc20, err = k.nativeEthLockedAs(ctx), nil
+		}
+		if err != nil {
+			return false
+		}
+		tokens = append(tokens, types.TokenValueLocked{
+			Denom:            supply.Denom,
+			Erc20:            erc20.GetAddress(),
+			CosmosOriginated: false,
+			Amount:           supply.Amount,
+		})
+		total = total.Add(supply)
+		return false
+	})
+
+	holders := make([]sdk.AccAddress, 0, 3)
+	for _, name := range []string{types.ModuleName, types.UnbatchedPoolAccountName, types.BatchesAccountName} {
+		holders = append(holders, k.accountKeeper.GetModuleAddress(name))
+	}
+	k.IterateERC20ToDenom(ctx, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		locked := sdk.NewCoin(erc20ToDenom.Denom, sdk.ZeroInt())
+		for _, holder := range holders {
+			locked = locked.Add(k.bankKeeper.GetBalance(ctx, holder, erc20ToDenom.Denom))
+		}
+		tokens = append(tokens, types.TokenValueLocked{
+			Denom:            erc20ToDenom.Denom,
+			Erc20:            erc20ToDenom.Erc20,
+			CosmosOriginated: true,
+			Amount:           locked.Amount,
+		})
+		total = total.Add(locked)
+		return false
+	})
+
+	return tokens, total
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestGetTotalValueLocked(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender            = RandomAccAddress()
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		cosmosERC20, _      = types.NewEthAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	voucher := token.GravityCoin()
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(voucher)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.NewCoins(voucher)))
+
+	const cosmosDenom = "ucosmos"
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(ctx, cosmosDenom, *cosmosERC20)
+	cosmosCoins := sdk.NewCoins(sdk.NewInt64Coin(cosmosDenom, 500))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, cosmosCoins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, cosmosCoins))
+
+	// nothing of the cosmos originated token is locked yet
+	tokens, total := input.GravityKeeper.GetTotalValueLocked(ctx)
+	require.Equal(t, []types.TokenValueLocked{
+		{Denom: voucher.Denom, Erc20: token.Contract.GetAddress(), CosmosOriginated: false, Amount: sdk.NewInt(1000)},
+		{Denom: cosmosDenom, Erc20: cosmosERC20.GetAddress(), CosmosOriginated: true, Amount: sdk.ZeroInt()},
+	}, tokens)
+	require.Equal(t, sdk.NewCoins(voucher), total)
+
+	// the unbatched transfer and its fee are locked, the voucher supply is unchanged
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver,
+		sdk.NewInt64Coin(cosmosDenom, 100), sdk.NewInt64Coin(cosmosDenom, 5))
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, mySender, types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(cosmosDenom, 50))))
+
+	res, err := NewQueryServerImpl(input.GravityKeeper).TotalValueLocked(sdk.WrapSDKContext(ctx), &types.QueryTotalValueLockedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000), res.Tokens[0].Amount)
+	require.Equal(t, sdk.NewInt(155), res.Tokens[1].Amount)
+	require.Equal(t, sdk.NewCoins(voucher, sdk.NewInt64Coin(cosmosDenom, 155)), res.Total)
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+/////////////////////////////
+//     BRIDGE UNJAILING    //
+/////////////////////////////
+
+// SetBridgeJailedHeight records that the validator was jailed at the given height for missing bridge signatures,
+// such a validator can only be unjailed through MsgUnjailValidator. Note this value is not saved and loaded in genesis
+func (k Keeper) SetBridgeJailedHeight(ctx sdk.Context, val sdk.ValAddress, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetBridgeJailedValidatorKey(val)), types.UInt64Bytes(height))
+}
+
+// GetBridgeJailedHeight returns the height at which the validator was jailed for missing bridge signatures,
+// found is false if the validator was not jailed by the gravity module or has since been unjailed
+func (k Keeper) GetBridgeJailedHeight(ctx sdk.Context, val sdk.ValAddress) (height uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetBridgeJailedValidatorKey(val)))
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return types.UInt64FromBytes(bz), true
+}
+
+// deleteBridgeJailedHeight removes the bridge jailing record of a validator
+func (k Keeper) deleteBridgeJailedHeight(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.GetBridgeJailedValidatorKey(val)))
+}
+
+// GetOutstandingBridgeConfirms returns the number of valsets, batches and logic calls created at or before the given
+// height which the validator has not confirmed. Only those still in the store are counted, once an item is pruned,
+// executed or timed out its confirm can no longer be submitted and it is no longer outstanding. As when slashing,
+// items created before the validator started validating are not expected to be confirmed by it
+func (k Keeper) GetOutstandingBridgeConfirms(ctx sdk.Context, val sdk.ValAddress, height uint64) (valsets, batches, logicCalls int) {
+	validator, found := k.ValidatorSet.GetValidator(ctx, val)
+	if !found {
+		return 0, 0, 0
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator consensus address"))
+	}
+	startHeight := int64(0)
+	if info, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr); found {
+		startHeight = info.StartHeight
+	}
+	expected := func(created uint64) bool {
+		return created <= height && startHeight < int64(created)
+	}
+
+	k.IterateValsets(ctx, func(_ []byte, valset *types.Valset) bool {
+		if !expected(valset.Height) {
+			return false
+		}
+		var orchestrators []string
+		for _, confirm := range k.GetValsetConfirms(ctx, valset.Nonce) {
+			orchestrators = append(orchestrators, confirm.Orchestrator)
+		}
+		if !k.confirmedByValidator(ctx, orchestrators, val) {
+			valsets++
+		}
+		return false
+	})
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		if !expected(batch.Block) {
+			return false
+		}
+		var orchestrators []string
+		for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract) {
+			orchestrators = append(orchestrators, confirm.Orchestrator)
+		}
+		if !k.confirmedByValidator(ctx, orchestrators, val) {
+			batches++
+		}
+		return false
+	})
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call types.OutgoingLogicCall) bool {
+		if !expected(call.Block) {
+			return false
+		}
+		var orchestrators []string
+		for _, confirm := range k.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce) {
+			orchestrators = append(orchestrators, confirm.Orchestrator)
+		}
+		if !k.confirmedByValidator(ctx, orchestrators, val) {
+			logicCalls++
+		}
+		return false
+	})
+	return valsets, batches, logicCalls
+}
+
+// confirmedByValidator returns true if one of the orchestrators that submitted a confirm belongs to the validator
+func (k Keeper) confirmedByValidator(ctx sdk.Context, orchestrators []string, val sdk.ValAddress) bool {
+	for _, orchestrator := range orchestrators {
+		orch, err := sdk.AccAddressFromBech32(orchestrator)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid confirm in store"))
+		}
+		if validator, found := k.GetOrchestratorValidator(ctx, orch); found && validator.GetOperator().Equals(val) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnjailCaughtUpValidator unjails a validator through the slashing module, a validator jailed by the gravity module for
+// missing bridge signatures must first submit every confirm it is missing from before its jailing
+func (k Keeper) UnjailCaughtUpValidator(ctx sdk.Context, val sdk.ValAddress) error {
+	if height, found := k.GetBridgeJailedHeight(ctx, val); found {
+		valsets, batches, logicCalls := k.GetOutstandingBridgeConfirms(ctx, val, height)
+		if valsets+batches+logicCalls != 0 {
+			return sdkerrors.Wrapf(types.ErrInvalid, "validator is missing %d valset, %d batch and %d logic call confirms",
+				valsets, batches, logicCalls)
+		}
+	}
+	if err := k.SlashingKeeper.Unjail(ctx, val); err != nil {
+		return err
+	}
+	k.deleteBridgeJailedHeight(ctx, val)
+	return nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestUnjailCaughtUpValidator(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	pk := input.GravityKeeper
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	valset := pk.SetValsetRequest(ctx)
+
+	// jail the validator for missing the valset confirm
+	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Jail(ctx, consAddr)
+	pk.SetBridgeJailedHeight(ctx, ValAddrs[0], uint64(ctx.BlockHeight()))
+
+	// valsets created after the jailing are not outstanding
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	pk.SetValsetRequest(ctx)
+
+	valsets, batches, logicCalls := pk.GetOutstandingBridgeConfirms(ctx, ValAddrs[0], valset.Height)
+	assert.Equal(t, 1, valsets)
+	assert.Equal(t, 0, batches)
+	assert.Equal(t, 0, logicCalls)
+	require.Error(t, pk.UnjailCaughtUpValidator(ctx, ValAddrs[0]))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// a confirm from another validator does not count
+	pk.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: OrchAddrs[1].String(),
+		EthAddress:   EthAddrs[1].String(),
+		Signature:    "",
+	})
+	require.Error(t, pk.UnjailCaughtUpValidator(ctx, ValAddrs[0]))
+
+	// once the outstanding confirm is submitted the validator can unjail
+	pk.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: OrchAddrs[0].String(),
+		EthAddress:   EthAddrs[0].String(),
+		Signature:    "",
+	})
+	require.NoError(t, pk.UnjailCaughtUpValidator(ctx, ValAddrs[0]))
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+	_, found := pk.GetBridgeJailedHeight(ctx, ValAddrs[0])
+	require.False(t, found)
+
+	// validators jailed for other reasons unjail as usual
+	val = input.StakingKeeper.Validator(ctx, ValAddrs[1])
+	consAddr, err = val.GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Jail(ctx, consAddr)
+	require.NoError(t, pk.UnjailCaughtUpValidator(ctx, ValAddrs[1]))
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[1]).IsJailed())
+}
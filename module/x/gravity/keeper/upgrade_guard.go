@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetPendingHighValueDeposits returns the deposits claimed by orchestrators but not observed yet whose amount reaches
+// the UpgradeGuardDepositThresholds param for their token
+func (k Keeper) GetPendingHighValueDeposits(ctx sdk.Context) (deposits []types.MsgSendToCosmosClaim) {
+	thresholds := make(map[string]sdk.Int)
+	for _, threshold := range k.GetParams(ctx).UpgradeGuardDepositThresholds {
+		thresholds[strings.ToLower(threshold.Contract)] = threshold.Amount
+	}
+	if len(thresholds) == 0 {
+		return nil
+	}
+
+	k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
+		if att.Observed {
+			return false
+		}
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid attestation in store"))
+		}
+		deposit, ok := claim.(*types.MsgSendToCosmosClaim)
+		if !ok {
+			return false
+		}
+		if threshold, found := thresholds[strings.ToLower(deposit.TokenContract)]; found && deposit.Amount.GTE(threshold) {
+			deposits = append(deposits, *deposit)
+		}
+		return false
+	})
+	return deposits
+}
+
+// NewUpgradeGuardProposalHandler wraps the software upgrade proposal handler so that an upgrade passing while high value
+// deposits are pending emits a warning, or fails when the UpgradeGuardBlocksUpgrades param is set. An attestation
+// straddling an upgrade has to be resubmitted by orchestrators restarting against the upgraded chain
+func NewUpgradeGuardProposalHandler(k Keeper, next govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if p, ok := content.(*upgradetypes.SoftwareUpgradeProposal); ok {
+			if err := k.guardUpgrade(ctx, p.Plan); err != nil {
+				return err
+			}
+		}
+		return next(ctx, content)
+	}
+}
+
+// guardUpgrade checks for pending high value deposits before plan is scheduled
+func (k Keeper) guardUpgrade(ctx sdk.Context, plan upgradetypes.Plan) error {
+	deposits := k.GetPendingHighValueDeposits(ctx)
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	nonces := make([]string, len(deposits))
+	for i, deposit := range deposits {
+		nonces[i] = fmt.Sprint(deposit.EventNonce)
+	}
+	if k.GetParams(ctx).UpgradeGuardBlocksUpgrades {
+		return sdkerrors.Wrapf(types.ErrInvalid, "upgrade %s refused while the high value deposits with event nonces %s are pending",
+			plan.Name, strings.Join(nonces, ","))
+	}
+
+	k.logger(ctx).Error("upgrade scheduled while high value deposits are pending",
+		"upgrade", plan.Name, "height", plan.Height, "event_nonces", strings.Join(nonces, ","))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpgradeWithPendingDeposits,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyUpgradeName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, fmt.Sprint(plan.Height)),
+			sdk.NewAttribute(types.AttributeKeyPendingDeposits, strings.Join(nonces, ",")),
+		),
+	)
+	return nil
+}
+
+// GetModuleVersions returns the consensus version of every module in state or in this binary by name, and whether
+// running the migrations of an upgrade on this binary would change any of them. A module missing from state would
+// have its genesis initialized, a module missing from the binary would be left unmigrated
+func (k Keeper) GetModuleVersions(ctx sdk.Context) ([]types.ModuleConsensusVersion, bool, error) {
+	if k.stateModuleVersions == nil || k.binaryModuleVersions == nil {
+		return nil, false, sdkerrors.Wrap(types.ErrInvalid, "module versions are not available on this node")
+	}
+	stateVersions := k.stateModuleVersions.GetModuleVersionMap(ctx)
+	binaryVersions := k.binaryModuleVersions()
+
+	byName := make(map[string]*types.ModuleConsensusVersion)
+	for name, version := range stateVersions {
+		byName[name] = &types.ModuleConsensusVersion{Name: name, StateVersion: version}
+	}
+	for name, version := range binaryVersions {
+		if _, ok := byName[name]; !ok {
+			byName[name] = &types.ModuleConsensusVersion{Name: name}
+		}
+		byName[name].BinaryVersion = version
+	}
+
+	versions := make([]types.ModuleConsensusVersion, 0, len(byName))
+	pending := false
+	for _, version := range byName {
+		versions = append(versions, *version)
+		pending = pending || version.StateVersion != version.BinaryVersion
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Name < versions[j].Name })
+	return versions, pending, nil
+}
+
+// optionalModules are the modules chains may or may not run which tooling checks the binary for, with the names of
+// their stores
+var optionalModules = []struct {
+	name   string
+	stores []string
+}{
+	{"wasm", []string{"wasm"}},
+	{"interchainaccounts", []string{"icacontroller", "icahost"}},
+	{"feegrant", []string{"feegrant"}},
+}
+
+// GetAppModules returns the stores mounted by this binary, the module versions of GetModuleVersions and whether this
+// binary includes each of the optionalModules, either as a module with a consensus version or as a mounted store
+func (k Keeper) GetAppModules(ctx sdk.Context) ([]types.MountedStore, []types.ModuleConsensusVersion, []types.OptionalModule, error) {
+	if k.mountedStores == nil {
+		return nil, nil, nil, sdkerrors.Wrap(types.ErrInvalid, "mounted stores are not available on this node")
+	}
+	versions, _, err := k.GetModuleVersions(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	included := make(map[string]bool)
+	for _, version := range versions {
+		included[version.Name] = version.BinaryVersion != 0
+	}
+	for _, store := range k.mountedStores {
+		included[store.Name] = true
+	}
+	optional := make([]types.OptionalModule, len(optionalModules))
+	for i, module := range optionalModules {
+		optional[i] = types.OptionalModule{Name: module.name, Included: included[module.name]}
+		for _, store := range module.stores {
+			optional[i].Included = optional[i].Included || included[store]
+		}
+	}
+	return k.mountedStores, versions, optional, nil
+}
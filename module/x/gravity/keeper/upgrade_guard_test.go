@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// Tests that upgrades passing while high value deposits are pending warn or fail depending on the params
+//nolint: exhaustivestruct
+func TestUpgradeGuardProposalHandler(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	pk := input.GravityKeeper
+
+	params := pk.GetParams(ctx)
+	params.UpgradeGuardDepositThresholds = []types.ERC20Token{{Contract: TokenContractAddrs[0], Amount: sdk.NewInt(1000)}}
+	pk.SetParams(ctx, params)
+
+	storeDeposit := func(nonce uint64, contract string, amount int64, observed bool) {
+		deposit := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  contract,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		claim, err := codectypes.NewAnyWithValue(deposit)
+		require.NoError(t, err)
+		hash, err := deposit.ClaimHash(types.ClaimEncodingVersion)
+		require.NoError(t, err)
+		pk.SetAttestation(ctx, nonce, hash, &types.Attestation{Observed: observed, Votes: []string{}, Claim: claim, ClaimHashVersion: types.ClaimEncodingVersion})
+	}
+	// below the threshold, already observed and without threshold
+	storeDeposit(1, TokenContractAddrs[0], 999, false)
+	storeDeposit(2, TokenContractAddrs[0], 5000, true)
+	storeDeposit(3, TokenContractAddrs[1], 5000, false)
+	require.Empty(t, pk.GetPendingHighValueDeposits(ctx))
+
+	scheduled := 0
+	handler := NewUpgradeGuardProposalHandler(pk, func(sdk.Context, govtypes.Content) error {
+		scheduled++
+		return nil
+	})
+	upgrade := upgradetypes.NewSoftwareUpgradeProposal("upgrade", "upgrade", upgradetypes.Plan{Name: "v2", Height: 100})
+	require.NoError(t, handler(ctx, upgrade))
+	require.Equal(t, 1, scheduled)
+
+	storeDeposit(4, TokenContractAddrs[0], 1000, false)
+	deposits := pk.GetPendingHighValueDeposits(ctx)
+	require.Len(t, deposits, 1)
+	require.Equal(t, uint64(4), deposits[0].EventNonce)
+
+	// the upgrade is only warned about by default
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, handler(ctx, upgrade))
+	require.Equal(t, 2, scheduled)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeUpgradeWithPendingDeposits, ctx.EventManager().Events()[0].Type)
+
+	// and refused when the guard blocks upgrades, other proposals pass through
+	params.UpgradeGuardBlocksUpgrades = true
+	pk.SetParams(ctx, params)
+	require.Error(t, handler(ctx, upgrade))
+	require.Equal(t, 2, scheduled)
+	require.NoError(t, handler(ctx, govtypes.NewTextProposal("text", "text")))
+	require.Equal(t, 3, scheduled)
+}
+
+type stateModuleVersions module.VersionMap
+
+func (v stateModuleVersions) GetModuleVersionMap(sdk.Context) module.VersionMap {
+	return module.VersionMap(v)
+}
+
+// Tests that the module versions report the modules of the state and of the binary and the pending migrations
+func TestGetModuleVersions(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	_, _, err := k.GetModuleVersions(ctx)
+	require.Error(t, err)
+
+	state := stateModuleVersions{"bank": 2, types.ModuleName: 3, "legacy": 1}
+	binary := module.VersionMap{"bank": 2, types.ModuleName: 4, "ibc": 2}
+	k.SetModuleVersions(state, func() module.VersionMap { return binary })
+	versions, pending, err := k.GetModuleVersions(ctx)
+	require.NoError(t, err)
+	require.True(t, pending)
+	require.Equal(t, []types.ModuleConsensusVersion{
+		{Name: "bank", StateVersion: 2, BinaryVersion: 2},
+		{Name: types.ModuleName, StateVersion: 3, BinaryVersion: 4},
+		{Name: "ibc", StateVersion: 0, BinaryVersion: 2},
+		{Name: "legacy", StateVersion: 1, BinaryVersion: 0},
+	}, versions)
+
+	k.SetModuleVersions(stateModuleVersions{"bank": 2}, func() module.VersionMap { return module.VersionMap{"bank": 2} })
+	_, pending, err = k.GetModuleVersions(ctx)
+	require.NoError(t, err)
+	require.False(t, pending)
+}
+
+// Tests that the app modules report the mounted stores by name and the optional modules the binary includes
+func TestGetAppModules(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	k.SetModuleVersions(stateModuleVersions{"bank": 2}, func() module.VersionMap {
+		return module.VersionMap{"bank": 2, "wasm": 1}
+	})
+	_, _, _, err := k.GetAppModules(ctx)
+	require.Error(t, err)
+
+	k.SetMountedStores(
+		sdk.NewKVStoreKeys("bank", "icahost"),
+		sdk.NewTransientStoreKeys("transient_params"),
+		sdk.NewMemoryStoreKeys("memory_capability"),
+	)
+	stores, versions, optional, err := k.GetAppModules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.MountedStore{
+		{Name: "bank", Kind: "kv"},
+		{Name: "icahost", Kind: "kv"},
+		{Name: "memory_capability", Kind: "memory"},
+		{Name: "transient_params", Kind: "transient"},
+	}, stores)
+	require.Len(t, versions, 2)
+	require.Equal(t, []types.OptionalModule{
+		{Name: "wasm", Included: true},
+		{Name: "interchainaccounts", Included: true},
+		{Name: "feegrant", Included: false},
+	}, optional)
+}
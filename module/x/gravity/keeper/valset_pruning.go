@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// isValsetPrunable returns true once a valset can no longer be submitted to Ethereum, as a later valset was observed
+// executed, and can no longer be slashed, as it was slashed and is older than the signed valsets window
+func isValsetPrunable(valset types.Valset, lastObserved *types.Valset, lastSlashedNonce uint64, height uint64, params types.Params) bool {
+	if lastObserved == nil || height < params.SignedValsetsWindow {
+		return false
+	}
+	return valset.Nonce < lastObserved.Nonce && valset.Nonce <= lastSlashedNonce && valset.Height < height-params.SignedValsetsWindow
+}
+
+// GetEarliestNeededValset returns the valset with the lowest nonce which can not be pruned yet, every valset before
+// it is deleted by the EndBlocker. It is nil if no valset is stored
+func (k Keeper) GetEarliestNeededValset(ctx sdk.Context, params types.Params) *types.Valset {
+	lastObserved := k.GetLastObservedValset(ctx)
+	lastSlashedNonce := k.GetLastSlashedValsetNonce(ctx)
+	height := uint64(ctx.BlockHeight())
+	var earliest *types.Valset
+	k.IterateValsetBySlashedValsetNonce(ctx, 0, func(_ []byte, valset *types.Valset) bool {
+		if isValsetPrunable(*valset, lastObserved, lastSlashedNonce, height, params) {
+			return false
+		}
+		earliest = valset
+		return true
+	})
+	return earliest
+}
+
+// PruneValsets deletes the valsets, their confirms and relay packages older than the earliest needed valset. The
+// valsets are iterated by ascending nonce, and so by ascending height, so the iteration stops at the first valset
+// still needed
+func (k Keeper) PruneValsets(ctx sdk.Context, params types.Params) {
+	lastObserved := k.GetLastObservedValset(ctx)
+	lastSlashedNonce := k.GetLastSlashedValsetNonce(ctx)
+	height := uint64(ctx.BlockHeight())
+	var prunable []uint64
+	k.IterateValsetBySlashedValsetNonce(ctx, 0, func(_ []byte, valset *types.Valset) bool {
+		if !isValsetPrunable(*valset, lastObserved, lastSlashedNonce, height, params) {
+			return true
+		}
+		prunable = append(prunable, valset.Nonce)
+		return false
+	})
+	for _, nonce := range prunable {
+		k.DeleteValset(ctx, nonce)
+		k.DeleteValsetConfirms(ctx, nonce)
+		k.DeleteValsetRelayPackage(ctx, nonce)
+	}
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Tests that valsets are only pruned once a later valset was observed, they were slashed and the signed valsets window
+// passed, and that the earliest needed valset is the first one kept
+func TestPruneValsets(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+
+	var nonces []uint64
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		nonces = append(nonces, k.SetValsetRequest(ctx).Nonce)
+	}
+	require.Equal(t, nonces[0], k.GetEarliestNeededValset(ctx, params).Nonce)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 1)
+	// nothing is pruned before a later valset is observed
+	k.PruneValsets(ctx, params)
+	require.Len(t, k.GetValsets(ctx), 3)
+
+	k.SetLastObservedValset(ctx, *k.GetValset(ctx, nonces[2]))
+	k.SetLastSlashedValsetNonce(ctx, nonces[0])
+	// the second valset was not slashed yet
+	require.Equal(t, nonces[1], k.GetEarliestNeededValset(ctx, params).Nonce)
+	k.PruneValsets(ctx, params)
+	require.Nil(t, k.GetValset(ctx, nonces[0]))
+	require.NotNil(t, k.GetValset(ctx, nonces[1]))
+
+	k.SetLastSlashedValsetNonce(ctx, nonces[2])
+	require.Equal(t, nonces[2], k.GetEarliestNeededValset(ctx, params).Nonce)
+	k.PruneValsets(ctx, params)
+	require.Len(t, k.GetValsets(ctx), 1)
+	require.NotNil(t, k.GetValset(ctx, nonces[2]))
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// buildValsetRelayPackage collects the confirms of valset in the order of the members of the valset they are checked
+// against by the Gravity contract, the same signing valset GetValsetSignedPower counts them against
+func (k Keeper) buildValsetRelayPackage(ctx sdk.Context, valset types.Valset) types.ValsetRelayPackage {
+	signingSet := k.GetLastObservedValset(ctx)
+	if signingSet == nil {
+		signingSet = &valset
+	}
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetValsetConfirms(ctx, valset.Nonce) {
+		signatures[strings.ToLower(confirm.EthAddress)] = confirm.Signature
+	}
+	relayPackage := types.ValsetRelayPackage{
+		ValsetNonce:        valset.Nonce,
+		SigningValsetNonce: signingSet.Nonce,
+		Signatures:         make([]types.RelaySignature, 0, len(signingSet.Members)),
+		Height:             uint64(ctx.BlockHeight()),
+	}
+	for _, member := range signingSet.Members {
+		relayPackage.Signatures = append(relayPackage.Signatures, types.RelaySignature{
+			EthereumAddress: member.EthereumAddress,
+			Power:           member.Power,
+			Signature:       signatures[strings.ToLower(member.EthereumAddress)],
+		})
+	}
+	return relayPackage
+}
+
+// setValsetRelayPackage stores the relay package of a relayable valset
+// WARNING: Do not make this function public
+func (k Keeper) setValsetRelayPackage(ctx sdk.Context, relayPackage types.ValsetRelayPackage) {
+	key := []byte(types.GetValsetRelayPackageKey(relayPackage.ValsetNonce))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&relayPackage))
+}
+
+// GetValsetRelayPackage returns the relay package of the valset with nonce, or nil if the valset has not become
+// relayable or was pruned
+func (k Keeper) GetValsetRelayPackage(ctx sdk.Context, nonce uint64) *types.ValsetRelayPackage {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.GetValsetRelayPackageKey(nonce)))
+	if bz == nil {
+		return nil
+	}
+	var relayPackage types.ValsetRelayPackage
+	k.cdc.MustUnmarshal(bz, &relayPackage)
+	return &relayPackage
+}
+
+// GetValsetRelayPackages returns the stored relay packages in valset nonce order
+func (k Keeper) GetValsetRelayPackages(ctx sdk.Context) []types.ValsetRelayPackage {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ValsetRelayPackageKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	var relayPackages []types.ValsetRelayPackage
+	for ; iter.Valid(); iter.Next() {
+		var relayPackage types.ValsetRelayPackage
+		k.cdc.MustUnmarshal(iter.Value(), &relayPackage)
+		relayPackages = append(relayPackages, relayPackage)
+	}
+	return relayPackages
+}
+
+// DeleteValsetRelayPackage deletes the relay package of the valset with nonce
+func (k Keeper) DeleteValsetRelayPackage(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetValsetRelayPackageKey(nonce)))
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// GetVoucherOrigin traces a denom back to its ERC20: a gravity voucher, a cosmos originated denom bridged to Ethereum,
+// or an IBC voucher of either. A denom paired with an ERC20 by this bridge is resolved directly, even an IBC voucher
+// bridged as a cosmos originated token, otherwise an IBC voucher is resolved through its base denom
+func (k Keeper) GetVoucherOrigin(ctx sdk.Context, denom string) (types.VoucherOrigin, error) {
+	origin := types.VoucherOrigin{Denom: denom, BaseDenom: denom}
+	var trace *ibctransfertypes.DenomTrace
+	var traceErr error
+	isIBCVoucher := strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/")
+	if isIBCVoucher {
+		found, err := k.getDenomTrace(ctx, denom)
+		if err == nil {
+			trace = &found
+			origin.IbcPath = trace.Path
+		}
+		traceErr = err
+	}
+
+	if cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, denom); err == nil {
+		origin.Erc20 = erc20.GetAddress()
+		origin.CosmosOriginated = cosmosOriginated
+		if !cosmosOriginated {
+			origin.SourceChain = fmt.Sprintf("eip155:%d", k.GetBridgeChainID(ctx))
+		} else if !isIBCVoucher {
+			origin.SourceChain = "cosmos:" + ctx.ChainID()
+		}
+		if trace != nil {
+			origin.BaseDenom = trace.BaseDenom
+		}
+	} else {
+		if traceErr != nil {
+			return types.VoucherOrigin{}, traceErr
+		}
+		if trace == nil {
+			return types.VoucherOrigin{}, err
+		}
+		// the hops lead to a gravity voucher of another chain, the ERC20 is known from its denom but not its bridge
+		erc20, err := types.GravityDenomToERC20(trace.BaseDenom)
+		if err != nil {
+			return types.VoucherOrigin{}, sdkerrors.Wrapf(types.ErrInvalid, "%s is neither a gravity voucher nor bridged", trace.GetFullDenomPath())
+		}
+		origin.BaseDenom = trace.BaseDenom
+		origin.Erc20 = erc20.GetAddress()
+	}
+
+	for _, metadataDenom := range []string{denom, origin.BaseDenom} {
+		metadata, found := k.bankKeeper.GetDenomMetaData(ctx, metadataDenom)
+		if !found {
+			continue
+		}
+		origin.Symbol = metadata.Symbol
+		if origin.Symbol == "" {
+			origin.Symbol = metadata.Display
+		}
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				origin.Decimals, origin.DecimalsKnown = unit.Exponent, true
+			}
+		}
+		break
+	}
+	return origin, nil
+}
+
+// getDenomTrace returns the trace of an IBC voucher denom
+func (k Keeper) getDenomTrace(ctx sdk.Context, denom string) (ibctransfertypes.DenomTrace, error) {
+	if k.denomTraces == nil {
+		return ibctransfertypes.DenomTrace{}, sdkerrors.Wrap(types.ErrInvalid, "denom trace source not set")
+	}
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return ibctransfertypes.DenomTrace{}, sdkerrors.Wrapf(types.ErrInvalid, "invalid IBC denom %s: %s", denom, err)
+	}
+	trace, found := k.denomTraces.GetDenomTrace(ctx, hash)
+	if !found {
+		return ibctransfertypes.DenomTrace{}, sdkerrors.Wrapf(types.ErrInvalid, "unknown IBC denom %s", denom)
+	}
+	return trace, nil
+}
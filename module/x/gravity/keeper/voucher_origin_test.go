@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// denomTraces is a DenomTraceSource holding fixed traces
+type denomTraces map[string]ibctransfertypes.DenomTrace
+
+func (d denomTraces) GetDenomTrace(_ sdk.Context, hash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+	trace, found := d[hash.String()]
+	return trace, found
+}
+
+// Tests that gravity vouchers, bridged cosmos denoms and IBC vouchers of both are traced back to their ERC20
+func TestGetVoucherOrigin(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithChainID("onomy-1")
+	contract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	cosmosContract := "0x2a24af0501a534fca004ee1bd667b783f205a546"
+	atomContract := "0x7f1d5f0f9a3c5e7b9d1f3a5c7e9b1d3f5a7c9e1b"
+	voucher := types.GravityDenomPrefix + contract
+
+	atom := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	foreignVoucher := ibctransfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: types.GravityDenomPrefix + contract}
+	traces := denomTraces{}
+	for _, trace := range []ibctransfertypes.DenomTrace{atom, foreignVoucher} {
+		traces[trace.Hash().String()] = trace
+	}
+	input.GravityKeeper.SetDenomTraceSource(traces)
+	k := input.GravityKeeper
+
+	cosmosAddr, err := types.NewEthAddress(cosmosContract)
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", *cosmosAddr)
+	atomAddr, err := types.NewEthAddress(atomContract)
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, atom.IBCDenom(), *atomAddr)
+	input.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "stake",
+		Display:    "nom",
+		Symbol:     "NOM",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "stake", Exponent: 0}, {Denom: "nom", Exponent: 18}},
+	})
+
+	origin, err := k.GetVoucherOrigin(ctx, voucher)
+	require.NoError(t, err)
+	require.Equal(t, types.VoucherOrigin{
+		Denom:       voucher,
+		BaseDenom:   voucher,
+		Erc20:       contract,
+		SourceChain: "eip155:11",
+	}, origin)
+
+	origin, err = k.GetVoucherOrigin(ctx, "stake")
+	require.NoError(t, err)
+	require.Equal(t, types.VoucherOrigin{
+		Denom:            "stake",
+		BaseDenom:        "stake",
+		Erc20:            cosmosContract,
+		CosmosOriginated: true,
+		SourceChain:      "cosmos:onomy-1",
+		Decimals:         18,
+		DecimalsKnown:    true,
+		Symbol:           "NOM",
+	}, origin)
+
+	// an IBC denom bridged as a cosmos originated token
+	origin, err = k.GetVoucherOrigin(ctx, atom.IBCDenom())
+	require.NoError(t, err)
+	require.Equal(t, types.VoucherOrigin{
+		Denom:            atom.IBCDenom(),
+		IbcPath:          "transfer/channel-0",
+		BaseDenom:        "uatom",
+		Erc20:            atomContract,
+		CosmosOriginated: true,
+	}, origin)
+
+	// the gravity voucher of another chain
+	origin, err = k.GetVoucherOrigin(ctx, foreignVoucher.IBCDenom())
+	require.NoError(t, err)
+	require.Equal(t, types.VoucherOrigin{
+		Denom:     foreignVoucher.IBCDenom(),
+		IbcPath:   "transfer/channel-1",
+		BaseDenom: voucher,
+		Erc20:     contract,
+	}, origin)
+
+	_, err = k.GetVoucherOrigin(ctx, "footoken")
+	require.Error(t, err)
+	_, err = k.GetVoucherOrigin(ctx, ibctransfertypes.DenomTrace{Path: "transfer/channel-2", BaseDenom: "uosmo"}.IBCDenom())
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
@@ -0,0 +1,89 @@
+package gravity
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/keeper"
+	"github.com/onomyprotocol/arc/module/eth/x/gravity/types"
+)
+
+// version1ParamKeys are the keys of the params of the first consensus version of the module
+var version1ParamKeys = [][]byte{
+	types.ParamsStoreKeyGravityID,
+	types.ParamsStoreKeyContractHash,
+	types.ParamsStoreKeyBridgeEthereumAddress,
+	types.ParamsStoreKeyBridgeContractChainID,
+	types.ParamsStoreKeySignedValsetsWindow,
+	types.ParamsStoreKeySignedBatchesWindow,
+	types.ParamsStoreKeySignedLogicCallsWindow,
+	types.ParamsStoreKeyTargetBatchTimeout,
+	types.ParamsStoreKeyAverageBlockTime,
+	types.ParamsStoreKeyAverageEthereumBlockTime,
+	types.ParamsStoreSlashFractionValset,
+	types.ParamsStoreSlashFractionBatch,
+	types.ParamStoreUnbondSlashingValsetsWindow,
+	types.ParamStoreSlashFractionBadEthSignature,
+	types.ParamStoreValsetRewardAmount,
+	types.ParamStoreBridgeActive,
+	types.ParamStoreEthereumBlacklist,
+	types.ParamStoreErc20ToDenomPermanentSwap,
+}
+
+// Tests that a chain upgraded from the first consensus version, whose param store only holds the params of that
+// version, has every param set by the migrations and runs the EndBlocker after them
+func TestMigrationsFromVersion1(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	ms, ok := ctx.MultiStore().(*rootmulti.Store)
+	require.True(t, ok)
+	paramsStore, ok := ms.GetStoreByName(paramstypes.StoreKey).(sdk.KVStore)
+	require.True(t, ok)
+	gravityParams := prefix.NewStore(paramsStore, []byte(types.DefaultParamspace+"/"))
+
+	// drop the params added since version 1, keeping a custom value of one of them
+	version1 := make(map[string]bool)
+	for _, key := range version1ParamKeys {
+		version1[string(key)] = true
+	}
+	for _, pair := range (&types.Params{}).ParamSetPairs() {
+		if !version1[string(pair.Key)] {
+			gravityParams.Delete(pair.Key)
+		}
+	}
+	require.Panics(t, func() { k.GetParams(ctx) })
+	gravityParams.Set(types.ParamStoreBatchRelayLatencySLA, []byte(`"100"`))
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(registry)
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(registry)
+	cfg := module.NewConfigurator(input.Marshaler, msgRouter, queryRouter)
+	mm := module.NewManager(NewAppModule(k, input.BankKeeper))
+	mm.RegisterServices(cfg)
+
+	versions, err := mm.RunMigrations(ctx, cfg, module.VersionMap{types.ModuleName: 1})
+	require.NoError(t, err)
+	require.Equal(t, NewAppModule(k, input.BankKeeper).ConsensusVersion(), versions[types.ModuleName])
+
+	params := k.GetParams(ctx)
+	defaults := types.DefaultParams()
+	require.Equal(t, uint64(100), params.BatchRelayLatencySla)
+	require.Equal(t, uint64(1), params.ClaimHashVersion)
+	require.Equal(t, defaults.RelayerFeeShare, params.RelayerFeeShare)
+	require.Equal(t, defaults.MinChainFeeBasisPoints, params.MinChainFeeBasisPoints)
+	require.NoError(t, params.ValidateBasic())
+
+	require.NotPanics(t, func() { EndBlocker(ctx, k) })
+}
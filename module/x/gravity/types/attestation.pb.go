@@ -34,6 +34,7 @@ const (
 	CLAIM_TYPE_ERC20_DEPLOYED      ClaimType = 3
 	CLAIM_TYPE_LOGIC_CALL_EXECUTED ClaimType = 4
 	CLAIM_TYPE_VALSET_UPDATED      ClaimType = 5
+	CLAIM_TYPE_FORK_DETECTED       ClaimType = 6
 )
 
 var ClaimType_name = map[int32]string{
@@ -43,6 +44,7 @@ var ClaimType_name = map[int32]string{
 	3: "CLAIM_TYPE_ERC20_DEPLOYED",
 	4: "CLAIM_TYPE_LOGIC_CALL_EXECUTED",
 	5: "CLAIM_TYPE_VALSET_UPDATED",
+	6: "CLAIM_TYPE_FORK_DETECTED",
 }
 
 var ClaimType_value = map[string]int32{
@@ -52,6 +54,7 @@ var ClaimType_value = map[string]int32{
 	"CLAIM_TYPE_ERC20_DEPLOYED":      3,
 	"CLAIM_TYPE_LOGIC_CALL_EXECUTED": 4,
 	"CLAIM_TYPE_VALSET_UPDATED":      5,
+	"CLAIM_TYPE_FORK_DETECTED":       6,
 }
 
 func (x ClaimType) String() string {
@@ -77,11 +80,15 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 // the key in which the attestation is stored is keyed on the exact details of the claim
 // but there is no reason to store those exact details becuause the next message sender
 // will kindly provide you with them.
+// CLAIM_HASH_VERSION:
+// The version of the claim encoding the claims were hashed with, which is also part of
+// the key of the attestation
 type Attestation struct {
-	Observed bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
-	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
-	Height   uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Claim    *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	Observed         bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
+	Votes            []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	Height           uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Claim            *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	ClaimHashVersion uint64     `protobuf:"varint,5,opt,name=claim_hash_version,json=claimHashVersion,proto3" json:"claim_hash_version,omitempty"`
 }
 
 func (m *Attestation) Reset()         { *m = Attestation{} }
@@ -145,6 +152,13 @@ func (m *Attestation) GetClaim() *types.Any {
 	return nil
 }
 
+func (m *Attestation) GetClaimHashVersion() uint64 {
+	if m != nil {
+		return m.ClaimHashVersion
+	}
+	return 0
+}
+
 // ERC20Token unique identifier for an Ethereum ERC20 token.
 // CONTRACT:
 // The contract address on ETH of the token, this could be a Cosmos
@@ -204,37 +218,39 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
 
 var fileDescriptor_e3205613bbab7525 = []byte{
-	// 477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0x6e, 0x9b, 0x4a,
-	0x14, 0xc6, 0x19, 0xff, 0x53, 0x3c, 0xd9, 0x58, 0x23, 0x2b, 0x72, 0xac, 0x5c, 0x62, 0x79, 0x71,
-	0x65, 0x45, 0x0a, 0xd3, 0xa4, 0x4f, 0x80, 0x61, 0xd2, 0x20, 0x91, 0x60, 0x01, 0xae, 0x9a, 0x6e,
-	0x10, 0xe0, 0x29, 0x46, 0x31, 0x8c, 0x05, 0x63, 0x54, 0xd6, 0xdd, 0x74, 0xd9, 0x77, 0xe8, 0xcb,
-	0x64, 0xe9, 0x65, 0xd5, 0x45, 0x54, 0xd9, 0x2f, 0x52, 0x19, 0xb0, 0x6b, 0x65, 0x35, 0xf3, 0xcd,
-	0xef, 0x9c, 0x33, 0xdf, 0x9c, 0x39, 0xf0, 0x22, 0x48, 0xdc, 0x2c, 0xe4, 0x39, 0xce, 0x6e, 0xb0,
-	0xcb, 0x39, 0x4d, 0xb9, 0xcb, 0x43, 0x16, 0x4b, 0xcb, 0x84, 0x71, 0x86, 0x60, 0x45, 0xa5, 0xec,
-	0xa6, 0xdf, 0x0d, 0x58, 0xc0, 0x8a, 0x63, 0xbc, 0xdb, 0x95, 0x11, 0xfd, 0xf3, 0x80, 0xb1, 0x60,
-	0x41, 0x71, 0xa1, 0xbc, 0xd5, 0x17, 0xec, 0xc6, 0x79, 0x89, 0x86, 0xdf, 0x00, 0x3c, 0x95, 0xff,
-	0x95, 0x44, 0x7d, 0x78, 0xc2, 0xbc, 0x94, 0x26, 0x19, 0x9d, 0xf5, 0xc0, 0x00, 0x8c, 0x4e, 0xcc,
-	0x83, 0x46, 0x5d, 0xd8, 0xcc, 0x18, 0xa7, 0x69, 0xaf, 0x36, 0xa8, 0x8f, 0xda, 0x66, 0x29, 0xd0,
-	0x19, 0x6c, 0xcd, 0x69, 0x18, 0xcc, 0x79, 0xaf, 0x3e, 0x00, 0xa3, 0x86, 0x59, 0x29, 0x74, 0x05,
-	0x9b, 0xfe, 0xc2, 0x0d, 0xa3, 0x5e, 0x63, 0x00, 0x46, 0xa7, 0xb7, 0x5d, 0xa9, 0x34, 0x21, 0xed,
-	0x4d, 0x48, 0x72, 0x9c, 0x9b, 0x65, 0xc8, 0x70, 0x09, 0x21, 0x31, 0x95, 0xdb, 0x77, 0x36, 0x7b,
-	0xa6, 0x85, 0x07, 0x9f, 0xc5, 0x3c, 0x71, 0x7d, 0x5e, 0x78, 0x68, 0x9b, 0x07, 0x8d, 0xee, 0x60,
-	0xcb, 0x8d, 0xd8, 0x2a, 0xe6, 0xbd, 0xda, 0x8e, 0x8c, 0xa5, 0x97, 0xd7, 0x4b, 0xe1, 0xf7, 0xeb,
-	0xe5, 0xff, 0x41, 0xc8, 0xe7, 0x2b, 0x4f, 0xf2, 0x59, 0x84, 0x7d, 0x96, 0x46, 0x2c, 0xad, 0x96,
-	0xeb, 0x74, 0xf6, 0x8c, 0x79, 0xbe, 0xa4, 0xa9, 0xa4, 0xc5, 0xdc, 0xac, 0xb2, 0xaf, 0xd6, 0x00,
-	0xb6, 0x95, 0xdd, 0xdd, 0x76, 0xbe, 0xa4, 0xa8, 0x0f, 0xcf, 0x14, 0x5d, 0xd6, 0x1e, 0x1c, 0xfb,
-	0x69, 0x42, 0x9c, 0xe9, 0xa3, 0x35, 0x21, 0x8a, 0x76, 0xa7, 0x11, 0xb5, 0x23, 0xa0, 0xff, 0xe0,
-	0xf9, 0x11, 0xb3, 0xc8, 0xa3, 0xea, 0xd8, 0x86, 0xa3, 0x18, 0xd6, 0x83, 0x61, 0x75, 0x00, 0x1a,
-	0xc0, 0x8b, 0x23, 0x3c, 0x96, 0x6d, 0xe5, 0xfe, 0x10, 0x44, 0xec, 0xfb, 0x4e, 0xed, 0x4d, 0x81,
-	0xe2, 0x9d, 0x8e, 0x4a, 0x26, 0xba, 0xf1, 0x44, 0xd4, 0x4e, 0x1d, 0x0d, 0xa1, 0x78, 0x84, 0x75,
-	0xe3, 0x83, 0xa6, 0x38, 0x8a, 0xac, 0xeb, 0x0e, 0xf9, 0x44, 0x94, 0xa9, 0x4d, 0xd4, 0x4e, 0xe3,
-	0x4d, 0x89, 0x8f, 0xb2, 0x6e, 0x11, 0xdb, 0x99, 0x4e, 0x54, 0x79, 0x87, 0x9b, 0xfd, 0xc6, 0xf7,
-	0x9f, 0xa2, 0x30, 0x76, 0x5e, 0x36, 0x22, 0x58, 0x6f, 0x44, 0xf0, 0x67, 0x23, 0x82, 0x1f, 0x5b,
-	0x51, 0x58, 0x6f, 0x45, 0xe1, 0xd7, 0x56, 0x14, 0x3e, 0x93, 0xa3, 0xe6, 0xb0, 0x98, 0x45, 0x79,
-	0xf1, 0x07, 0x3e, 0x5b, 0xec, 0x7b, 0x54, 0x4d, 0xd0, 0xb5, 0x97, 0x84, 0xb3, 0x80, 0xe2, 0x88,
-	0xcd, 0x56, 0x0b, 0x8a, 0xbf, 0xe2, 0xfd, 0xdc, 0x15, 0xfd, 0xf3, 0x5a, 0x45, 0xda, 0xfb, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x71, 0x55, 0xdb, 0x8f, 0x02, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x6e, 0x9b, 0x4c,
+	0x1c, 0xc5, 0x21, 0xb1, 0xad, 0x78, 0xb2, 0x41, 0x23, 0x2b, 0x22, 0x96, 0x3f, 0x62, 0x79, 0xf1,
+	0xc9, 0x8a, 0x5a, 0x68, 0x92, 0x13, 0x60, 0x18, 0xd7, 0x56, 0x71, 0xb0, 0x30, 0x8e, 0x9a, 0x6e,
+	0x46, 0x18, 0x4f, 0x01, 0xc5, 0x30, 0x16, 0x8c, 0x51, 0xb9, 0x41, 0x97, 0xbd, 0x43, 0x4f, 0xd0,
+	0x5b, 0x64, 0x99, 0x65, 0xd5, 0x45, 0x54, 0xd9, 0xfb, 0x9e, 0xa1, 0xf2, 0xe0, 0xb8, 0x56, 0x56,
+	0xcc, 0xfb, 0xff, 0x1e, 0x4f, 0x8f, 0x3f, 0x03, 0x5a, 0x41, 0xea, 0xe5, 0x11, 0x2b, 0xb4, 0xfc,
+	0x4a, 0xf3, 0x18, 0x23, 0x19, 0xf3, 0x58, 0x44, 0x13, 0x75, 0x99, 0x52, 0x46, 0x21, 0xd8, 0x51,
+	0x35, 0xbf, 0x6a, 0x36, 0x02, 0x1a, 0x50, 0x3e, 0xd6, 0xb6, 0xa7, 0xd2, 0xd1, 0x3c, 0x0f, 0x28,
+	0x0d, 0x16, 0x44, 0xe3, 0x6a, 0xb6, 0xfa, 0xac, 0x79, 0x49, 0x51, 0xa2, 0xce, 0x0f, 0x11, 0x9c,
+	0xea, 0xff, 0x22, 0x61, 0x13, 0x9c, 0xd0, 0x59, 0x46, 0xd2, 0x9c, 0xcc, 0x65, 0xb1, 0x2d, 0x76,
+	0x4f, 0x9c, 0xbd, 0x86, 0x0d, 0x50, 0xcd, 0x29, 0x23, 0x99, 0x7c, 0xd4, 0x3e, 0xee, 0xd6, 0x9d,
+	0x52, 0xc0, 0x33, 0x50, 0x0b, 0x49, 0x14, 0x84, 0x4c, 0x3e, 0x6e, 0x8b, 0xdd, 0x8a, 0xb3, 0x53,
+	0xf0, 0x12, 0x54, 0xfd, 0x85, 0x17, 0xc5, 0x72, 0xa5, 0x2d, 0x76, 0x4f, 0xaf, 0x1b, 0x6a, 0x59,
+	0x42, 0x7d, 0x29, 0xa1, 0xea, 0x49, 0xe1, 0x94, 0x16, 0xf8, 0x06, 0x40, 0x7e, 0xc0, 0xa1, 0x97,
+	0x85, 0x38, 0x27, 0x69, 0x16, 0xd1, 0x44, 0xae, 0xf2, 0x3c, 0x89, 0x93, 0x81, 0x97, 0x85, 0x77,
+	0xe5, 0xbc, 0xb3, 0x04, 0x00, 0x39, 0xc6, 0xf5, 0x3b, 0x97, 0x3e, 0x10, 0xde, 0xd8, 0xa7, 0x09,
+	0x4b, 0x3d, 0x9f, 0xf1, 0xc6, 0x75, 0x67, 0xaf, 0x61, 0x1f, 0xd4, 0xbc, 0x98, 0xae, 0x12, 0x26,
+	0x1f, 0x6d, 0x49, 0x4f, 0x7d, 0x7c, 0xbe, 0x10, 0x7e, 0x3d, 0x5f, 0xfc, 0x1f, 0x44, 0x2c, 0x5c,
+	0xcd, 0x54, 0x9f, 0xc6, 0x9a, 0x4f, 0xb3, 0x98, 0x66, 0xbb, 0xc7, 0xdb, 0x6c, 0xfe, 0xa0, 0xb1,
+	0x62, 0x49, 0x32, 0x75, 0x98, 0x30, 0x67, 0xf7, 0xf6, 0xe5, 0x1f, 0x11, 0xd4, 0x8d, 0x6d, 0x0d,
+	0xb7, 0x58, 0x12, 0xd8, 0x04, 0x67, 0x86, 0xa5, 0x0f, 0x47, 0xd8, 0xbd, 0x1f, 0x23, 0x3c, 0xbd,
+	0x9d, 0x8c, 0x91, 0x31, 0xec, 0x0f, 0x91, 0x29, 0x09, 0xf0, 0x3f, 0x70, 0x7e, 0xc0, 0x26, 0xe8,
+	0xd6, 0xc4, 0xae, 0x8d, 0x0d, 0x7b, 0x32, 0xb2, 0x27, 0x92, 0x08, 0xdb, 0xa0, 0x75, 0x80, 0x7b,
+	0xba, 0x6b, 0x0c, 0xf6, 0x26, 0xe4, 0x0e, 0xa4, 0xa3, 0x57, 0x01, 0xfc, 0x3b, 0xb1, 0x89, 0xc6,
+	0x96, 0x7d, 0x8f, 0x4c, 0xe9, 0x18, 0x76, 0x80, 0x72, 0x80, 0x2d, 0xfb, 0xfd, 0xd0, 0xc0, 0x86,
+	0x6e, 0x59, 0x18, 0x7d, 0x44, 0xc6, 0xd4, 0x45, 0xa6, 0x54, 0x79, 0x15, 0x71, 0xa7, 0x5b, 0x13,
+	0xe4, 0xe2, 0xe9, 0xd8, 0xd4, 0xb7, 0xb8, 0x0a, 0x5b, 0x40, 0x3e, 0xc0, 0x7d, 0xdb, 0xf9, 0x80,
+	0x4d, 0xe4, 0x22, 0x63, 0x4b, 0x6b, 0xcd, 0xca, 0xd7, 0xef, 0x8a, 0xd0, 0x1b, 0x3d, 0xae, 0x15,
+	0xf1, 0x69, 0xad, 0x88, 0xbf, 0xd7, 0x8a, 0xf8, 0x6d, 0xa3, 0x08, 0x4f, 0x1b, 0x45, 0xf8, 0xb9,
+	0x51, 0x84, 0x4f, 0x37, 0x07, 0xab, 0xa3, 0x09, 0x8d, 0x0b, 0xfe, 0x3f, 0x7d, 0xba, 0xd0, 0xbc,
+	0xd4, 0xd7, 0x62, 0x3a, 0x5f, 0x2d, 0x88, 0xf6, 0x45, 0x7b, 0xb9, 0xb1, 0x7c, 0x97, 0xb3, 0x1a,
+	0x37, 0xdd, 0xfc, 0x1d, 0x00, 0x7e, 0x53, 0xfa, 0xd5, 0xc9, 0x02, 0x00, 0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -257,6 +273,11 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimHashVersion != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ClaimHashVersion))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Claim != nil {
 		{
 			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
@@ -369,6 +390,9 @@ func (m *Attestation) Size() (n int) {
 		l = m.Claim.Size()
 		n += 1 + l + sovAttestation(uint64(l))
 	}
+	if m.ClaimHashVersion != 0 {
+		n += 1 + sovAttestation(uint64(m.ClaimHashVersion))
+	}
 	return n
 }
 
@@ -529,6 +553,25 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHashVersion", wireType)
+			}
+			m.ClaimHashVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimHashVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &SendToEthAuthorization{}
+
+// NewSendToEthAuthorization creates a SendToEthAuthorization spending up to spendLimit, to the allowed destinations or
+// to any destination if none are given
+func NewSendToEthAuthorization(spendLimit sdk.Coins, allowedEthDestinations []string) *SendToEthAuthorization {
+	return &SendToEthAuthorization{
+		SpendLimit:             spendLimit,
+		AllowedEthDestinations: allowedEthDestinations,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL
+func (a SendToEthAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSendToEth{})
+}
+
+// Accept implements Authorization.Accept, the amount and fees of the send are taken from the spend limit and the
+// grant is deleted once the limit is used up
+func (a SendToEthAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	send, ok := msg.(*MsgSendToEth)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if !a.isAllowedEthDestination(send.EthDest) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s is not an allowed Ethereum destination", send.EthDest)
+	}
+
+	spent := sdk.NewCoins(send.Amount).Add(send.BridgeFee)
+	if send.RelayFee != nil {
+		spent = spent.Add(*send.RelayFee)
+	}
+	if send.ChainFee != nil {
+		spent = spent.Add(*send.ChainFee)
+	}
+	limitLeft, isNegative := a.SpendLimit.SafeSub(spent)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("%s is more than the spend limit %s", spent, a.SpendLimit)
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true, Updated: nil}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Delete:  false,
+		Updated: NewSendToEthAuthorization(limitLeft, a.AllowedEthDestinations),
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a SendToEthAuthorization) ValidateBasic() error {
+	if a.SpendLimit == nil {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit %s must be valid positive coins", a.SpendLimit)
+	}
+	seen := make(map[string]bool, len(a.AllowedEthDestinations))
+	for _, dest := range a.AllowedEthDestinations {
+		if err := ValidateEthAddress(dest); err != nil {
+			return sdkerrors.Wrap(err, "allowed eth destination")
+		}
+		if seen[strings.ToLower(dest)] {
+			return sdkerrors.Wrapf(ErrDuplicate, "allowed eth destination %s", dest)
+		}
+		seen[strings.ToLower(dest)] = true
+	}
+	return nil
+}
+
+// isAllowedEthDestination returns true if the grant has no allowed destinations or the destination is one of them
+func (a SendToEthAuthorization) isAllowedEthDestination(dest string) bool {
+	if len(a.AllowedEthDestinations) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowedEthDestinations {
+		if strings.EqualFold(allowed, dest) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,402 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gravity/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SendToEthAuthorization allows the grantee to send the granter's coins to
+// Ethereum with MsgSendToEth, so that operational hot keys can only bridge
+// constrained amounts to known destinations.
+// SPEND_LIMIT:
+// the coins the grantee can spend, counting the amount and both fees of
+// every send. Only the denoms listed can be sent or paid as fees
+// ALLOWED_ETH_DESTINATIONS:
+// the Ethereum addresses the grantee can send to, any address if empty
+type SendToEthAuthorization struct {
+	SpendLimit             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	AllowedEthDestinations []string                                 `protobuf:"bytes,2,rep,name=allowed_eth_destinations,json=allowedEthDestinations,proto3" json:"allowed_eth_destinations,omitempty"`
+}
+
+func (m *SendToEthAuthorization) Reset()         { *m = SendToEthAuthorization{} }
+func (m *SendToEthAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SendToEthAuthorization) ProtoMessage()    {}
+func (*SendToEthAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b28156cdc62fcfc, []int{0}
+}
+func (m *SendToEthAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToEthAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToEthAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendToEthAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthAuthorization.Merge(m, src)
+}
+func (m *SendToEthAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToEthAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendToEthAuthorization proto.InternalMessageInfo
+
+func (m *SendToEthAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *SendToEthAuthorization) GetAllowedEthDestinations() []string {
+	if m != nil {
+		return m.AllowedEthDestinations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SendToEthAuthorization)(nil), "gravity.v1.SendToEthAuthorization")
+}
+
+func init() { proto.RegisterFile("gravity/v1/authz.proto", fileDescriptor_5b28156cdc62fcfc) }
+
+var fileDescriptor_5b28156cdc62fcfc = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x13, 0x2a, 0x21, 0x91, 0x8a, 0x81, 0x0a, 0x55, 0x6d, 0x07, 0xb7, 0x62, 0xea, 0xd2,
+	0x98, 0xd0, 0x05, 0xb1, 0x51, 0xe8, 0x06, 0x4b, 0x61, 0x62, 0x89, 0x9c, 0xc4, 0x4a, 0x2c, 0x12,
+	0x5f, 0x15, 0x5f, 0x02, 0xed, 0x53, 0xf0, 0x1c, 0xcc, 0x3c, 0x44, 0xc7, 0x0a, 0x16, 0x26, 0x40,
+	0xed, 0x8b, 0xa0, 0x38, 0x46, 0x2a, 0x93, 0x7d, 0xf7, 0x9f, 0x3f, 0x7d, 0xf2, 0x39, 0xed, 0x38,
+	0x67, 0xa5, 0xc0, 0x05, 0x2d, 0x3d, 0xca, 0x0a, 0x4c, 0x96, 0xee, 0x3c, 0x07, 0x84, 0x96, 0x63,
+	0xfa, 0x6e, 0xe9, 0xf5, 0x8e, 0x63, 0x88, 0x41, 0xb7, 0x69, 0x75, 0xab, 0x27, 0x7a, 0xdd, 0x10,
+	0x54, 0x06, 0xca, 0xaf, 0x83, 0xba, 0x30, 0x11, 0xa9, 0x2b, 0x1a, 0x30, 0xc5, 0x69, 0xe9, 0x05,
+	0x1c, 0x99, 0x47, 0x43, 0x10, 0xb2, 0xce, 0x4f, 0x3e, 0x6c, 0xa7, 0x7d, 0xc7, 0x65, 0x74, 0x0f,
+	0x53, 0x4c, 0x2e, 0x0b, 0x4c, 0x20, 0x17, 0x4b, 0x86, 0x02, 0x64, 0x2b, 0x75, 0x9a, 0x6a, 0xce,
+	0x65, 0xe4, 0xa7, 0x22, 0x13, 0xd8, 0xb1, 0x07, 0x8d, 0x61, 0xf3, 0xac, 0xeb, 0x1a, 0x7c, 0x05,
+	0x74, 0x0d, 0xd0, 0xbd, 0x02, 0x21, 0x27, 0xa7, 0xab, 0xaf, 0xbe, 0xf5, 0xfa, 0xdd, 0x1f, 0xc6,
+	0x02, 0x93, 0x22, 0x70, 0x43, 0xc8, 0x8c, 0x8b, 0x39, 0x46, 0x2a, 0x7a, 0xa4, 0xb8, 0x98, 0x73,
+	0xa5, 0x1f, 0xa8, 0x99, 0xa3, 0xf9, 0x37, 0x15, 0xbe, 0x75, 0xee, 0x74, 0x58, 0x9a, 0xc2, 0x13,
+	0x8f, 0x7c, 0x8e, 0x89, 0x1f, 0x71, 0x85, 0x42, 0x6a, 0x11, 0xd5, 0xd9, 0x1b, 0x34, 0x86, 0x07,
+	0xb3, 0xb6, 0xc9, 0xa7, 0x98, 0x5c, 0xef, 0xa4, 0x17, 0x47, 0xef, 0x6f, 0xa3, 0xc3, 0x7f, 0xea,
+	0x93, 0xdb, 0xd5, 0x86, 0xd8, 0xeb, 0x0d, 0xb1, 0x7f, 0x36, 0xc4, 0x7e, 0xd9, 0x12, 0x6b, 0xbd,
+	0x25, 0xd6, 0xe7, 0x96, 0x58, 0x0f, 0xe3, 0x1d, 0x39, 0x90, 0x90, 0x2d, 0xf4, 0x37, 0x84, 0x90,
+	0x52, 0x96, 0x87, 0x34, 0x83, 0xa8, 0x48, 0x39, 0x7d, 0xa6, 0x7f, 0xab, 0xd0, 0xb6, 0xc1, 0xbe,
+	0x1e, 0x1a, 0xff, 0x0e, 0x00, 0xf6, 0x29, 0x52, 0xd4, 0xa2, 0x01, 0x00, 0x00,
+}
+
+func (m *SendToEthAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedEthDestinations) > 0 {
+		for iNdEx := len(m.AllowedEthDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedEthDestinations[iNdEx])
+			copy(dAtA[i:], m.AllowedEthDestinations[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedEthDestinations[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SendToEthAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedEthDestinations) > 0 {
+		for _, s := range m.AllowedEthDestinations {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SendToEthAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedEthDestinations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedEthDestinations = append(m.AllowedEthDestinations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests that a SendToEthAuthorization only accepts sends to its destinations within its spend limit
+func TestSendToEthAuthorization(t *testing.T) {
+	allowed := "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	other := "0x2a24af0501a534fca004ee1bd667b783f205a546"
+	authorization := NewSendToEthAuthorization(
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("footoken", 10)),
+		[]string{allowed},
+	)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/gravity.v1.MsgSendToEth", authorization.MsgTypeURL())
+
+	send := MsgSendToEth{
+		Sender:    "gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm",
+		EthDest:   "0xD041C41EA1BF0F006ADBB6D2C9EF9D425DE5EAD7",
+		Amount:    sdk.NewInt64Coin("stake", 60),
+		BridgeFee: sdk.NewInt64Coin("stake", 10),
+		RelayFee:  &sdk.Coin{Denom: "footoken", Amount: sdk.NewInt(10)},
+	}
+	res, err := authorization.Accept(sdk.Context{}, &send)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, NewSendToEthAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), []string{allowed}), res.Updated)
+
+	// the fees count against the limit
+	authorization = res.Updated.(*SendToEthAuthorization)
+	send.RelayFee = nil
+	send.Amount = sdk.NewInt64Coin("stake", 25)
+	_, err = authorization.Accept(sdk.Context{}, &send)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// using up the limit deletes the grant
+	send.Amount = sdk.NewInt64Coin("stake", 20)
+	res, err = authorization.Accept(sdk.Context{}, &send)
+	require.NoError(t, err)
+	require.True(t, res.Delete)
+
+	send.EthDest = other
+	_, err = authorization.Accept(sdk.Context{}, &send)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// any destination is allowed when none are listed
+	res, err = NewSendToEthAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil).Accept(sdk.Context{}, &send)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+
+	_, err = authorization.Accept(sdk.Context{}, &MsgCancelSendToEth{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	require.Error(t, NewSendToEthAuthorization(nil, nil).ValidateBasic())
+	require.Error(t, NewSendToEthAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), []string{"0x12"}).ValidateBasic())
+	require.ErrorIs(t, NewSendToEthAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), []string{allowed, other, allowed}).ValidateBasic(), ErrDuplicate)
+}
@@ -14,7 +14,18 @@ import (
 )
 
 func (o OutgoingTransferTx) ToInternal() (*InternalOutgoingTransferTx, error) {
-	return NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, o.Erc20Token, o.Erc20Fee)
+	tx, err := NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, o.Erc20Token, o.Erc20Fee)
+	if err != nil {
+		return nil, err
+	}
+	if o.RelayFee != nil {
+		if err := ValidateRelayFee(*o.RelayFee); err != nil {
+			return nil, err
+		}
+		relayFee := *o.RelayFee
+		tx.RelayFee = &relayFee
+	}
+	return tx, nil
 }
 
 // InternalOutgoingTransferTx is an internal duplicate of OutgoingTransferTx with validation
@@ -24,6 +35,8 @@ type InternalOutgoingTransferTx struct {
 	DestAddress *EthAddress
 	Erc20Token  *InternalERC20Token
 	Erc20Fee    *InternalERC20Token
+	// RelayFee is paid on Cosmos to the relayer of the batch, nil if there is none
+	RelayFee *sdk.Coin
 }
 
 func NewInternalOutgoingTransferTx(
@@ -56,6 +69,7 @@ func NewInternalOutgoingTransferTx(
 		DestAddress: dest,
 		Erc20Token:  token,
 		Erc20Fee:    fee,
+		RelayFee:    nil,
 	}, nil
 }
 
@@ -66,6 +80,7 @@ func (i InternalOutgoingTransferTx) ToExternal() OutgoingTransferTx {
 		DestAddress: i.DestAddress.GetAddress(),
 		Erc20Token:  i.Erc20Token.ToExternal(),
 		Erc20Fee:    i.Erc20Fee.ToExternal(),
+		RelayFee:    i.RelayFee,
 	}
 }
 
@@ -84,6 +99,20 @@ func (i InternalOutgoingTransferTx) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrap(err, "invalid Erc20Fee")
 	}
+	if i.RelayFee != nil {
+		if err := ValidateRelayFee(*i.RelayFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateRelayFee checks that a relay fee is a valid coin worth paying, an absent relay
+// fee is represented by nil and never by a zero coin
+func ValidateRelayFee(relayFee sdk.Coin) error {
+	if relayFee.Amount.IsNil() || !relayFee.IsValid() || !relayFee.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid relay fee %s", relayFee)
+	}
 	return nil
 }
 
@@ -97,6 +126,8 @@ type InternalOutgoingTxBatch struct {
 	Transactions  []*InternalOutgoingTransferTx
 	TokenContract EthAddress
 	Block         uint64
+	// RelayableSinceHeight is the block at which the batch collected enough signatures to be relayed
+	RelayableSinceHeight uint64
 }
 
 func NewInternalOutgingTxBatch(
@@ -107,11 +138,12 @@ func NewInternalOutgingTxBatch(
 	block uint64) (*InternalOutgoingTxBatch, error) {
 
 	ret := &InternalOutgoingTxBatch{
-		BatchNonce:    nonce,
-		BatchTimeout:  timeout,
-		Transactions:  transactions,
-		TokenContract: contract,
-		Block:         block,
+		BatchNonce:           nonce,
+		BatchTimeout:         timeout,
+		Transactions:         transactions,
+		TokenContract:        contract,
+		Block:                block,
+		RelayableSinceHeight: 0,
 	}
 	if err := ret.ValidateBasic(); err != nil {
 		return nil, err
@@ -134,11 +166,12 @@ func NewInternalOutgingTxBatchFromExternalBatch(batch OutgoingTxBatch) (*Interna
 	}
 
 	return &InternalOutgoingTxBatch{
-		BatchNonce:    batch.BatchNonce,
-		BatchTimeout:  batch.BatchTimeout,
-		Transactions:  txs,
-		TokenContract: *contractAddr,
-		Block:         batch.Block,
+		BatchNonce:           batch.BatchNonce,
+		BatchTimeout:         batch.BatchTimeout,
+		Transactions:         txs,
+		TokenContract:        *contractAddr,
+		Block:                batch.Block,
+		RelayableSinceHeight: batch.RelayableSinceHeight,
 	}, nil
 }
 
@@ -152,11 +185,12 @@ func (i *InternalOutgoingTxBatch) ToExternal() OutgoingTxBatch {
 		txs[i] = tx.ToExternal()
 	}
 	return OutgoingTxBatch{
-		BatchNonce:    i.BatchNonce,
-		BatchTimeout:  i.BatchTimeout,
-		Transactions:  txs,
-		TokenContract: i.TokenContract.GetAddress(),
-		Block:         i.Block,
+		BatchNonce:           i.BatchNonce,
+		BatchTimeout:         i.BatchTimeout,
+		Transactions:         txs,
+		TokenContract:        i.TokenContract.GetAddress(),
+		Block:                i.Block,
+		RelayableSinceHeight: i.RelayableSinceHeight,
 	}
 }
 
@@ -170,26 +204,77 @@ func (i *InternalOutgoingTxBatches) ToExternalArray() []OutgoingTxBatch {
 		}
 
 		arr = append(arr, OutgoingTxBatch{
-			BatchNonce:    val.BatchNonce,
-			BatchTimeout:  val.BatchTimeout,
-			Transactions:  txs,
-			TokenContract: val.TokenContract.GetAddress(),
-			Block:         val.Block,
+			BatchNonce:           val.BatchNonce,
+			BatchTimeout:         val.BatchTimeout,
+			Transactions:         txs,
+			TokenContract:        val.TokenContract.GetAddress(),
+			Block:                val.Block,
+			RelayableSinceHeight: val.RelayableSinceHeight,
 		})
 	}
 
 	return arr
 }
 
+// RelayFees returns the total relay fees, per denom, of the transactions in the batch
+func (i *InternalOutgoingTxBatch) RelayFees() sdk.Coins {
+	return TransfersRelayFees(i.Transactions)
+}
+
+// TransfersRelayFees returns the total relay fees, per denom, of transactions
+func TransfersRelayFees(txs []*InternalOutgoingTransferTx) sdk.Coins {
+	fees := sdk.NewCoins()
+	for _, tx := range txs {
+		if tx.RelayFee != nil {
+			fees = fees.Add(*tx.RelayFee)
+		}
+	}
+	return fees
+}
+
+// SplitBySuccess returns the transactions of the batch executed on Ethereum and those which failed according to the
+// tx success bitmap of a MsgBatchSendToEthClaim, bit i (least significant bit first within byte i / 8) being set if
+// the transaction i was executed. An empty bitmap means every transaction was executed. The bitmap must be exactly
+// as long as needed for the transactions, without bits set past the last one
+func (i *InternalOutgoingTxBatch) SplitBySuccess(bitmap []byte) (executed, failed []*InternalOutgoingTransferTx, err error) {
+	if len(bitmap) == 0 {
+		return i.Transactions, nil, nil
+	}
+	if len(bitmap) != (len(i.Transactions)+7)/8 {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalid, "tx success bitmap of %d bytes for %d transactions", len(bitmap), len(i.Transactions))
+	}
+	if rest := len(i.Transactions) % 8; rest != 0 && bitmap[len(bitmap)-1]>>rest != 0 {
+		return nil, nil, sdkerrors.Wrap(ErrInvalid, "tx success bitmap has bits set past the last transaction")
+	}
+	for index, tx := range i.Transactions {
+		if bitmap[index/8]&(1<<(index%8)) != 0 {
+			executed = append(executed, tx)
+		} else {
+			failed = append(failed, tx)
+		}
+	}
+	return executed, failed, nil
+}
+
 func (i *InternalOutgoingTxBatch) ValidateBasic() error {
 	if err := i.TokenContract.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "invalid eth address")
 	}
 
+	// Gravity.sol transfers the amounts and fees of the batch out of its balance of the token, their total has to be
+	// a uint256 like that balance
+	total := sdk.ZeroInt()
 	for i, tx := range i.Transactions {
 		if err := tx.ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "transaction %d is invalid", i)
 		}
+		var err error
+		if total, err = AddUint256(total, tx.Erc20Token.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "total of transaction %d", i)
+		}
+		if total, err = AddUint256(total, tx.Erc20Fee.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "total of transaction %d", i)
+		}
 	}
 	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,6 +32,9 @@ type OutgoingTxBatch struct {
 	Transactions  []OutgoingTransferTx `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions"`
 	TokenContract string               `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Block         uint64               `protobuf:"varint,5,opt,name=block,proto3" json:"block,omitempty"`
+	// the Cosmos block height at which the confirmed power first crossed the
+	// Ethereum signature threshold, zero while the batch is not yet relayable
+	RelayableSinceHeight uint64 `protobuf:"varint,6,opt,name=relayable_since_height,json=relayableSinceHeight,proto3" json:"relayable_since_height,omitempty"`
 }
 
 func (m *OutgoingTxBatch) Reset()         { *m = OutgoingTxBatch{} }
@@ -100,6 +105,13 @@ func (m *OutgoingTxBatch) GetBlock() uint64 {
 	return 0
 }
 
+func (m *OutgoingTxBatch) GetRelayableSinceHeight() uint64 {
+	if m != nil {
+		return m.RelayableSinceHeight
+	}
+	return 0
+}
+
 // OutgoingTransferTx represents an individual send from gravity to ETH
 type OutgoingTransferTx struct {
 	Id          uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -107,6 +119,9 @@ type OutgoingTransferTx struct {
 	DestAddress string     `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
 	Erc20Token  ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token"`
 	Erc20Fee    ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee"`
+	// an optional fee paid on Cosmos in any denom, held by the module and paid
+	// to the relayer once the batch containing this transfer is executed
+	RelayFee *types.Coin `protobuf:"bytes,6,opt,name=relay_fee,json=relayFee,proto3" json:"relay_fee,omitempty"`
 }
 
 func (m *OutgoingTransferTx) Reset()         { *m = OutgoingTransferTx{} }
@@ -177,6 +192,169 @@ func (m *OutgoingTransferTx) GetErc20Fee() ERC20Token {
 	return ERC20Token{}
 }
 
+func (m *OutgoingTransferTx) GetRelayFee() *types.Coin {
+	if m != nil {
+		return m.RelayFee
+	}
+	return nil
+}
+
+// ScheduledOutgoingTransferTx is a transfer whose funds are locked but which only
+// enters the pool of unbatched transactions once the execute_after_height is reached
+type ScheduledOutgoingTransferTx struct {
+	ExecuteAfterHeight uint64             `protobuf:"varint,1,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty"`
+	Transfer           OutgoingTransferTx `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer"`
+}
+
+func (m *ScheduledOutgoingTransferTx) Reset()         { *m = ScheduledOutgoingTransferTx{} }
+func (m *ScheduledOutgoingTransferTx) String() string { return proto.CompactTextString(m) }
+func (*ScheduledOutgoingTransferTx) ProtoMessage()    {}
+func (*ScheduledOutgoingTransferTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{2}
+}
+func (m *ScheduledOutgoingTransferTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledOutgoingTransferTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledOutgoingTransferTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledOutgoingTransferTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledOutgoingTransferTx.Merge(m, src)
+}
+func (m *ScheduledOutgoingTransferTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledOutgoingTransferTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledOutgoingTransferTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledOutgoingTransferTx proto.InternalMessageInfo
+
+func (m *ScheduledOutgoingTransferTx) GetExecuteAfterHeight() uint64 {
+	if m != nil {
+		return m.ExecuteAfterHeight
+	}
+	return 0
+}
+
+func (m *ScheduledOutgoingTransferTx) GetTransfer() OutgoingTransferTx {
+	if m != nil {
+		return m.Transfer
+	}
+	return OutgoingTransferTx{}
+}
+
+// RecurringSendToEth adds a transfer to the pool every interval blocks until the
+// remaining transfers are sent, their amounts and bridge fees are escrowed upfront
+type RecurringSendToEth struct {
+	Id         uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender     string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	EthDest    string     `protobuf:"bytes,3,opt,name=eth_dest,json=ethDest,proto3" json:"eth_dest,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	BridgeFee  types.Coin `protobuf:"bytes,5,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+	Interval   uint64     `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Remaining  uint64     `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	NextHeight uint64     `protobuf:"varint,8,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *RecurringSendToEth) Reset()         { *m = RecurringSendToEth{} }
+func (m *RecurringSendToEth) String() string { return proto.CompactTextString(m) }
+func (*RecurringSendToEth) ProtoMessage()    {}
+func (*RecurringSendToEth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{3}
+}
+func (m *RecurringSendToEth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringSendToEth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringSendToEth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringSendToEth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringSendToEth.Merge(m, src)
+}
+func (m *RecurringSendToEth) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringSendToEth) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringSendToEth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringSendToEth proto.InternalMessageInfo
+
+func (m *RecurringSendToEth) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RecurringSendToEth) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *RecurringSendToEth) GetEthDest() string {
+	if m != nil {
+		return m.EthDest
+	}
+	return ""
+}
+
+func (m *RecurringSendToEth) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *RecurringSendToEth) GetBridgeFee() types.Coin {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return types.Coin{}
+}
+
+func (m *RecurringSendToEth) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *RecurringSendToEth) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *RecurringSendToEth) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
 // OutgoingLogicCall represents an individual logic call from gravity to ETH
 type OutgoingLogicCall struct {
 	Transfers            []ERC20Token `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
@@ -187,13 +365,16 @@ type OutgoingLogicCall struct {
 	InvalidationId       []byte       `protobuf:"bytes,6,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
 	InvalidationNonce    uint64       `protobuf:"varint,7,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
 	Block                uint64       `protobuf:"varint,8,opt,name=block,proto3" json:"block,omitempty"`
+	// the gas the relayer should allow for the execution of the logic call on Ethereum, zero if the scheduler gave no
+	// hint. It is not part of the signed checkpoint
+	GasLimit uint64 `protobuf:"varint,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
 func (m *OutgoingLogicCall) String() string { return proto.CompactTextString(m) }
 func (*OutgoingLogicCall) ProtoMessage()    {}
 func (*OutgoingLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{2}
+	return fileDescriptor_4453b445b0660cab, []int{4}
 }
 func (m *OutgoingLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,174 +459,613 @@ func (m *OutgoingLogicCall) GetBlock() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*OutgoingTxBatch)(nil), "gravity.v1.OutgoingTxBatch")
-	proto.RegisterType((*OutgoingTransferTx)(nil), "gravity.v1.OutgoingTransferTx")
-	proto.RegisterType((*OutgoingLogicCall)(nil), "gravity.v1.OutgoingLogicCall")
+func (m *OutgoingLogicCall) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
+// LogicCallDeposit is a fee deposit escrowed by the module or account that scheduled
+// an outgoing logic call, it is refunded to the sponsor once the execution of the
+// logic call is observed or the logic call times out
+type LogicCallDeposit struct {
+	InvalidationId    []byte                                   `protobuf:"bytes,1,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	InvalidationNonce uint64                                   `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	Sponsor           string                                   `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Amount            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
 
-var fileDescriptor_4453b445b0660cab = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xda, 0x4c,
-	0x10, 0xc5, 0x84, 0x90, 0x30, 0x10, 0xa2, 0xac, 0x10, 0xb2, 0xa2, 0x4f, 0x0e, 0x1f, 0x55, 0x55,
-	0x2e, 0x60, 0x42, 0x7b, 0x69, 0xa5, 0x1e, 0x0a, 0x4a, 0xd5, 0x4a, 0x55, 0x2b, 0x59, 0x9c, 0x7a,
-	0xb1, 0x16, 0xef, 0xe2, 0xac, 0x62, 0xbc, 0xc8, 0xbb, 0x20, 0xf8, 0x17, 0xfd, 0x59, 0x39, 0xe6,
-	0xd8, 0x5c, 0xa2, 0x0a, 0xfe, 0x48, 0xb5, 0x63, 0x9b, 0x90, 0x56, 0x6a, 0x7b, 0xf3, 0xbc, 0x79,
-	0x6f, 0x67, 0xde, 0xcc, 0x18, 0x9a, 0x61, 0x42, 0x97, 0x42, 0xaf, 0xdd, 0xe5, 0xa5, 0x3b, 0xa1,
-	0x3a, 0xb8, 0xee, 0xcd, 0x13, 0xa9, 0x25, 0x81, 0x0c, 0xef, 0x2d, 0x2f, 0xcf, 0x1b, 0xa1, 0x0c,
-	0x25, 0xc2, 0xae, 0xf9, 0x4a, 0x19, 0xe7, 0xff, 0xed, 0x29, 0xa9, 0xd6, 0x5c, 0x69, 0xaa, 0x85,
-	0x8c, 0xd3, 0x6c, 0xfb, 0xc1, 0x82, 0xd3, 0x2f, 0x0b, 0x1d, 0x4a, 0x11, 0x87, 0xe3, 0xd5, 0xd0,
-	0xbc, 0x4c, 0x2e, 0xa0, 0x8a, 0x25, 0xfc, 0x58, 0xc6, 0x01, 0xb7, 0xad, 0x96, 0xd5, 0x29, 0x79,
-	0x80, 0xd0, 0x67, 0x83, 0x90, 0x67, 0x70, 0x92, 0x12, 0xb4, 0x98, 0x71, 0xb9, 0xd0, 0x76, 0x11,
-	0x29, 0x35, 0x04, 0xc7, 0x29, 0x46, 0x3e, 0x40, 0x4d, 0x27, 0x34, 0x56, 0x34, 0x30, 0xe5, 0x94,
-	0x7d, 0xd0, 0x3a, 0xe8, 0x54, 0x07, 0x4e, 0xef, 0xb1, 0xe1, 0xde, 0xae, 0xb0, 0xe1, 0x4d, 0x79,
-	0x32, 0x5e, 0x0d, 0x4b, 0xb7, 0x0f, 0x17, 0x05, 0xef, 0x89, 0x92, 0x3c, 0x87, 0xba, 0x96, 0x37,
-	0x3c, 0xf6, 0x03, 0x19, 0xeb, 0x84, 0x06, 0xda, 0x2e, 0xb5, 0xac, 0x4e, 0xc5, 0x3b, 0x41, 0x74,
-	0x94, 0x81, 0xa4, 0x01, 0x87, 0x93, 0x48, 0x06, 0x37, 0xf6, 0x21, 0x76, 0x93, 0x06, 0xed, 0x7b,
-	0x0b, 0xc8, 0xef, 0x75, 0x48, 0x1d, 0x8a, 0x82, 0x65, 0xd6, 0x8a, 0x82, 0x91, 0x26, 0x94, 0x15,
-	0x8f, 0x19, 0x4f, 0xd0, 0x4b, 0xc5, 0xcb, 0x22, 0xf2, 0x3f, 0xd4, 0x18, 0x57, 0xda, 0xa7, 0x8c,
-	0x25, 0x5c, 0x19, 0x17, 0x26, 0x5b, 0x35, 0xd8, 0xbb, 0x14, 0x22, 0x6f, 0xa1, 0xca, 0x93, 0x60,
-	0xd0, 0xf7, 0xb1, 0x1d, 0xec, 0xad, 0x3a, 0x68, 0xee, 0xfb, 0xbc, 0xf2, 0x46, 0x83, 0xfe, 0xd8,
-	0x64, 0x33, 0x7f, 0x80, 0x02, 0x44, 0xc8, 0x6b, 0xa8, 0xa4, 0xf2, 0x29, 0xe7, 0xd8, 0xfa, 0xdf,
-	0xc4, 0xc7, 0x48, 0x7f, 0xcf, 0x79, 0xfb, 0xbe, 0x08, 0x67, 0xb9, 0xb7, 0x4f, 0x32, 0x14, 0xc1,
-	0x88, 0x46, 0x11, 0x79, 0x03, 0x15, 0x9d, 0x19, 0x55, 0xb6, 0x85, 0x53, 0xff, 0xf3, 0x83, 0x8f,
-	0x74, 0xd2, 0x87, 0xd2, 0x94, 0x73, 0x65, 0x17, 0xff, 0x41, 0x86, 0x4c, 0xf2, 0x0a, 0x9a, 0x91,
-	0x29, 0xbd, 0x5b, 0xce, 0x2f, 0xa3, 0x6a, 0x60, 0x36, 0x5f, 0x52, 0x3e, 0x33, 0x1b, 0x8e, 0xe6,
-	0x74, 0x1d, 0x49, 0xca, 0x70, 0x5e, 0x35, 0x2f, 0x0f, 0x4d, 0x26, 0xbf, 0xaa, 0x74, 0x8f, 0x79,
-	0x48, 0x5e, 0xc0, 0xa9, 0x88, 0x97, 0x34, 0x12, 0x0c, 0x0f, 0xd8, 0x17, 0xcc, 0x2e, 0xa3, 0xb6,
-	0xbe, 0x0f, 0x7f, 0x64, 0xa4, 0x0b, 0xe4, 0x09, 0x31, 0x3d, 0xe3, 0x23, 0x7c, 0xed, 0x6c, 0x3f,
-	0x93, 0x5e, 0xf3, 0xee, 0x6e, 0x8e, 0xf7, 0xee, 0x66, 0xe8, 0xdf, 0x6e, 0x1c, 0xeb, 0x6e, 0xe3,
-	0x58, 0x3f, 0x36, 0x8e, 0xf5, 0x6d, 0xeb, 0x14, 0xee, 0xb6, 0x4e, 0xe1, 0xfb, 0xd6, 0x29, 0x7c,
-	0xbd, 0x0a, 0x85, 0xbe, 0x5e, 0x4c, 0x7a, 0x81, 0x9c, 0xb9, 0x32, 0x96, 0xb3, 0x35, 0xfe, 0x49,
-	0x81, 0x8c, 0xdc, 0x40, 0xaa, 0x99, 0x54, 0xdd, 0x6c, 0x68, 0xdd, 0x49, 0x22, 0x58, 0xc8, 0xdd,
-	0x99, 0x64, 0x8b, 0x88, 0xbb, 0x2b, 0x37, 0xff, 0x11, 0xf5, 0x7a, 0xce, 0xd5, 0xa4, 0x8c, 0xb2,
-	0x97, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x32, 0xd8, 0x96, 0xbf, 0xda, 0x03, 0x00, 0x00,
+func (m *LogicCallDeposit) Reset()         { *m = LogicCallDeposit{} }
+func (m *LogicCallDeposit) String() string { return proto.CompactTextString(m) }
+func (*LogicCallDeposit) ProtoMessage()    {}
+func (*LogicCallDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{5}
+}
+func (m *LogicCallDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogicCallDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogicCallDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogicCallDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicCallDeposit.Merge(m, src)
+}
+func (m *LogicCallDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogicCallDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicCallDeposit.DiscardUnknown(m)
 }
 
-func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LogicCallDeposit proto.InternalMessageInfo
+
+func (m *LogicCallDeposit) GetInvalidationId() []byte {
+	if m != nil {
+		return m.InvalidationId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OutgoingTxBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LogicCallDeposit) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
 }
 
-func (m *OutgoingTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != 0 {
-		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x28
+func (m *LogicCallDeposit) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *LogicCallDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Transactions) > 0 {
-		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBatch(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	return nil
+}
+
+// BatchRelayLatency tracks how long batches of a given token take to be executed
+// on Ethereum, measured in Cosmos blocks from batch creation until the execution
+// is observed by the oracle
+type BatchRelayLatency struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// moving average of the relay latency over recent executed batches
+	AverageBlocks uint64 `protobuf:"varint,2,opt,name=average_blocks,json=averageBlocks,proto3" json:"average_blocks,omitempty"`
+	// the latency of the most recently executed batch
+	LastBlocks uint64 `protobuf:"varint,3,opt,name=last_blocks,json=lastBlocks,proto3" json:"last_blocks,omitempty"`
+	// the nonce of the most recently executed batch
+	LastBatchNonce uint64 `protobuf:"varint,4,opt,name=last_batch_nonce,json=lastBatchNonce,proto3" json:"last_batch_nonce,omitempty"`
+	// the total number of executed batches that have been measured
+	Samples uint64 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *BatchRelayLatency) Reset()         { *m = BatchRelayLatency{} }
+func (m *BatchRelayLatency) String() string { return proto.CompactTextString(m) }
+func (*BatchRelayLatency) ProtoMessage()    {}
+func (*BatchRelayLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{6}
+}
+func (m *BatchRelayLatency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchRelayLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchRelayLatency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BatchTimeout != 0 {
-		i = encodeVarintBatch(dAtA, i, uint64(m.BatchTimeout))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *BatchRelayLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRelayLatency.Merge(m, src)
+}
+func (m *BatchRelayLatency) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchRelayLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRelayLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRelayLatency proto.InternalMessageInfo
+
+func (m *BatchRelayLatency) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintBatch(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *BatchRelayLatency) GetAverageBlocks() uint64 {
+	if m != nil {
+		return m.AverageBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *OutgoingTransferTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchRelayLatency) GetLastBlocks() uint64 {
+	if m != nil {
+		return m.LastBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *OutgoingTransferTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchRelayLatency) GetLastBatchNonce() uint64 {
+	if m != nil {
+		return m.LastBatchNonce
+	}
+	return 0
 }
 
-func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
+func (m *BatchRelayLatency) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+// ExecutedBatch is a batch whose execution on Ethereum was observed, kept for
+// auditing once it left the outgoing batches. It is indexed by executed height
+// for the retention window and then archived compressed by token and nonce
+type ExecutedBatch struct {
+	Batch OutgoingTxBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch"`
+	// the Cosmos height the execution was observed at
+	ExecutedHeight uint64 `protobuf:"varint,2,opt,name=executed_height,json=executedHeight,proto3" json:"executed_height,omitempty"`
+	// the Ethereum height of the executed event
+	EthBlockHeight uint64 `protobuf:"varint,3,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	// the transactions executed on Ethereum, empty if all of them were, see
+	// MsgBatchSendToEthClaim
+	TxSuccessBitmap []byte `protobuf:"bytes,4,opt,name=tx_success_bitmap,json=txSuccessBitmap,proto3" json:"tx_success_bitmap,omitempty"`
+}
+
+func (m *ExecutedBatch) Reset()         { *m = ExecutedBatch{} }
+func (m *ExecutedBatch) String() string { return proto.CompactTextString(m) }
+func (*ExecutedBatch) ProtoMessage()    {}
+func (*ExecutedBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{7}
+}
+func (m *ExecutedBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintBatch(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *ExecutedBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedBatch.Merge(m, src)
+}
+func (m *ExecutedBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedBatch proto.InternalMessageInfo
+
+func (m *ExecutedBatch) GetBatch() OutgoingTxBatch {
+	if m != nil {
+		return m.Batch
+	}
+	return OutgoingTxBatch{}
+}
+
+func (m *ExecutedBatch) GetExecutedHeight() uint64 {
+	if m != nil {
+		return m.ExecutedHeight
+	}
+	return 0
+}
+
+func (m *ExecutedBatch) GetEthBlockHeight() uint64 {
+	if m != nil {
+		return m.EthBlockHeight
+	}
+	return 0
+}
+
+func (m *ExecutedBatch) GetTxSuccessBitmap() []byte {
+	if m != nil {
+		return m.TxSuccessBitmap
+	}
+	return nil
+}
+
+// BridgeFeeTier is a bridge fee suggestion for transfers of a token to be batched
+// within blocks Cosmos blocks of entering the pool
+type BridgeFeeTier struct {
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// moving average over recent batches of the lowest fee of their transfers which
+	// were batched within blocks of entering the pool
+	Fee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+	// the total number of batches that have been measured
+	Samples uint64 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *BridgeFeeTier) Reset()         { *m = BridgeFeeTier{} }
+func (m *BridgeFeeTier) String() string { return proto.CompactTextString(m) }
+func (*BridgeFeeTier) ProtoMessage()    {}
+func (*BridgeFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{8}
+}
+func (m *BridgeFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintBatch(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.DestAddress) > 0 {
-		i -= len(m.DestAddress)
-		copy(dAtA[i:], m.DestAddress)
-		i = encodeVarintBatch(dAtA, i, uint64(len(m.DestAddress)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *BridgeFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFeeTier.Merge(m, src)
+}
+func (m *BridgeFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeFeeTier proto.InternalMessageInfo
+
+func (m *BridgeFeeTier) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintBatch(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *BridgeFeeTier) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
 	}
-	if m.Id != 0 {
-		i = encodeVarintBatch(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+// BridgeFeeTiers are the fast, normal and slow bridge fee suggestions of a token,
+// learned from the fees of the transfers which were batched, like a gas oracle
+type BridgeFeeTiers struct {
+	TokenContract string        `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Fast          BridgeFeeTier `protobuf:"bytes,2,opt,name=fast,proto3" json:"fast"`
+	Normal        BridgeFeeTier `protobuf:"bytes,3,opt,name=normal,proto3" json:"normal"`
+	Slow          BridgeFeeTier `protobuf:"bytes,4,opt,name=slow,proto3" json:"slow"`
+}
+
+func (m *BridgeFeeTiers) Reset()         { *m = BridgeFeeTiers{} }
+func (m *BridgeFeeTiers) String() string { return proto.CompactTextString(m) }
+func (*BridgeFeeTiers) ProtoMessage()    {}
+func (*BridgeFeeTiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{9}
+}
+func (m *BridgeFeeTiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFeeTiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFeeTiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeFeeTiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFeeTiers.Merge(m, src)
+}
+func (m *BridgeFeeTiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFeeTiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFeeTiers.DiscardUnknown(m)
 }
 
-func (m *OutgoingLogicCall) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_BridgeFeeTiers proto.InternalMessageInfo
+
+func (m *BridgeFeeTiers) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BridgeFeeTiers) GetFast() BridgeFeeTier {
+	if m != nil {
+		return m.Fast
+	}
+	return BridgeFeeTier{}
+}
+
+func (m *BridgeFeeTiers) GetNormal() BridgeFeeTier {
+	if m != nil {
+		return m.Normal
+	}
+	return BridgeFeeTier{}
+}
+
+func (m *BridgeFeeTiers) GetSlow() BridgeFeeTier {
+	if m != nil {
+		return m.Slow
+	}
+	return BridgeFeeTier{}
+}
+
+// SubmitBatchCalldata holds the arguments of a Gravity.sol submitBatch call for a
+// batch that has reached the signature threshold. The validator set is the last
+// one observed on Ethereum in the order the contract stores it and the signature
+// components are parallel to it, validators that did not sign have zero v, r and s
+type SubmitBatchCalldata struct {
+	Validators    []string                                 `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	Powers        []uint64                                 `protobuf:"varint,2,rep,packed,name=powers,proto3" json:"powers,omitempty"`
+	ValsetNonce   uint64                                   `protobuf:"varint,3,opt,name=valset_nonce,json=valsetNonce,proto3" json:"valset_nonce,omitempty"`
+	RewardAmount  github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,4,opt,name=reward_amount,json=rewardAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_amount"`
+	RewardToken   string                                   `protobuf:"bytes,5,opt,name=reward_token,json=rewardToken,proto3" json:"reward_token,omitempty"`
+	V             []uint32                                 `protobuf:"varint,6,rep,packed,name=v,proto3" json:"v,omitempty"`
+	R             [][]byte                                 `protobuf:"bytes,7,rep,name=r,proto3" json:"r,omitempty"`
+	S             [][]byte                                 `protobuf:"bytes,8,rep,name=s,proto3" json:"s,omitempty"`
+	Amounts       []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,rep,name=amounts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amounts"`
+	Destinations  []string                                 `protobuf:"bytes,10,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	Fees          []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,rep,name=fees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fees"`
+	BatchNonce    uint64                                   `protobuf:"varint,12,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TokenContract string                                   `protobuf:"bytes,13,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchTimeout  uint64                                   `protobuf:"varint,14,opt,name=batch_timeout,json=batchTimeout,proto3" json:"batch_timeout,omitempty"`
+	// the normalized power of the signatures included above
+	SignedPower uint64 `protobuf:"varint,15,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+}
+
+func (m *SubmitBatchCalldata) Reset()         { *m = SubmitBatchCalldata{} }
+func (m *SubmitBatchCalldata) String() string { return proto.CompactTextString(m) }
+func (*SubmitBatchCalldata) ProtoMessage()    {}
+func (*SubmitBatchCalldata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{10}
+}
+func (m *SubmitBatchCalldata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitBatchCalldata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitBatchCalldata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitBatchCalldata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitBatchCalldata.Merge(m, src)
+}
+func (m *SubmitBatchCalldata) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitBatchCalldata) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitBatchCalldata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitBatchCalldata proto.InternalMessageInfo
+
+func (m *SubmitBatchCalldata) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *SubmitBatchCalldata) GetPowers() []uint64 {
+	if m != nil {
+		return m.Powers
+	}
+	return nil
+}
+
+func (m *SubmitBatchCalldata) GetValsetNonce() uint64 {
+	if m != nil {
+		return m.ValsetNonce
+	}
+	return 0
+}
+
+func (m *SubmitBatchCalldata) GetRewardToken() string {
+	if m != nil {
+		return m.RewardToken
+	}
+	return ""
+}
+
+func (m *SubmitBatchCalldata) GetV() []uint32 {
+	if m != nil {
+		return m.V
+	}
+	return nil
+}
+
+func (m *SubmitBatchCalldata) GetR() [][]byte {
+	if m != nil {
+		return m.R
+	}
+	return nil
+}
+
+func (m *SubmitBatchCalldata) GetS() [][]byte {
+	if m != nil {
+		return m.S
+	}
+	return nil
+}
+
+func (m *SubmitBatchCalldata) GetDestinations() []string {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+func (m *SubmitBatchCalldata) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *SubmitBatchCalldata) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *SubmitBatchCalldata) GetBatchTimeout() uint64 {
+	if m != nil {
+		return m.BatchTimeout
+	}
+	return 0
+}
+
+func (m *SubmitBatchCalldata) GetSignedPower() uint64 {
+	if m != nil {
+		return m.SignedPower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OutgoingTxBatch)(nil), "gravity.v1.OutgoingTxBatch")
+	proto.RegisterType((*OutgoingTransferTx)(nil), "gravity.v1.OutgoingTransferTx")
+	proto.RegisterType((*ScheduledOutgoingTransferTx)(nil), "gravity.v1.ScheduledOutgoingTransferTx")
+	proto.RegisterType((*RecurringSendToEth)(nil), "gravity.v1.RecurringSendToEth")
+	proto.RegisterType((*OutgoingLogicCall)(nil), "gravity.v1.OutgoingLogicCall")
+	proto.RegisterType((*LogicCallDeposit)(nil), "gravity.v1.LogicCallDeposit")
+	proto.RegisterType((*BatchRelayLatency)(nil), "gravity.v1.BatchRelayLatency")
+	proto.RegisterType((*ExecutedBatch)(nil), "gravity.v1.ExecutedBatch")
+	proto.RegisterType((*BridgeFeeTier)(nil), "gravity.v1.BridgeFeeTier")
+	proto.RegisterType((*BridgeFeeTiers)(nil), "gravity.v1.BridgeFeeTiers")
+	proto.RegisterType((*SubmitBatchCalldata)(nil), "gravity.v1.SubmitBatchCalldata")
+}
+
+func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
+
+var fileDescriptor_4453b445b0660cab = []byte{
+	// 1305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0xd9, 0x96, 0x46, 0x3f, 0x8e, 0xb7, 0x86, 0xc1, 0xfc, 0x40, 0x56, 0x54, 0xa4,
+	0x15, 0x0a, 0x44, 0xb2, 0x9d, 0xa2, 0x41, 0x0b, 0xb4, 0x48, 0xe4, 0x24, 0x48, 0x80, 0xf4, 0x07,
+	0xb4, 0x4f, 0xbd, 0x10, 0x2b, 0x72, 0x4c, 0x2d, 0x42, 0x71, 0x05, 0xee, 0x4a, 0x91, 0xcf, 0x7d,
+	0x81, 0x06, 0xe8, 0x53, 0xb4, 0xef, 0xd1, 0xe6, 0x98, 0x43, 0x0f, 0x45, 0x0e, 0x69, 0x91, 0x9c,
+	0xfa, 0x02, 0x3d, 0x17, 0xfb, 0x43, 0x99, 0x8e, 0xd5, 0x5a, 0xc9, 0x49, 0x9a, 0x6f, 0x67, 0xb8,
+	0xbb, 0xdf, 0xcc, 0x7c, 0x3b, 0xb0, 0x1d, 0xa5, 0x74, 0xca, 0xe4, 0x49, 0x6f, 0xba, 0xd7, 0x1b,
+	0x50, 0x19, 0x0c, 0xbb, 0xe3, 0x94, 0x4b, 0x4e, 0xc0, 0xe2, 0xdd, 0xe9, 0xde, 0x95, 0x66, 0xc0,
+	0xc5, 0x88, 0x8b, 0xde, 0x80, 0x0a, 0xec, 0x4d, 0xf7, 0x06, 0x28, 0xe9, 0x5e, 0x2f, 0xe0, 0x2c,
+	0x31, 0xbe, 0x57, 0xb6, 0x22, 0x1e, 0x71, 0xfd, 0xb7, 0xa7, 0xfe, 0x59, 0xf4, 0x5a, 0xee, 0xcb,
+	0x54, 0x4a, 0x14, 0x92, 0x4a, 0xc6, 0x6d, 0x4c, 0xfb, 0xa7, 0x02, 0x6c, 0x7c, 0x3b, 0x91, 0x11,
+	0x67, 0x49, 0x74, 0x34, 0xeb, 0xab, 0x9d, 0xc9, 0x0e, 0x54, 0xf5, 0x11, 0xfc, 0x84, 0x27, 0x01,
+	0xba, 0x4e, 0xcb, 0xe9, 0x94, 0x3c, 0xd0, 0xd0, 0x37, 0x0a, 0x21, 0x1f, 0x42, 0xdd, 0x38, 0x48,
+	0x36, 0x42, 0x3e, 0x91, 0x6e, 0x41, 0xbb, 0xd4, 0x34, 0x78, 0x64, 0x30, 0xf2, 0x10, 0x6a, 0x32,
+	0xa5, 0x89, 0xa0, 0x81, 0xda, 0x4e, 0xb8, 0xc5, 0x56, 0xb1, 0x53, 0xdd, 0x6f, 0x76, 0x4f, 0x2f,
+	0xd4, 0x9d, 0x6f, 0xac, 0xfc, 0x8e, 0x31, 0x3d, 0x9a, 0xf5, 0x4b, 0xcf, 0x5f, 0xed, 0xac, 0x78,
+	0x67, 0x22, 0xc9, 0x0d, 0x68, 0x48, 0xfe, 0x04, 0x13, 0x3f, 0xe0, 0x89, 0x4c, 0x69, 0x20, 0xdd,
+	0x52, 0xcb, 0xe9, 0x54, 0xbc, 0xba, 0x46, 0x0f, 0x2c, 0x48, 0xb6, 0x60, 0x75, 0x10, 0xf3, 0xe0,
+	0x89, 0xbb, 0xaa, 0x4f, 0x63, 0x0c, 0xf2, 0x29, 0x6c, 0xa7, 0x18, 0xd3, 0x13, 0x3a, 0x88, 0xd1,
+	0x17, 0x2c, 0x09, 0xd0, 0x1f, 0x22, 0x8b, 0x86, 0xd2, 0x5d, 0xd3, 0x6e, 0x5b, 0xf3, 0xd5, 0x43,
+	0xb5, 0xf8, 0x50, 0xaf, 0xb5, 0x9f, 0x15, 0x80, 0x9c, 0x3f, 0x1d, 0x69, 0x40, 0x81, 0x85, 0x96,
+	0x90, 0x02, 0x0b, 0xc9, 0x36, 0xac, 0x09, 0x4c, 0x42, 0x4c, 0x35, 0x03, 0x15, 0xcf, 0x5a, 0xe4,
+	0x3a, 0xd4, 0x42, 0x14, 0xd2, 0xa7, 0x61, 0x98, 0xa2, 0x50, 0x77, 0x57, 0xab, 0x55, 0x85, 0xdd,
+	0x35, 0x10, 0xf9, 0x12, 0xaa, 0x98, 0x06, 0xfb, 0xbb, 0xbe, 0xbe, 0x84, 0xbe, 0x51, 0x75, 0x7f,
+	0x3b, 0xcf, 0xce, 0x7d, 0xef, 0x60, 0x7f, 0xf7, 0x48, 0xad, 0x5a, 0x56, 0x40, 0x07, 0x68, 0x84,
+	0x7c, 0x0e, 0x15, 0x13, 0x7e, 0x8c, 0xe8, 0xae, 0x2e, 0x11, 0x5c, 0xd6, 0xee, 0x0f, 0x10, 0xc9,
+	0x67, 0x50, 0xd1, 0x77, 0xd6, 0xa1, 0x6b, 0x3a, 0xf4, 0x72, 0xd7, 0x94, 0x56, 0x57, 0x95, 0x56,
+	0xd7, 0x96, 0x56, 0xf7, 0x80, 0xb3, 0xc4, 0x2b, 0x6b, 0xdf, 0x07, 0x88, 0xed, 0x67, 0x0e, 0x5c,
+	0x3d, 0x0c, 0x86, 0x18, 0x4e, 0x62, 0x0c, 0x17, 0x90, 0xb3, 0x0b, 0x5b, 0x38, 0xc3, 0x60, 0x22,
+	0xd1, 0xa7, 0xc7, 0x12, 0xd3, 0x8c, 0x67, 0x43, 0x17, 0xb1, 0x6b, 0x77, 0xd5, 0x92, 0x61, 0x99,
+	0xdc, 0x81, 0xb2, 0xb4, 0xf1, 0x9a, 0xc0, 0x65, 0xcb, 0x63, 0x1e, 0xd5, 0xfe, 0xa5, 0x00, 0xc4,
+	0xc3, 0x60, 0x92, 0xa6, 0x2c, 0x89, 0x0e, 0x31, 0x09, 0x8f, 0xf8, 0x7d, 0x39, 0x5c, 0x3a, 0x4f,
+	0x97, 0xa1, 0x8c, 0x72, 0xe8, 0xab, 0xbc, 0xd8, 0x1c, 0xad, 0xa3, 0x1c, 0xde, 0x43, 0x21, 0xc9,
+	0x6d, 0x58, 0xa3, 0x23, 0x3e, 0x49, 0xa4, 0x5b, 0xba, 0x80, 0x22, 0x7b, 0x28, 0xeb, 0x4e, 0xbe,
+	0x02, 0x18, 0xa4, 0x2c, 0x8c, 0x30, 0x97, 0x9a, 0x0b, 0x83, 0x2b, 0x26, 0x44, 0xa5, 0xe7, 0x0a,
+	0x94, 0x59, 0x22, 0x31, 0x9d, 0xd2, 0xd8, 0x96, 0xe8, 0xdc, 0x26, 0xd7, 0x54, 0xea, 0x46, 0x94,
+	0x25, 0x2c, 0x89, 0xdc, 0x75, 0xbd, 0x78, 0x0a, 0xa8, 0xbe, 0x4d, 0x70, 0x26, 0x33, 0xde, 0xcb,
+	0xa6, 0x6f, 0x15, 0x64, 0xab, 0xfa, 0x9f, 0x02, 0x6c, 0x66, 0xa4, 0x3e, 0xe6, 0x11, 0x0b, 0x0e,
+	0x68, 0x1c, 0x93, 0x2f, 0xa0, 0x92, 0xf1, 0x29, 0x5c, 0xa7, 0x55, 0xbc, 0xb0, 0x94, 0x4e, 0xdd,
+	0xc9, 0x2e, 0x94, 0x8e, 0x11, 0x85, 0x5b, 0x58, 0x22, 0x4c, 0x7b, 0xaa, 0x7e, 0x8c, 0xd5, 0xd6,
+	0xf3, 0x66, 0x7e, 0xab, 0x49, 0xb6, 0xf4, 0x6a, 0xd6, 0xd4, 0x59, 0xb7, 0xb8, 0xb0, 0x3e, 0xa6,
+	0x27, 0x31, 0xa7, 0xa1, 0x4e, 0x47, 0xcd, 0xcb, 0x4c, 0xb5, 0x92, 0xa9, 0x90, 0xe9, 0xfb, 0xcc,
+	0x24, 0x1f, 0xc3, 0x06, 0x4b, 0xa6, 0x34, 0x66, 0xa1, 0x16, 0x3c, 0x9f, 0x85, 0x9a, 0xcf, 0x9a,
+	0xd7, 0xc8, 0xc3, 0x8f, 0x42, 0x72, 0x13, 0xc8, 0x19, 0x47, 0x23, 0x7b, 0x86, 0xde, 0xcd, 0xfc,
+	0x8a, 0x51, 0xbf, 0xb9, 0xce, 0x94, 0xf3, 0x3a, 0x73, 0x15, 0x2a, 0x11, 0x15, 0x7e, 0xcc, 0x46,
+	0x4c, 0xba, 0x15, 0x93, 0xb7, 0x88, 0x8a, 0xc7, 0xca, 0x6e, 0xff, 0xed, 0xc0, 0xa5, 0x39, 0xe1,
+	0xf7, 0x70, 0xcc, 0x05, 0x5b, 0x78, 0x3e, 0xe7, 0x1d, 0xce, 0x57, 0xf8, 0xaf, 0xf3, 0xb9, 0xb0,
+	0x2e, 0xc6, 0x3c, 0x11, 0x3c, 0xcd, 0x6a, 0xda, 0x9a, 0x24, 0xc8, 0xd5, 0x74, 0xf1, 0xff, 0xcb,
+	0x72, 0x57, 0xa5, 0xec, 0xe7, 0x3f, 0x77, 0x3a, 0x11, 0x93, 0xc3, 0xc9, 0xa0, 0x1b, 0xf0, 0x51,
+	0xcf, 0x3e, 0x3f, 0xe6, 0xe7, 0xa6, 0x08, 0x9f, 0xf4, 0xe4, 0xc9, 0x18, 0x85, 0x0e, 0x10, 0x59,
+	0xfd, 0xb7, 0x7f, 0x75, 0x60, 0x53, 0xbf, 0x23, 0x9e, 0x12, 0x8e, 0xc7, 0x54, 0x62, 0x12, 0x9c,
+	0x2c, 0xd0, 0x70, 0x67, 0x91, 0x86, 0xdf, 0x80, 0x06, 0x9d, 0x62, 0x4a, 0x23, 0xf4, 0x35, 0xad,
+	0xc2, 0x5e, 0xb3, 0x6e, 0xd1, 0xbe, 0x06, 0x55, 0xa5, 0xc7, 0x54, 0xc8, 0xcc, 0xa7, 0x68, 0x2a,
+	0x5d, 0x41, 0xd6, 0xa1, 0x03, 0x97, 0x8c, 0x43, 0xee, 0x1d, 0x2b, 0x69, 0xaf, 0x86, 0xf6, 0x3a,
+	0x7d, 0xcb, 0x14, 0x5b, 0x74, 0x34, 0x8e, 0x51, 0x64, 0xf5, 0x63, 0xcd, 0xf6, 0x6f, 0x0e, 0xd4,
+	0xef, 0x1b, 0xd1, 0x0a, 0x75, 0x00, 0xb9, 0x0d, 0xab, 0xfa, 0x83, 0xfa, 0xec, 0xd5, 0xfd, 0xab,
+	0x0b, 0xc5, 0xca, 0x3c, 0xa2, 0xb6, 0xe6, 0x8d, 0xbf, 0x4a, 0xb5, 0x95, 0xbf, 0x30, 0xeb, 0x4e,
+	0x73, 0xaf, 0x46, 0x06, 0x5b, 0x45, 0xec, 0xc0, 0x25, 0x25, 0x48, 0xfa, 0x5e, 0x99, 0x67, 0xd1,
+	0x7a, 0xca, 0xa1, 0xbe, 0x9c, 0xf5, 0xfc, 0x04, 0x36, 0xe5, 0xcc, 0x17, 0x93, 0x20, 0x40, 0x21,
+	0xfc, 0x01, 0x93, 0x23, 0x3a, 0xb6, 0xbd, 0xb1, 0x21, 0x67, 0x87, 0x06, 0xef, 0x6b, 0xb8, 0xfd,
+	0x83, 0x03, 0xf5, 0x7e, 0x26, 0x30, 0x47, 0x0c, 0x53, 0x25, 0x88, 0x96, 0x3b, 0x23, 0x92, 0xd6,
+	0x22, 0x77, 0xa0, 0xa8, 0x54, 0x4b, 0xab, 0x64, 0xbf, 0xab, 0xae, 0xf0, 0xf2, 0xd5, 0xce, 0x47,
+	0x4b, 0xd4, 0xc0, 0xa3, 0x44, 0x7a, 0x2a, 0x34, 0xcf, 0x67, 0xf1, 0x2c, 0x9f, 0x2f, 0x1d, 0x68,
+	0x9c, 0x39, 0x85, 0x58, 0xb6, 0x2a, 0x6e, 0x41, 0xe9, 0x98, 0x0a, 0x69, 0xdf, 0x88, 0xcb, 0x79,
+	0xda, 0xcf, 0x7c, 0x70, 0x2e, 0x34, 0xd4, 0x08, 0x78, 0xc2, 0xd3, 0x11, 0x8d, 0xdd, 0xe2, 0x72,
+	0x61, 0xd6, 0x5d, 0xed, 0x26, 0x62, 0xfe, 0xd4, 0x2d, 0x2d, 0x17, 0xa6, 0x9d, 0xdb, 0xbf, 0x97,
+	0xe0, 0x83, 0xc3, 0xc9, 0x60, 0xc4, 0x4c, 0x6d, 0xa9, 0x3e, 0x0f, 0xa9, 0xa4, 0xa4, 0x09, 0x60,
+	0xfb, 0x93, 0x5b, 0x75, 0xad, 0x78, 0x39, 0x44, 0x25, 0x62, 0xcc, 0x9f, 0x62, 0x6a, 0x24, 0xb4,
+	0xe4, 0x59, 0x4b, 0x4d, 0x10, 0x53, 0x1a, 0x0b, 0x94, 0xb6, 0x78, 0x0d, 0x97, 0x55, 0x83, 0x99,
+	0xca, 0x3d, 0x84, 0x7a, 0x8a, 0x4f, 0x69, 0x1a, 0xfa, 0xb9, 0x87, 0xea, 0xdd, 0xb3, 0x56, 0x33,
+	0x1f, 0xb9, 0x6b, 0x5e, 0xaf, 0xeb, 0x60, 0x6d, 0x3b, 0x97, 0xac, 0x9a, 0xc9, 0xc5, 0x60, 0x66,
+	0xf4, 0xa8, 0x81, 0x33, 0x75, 0xd7, 0x5a, 0xc5, 0x4e, 0xdd, 0x73, 0xa6, 0xca, 0x4a, 0xdd, 0xf5,
+	0x56, 0xb1, 0x53, 0xf3, 0x9c, 0x54, 0x59, 0xc2, 0x2d, 0x1b, 0x4b, 0x90, 0x87, 0xb0, 0x6e, 0x8e,
+	0x26, 0xdc, 0x4a, 0xab, 0xf8, 0x1e, 0x67, 0xcb, 0xc2, 0x49, 0xdb, 0x0c, 0x54, 0x2c, 0xa1, 0x66,
+	0x98, 0x04, 0x4d, 0xe4, 0x19, 0x8c, 0xf4, 0xed, 0x5b, 0x54, 0x7d, 0xaf, 0xad, 0x74, 0xec, 0xdb,
+	0xa3, 0x6f, 0xed, 0xdc, 0xe8, 0x7b, 0xbe, 0x62, 0xeb, 0x8b, 0x2a, 0xf6, 0xdc, 0x84, 0xdc, 0x58,
+	0x30, 0x21, 0x5f, 0x87, 0x9a, 0x60, 0x51, 0x82, 0xa1, 0xaf, 0x93, 0xee, 0x6e, 0x98, 0x1c, 0x1b,
+	0xec, 0x3b, 0x05, 0xf5, 0xbf, 0x7e, 0xfe, 0xba, 0xe9, 0xbc, 0x78, 0xdd, 0x74, 0xfe, 0x7a, 0xdd,
+	0x74, 0x7e, 0x7c, 0xd3, 0x5c, 0x79, 0xf1, 0xa6, 0xb9, 0xf2, 0xc7, 0x9b, 0xe6, 0xca, 0xf7, 0xb7,
+	0x72, 0xf7, 0xe2, 0x09, 0x1f, 0x9d, 0xe8, 0x79, 0x3e, 0xe0, 0x71, 0x8f, 0xa6, 0x41, 0x6f, 0xc4,
+	0xd5, 0x94, 0xd6, 0x9b, 0xf5, 0xb2, 0xe1, 0x5f, 0x5f, 0x74, 0xb0, 0xa6, 0x9d, 0x6e, 0xfd, 0x3b,
+	0x00, 0x8d, 0x48, 0x70, 0xbd, 0x6e, 0x0c, 0x00, 0x00,
+}
+
+func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -455,56 +1075,314 @@ func (m *OutgoingLogicCall) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OutgoingLogicCall) MarshalTo(dAtA []byte) (int, error) {
+func (m *OutgoingTxBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OutgoingTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Block != 0 {
-		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintBatch(dAtA, i, uint64(m.InvalidationNonce))
+	if m.RelayableSinceHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.RelayableSinceHeight))
 		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.InvalidationId) > 0 {
-		i -= len(m.InvalidationId)
-		copy(dAtA[i:], m.InvalidationId)
-		i = encodeVarintBatch(dAtA, i, uint64(len(m.InvalidationId)))
-		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x30
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintBatch(dAtA, i, uint64(m.Timeout))
+	if m.Block != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
 		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintBatch(dAtA, i, uint64(len(m.Payload)))
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.LogicContractAddress) > 0 {
-		i -= len(m.LogicContractAddress)
-		copy(dAtA[i:], m.LogicContractAddress)
-		i = encodeVarintBatch(dAtA, i, uint64(len(m.LogicContractAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BatchTimeout != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTransferTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTransferTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RelayFee != nil {
+		{
+			size, err := m.RelayFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledOutgoingTransferTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledOutgoingTransferTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledOutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.ExecuteAfterHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringSendToEth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringSendToEth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.EthDest) > 0 {
+		i -= len(m.EthDest)
+		copy(dAtA[i:], m.EthDest)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.EthDest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingLogicCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingLogicCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Block != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LogicContractAddress) > 0 {
+		i -= len(m.LogicContractAddress)
+		copy(dAtA[i:], m.LogicContractAddress)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.LogicContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -525,125 +1403,2358 @@ func (m *OutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintBatch(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBatch(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *OutgoingTxBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovBatch(uint64(m.BatchNonce))
-	}
-	if m.BatchTimeout != 0 {
-		n += 1 + sovBatch(uint64(m.BatchTimeout))
-	}
-	if len(m.Transactions) > 0 {
-		for _, e := range m.Transactions {
-			l = e.Size()
-			n += 1 + l + sovBatch(uint64(l))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogicCallDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogicCallDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogicCallDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchRelayLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchRelayLatency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchRelayLatency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastBatchNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.LastBatchNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastBlocks != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.LastBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AverageBlocks != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.AverageBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxSuccessBitmap) > 0 {
+		i -= len(m.TxSuccessBitmap)
+		copy(dAtA[i:], m.TxSuccessBitmap)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TxSuccessBitmap)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecutedHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.ExecutedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Blocks != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFeeTiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFeeTiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFeeTiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Slow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Normal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Fast.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitBatchCalldata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitBatchCalldata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitBatchCalldata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedPower != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BatchTimeout != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchTimeout))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Fees[iNdEx].Size()
+				i -= size
+				if _, err := m.Fees[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Destinations[iNdEx])
+			copy(dAtA[i:], m.Destinations[iNdEx])
+			i = encodeVarintBatch(dAtA, i, uint64(len(m.Destinations[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amounts[iNdEx].Size()
+				i -= size
+				if _, err := m.Amounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.S) > 0 {
+		for iNdEx := len(m.S) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.S[iNdEx])
+			copy(dAtA[i:], m.S[iNdEx])
+			i = encodeVarintBatch(dAtA, i, uint64(len(m.S[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.R) > 0 {
+		for iNdEx := len(m.R) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.R[iNdEx])
+			copy(dAtA[i:], m.R[iNdEx])
+			i = encodeVarintBatch(dAtA, i, uint64(len(m.R[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.V) > 0 {
+		dAtA12 := make([]byte, len(m.V)*10)
+		var j11 int
+		for _, num := range m.V {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintBatch(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RewardToken) > 0 {
+		i -= len(m.RewardToken)
+		copy(dAtA[i:], m.RewardToken)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.RewardToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ValsetNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.ValsetNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Powers) > 0 {
+		dAtA14 := make([]byte, len(m.Powers)*10)
+		var j13 int
+		for _, num := range m.Powers {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintBatch(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintBatch(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OutgoingTxBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovBatch(uint64(m.BatchNonce))
+	}
+	if m.BatchTimeout != 0 {
+		n += 1 + sovBatch(uint64(m.BatchTimeout))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovBatch(uint64(m.Block))
+	}
+	if m.RelayableSinceHeight != 0 {
+		n += 1 + sovBatch(uint64(m.RelayableSinceHeight))
+	}
+	return n
+}
+
+func (m *OutgoingTransferTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBatch(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = m.Erc20Token.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	l = m.Erc20Fee.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	if m.RelayFee != nil {
+		l = m.RelayFee.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledOutgoingTransferTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovBatch(uint64(m.ExecuteAfterHeight))
+	}
+	l = m.Transfer.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	return n
+}
+
+func (m *RecurringSendToEth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBatch(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.EthDest)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovBatch(uint64(m.Interval))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovBatch(uint64(m.Remaining))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovBatch(uint64(m.NextHeight))
+	}
+	return n
+}
+
+func (m *OutgoingLogicCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	l = len(m.LogicContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovBatch(uint64(m.Timeout))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovBatch(uint64(m.InvalidationNonce))
+	}
+	if m.Block != 0 {
+		n += 1 + sovBatch(uint64(m.Block))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovBatch(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *LogicCallDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovBatch(uint64(m.InvalidationNonce))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchRelayLatency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.AverageBlocks != 0 {
+		n += 1 + sovBatch(uint64(m.AverageBlocks))
+	}
+	if m.LastBlocks != 0 {
+		n += 1 + sovBatch(uint64(m.LastBlocks))
+	}
+	if m.LastBatchNonce != 0 {
+		n += 1 + sovBatch(uint64(m.LastBatchNonce))
+	}
+	if m.Samples != 0 {
+		n += 1 + sovBatch(uint64(m.Samples))
+	}
+	return n
+}
+
+func (m *ExecutedBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	if m.ExecutedHeight != 0 {
+		n += 1 + sovBatch(uint64(m.ExecutedHeight))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovBatch(uint64(m.EthBlockHeight))
+	}
+	l = len(m.TxSuccessBitmap)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovBatch(uint64(m.Blocks))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovBatch(uint64(m.Samples))
+	}
+	return n
+}
+
+func (m *BridgeFeeTiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = m.Fast.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	l = m.Normal.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	l = m.Slow.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	return n
+}
+
+func (m *SubmitBatchCalldata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	if len(m.Powers) > 0 {
+		l = 0
+		for _, e := range m.Powers {
+			l += sovBatch(uint64(e))
+		}
+		n += 1 + sovBatch(uint64(l)) + l
+	}
+	if m.ValsetNonce != 0 {
+		n += 1 + sovBatch(uint64(m.ValsetNonce))
+	}
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	l = len(m.RewardToken)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if len(m.V) > 0 {
+		l = 0
+		for _, e := range m.V {
+			l += sovBatch(uint64(e))
+		}
+		n += 1 + sovBatch(uint64(l)) + l
+	}
+	if len(m.R) > 0 {
+		for _, b := range m.R {
+			l = len(b)
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	if len(m.S) > 0 {
+		for _, b := range m.S {
+			l = len(b)
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for _, s := range m.Destinations {
+			l = len(s)
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovBatch(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.BatchTimeout != 0 {
+		n += 1 + sovBatch(uint64(m.BatchTimeout))
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovBatch(uint64(m.SignedPower))
+	}
+	return n
+}
+
+func sovBatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBatch(x uint64) (n int) {
+	return sovBatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingTxBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingTxBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
+			}
+			m.BatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, OutgoingTransferTx{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayableSinceHeight", wireType)
+			}
+			m.RelayableSinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayableSinceHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingTransferTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingTransferTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayFee == nil {
+				m.RelayFee = &types.Coin{}
+			}
+			if err := m.RelayFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledOutgoingTransferTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledOutgoingTransferTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledOutgoingTransferTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
+			}
+			m.ExecuteAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfterHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringSendToEth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringSendToEth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingLogicCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, ERC20Token{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, ERC20Token{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogicCallDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogicCallDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogicCallDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchRelayLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRelayLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRelayLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlocks", wireType)
+			}
+			m.AverageBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlocks", wireType)
+			}
+			m.LastBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchNonce", wireType)
+			}
+			m.LastBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedHeight", wireType)
+			}
+			m.ExecutedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSuccessBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxSuccessBitmap = append(m.TxSuccessBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxSuccessBitmap == nil {
+				m.TxSuccessBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovBatch(uint64(l))
-	}
-	if m.Block != 0 {
-		n += 1 + sovBatch(uint64(m.Block))
-	}
-	return n
-}
-
-func (m *OutgoingTransferTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBatch(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovBatch(uint64(l))
-	}
-	l = len(m.DestAddress)
-	if l > 0 {
-		n += 1 + l + sovBatch(uint64(l))
-	}
-	l = m.Erc20Token.Size()
-	n += 1 + l + sovBatch(uint64(l))
-	l = m.Erc20Fee.Size()
-	n += 1 + l + sovBatch(uint64(l))
-	return n
-}
 
-func (m *OutgoingLogicCall) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Transfers) > 0 {
-		for _, e := range m.Transfers {
-			l = e.Size()
-			n += 1 + l + sovBatch(uint64(l))
-		}
-	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovBatch(uint64(l))
-		}
-	}
-	l = len(m.LogicContractAddress)
-	if l > 0 {
-		n += 1 + l + sovBatch(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovBatch(uint64(l))
-	}
-	if m.Timeout != 0 {
-		n += 1 + sovBatch(uint64(m.Timeout))
-	}
-	l = len(m.InvalidationId)
-	if l > 0 {
-		n += 1 + l + sovBatch(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovBatch(uint64(m.InvalidationNonce))
-	}
-	if m.Block != 0 {
-		n += 1 + sovBatch(uint64(m.Block))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBatch(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBatch(x uint64) (n int) {
-	return sovBatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
+func (m *BridgeFeeTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -666,17 +3777,17 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutgoingTxBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeFeeTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutgoingTxBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			m.BatchNonce = 0
+			m.Blocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -686,16 +3797,16 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				m.Blocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.BatchTimeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -705,14 +3816,130 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchTimeout |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeFeeTiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeFeeTiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeFeeTiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -739,16 +3966,15 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transactions = append(m.Transactions, OutgoingTransferTx{})
-			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Normal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -758,29 +3984,30 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			if err := m.Normal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slow", wireType)
 			}
-			m.Block = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -790,11 +4017,25 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
@@ -816,7 +4057,7 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
+func (m *SubmitBatchCalldata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -839,34 +4080,15 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutgoingTransferTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmitBatchCalldata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutgoingTransferTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmitBatchCalldata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBatch
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -894,11 +4116,106 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBatch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Powers = append(m.Powers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBatch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBatch
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBatch
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Powers) == 0 {
+					m.Powers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBatch
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Powers = append(m.Powers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Powers", wireType)
+			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			}
+			m.ValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -926,13 +4243,15 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -942,30 +4261,105 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBatch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.V = append(m.V, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBatch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBatch
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBatch
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.V) == 0 {
+					m.V = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBatch
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.V = append(m.V, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -975,80 +4369,29 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.R = append(m.R, make([]byte, postIndex-iNdEx))
+			copy(m.R[len(m.R)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBatch(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBatch
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBatch
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutgoingLogicCall: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutgoingLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1058,31 +4401,29 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transfers = append(m.Transfers, ERC20Token{})
-			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.S = append(m.S, make([]byte, postIndex-iNdEx))
+			copy(m.S[len(m.S)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1092,29 +4433,31 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = append(m.Fees, ERC20Token{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amounts = append(m.Amounts, v)
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1142,13 +4485,13 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Destinations = append(m.Destinations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1158,31 +4501,33 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Fees = append(m.Fees, v)
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			m.Timeout = 0
+			m.BatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1192,16 +4537,16 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
+				m.BatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1211,31 +4556,29 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationId == nil {
-				m.InvalidationId = []byte{}
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
 			}
-			m.InvalidationNonce = 0
+			m.BatchTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1245,16 +4588,16 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				m.BatchTimeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
 			}
-			m.Block = 0
+			m.SignedPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1264,7 +4607,7 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				m.SignedPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
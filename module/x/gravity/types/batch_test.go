@@ -84,3 +84,41 @@ func TestOutgoingLogicCallCheckpointGold1(t *testing.T) {
 	// a different hash.
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
+
+// Tests that the tx success bitmap splits the transactions of a batch least significant bit first and is rejected
+// unless exactly as long as the transactions
+func TestSplitBySuccess(t *testing.T) {
+	batch := InternalOutgoingTxBatch{}
+	for id := uint64(1); id <= 10; id++ {
+		batch.Transactions = append(batch.Transactions, &InternalOutgoingTransferTx{Id: id})
+	}
+	ids := func(txs []*InternalOutgoingTransferTx) []uint64 {
+		var ids []uint64
+		for _, tx := range txs {
+			ids = append(ids, tx.Id)
+		}
+		return ids
+	}
+
+	executed, failed, err := batch.SplitBySuccess(nil)
+	require.NoError(t, err)
+	assert.Len(t, executed, 10)
+	assert.Empty(t, failed)
+
+	executed, failed, err = batch.SplitBySuccess([]byte{0xfd, 0x01})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 3, 4, 5, 6, 7, 8, 9}, ids(executed))
+	assert.Equal(t, []uint64{2, 10}, ids(failed))
+
+	executed, failed, err = batch.SplitBySuccess([]byte{0x00, 0x00})
+	require.NoError(t, err)
+	assert.Empty(t, executed)
+	assert.Len(t, failed, 10)
+
+	_, _, err = batch.SplitBySuccess([]byte{0xff})
+	require.Error(t, err)
+	_, _, err = batch.SplitBySuccess([]byte{0xff, 0x03, 0x00})
+	require.Error(t, err)
+	_, _, err = batch.SplitBySuccess([]byte{0xff, 0x07})
+	require.Error(t, err)
+}
@@ -0,0 +1,172 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// ClaimEncodingVersion is the latest version of the canonical claim encoding hashed by ClaimHash. Any change to the
+// fields of a claim type or to how they are encoded must add a new version, which governance then rolls out through
+// the claim_hash_version param, the previous versions stay supported for the attestations keyed with them
+const ClaimEncodingVersion = 3
+
+// claimFields are the fields of a claim in its canonical encoding, the values are either strings or lists of
+// claimFields
+type claimFields map[string]interface{}
+
+// CanonicalClaimBytes returns the canonical encoding of a claim in a version, which is hashed by ClaimHash. It is
+// a JSON object without insignificant whitespace and without HTML escaping whose keys are sorted bytewise. Along with
+// the fields of the claim it holds its "type" and the encoding "version". Integers are encoded as decimal strings and
+// byte strings as lowercase hex, so the encoding can be reproduced without a JSON library preserving 64 bit numbers
+func CanonicalClaimBytes(claim EthereumClaim, version uint64) ([]byte, error) {
+	var (
+		fields claimFields
+		err    error
+	)
+	switch version {
+	case 1:
+		fields, err = claimFieldsV1(claim)
+	case 2:
+		fields, err = claimFieldsV2(claim)
+	case 3:
+		fields, err = claimFieldsV3(claim)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalid, "unsupported claim hash version %d", version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	fields["type"] = claim.GetType().String()
+	fields["version"] = encodeUint(version)
+
+	// maps are marshalled with sorted keys, only the HTML escaping and the trailing newline of the encoder
+	// have to be turned off
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(fields); err != nil {
+		return nil, sdkerrors.Wrap(err, "encoding claim")
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// claimFieldsV1 returns the fields of a claim in the first version of the encoding, every field but the orchestrator
+func claimFieldsV1(claim EthereumClaim) (claimFields, error) {
+	var fields claimFields
+	switch claim := claim.(type) {
+	case *MsgSendToCosmosClaim:
+		fields = claimFields{
+			"event_nonce":     encodeUint(claim.EventNonce),
+			"block_height":    encodeUint(claim.BlockHeight),
+			"token_contract":  claim.TokenContract,
+			"amount":          claim.Amount.String(),
+			"ethereum_sender": claim.EthereumSender,
+			"cosmos_receiver": claim.CosmosReceiver,
+		}
+	case *MsgBatchSendToEthClaim:
+		// the partial executions of batches can not be told apart from the full ones before version 3
+		if len(claim.TxSuccessBitmap) != 0 {
+			return nil, sdkerrors.Wrap(ErrInvalid, "tx success bitmap requires claim hash version 3")
+		}
+		fields = claimFields{
+			"event_nonce":    encodeUint(claim.EventNonce),
+			"block_height":   encodeUint(claim.BlockHeight),
+			"batch_nonce":    encodeUint(claim.BatchNonce),
+			"token_contract": claim.TokenContract,
+			"relayer":        strings.ToLower(claim.Relayer),
+		}
+	case *MsgERC20DeployedClaim:
+		fields = claimFields{
+			"event_nonce":    encodeUint(claim.EventNonce),
+			"block_height":   encodeUint(claim.BlockHeight),
+			"cosmos_denom":   claim.CosmosDenom,
+			"token_contract": claim.TokenContract,
+			"name":           claim.Name,
+			"symbol":         claim.Symbol,
+			"decimals":       encodeUint(claim.Decimals),
+		}
+	case *MsgLogicCallExecutedClaim:
+		fields = claimFields{
+			"event_nonce":        encodeUint(claim.EventNonce),
+			"block_height":       encodeUint(claim.BlockHeight),
+			"invalidation_id":    hex.EncodeToString(claim.InvalidationId),
+			"invalidation_nonce": encodeUint(claim.InvalidationNonce),
+		}
+	case *MsgValsetUpdatedClaim:
+		var members BridgeValidators = claim.Members
+		internalMembers, err := members.ToInternal()
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid members")
+		}
+		// the members are sorted as in the valset checkpoint, so their order on Ethereum does not matter
+		internalMembers.Sort()
+		encodedMembers := make([]claimFields, len(*internalMembers))
+		for i, member := range *internalMembers {
+			encodedMembers[i] = claimFields{
+				"ethereum_address": member.EthereumAddress.GetAddress(),
+				"power":            encodeUint(member.Power),
+			}
+		}
+		fields = claimFields{
+			"event_nonce":   encodeUint(claim.EventNonce),
+			"valset_nonce":  encodeUint(claim.ValsetNonce),
+			"block_height":  encodeUint(claim.BlockHeight),
+			"members":       encodedMembers,
+			"reward_amount": claim.RewardAmount.String(),
+			"reward_token":  claim.RewardToken,
+		}
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalid, "unknown claim type %T", claim)
+	}
+	return fields, nil
+}
+
+// claimFieldsV2 returns the fields of a claim in the second version of the encoding, which adds the lowercase hash of
+// the Ethereum block of the event to the fields of the first version
+func claimFieldsV2(claim EthereumClaim) (claimFields, error) {
+	fields, err := claimFieldsV1(claim)
+	if err != nil {
+		return nil, err
+	}
+	fields["block_hash"] = strings.ToLower(claim.GetBlockHash())
+	return fields, nil
+}
+
+// claimFieldsV3 returns the fields of a claim in the third version of the encoding, which adds the lowercase hex tx
+// success bitmap of the batch claims to the fields of the second version
+func claimFieldsV3(claim EthereumClaim) (claimFields, error) {
+	batchClaim, isBatchClaim := claim.(*MsgBatchSendToEthClaim)
+	if isBatchClaim && len(batchClaim.TxSuccessBitmap) != 0 {
+		// the earlier versions reject a bitmap, it is added to their fields below
+		withoutBitmap := *batchClaim
+		withoutBitmap.TxSuccessBitmap = nil
+		claim = &withoutBitmap
+	}
+	fields, err := claimFieldsV2(claim)
+	if err != nil {
+		return nil, err
+	}
+	if isBatchClaim {
+		fields["tx_success_bitmap"] = hex.EncodeToString(batchClaim.TxSuccessBitmap)
+	}
+	return fields, nil
+}
+
+// hashClaim returns the SHA256 hash of the canonical encoding of a claim in a version
+func hashClaim(claim EthereumClaim, version uint64) ([]byte, error) {
+	bz, err := CanonicalClaimBytes(claim, version)
+	if err != nil {
+		return nil, err
+	}
+	return tmhash.Sum(bz), nil
+}
+
+func encodeUint(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
@@ -0,0 +1,206 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden claim hashes")
+
+// goldenClaimHash is an entry of testdata/claim_hashes.json, which implementations in other languages can test
+// their claim hashing against
+type goldenClaimHash struct {
+	Name      string `json:"name"`
+	Canonical string `json:"canonical"`
+	Hash      string `json:"hash"`
+}
+
+func goldenClaims() []struct {
+	name  string
+	claim EthereumClaim
+} {
+	return []struct {
+		name  string
+		claim EthereumClaim
+	}{
+		{"send_to_cosmos", &MsgSendToCosmosClaim{
+			EventNonce:     7,
+			BlockHeight:    1234,
+			TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Amount:         sdk.NewIntWithDecimal(15, 18),
+			EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			CosmosReceiver: "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+			Orchestrator:   "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+			BlockHash:      "0x1c9e5b5d1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2e5b0",
+		}},
+		{"batch_send_to_eth", &MsgBatchSendToEthClaim{
+			EventNonce:    8,
+			BlockHeight:   1240,
+			BatchNonce:    3,
+			TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Orchestrator:  "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+			BlockHash:     "0x8a4f6c2e0b1d3f5a7c9e1b3d5f7a9c1e3b5d7f9a1c3e5b7d9f1a3c5e7b9d07d2",
+			Relayer:       "0xD041C41EA1BF0F006ADBB6D2C9EF9D425DE5EAD7",
+		}},
+		{"batch_send_to_eth_partial", &MsgBatchSendToEthClaim{
+			EventNonce:      12,
+			BlockHeight:     1280,
+			BatchNonce:      5,
+			TokenContract:   "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Orchestrator:    "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+			BlockHash:       "0x3c5e7b9d1f3a5c7e9b1d3f5a7c9e1b3d5f7a9c1e3b5d7f9a1c3e5b7d9f1a3c5e",
+			Relayer:         "0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7",
+			TxSuccessBitmap: []byte{0xfd, 0x01},
+		}},
+		{"erc20_deployed", &MsgERC20DeployedClaim{
+			EventNonce:    9,
+			BlockHeight:   1250,
+			CosmosDenom:   "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Name:          "Atom <IBC>",
+			Symbol:        "ATOM",
+			Decimals:      6,
+			Orchestrator:  "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+			BlockHash:     "0x2b7d9f1a3c5e7b9d1f3a5c7e9b1d3f5a7c9e1b3d5f7a9c1e3b5d7f9a1c3e5b7d",
+		}},
+		{"logic_call_executed", &MsgLogicCallExecutedClaim{
+			EventNonce:        10,
+			BlockHeight:       1260,
+			InvalidationId:    []byte{0xde, 0xad, 0xbe, 0xef},
+			InvalidationNonce: 2,
+			Orchestrator:      "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+			BlockHash:         "0x5e7b9d1f3a5c7e9b1d3f5a7c9e1b3d5f7a9c1e3b5d7f9a1c3e5b7d9f1a3c5e7b",
+		}},
+		{"valset_updated", &MsgValsetUpdatedClaim{
+			EventNonce:  11,
+			ValsetNonce: 4,
+			BlockHeight: 1270,
+			Members: []BridgeValidator{
+				{Power: 1073741824, EthereumAddress: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"},
+				{Power: 3221225472, EthereumAddress: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"},
+			},
+			RewardAmount: sdk.ZeroInt(),
+			RewardToken:  ZeroAddressString,
+			Orchestrator: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+			BlockHash:    "0x9d1f3a5c7e9b1d3f5a7c9e1b3d5f7a9c1e3b5d7f9a1c3e5b7d9f1a3c5e7b9d1f",
+		}},
+	}
+}
+
+// Tests the claim hashes against the golden file, run with -update to rewrite it after bumping ClaimEncodingVersion
+func TestClaimHashGolden(t *testing.T) {
+	path := filepath.Join("testdata", "claim_hashes.json")
+
+	var actual []goldenClaimHash
+	for _, c := range goldenClaims() {
+		canonical, err := CanonicalClaimBytes(c.claim, ClaimEncodingVersion)
+		require.NoError(t, err)
+		hash, err := c.claim.ClaimHash(ClaimEncodingVersion)
+		require.NoError(t, err)
+		actual = append(actual, goldenClaimHash{Name: c.name, Canonical: string(canonical), Hash: hex.EncodeToString(hash)})
+	}
+
+	if *updateGolden {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		require.NoError(t, enc.Encode(actual))
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+	}
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var golden []goldenClaimHash
+	require.NoError(t, json.Unmarshal(bz, &golden))
+	require.Equal(t, golden, actual)
+}
+
+// Tests that the claim hash covers every field but the orchestrator and the bridge contract and does not depend on the
+// order of the members
+func TestClaimHashFields(t *testing.T) {
+	hash := func(claim EthereumClaim) []byte {
+		h, err := claim.ClaimHash(ClaimEncodingVersion)
+		require.NoError(t, err)
+		return h
+	}
+
+	claims := goldenClaims()
+	sendToCosmos := *claims[0].claim.(*MsgSendToCosmosClaim)
+	modified := sendToCosmos
+	modified.Orchestrator = "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn"
+	require.Equal(t, hash(&sendToCosmos), hash(&modified))
+	modified.BridgeContract = "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+	require.Equal(t, hash(&sendToCosmos), hash(&modified))
+	modified.Amount = sendToCosmos.Amount.AddRaw(1)
+	require.NotEqual(t, hash(&sendToCosmos), hash(&modified))
+
+	// a relayer reported in another case is the same relayer
+	batch := *claims[1].claim.(*MsgBatchSendToEthClaim)
+	lowered := batch
+	lowered.Relayer = "0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7"
+	require.Equal(t, hash(&batch), hash(&lowered))
+
+	// the tx success bitmap is hashed from the third version on, before it a bitmap can not be hashed
+	partial := batch
+	partial.TxSuccessBitmap = []byte{0x01}
+	require.NotEqual(t, hash(&batch), hash(&partial))
+	_, err := partial.ClaimHash(2)
+	require.Error(t, err)
+	v2, err := batch.ClaimHash(2)
+	require.NoError(t, err)
+	require.NotEqual(t, v2, hash(&batch))
+
+	valset := *claims[5].claim.(*MsgValsetUpdatedClaim)
+	reordered := valset
+	reordered.Members = []BridgeValidator{valset.Members[1], valset.Members[0]}
+	require.Equal(t, hash(&valset), hash(&reordered))
+
+	// a claim of the same event with other fields does not collide through the separators of the encoding
+	erc20 := *claims[3].claim.(*MsgERC20DeployedClaim)
+	shifted := erc20
+	shifted.Name, shifted.Symbol = "Atom", "<IBC>ATOM"
+	require.NotEqual(t, hash(&erc20), hash(&shifted))
+
+	// the block hash is hashed from the second version on, in any case
+	rehashed := sendToCosmos
+	rehashed.BlockHash = "0x" + strings.ToUpper(sendToCosmos.BlockHash[2:])
+	require.Equal(t, hash(&sendToCosmos), hash(&rehashed))
+	rehashed.BlockHash = "0x" + strings.Repeat("ab", 32)
+	require.NotEqual(t, hash(&sendToCosmos), hash(&rehashed))
+	v1, err := sendToCosmos.ClaimHash(1)
+	require.NoError(t, err)
+	rehashedV1, err := rehashed.ClaimHash(1)
+	require.NoError(t, err)
+	require.Equal(t, v1, rehashedV1)
+}
+
+// Tests that only the supported claim hash versions can hash claims or be set in the params, and that attestations
+// of the same claim in two versions have distinct keys
+func TestClaimHashVersion(t *testing.T) {
+	claim := goldenClaims()[0].claim
+	_, err := claim.ClaimHash(0)
+	require.Error(t, err)
+	_, err = claim.ClaimHash(ClaimEncodingVersion + 1)
+	require.Error(t, err)
+
+	require.Error(t, validateClaimHashVersion(uint64(0)))
+	require.NoError(t, validateClaimHashVersion(uint64(ClaimEncodingVersion)))
+	require.Error(t, validateClaimHashVersion(uint64(ClaimEncodingVersion+1)))
+
+	hash, err := claim.ClaimHash(ClaimEncodingVersion)
+	require.NoError(t, err)
+	key := GetAttestationKey(claim.GetEventNonce(), ClaimEncodingVersion, hash)
+	require.NotEqual(t, GetAttestationKey(claim.GetEventNonce(), ClaimEncodingVersion+1, hash), key)
+	prefix := GetAttestationNoncePrefix(claim.GetEventNonce())
+	require.Equal(t, prefix, key[:len(prefix)])
+}
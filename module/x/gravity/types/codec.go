@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -32,6 +33,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
 		&MsgSubmitBadSignatureEvidence{},
+		&MsgUnjailValidator{},
+		&MsgCreateRecurringSendToEth{},
+		&MsgCancelRecurringSendToEth{},
+		&MsgForkDetectedClaim{},
+		&MsgSetSelfBridgeLimit{},
+		&MsgSubmitGravityProposal{},
+		&MsgExecuteIbcAutoForwards{},
 	)
 
 	registry.RegisterInterface(
@@ -44,7 +52,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 	)
 
-	registry.RegisterImplementations((*govtypes.Content)(nil), &UnhaltBridgeProposal{}, &AirdropProposal{}, &IBCMetadataProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &UnhaltBridgeProposal{}, &AirdropProposal{}, &IBCMetadataProposal{}, &RecoverStrandedFundsProposal{}, &EmergencyValsetProposal{}, &ReleaseHeldDepositsProposal{}, &RefundHeldDepositsProposal{})
+
+	registry.RegisterImplementations((*authz.Authorization)(nil), &SendToEthAuthorization{})
 
 	registry.RegisterInterface("gravity.v1beta1.EthereumSigned", (*EthereumSigned)(nil), &Valset{}, &OutgoingTxBatch{}, &OutgoingLogicCall{})
 
@@ -74,4 +84,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&IDSet{}, "gravity/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
+	cdc.RegisterConcrete(&MsgUnjailValidator{}, "gravity/MsgUnjailValidator", nil)
+	cdc.RegisterConcrete(&MsgCreateRecurringSendToEth{}, "gravity/MsgCreateRecurringSendToEth", nil)
+	cdc.RegisterConcrete(&MsgCancelRecurringSendToEth{}, "gravity/MsgCancelRecurringSendToEth", nil)
+	cdc.RegisterConcrete(&MsgForkDetectedClaim{}, "gravity/MsgForkDetectedClaim", nil)
+	cdc.RegisterConcrete(&MsgSetSelfBridgeLimit{}, "gravity/MsgSetSelfBridgeLimit", nil)
+	cdc.RegisterConcrete(&MsgSubmitGravityProposal{}, "gravity/MsgSubmitGravityProposal", nil)
+	cdc.RegisterConcrete(&MsgExecuteIbcAutoForwards{}, "gravity/MsgExecuteIbcAutoForwards", nil)
 }
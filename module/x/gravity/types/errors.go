@@ -20,4 +20,9 @@ var (
 	ErrInvalidValAddress       = sdkerrors.Register(ModuleName, 13, "invalid validator address in current valset %v")
 	ErrInvalidEthAddress       = sdkerrors.Register(ModuleName, 14, "discovered invalid eth address stored for validator %v")
 	ErrInvalidValset           = sdkerrors.Register(ModuleName, 15, "generated invalid valset")
+	ErrScreened                = sdkerrors.Register(ModuleName, 16, "transfer vetoed by screening")
+	ErrTokenPaused             = sdkerrors.Register(ModuleName, 17, "token paused")
+	ErrSelfBridgeLimitExceeded = sdkerrors.Register(ModuleName, 18, "self bridge limit exceeded")
+	ErrUint256Overflow         = sdkerrors.Register(ModuleName, 19, "amount overflows uint256")
+	ErrContractReceiver        = sdkerrors.Register(ModuleName, 20, "receiver is a contract")
 )
@@ -3,11 +3,13 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"regexp"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 const (
@@ -25,6 +27,13 @@ const (
 
 	// ZeroAddress is an EthAddress containing the zero ethereum address
 	ZeroAddressString = "0x0000000000000000000000000000000000000000"
+
+	// NativeEthDenom is the denom of the vouchers of native ETH deposits, reported with the zero address as token
+	// contract, and of the WETH contract they are wrapped into
+	NativeEthDenom = "gravity-eth"
+
+	// NativeEthDisplayDenom is the display denom of NativeEthDenom, which has 18 decimals like ETH
+	NativeEthDisplayDenom = "eth"
 )
 
 // Regular EthAddress
@@ -128,10 +137,40 @@ func NewInternalERC20Token(amount sdk.Int, contract string) (*InternalERC20Token
 	return ret, nil
 }
 
+// ValidateUint256 checks that amount is set, not negative and fits in the uint256 amounts of Ethereum. Amounts of
+// Ethereum events are uint256, one outside of that range comes from a malformed or malicious encoding
+func ValidateUint256(amount sdk.Int) error {
+	if amount.IsNil() {
+		return sdkerrors.Wrap(ErrEmpty, "amount")
+	}
+	if amount.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalid, "negative amount %s", amount)
+	}
+	if amount.BigInt().BitLen() > 256 {
+		return sdkerrors.Wrap(ErrUint256Overflow, amount.String())
+	}
+	return nil
+}
+
+// AddUint256 returns a + b, or an error if the sum does not fit in a uint256 where sdk.Int.Add would panic
+func AddUint256(a sdk.Int, b sdk.Int) (sdk.Int, error) {
+	if err := ValidateUint256(a); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := ValidateUint256(b); err != nil {
+		return sdk.Int{}, err
+	}
+	sum := new(big.Int).Add(a.BigInt(), b.BigInt())
+	if sum.BitLen() > 256 {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrUint256Overflow, "%s + %s", a, b)
+	}
+	return sdk.NewIntFromBigInt(sum), nil
+}
+
 // ValidateBasic performs validation on all fields of an InternalERC20Token
 func (i *InternalERC20Token) ValidateBasic() error {
-	if i.Amount.IsNegative() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "coins must not be negative")
+	if err := ValidateUint256(i.Amount); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
 	}
 	err := i.Contract.ValidateBasic()
 	if err != nil {
@@ -167,16 +206,64 @@ func (e *ERC20Token) ValidateBasic() error {
 	return nil
 }
 
+// NewFormattedAmount formats the amount of e, the display string is only set when the decimals of the token are known
+func NewFormattedAmount(e ERC20Token, decimals uint32, known bool) FormattedAmount {
+	formatted := FormattedAmount{Contract: e.Contract, Raw: e.Amount, Decimals: 0, Display: ""}
+	if known {
+		formatted.Decimals = decimals
+		formatted.Display = FormatDecimalAmount(e.Amount, decimals)
+	}
+	return formatted
+}
+
+// FormatDecimalAmount places the decimal point of amount decimals digits from the right, without trailing zeros
+func FormatDecimalAmount(amount sdk.Int, decimals uint32) string {
+	digits := amount.Abs().String()
+	if decimals == 0 {
+		return amount.String()
+	}
+	if pad := int(decimals) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(decimals)
+	formatted := digits[:point]
+	if fraction := strings.TrimRight(digits[point:], "0"); fraction != "" {
+		formatted += "." + fraction
+	}
+	if amount.IsNegative() {
+		formatted = "-" + formatted
+	}
+	return formatted
+}
+
 // Add adds one ERC20 to another
 // TODO: make this return errors instead
 func (i *InternalERC20Token) Add(o *InternalERC20Token) (*InternalERC20Token, error) {
 	if i.Contract.GetAddress() != o.Contract.GetAddress() {
 		return nil, sdkerrors.Wrap(ErrMismatched, "cannot add two different tokens")
 	}
-	sum := i.Amount.Add(o.Amount) // validation happens in NewInternalERC20Token()
+	sum, err := AddUint256(i.Amount, o.Amount)
+	if err != nil {
+		return nil, err
+	}
 	return NewInternalERC20Token(sum, i.Contract.GetAddress())
 }
 
+// NativeEthMetadata returns the bank metadata of NativeEthDenom
+func NativeEthMetadata() bank.Metadata {
+	return bank.Metadata{
+		Description: "Native ETH bridged from Ethereum",
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: NativeEthDenom, Exponent: 0, Aliases: []string{"wei"}},
+			{Denom: NativeEthDisplayDenom, Exponent: 18, Aliases: nil},
+		},
+		Base:    NativeEthDenom,
+		Display: NativeEthDisplayDenom,
+		Name:    "Ether",
+		Symbol:  "ETH",
+	}
+}
+
 // GravityDenomToERC20 converts a gravity cosmos denom to an EthAddress
 func GravityDenomToERC20(denom string) (*EthAddress, error) {
 	fullPrefix := GravityDenomPrefix + GravityDenomSeparator
@@ -2,6 +2,7 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -20,10 +21,29 @@ func NewEthereumSignature(hash []byte, privateKey *ecdsa.PrivateKey) ([]byte, er
 	return crypto.Sign(protectedHash.Bytes(), privateKey)
 }
 
-func EthAddressFromSignature(hash []byte, signature []byte) (*EthAddress, error) {
+// ValidateEthereumSignatureFormat performs the stateless checks on a signature, it must be
+// exactly 65 bytes (r, s, v) with a recovery id in either the 0/1 or the legacy 27/28 format
+func ValidateEthereumSignatureFormat(signature []byte) error {
 	if len(signature) < 65 {
-		return nil, sdkerrors.Wrap(ErrInvalid, "signature too short")
+		return sdkerrors.Wrap(ErrInvalid, "signature too short")
+	}
+	if len(signature) > 65 {
+		return sdkerrors.Wrap(ErrInvalid, "signature too long")
+	}
+	switch signature[64] {
+	case 0, 1, 27, 28:
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "signature recovery id %d", signature[64])
+	}
+}
+
+func EthAddressFromSignature(hash []byte, signature []byte) (*EthAddress, error) {
+	if err := ValidateEthereumSignatureFormat(signature); err != nil {
+		return nil, err
 	}
+	// copy so that normalizing the recovery id below does not modify the caller's signature
+	signature = append([]byte(nil), signature...)
 	// To verify signature
 	// - use crypto.SigToPub to get the public key
 	// - use crypto.PubkeyToAddress to get the address
@@ -66,7 +86,8 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress EthAddr
 		return sdkerrors.Wrap(err, "unable to get address from signature")
 	}
 
-	if addr.GetAddress() != ethAddress.GetAddress() {
+	// the recovered address is EIP-55 checksummed, registered keys may not be
+	if !strings.EqualFold(addr.GetAddress(), ethAddress.GetAddress()) {
 		return sdkerrors.Wrap(ErrInvalid, "signature not matching")
 	}
 
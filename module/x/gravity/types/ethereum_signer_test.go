@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -61,6 +62,23 @@ func TestValsetConfirmSig(t *testing.T) {
 			srcETHAddr:   ethAddress,
 			expErr:       true,
 		},
+		"signature too long": {
+			srcHash:      hash,
+			srcSignature: correctSig + "00",
+			srcETHAddr:   ethAddress,
+			expErr:       true,
+		},
+		"invalid recovery id": {
+			srcHash:      hash,
+			srcSignature: correctSig[0:128] + "1d",
+			srcETHAddr:   ethAddress,
+			expErr:       true,
+		},
+		"lowercase eth address": {
+			srcHash:      hash,
+			srcSignature: correctSig,
+			srcETHAddr:   strings.ToLower(ethAddress),
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
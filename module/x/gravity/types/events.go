@@ -10,6 +10,41 @@ const (
 	EventTypeBridgeDepositReceived       = "deposit_received"
 	EventTypeBridgeWithdrawCanceled      = "withdraw_canceled"
 	EventTypeInvalidSendToCosmosReceiver = "invalid_send_to_cosmos_receiver"
+	EventTypeBatchRelayLatencySLA        = "batch_relay_latency_sla_exceeded"
+	EventTypeBatchRelayable              = "batch_relayable"
+	EventTypeValsetRelayable             = "valset_relayable"
+	EventTypeBridgeFeeExchanged          = "bridge_fee_exchanged"
+	EventTypeBatchRelayFeesPaid          = "batch_relay_fees_paid"
+	EventTypeLogicCallDepositRefunded    = "logic_call_deposit_refunded"
+	EventTypeBatchSignatureMissed        = "batch_signature_missed"
+	EventTypeUpgradeWithPendingDeposits  = "upgrade_with_pending_deposits"
+	EventTypeEmergencyValsetScheduled    = "emergency_valset_scheduled"
+	EventTypeEmergencyValsetStored       = "emergency_valset_stored"
+	EventTypeScheduledWithdrawalReleased = "scheduled_withdrawal_released"
+	EventTypeRecurringSendToEthCreated   = "recurring_send_to_eth_created"
+	EventTypeRecurringSendToEthSent      = "recurring_send_to_eth_sent"
+	EventTypeRecurringSendToEthEnded     = "recurring_send_to_eth_ended"
+	EventTypeDepositHeld                 = "deposit_held"
+	EventTypeHeldDepositReleased         = "held_deposit_released"
+	EventTypeHeldDepositRefunded         = "held_deposit_refunded"
+	EventTypeDepositRefunded             = "deposit_refunded"
+	EventTypeForkDetected                = "fork_detected"
+	EventTypeSelfBridgeLimitSet          = "self_bridge_limit_set"
+	EventTypeExpeditedQuorumReached      = "expedited_quorum_reached"
+	EventTypeBatchPartiallyExecuted      = "batch_partially_executed"
+	EventTypeParamChangePending          = "param_change_pending"
+	EventTypeParamChangeApplied          = "param_change_applied"
+	EventTypeParamChangeDropped          = "param_change_dropped"
+	EventTypeBridgeBound                 = "bridge_bound"
+	EventTypeContractReceiver            = "contract_receiver"
+	EventTypeFeesBurned                  = "fees_burned"
+	EventTypeIbcAutoForwardQueued        = "ibc_auto_forward_queued"
+	EventTypeIbcAutoForward              = "ibc_auto_forward"
+	EventTypeIbcAutoForwardFailed        = "ibc_auto_forward_failed"
+	EventTypeBridgeUnhalted              = "bridge_unhalted"
+	EventTypeAttestationDeleted          = "attestation_deleted"
+	EventTypeValidatorEventNonceReset    = "validator_event_nonce_reset"
+	EventTypeModuleSendToEth             = "module_send_to_eth"
 
 	AttributeKeyAttestationID          = "attestation_id"
 	AttributeKeyBatchConfirmKey        = "batch_confirm_key"
@@ -28,4 +63,49 @@ const (
 	AttributeKeyInvalidationNonce      = "logic_call_invalidation_nonce"
 	AttributeKeyBadEthSignature        = "bad_eth_signature"
 	AttributeKeyBadEthSignatureSubject = "bad_eth_signature_subject"
+	AttributeKeyTokenContract          = "token_contract"
+	AttributeKeyBatchAge               = "batch_age"
+	AttributeKeyBatchRelayLatencySLA   = "batch_relay_latency_sla"
+	AttributeKeyRelayableSinceHeight   = "relayable_since_height"
+	AttributeKeySignedPower            = "signed_power"
+	AttributeKeyFeePaid                = "fee_paid"
+	AttributeKeyFeeExchanged           = "fee_exchanged"
+	AttributeKeyRelayer                = "relayer"
+	AttributeKeyRelayFees              = "relay_fees"
+	AttributeKeyRelayFeesRecipient     = "relay_fees_recipient"
+	AttributeKeyCommunityPoolFees      = "community_pool_fees"
+	AttributeKeyLogicCallSponsor       = "logic_call_sponsor"
+	AttributeKeyLogicCallDeposit       = "logic_call_deposit"
+	AttributeKeyUnjailedValidator      = "unjailed_validator"
+	AttributeKeyValidator              = "validator"
+	AttributeKeyMissedBatchSignatures  = "missed_batch_signatures"
+	AttributeKeyPenalty                = "penalty"
+	AttributeKeyUpgradeName            = "upgrade_name"
+	AttributeKeyUpgradeHeight          = "upgrade_height"
+	AttributeKeyPendingDeposits        = "pending_deposits"
+	AttributeKeyExecuteAfterHeight     = "execute_after_height"
+	AttributeKeyRecurringSendID        = "recurring_send_id"
+	AttributeKeyRemaining              = "remaining"
+	AttributeKeyRefund                 = "refund"
+	AttributeKeyReason                 = "reason"
+	AttributeKeyActivationHeight       = "activation_height"
+	AttributeKeyEthereumHeight         = "ethereum_height"
+	AttributeKeyObservedBlockHash      = "observed_block_hash"
+	AttributeKeyConflictingBlockHash   = "conflicting_block_hash"
+	AttributeKeyLimit                  = "limit"
+	AttributeKeyProposalID             = "proposal_id"
+	AttributeKeyRepooledTxIDs          = "repooled_tx_ids"
+	AttributeKeyParamKey               = "param_key"
+	AttributeKeyParamValue             = "param_value"
+	AttributeKeyGravityID              = "gravity_id"
+	AttributeKeyEthDest                = "eth_dest"
+	AttributeKeyBurnedFees             = "burned_fees"
+	AttributeKeyForeignReceiver        = "foreign_receiver"
+	AttributeKeyIbcChannel             = "ibc_channel"
+	AttributeKeyTargetNonce            = "target_nonce"
+	AttributeKeyLastObservedNonce      = "last_observed_nonce"
+	AttributeKeyAttestationsDeleted    = "attestations_deleted"
+	AttributeKeyValidatorsReset        = "validators_reset"
+	AttributeKeyVotes                  = "votes"
+	AttributeKeySenderModule           = "sender_module"
 )
@@ -4,25 +4,30 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
-// StakingKeeper defines the expected staking keeper methods
-type StakingKeeper interface {
+// ValidatorSetSource provides the validator set securing the bridge, which signs the valsets, batches and logic calls
+// and votes on the Ethereum claims, and punishes its members for misbehaving on the bridge. The local staking keeper
+// implements it, a consumer chain under interchain security can instead provide the validator set of its provider
+// chain obtained through ccv. A source without local staking returns an empty ValidatorQueueIterator and no
+// delegations
+type ValidatorSetSource interface {
 	GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator
 	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64
 	GetLastTotalPower(ctx sdk.Context) (power sdk.Int)
-	IterateValidators(sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool))
-	ValidatorQueueIterator(ctx sdk.Context, endTime time.Time, endHeight int64) sdk.Iterator
-	GetParams(ctx sdk.Context) stakingtypes.Params
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
-	IterateBondedValidatorsByPower(sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool))
-	IterateLastValidators(sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool))
 	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI
-	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
+	UnbondingTime(ctx sdk.Context) time.Duration
+	ValidatorQueueIterator(ctx sdk.Context, endTime time.Time, endHeight int64) sdk.Iterator
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
 	Jail(sdk.Context, sdk.ConsAddress)
 }
@@ -44,6 +49,82 @@ type SlashingKeeper interface {
 
 type DistributionKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 	GetFeePool(ctx sdk.Context) (feePool types.FeePool)
 	SetFeePool(ctx sdk.Context, feePool types.FeePool)
 }
+
+// ScreeningKeeper screens the transfers sent to Ethereum before they enter the outgoing pool, a deployment
+// can wire it to a compliance module or contract to veto transfers by returning an error
+type ScreeningKeeper interface {
+	ScreenSendToEth(ctx sdk.Context, sender sdk.AccAddress, receiver EthAddress, amount sdk.Coin) error
+}
+
+// ModuleVersionSource provides the consensus versions of the modules in state, the upgrade keeper implements it
+type ModuleVersionSource interface {
+	GetModuleVersionMap(ctx sdk.Context) module.VersionMap
+}
+
+// DenomTraceSource resolves the hash of an IBC voucher denom to its trace, the IBC transfer keeper implements it
+type DenomTraceSource interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool)
+}
+
+// IbcTransferKeeper sends the deposits forwarded to other chains over IBC, the IBC transfer keeper implements it
+type IbcTransferKeeper interface {
+	SendTransfer(
+		ctx sdk.Context,
+		sourcePort, sourceChannel string,
+		token sdk.Coin,
+		sender sdk.AccAddress,
+		receiver string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+	) error
+}
+
+// BridgeHooks is notified of the coins the bridge moved for an account, an incentive program can be wired to it to
+// reward bridge usage as it happens
+type BridgeHooks interface {
+	// AfterWithdrawalExecuted is called for every transfer of a batch executed on Ethereum, amount excludes the fees
+	AfterWithdrawalExecuted(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin)
+	// AfterDepositCredited is called for the deposits credited to an account, but not for the held deposits
+	AfterDepositCredited(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coins)
+}
+
+// AttestationHooks are notified of the attestations applied to the state, strictly in event nonce order even when
+// the attestations were observed out of order
+type AttestationHooks interface {
+	// AfterAttestationApplied is called once an observed claim was handled, failed is set if its handler failed
+	// and its state changes were discarded
+	AfterAttestationApplied(ctx sdk.Context, claim EthereumClaim, failed bool)
+}
+
+// NoopScreeningKeeper is the default ScreeningKeeper, it accepts every transfer
+type NoopScreeningKeeper struct{}
+
+var _ ScreeningKeeper = NoopScreeningKeeper{}
+
+// ScreenSendToEth accepts the transfer
+func (NoopScreeningKeeper) ScreenSendToEth(sdk.Context, sdk.AccAddress, EthAddress, sdk.Coin) error {
+	return nil
+}
+
+// NoopBridgeHooks are the default BridgeHooks, they ignore the bridged coins
+type NoopBridgeHooks struct{}
+
+var _ BridgeHooks = NoopBridgeHooks{}
+
+// AfterWithdrawalExecuted ignores the withdrawal
+func (NoopBridgeHooks) AfterWithdrawalExecuted(sdk.Context, sdk.AccAddress, sdk.Coin) {}
+
+// AfterDepositCredited ignores the deposit
+func (NoopBridgeHooks) AfterDepositCredited(sdk.Context, sdk.AccAddress, sdk.Coins) {}
+
+// NoopAttestationHooks are the default AttestationHooks, they ignore the applied attestations
+type NoopAttestationHooks struct{}
+
+var _ AttestationHooks = NoopAttestationHooks{}
+
+// AfterAttestationApplied ignores the attestation
+func (NoopAttestationHooks) AfterAttestationApplied(sdk.Context, EthereumClaim, bool) {}
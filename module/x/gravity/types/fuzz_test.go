@@ -0,0 +1,150 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// fuzzedMsg is a gravity message decoded from fuzzed protobuf bytes
+type fuzzedMsg interface {
+	codec.ProtoMarshaler
+	sdk.Msg
+}
+
+// fuzzedMsgs builds an empty message of every gravity Msg type, indexed by the fuzzed selector
+var fuzzedMsgs = []func() fuzzedMsg{
+	func() fuzzedMsg { return &MsgValsetConfirm{} },
+	func() fuzzedMsg { return &MsgSendToEth{} },
+	func() fuzzedMsg { return &MsgRequestBatch{} },
+	func() fuzzedMsg { return &MsgConfirmBatch{} },
+	func() fuzzedMsg { return &MsgConfirmLogicCall{} },
+	func() fuzzedMsg { return &MsgSendToCosmosClaim{} },
+	func() fuzzedMsg { return &MsgBatchSendToEthClaim{} },
+	func() fuzzedMsg { return &MsgERC20DeployedClaim{} },
+	func() fuzzedMsg { return &MsgSetOrchestratorAddress{} },
+	func() fuzzedMsg { return &MsgLogicCallExecutedClaim{} },
+	func() fuzzedMsg { return &MsgValsetUpdatedClaim{} },
+	func() fuzzedMsg { return &MsgCancelSendToEth{} },
+	func() fuzzedMsg { return &MsgSubmitBadSignatureEvidence{} },
+	func() fuzzedMsg { return &MsgUnjailValidator{} },
+	func() fuzzedMsg { return &MsgCreateRecurringSendToEth{} },
+	func() fuzzedMsg { return &MsgCancelRecurringSendToEth{} },
+	func() fuzzedMsg { return &MsgForkDetectedClaim{} },
+	func() fuzzedMsg { return &MsgSetSelfBridgeLimit{} },
+	func() fuzzedMsg { return &MsgSubmitGravityProposal{} },
+	func() fuzzedMsg { return &MsgExecuteIbcAutoForwards{} },
+}
+
+// fuzzSeedMsgs are valid messages the fuzzer starts mutating from, next to the empty message of every type
+func fuzzSeedMsgs() []fuzzedMsg {
+	var (
+		sender   = "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn"
+		ethAddr  = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+		contract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	return []fuzzedMsg{
+		&MsgSendToEth{
+			Sender:    sender,
+			EthDest:   ethAddr,
+			Amount:    sdk.NewInt64Coin("stake", 100),
+			BridgeFee: sdk.NewInt64Coin("stake", 1),
+		},
+		&MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    10,
+			TokenContract:  contract,
+			Amount:         sdk.NewInt(100),
+			EthereumSender: ethAddr,
+			CosmosReceiver: sender,
+			Orchestrator:   sender,
+		},
+		&MsgBatchSendToEthClaim{
+			EventNonce:    1,
+			BlockHeight:   10,
+			BatchNonce:    1,
+			TokenContract: contract,
+			Orchestrator:  sender,
+		},
+		&MsgERC20DeployedClaim{
+			EventNonce:    1,
+			BlockHeight:   10,
+			CosmosDenom:   "stake",
+			TokenContract: contract,
+			Name:          "stake",
+			Symbol:        "STK",
+			Decimals:      6,
+			Orchestrator:  sender,
+		},
+		&MsgValsetUpdatedClaim{
+			EventNonce:   1,
+			ValsetNonce:  1,
+			BlockHeight:  10,
+			Members:      []BridgeValidator{{Power: 100, EthereumAddress: ethAddr}},
+			RewardAmount: sdk.ZeroInt(),
+			Orchestrator: sender,
+		},
+	}
+}
+
+// FuzzMsgValidateBasic decodes fuzzed bytes into every gravity message and checks that ValidateBasic rejects
+// malformed addresses, amounts and strings with an error rather than a panic, and that a message it accepts has
+// signers and, for a claim, a hash
+func FuzzMsgValidateBasic(f *testing.F) {
+	for i, newMsg := range fuzzedMsgs {
+		bz, err := newMsg().Marshal()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(uint8(i), bz)
+	}
+	for _, seed := range fuzzSeedMsgs() {
+		bz, err := seed.Marshal()
+		if err != nil {
+			f.Fatal(err)
+		}
+		for i, newMsg := range fuzzedMsgs {
+			if sdk.MsgTypeURL(newMsg()) == sdk.MsgTypeURL(seed) {
+				f.Add(uint8(i), bz)
+			}
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, selector uint8, bz []byte) {
+		msg := fuzzedMsgs[int(selector)%len(fuzzedMsgs)]()
+		if err := msg.Unmarshal(bz); err != nil {
+			return
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+		if len(msg.GetSigners()) == 0 {
+			t.Fatalf("%T passed ValidateBasic without signers", msg)
+		}
+		if claim, ok := msg.(EthereumClaim); ok {
+			if _, err := claim.ClaimHash(ClaimEncodingVersion); err != nil {
+				t.Fatalf("%T passed ValidateBasic without a hash: %v", msg, err)
+			}
+		}
+	})
+}
+
+// FuzzGenesisValidateBasic decodes fuzzed bytes into a genesis state and checks that its validation does not panic
+func FuzzGenesisValidateBasic(f *testing.F) {
+	defaultGenesis := DefaultGenesisState()
+	bz, err := defaultGenesis.Marshal()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(bz)
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		var genesis GenesisState
+		if err := genesis.Unmarshal(bz); err != nil {
+			return
+		}
+		_ = genesis.ValidateBasic()
+	})
+}
@@ -9,6 +9,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+
+	"github.com/onomyprotocol/arc/module/eth/chain"
 )
 
 // DefaultParamspace defines the default auth module parameter subspace
@@ -18,9 +21,16 @@ const (
 )
 
 var (
+	// DefaultGravityID is the gravity id of the default params unless the port configured its chain
+	DefaultGravityID = "defaultgravityid"
+
 	// AttestationVotesPowerThreshold threshold of votes power to succeed
 	AttestationVotesPowerThreshold = sdk.NewInt(66)
 
+	// EthereumSignaturePowerThreshold is the normalized power, out of 2^32, that the Gravity
+	// contract requires before it will accept a signed valset update, batch or logic call
+	EthereumSignaturePowerThreshold uint64 = 2863311530
+
 	// ParamsStoreKeyGravityID stores the gravity id
 	ParamsStoreKeyGravityID = []byte("GravityID")
 
@@ -82,6 +92,126 @@ var (
 	// this could be for technical reasons (zero address) or non-technical reasons, these apply across all ERC20 tokens
 	ParamStoreEthereumBlacklist = []byte("EthereumBlacklist")
 
+	// ParamStoreBatchRelayLatencySLA stores the number of blocks a fully signed batch may wait for a relayer
+	// before a warning event is emitted
+	ParamStoreBatchRelayLatencySLA = []byte("BatchRelayLatencySLA")
+
+	// ParamStoreBridgeFeeExchangeRates stores the denoms a bridge fee may be paid in other than the denom being sent
+	// and the fixed rate at which they are exchanged with the community pool
+	ParamStoreBridgeFeeExchangeRates = []byte("BridgeFeeExchangeRates")
+
+	// ParamStoreMaxOutgoingBatchesPerToken stores the number of unexecuted batches a token may have before
+	// no new batch is created for it
+	ParamStoreMaxOutgoingBatchesPerToken = []byte("MaxOutgoingBatchesPerToken")
+
+	// ParamStoreValsetRequestSlashPowerThreshold stores the share of the latest valset power a slashed validator
+	// must hold to request a new valset in the same block
+	ParamStoreValsetRequestSlashPowerThreshold = []byte("ValsetRequestSlashPowerThreshold")
+
+	// ParamStoreDowntimeOverlapPolicy stores whether validators offline for consensus are also slashed for the
+	// bridge signatures they missed in the same window
+	ParamStoreDowntimeOverlapPolicy = []byte("DowntimeOverlapPolicy")
+
+	// ParamStoreEscalateBatchSigningPenalties stores whether missed batch signatures are penalised progressively
+	ParamStoreEscalateBatchSigningPenalties = []byte("EscalateBatchSigningPenalties")
+
+	// ParamStoreUpgradeGuardDepositThresholds stores the amount from which a deposit of a token is high value
+	ParamStoreUpgradeGuardDepositThresholds = []byte("UpgradeGuardDepositThresholds")
+
+	// ParamStoreUpgradeGuardBlocksUpgrades stores whether software upgrades fail to be scheduled while high value
+	// deposits are pending
+	ParamStoreUpgradeGuardBlocksUpgrades = []byte("UpgradeGuardBlocksUpgrades")
+
+	// ParamStoreAttestationCatchUpLag stores the event nonce lag from which attestations are processed in catch up mode
+	ParamStoreAttestationCatchUpLag = []byte("AttestationCatchUpLag")
+
+	// ParamStoreRelayerFeeShare stores the share of the relay fees of a batch paid to its relayer
+	ParamStoreRelayerFeeShare = []byte("RelayerFeeShare")
+
+	// ParamStoreSyntheticDelegationModules stores the module accounts whose delegations are excluded from valset power
+	ParamStoreSyntheticDelegationModules = []byte("SyntheticDelegationModules")
+
+	// ParamStoreMaxValsetPowerShare stores the largest share of the valset power a single validator may hold
+	ParamStoreMaxValsetPowerShare = []byte("MaxValsetPowerShare")
+
+	// ParamStoreMinBridgeValidators stores the number of registered validators required to activate the bridge
+	ParamStoreMinBridgeValidators = []byte("MinBridgeValidators")
+
+	// ParamStoreClaimHashVersion stores the version of the claim encoding attestations are keyed with
+	ParamStoreClaimHashVersion = []byte("ClaimHashVersion")
+
+	// ParamStoreClaimHashVersionEthereumHeight stores the Ethereum height from which claims use the claim hash version
+	ParamStoreClaimHashVersionEthereumHeight = []byte("ClaimHashVersionEthereumHeight")
+
+	// ParamStoreDepositPausedTokens stores the ERC20 contracts whose observed deposits are held
+	ParamStoreDepositPausedTokens = []byte("DepositPausedTokens")
+
+	// ParamStoreWithdrawalPausedTokens stores the ERC20 contracts whose withdrawals are rejected
+	ParamStoreWithdrawalPausedTokens = []byte("WithdrawalPausedTokens")
+
+	// ParamStoreInvalidReceiverPolicy stores what happens to the deposits whose receiver can not receive them
+	ParamStoreInvalidReceiverPolicy = []byte("InvalidReceiverPolicy")
+
+	// ParamStoreExpeditedVotingPeriod stores the seconds after which bridge emergency proposals are tallied early
+	ParamStoreExpeditedVotingPeriod = []byte("ExpeditedVotingPeriod")
+
+	// ParamStoreExpeditedQuorum stores the quorum a bridge emergency proposal must reach to pass early
+	ParamStoreExpeditedQuorum = []byte("ExpeditedQuorum")
+
+	// ParamStoreExecutedBatchRetention stores the blocks executed batches are kept for before they are archived
+	ParamStoreExecutedBatchRetention = []byte("ExecutedBatchRetention")
+
+	// ParamStoreWethContract stores the WETH contract native ETH deposits are wrapped into
+	ParamStoreWethContract = []byte("WethContract")
+
+	// ParamStoreTokenSupplyCaps stores the maximum outstanding vouchers of the capped tokens
+	ParamStoreTokenSupplyCaps = []byte("TokenSupplyCaps")
+
+	// ParamStoreSupplyCapPolicy stores what happens to the deposits exceeding a supply cap
+	ParamStoreSupplyCapPolicy = []byte("SupplyCapPolicy")
+
+	// ParamStoreCriticalParamChangeDelay stores the blocks a passed change of a critical param waits before it applies
+	ParamStoreCriticalParamChangeDelay = []byte("CriticalParamChangeDelay")
+
+	// ParamStoreBlockModuleAccountReceivers stores whether deposits to module accounts are handled as invalid receivers
+	ParamStoreBlockModuleAccountReceivers = []byte("BlockModuleAccountReceivers")
+
+	// ParamStoreAllowedReceiverModules stores the modules whose accounts still receive deposits
+	ParamStoreAllowedReceiverModules = []byte("AllowedReceiverModules")
+
+	// ParamStoreContractReceivers stores the Ethereum contracts known to lose the ERC20 transfers sent to them
+	ParamStoreContractReceivers = []byte("ContractReceivers")
+
+	// ParamStoreContractReceiverPolicy stores what happens to the withdrawals to the contract receivers
+	ParamStoreContractReceiverPolicy = []byte("ContractReceiverPolicy")
+
+	// ParamStoreFeeBurnShare stores the share of the collected bridge fees which is burned
+	ParamStoreFeeBurnShare = []byte("FeeBurnShare")
+
+	// ParamStoreFeeBurnDenoms stores the denoms of the collected bridge fees which are partly burned
+	ParamStoreFeeBurnDenoms = []byte("FeeBurnDenoms")
+
+	// ParamStoreUsageEpochLength stores the blocks of an epoch of the bridge usage
+	ParamStoreUsageEpochLength = []byte("UsageEpochLength")
+
+	// ParamStoreUsageEpochsRetained stores the epochs of bridge usage kept in the state
+	ParamStoreUsageEpochsRetained = []byte("UsageEpochsRetained")
+
+	// ParamStoreIbcAutoForwardChannels stores the IBC channels the deposits to other chains are forwarded over
+	ParamStoreIbcAutoForwardChannels = []byte("IbcAutoForwardChannels")
+
+	// ParamStoreIbcAutoForwardTimeout stores the seconds after which a forwarded deposit times out
+	ParamStoreIbcAutoForwardTimeout = []byte("IbcAutoForwardTimeout")
+
+	// ParamStoreLogicCallMaxGasLimit stores the highest gas limit a logic call can be scheduled with
+	ParamStoreLogicCallMaxGasLimit = []byte("LogicCallMaxGasLimit")
+
+	// ParamStoreLogicCallMaxPayloadSize stores the size of the largest payload a logic call can be scheduled with
+	ParamStoreLogicCallMaxPayloadSize = []byte("LogicCallMaxPayloadSize")
+
+	// ParamStoreMinChainFeeBasisPoints stores the smallest chain fee of a MsgSendToEth in basis points of its amount
+	ParamStoreMinChainFeeBasisPoints = []byte("MinChainFeeBasisPoints")
+
 	// ParamStoreErc20ToDenomPermanentSwap the key of Erc20ToDenomPair for store.
 	ParamStoreErc20ToDenomPermanentSwap = []byte("Erc20ToDenomPermanentSwap")
 
@@ -106,61 +236,202 @@ var (
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
-		BridgeActive:      true,
-		EthereumBlacklist: []string{},
-		Erc20ToDenomPermanentSwap: ERC20ToDenom{},
+		BridgeActive:                     true,
+		EthereumBlacklist:                []string{},
+		BatchRelayLatencySla:             0,
+		BridgeFeeExchangeRates:           []BridgeFeeExchangeRate{},
+		MaxOutgoingBatchesPerToken:       0,
+		ValsetRequestSlashPowerThreshold: sdk.Dec{},
+		DowntimeOverlapPolicy:            DOWNTIME_OVERLAP_POLICY_SLASH_BOTH,
+		EscalateBatchSigningPenalties:    false,
+		UpgradeGuardDepositThresholds:    []ERC20Token{},
+		UpgradeGuardBlocksUpgrades:       false,
+		AttestationCatchUpLag:            0,
+		RelayerFeeShare:                  sdk.Dec{},
+		SyntheticDelegationModules:       []string{},
+		MaxValsetPowerShare:              sdk.Dec{},
+		MinBridgeValidators:              0,
+		ClaimHashVersion:                 0,
+		ClaimHashVersionEthereumHeight:   0,
+		DepositPausedTokens:              []string{},
+		WithdrawalPausedTokens:           []string{},
+		InvalidReceiverPolicy:            INVALID_RECEIVER_POLICY_COMMUNITY_POOL,
+		ExpeditedVotingPeriod:            0,
+		ExpeditedQuorum:                  sdk.Dec{},
+		ExecutedBatchRetention:           0,
+		WethContract:                     "",
+		TokenSupplyCaps:                  []ERC20Token{},
+		SupplyCapPolicy:                  SUPPLY_CAP_POLICY_HOLD,
+		CriticalParamChangeDelay:         0,
+		BlockModuleAccountReceivers:      false,
+		AllowedReceiverModules:           []string{},
+		ContractReceivers:                []string{},
+		ContractReceiverPolicy:           CONTRACT_RECEIVER_POLICY_ALLOW,
+		FeeBurnShare:                     sdk.Dec{},
+		FeeBurnDenoms:                    []string{},
+		UsageEpochLength:                 0,
+		UsageEpochsRetained:              0,
+		IbcAutoForwardChannels:           []IbcAutoForwardChannel{},
+		IbcAutoForwardTimeout:            0,
+		LogicCallMaxGasLimit:             0,
+		LogicCallMaxPayloadSize:          0,
+		MinChainFeeBasisPoints:           0,
+		Erc20ToDenomPermanentSwap:        ERC20ToDenom{},
 	}
 )
 
 // ValidateBasic validates genesis state by looping through the params and
 // calling their validation functions
 func (s GenesisState) ValidateBasic() error {
+	if s.Params == nil {
+		return sdkerrors.Wrap(ErrEmpty, "params")
+	}
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	if s.BridgeBinding != nil {
+		if err := ValidateEthAddress(s.BridgeBinding.BridgeEthereumAddress); err != nil {
+			return sdkerrors.Wrap(err, "bridge binding")
+		}
+	}
+	for _, provenance := range s.Erc20Provenances {
+		if err := ValidateEthAddress(provenance.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "erc20 provenance")
+		}
+	}
+	if err := s.BurnedFees.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "burned fees")
+	}
+	for _, forward := range s.PendingIbcAutoForwards {
+		if _, err := IBCAddressFromBech32(forward.ForeignReceiver); err != nil {
+			return sdkerrors.Wrap(err, "pending ibc auto forward")
+		}
+		if err := forward.Token.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "pending ibc auto forward")
+		}
+		if err := host.ChannelIdentifierValidator(forward.IbcChannel); err != nil {
+			return sdkerrors.Wrap(err, "pending ibc auto forward")
+		}
+	}
+	for _, relayPackage := range s.ValsetRelayPackages {
+		for _, signature := range relayPackage.Signatures {
+			if err := ValidateEthAddress(signature.EthereumAddress); err != nil {
+				return sdkerrors.Wrap(err, "valset relay package")
+			}
+		}
+	}
+	for _, usage := range s.BridgeUsage {
+		if _, err := sdk.AccAddressFromBech32(usage.Address); err != nil {
+			return sdkerrors.Wrap(err, "bridge usage")
+		}
+		if err := usage.Sent.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "bridge usage")
+		}
+		if err := usage.Received.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "bridge usage")
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns empty genesis state
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:             DefaultParams(),
-		GravityNonces:      GravityNonces{},
-		Valsets:            []Valset{},
-		ValsetConfirms:     []MsgValsetConfirm{},
-		Batches:            []OutgoingTxBatch{},
-		BatchConfirms:      []MsgConfirmBatch{},
-		LogicCalls:         []OutgoingLogicCall{},
-		LogicCallConfirms:  []MsgConfirmLogicCall{},
-		Attestations:       []Attestation{},
-		DelegateKeys:       []MsgSetOrchestratorAddress{},
-		Erc20ToDenoms:      []ERC20ToDenom{},
-		UnbatchedTransfers: []OutgoingTransferTx{},
+		Params:              DefaultParams(),
+		GravityNonces:       GravityNonces{},
+		Valsets:             []Valset{},
+		ValsetConfirms:      []MsgValsetConfirm{},
+		Batches:             []OutgoingTxBatch{},
+		BatchConfirms:       []MsgConfirmBatch{},
+		LogicCalls:          []OutgoingLogicCall{},
+		LogicCallConfirms:   []MsgConfirmLogicCall{},
+		Attestations:        []Attestation{},
+		DelegateKeys:        []MsgSetOrchestratorAddress{},
+		Erc20ToDenoms:       []ERC20ToDenom{},
+		UnbatchedTransfers:  []OutgoingTransferTx{},
+		LogicCallDeposits:   []LogicCallDeposit{},
+		ScheduledTransfers:  []ScheduledOutgoingTransferTx{},
+		RecurringSends:      []RecurringSendToEth{},
+		HeldDeposits:        []HeldDeposit{},
+		ForkAttestations:    []ForkAttestation{},
+		ObservedBlockHashes: []ObservedBlockHash{},
+		SelfBridgeLimits:    []SelfBridgeLimit{},
+		ProposalMetadata:    []GravityProposalMetadata{},
+		ExecutedBatches:     []ExecutedBatch{},
+		ArchivedBatches:     []ExecutedBatch{},
+		PendingParamChanges: []PendingParamChange{},
+		Erc20Provenances:    []ERC20Provenance{},
+	}
+}
+
+// defaultGravityID returns the gravity id of the chain of the port, or DefaultGravityID before it is configured
+func defaultGravityID() string {
+	if id := chain.Current().GravityID; id != "" {
+		return id
 	}
+	return DefaultGravityID
 }
 
 // DefaultParams returns a copy of the default params
 func DefaultParams() *Params {
 	return &Params{
-		GravityId:                    "defaultgravityid",
-		ContractSourceHash:           "",
-		BridgeEthereumAddress:        "0x0000000000000000000000000000000000000000",
-		BridgeChainId:                0,
-		SignedValsetsWindow:          10000,
-		SignedBatchesWindow:          10000,
-		SignedLogicCallsWindow:       10000,
-		TargetBatchTimeout:           43200000,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBatch:           sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionLogicCall:       sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		UnbondSlashingValsetsWindow:  10000,
-		SlashFractionBadEthSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
-		BridgeActive:                 true,
-		EthereumBlacklist:            []string{},
-		Erc20ToDenomPermanentSwap:    ERC20ToDenom{},
+		GravityId:                        defaultGravityID(),
+		ContractSourceHash:               "",
+		BridgeEthereumAddress:            "0x0000000000000000000000000000000000000000",
+		BridgeChainId:                    0,
+		SignedValsetsWindow:              10000,
+		SignedBatchesWindow:              10000,
+		SignedLogicCallsWindow:           10000,
+		TargetBatchTimeout:               43200000,
+		AverageBlockTime:                 5000,
+		AverageEthereumBlockTime:         15000,
+		SlashFractionValset:              sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionBatch:               sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionLogicCall:           sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		UnbondSlashingValsetsWindow:      10000,
+		SlashFractionBadEthSignature:     sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		ValsetReward:                     sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		BridgeActive:                     true,
+		EthereumBlacklist:                []string{},
+		BatchRelayLatencySla:             720,
+		BridgeFeeExchangeRates:           []BridgeFeeExchangeRate{},
+		MaxOutgoingBatchesPerToken:       0,
+		ValsetRequestSlashPowerThreshold: sdk.NewDecWithPrec(5, 2),
+		DowntimeOverlapPolicy:            DOWNTIME_OVERLAP_POLICY_SLASH_BOTH,
+		EscalateBatchSigningPenalties:    false,
+		UpgradeGuardDepositThresholds:    []ERC20Token{},
+		UpgradeGuardBlocksUpgrades:       false,
+		AttestationCatchUpLag:            1000,
+		RelayerFeeShare:                  sdk.OneDec(),
+		SyntheticDelegationModules:       []string{},
+		MaxValsetPowerShare:              sdk.OneDec(),
+		MinBridgeValidators:              0,
+		ClaimHashVersion:                 ClaimEncodingVersion,
+		ClaimHashVersionEthereumHeight:   0,
+		DepositPausedTokens:              []string{},
+		WithdrawalPausedTokens:           []string{},
+		InvalidReceiverPolicy:            INVALID_RECEIVER_POLICY_COMMUNITY_POOL,
+		ExpeditedVotingPeriod:            86400,
+		ExpeditedQuorum:                  sdk.NewDecWithPrec(5, 1),
+		ExecutedBatchRetention:           14400,
+		WethContract:                     "",
+		TokenSupplyCaps:                  []ERC20Token{},
+		SupplyCapPolicy:                  SUPPLY_CAP_POLICY_HOLD,
+		CriticalParamChangeDelay:         14400,
+		BlockModuleAccountReceivers:      true,
+		AllowedReceiverModules:           []string{},
+		ContractReceivers:                []string{},
+		ContractReceiverPolicy:           CONTRACT_RECEIVER_POLICY_ALLOW,
+		FeeBurnShare:                     sdk.ZeroDec(),
+		FeeBurnDenoms:                    []string{},
+		UsageEpochLength:                 0,
+		UsageEpochsRetained:              4,
+		IbcAutoForwardChannels:           []IbcAutoForwardChannel{},
+		IbcAutoForwardTimeout:            86400,
+		LogicCallMaxGasLimit:             15_000_000,
+		LogicCallMaxPayloadSize:          65536,
+		MinChainFeeBasisPoints:           0,
+		Erc20ToDenomPermanentSwap:        ERC20ToDenom{},
 	}
 }
 
@@ -214,6 +485,120 @@ func (p Params) ValidateBasic() error {
 	if err := validateValsetRewardAmount(p.ValsetReward); err != nil {
 		return sdkerrors.Wrap(err, "ValsetReward amount")
 	}
+	if err := validateBatchRelayLatencySLA(p.BatchRelayLatencySla); err != nil {
+		return sdkerrors.Wrap(err, "batch relay latency sla")
+	}
+	if err := validateBridgeFeeExchangeRates(p.BridgeFeeExchangeRates); err != nil {
+		return sdkerrors.Wrap(err, "bridge fee exchange rates")
+	}
+	if err := validateMaxOutgoingBatchesPerToken(p.MaxOutgoingBatchesPerToken); err != nil {
+		return sdkerrors.Wrap(err, "max outgoing batches per token")
+	}
+	if err := validateValsetRequestSlashPowerThreshold(p.ValsetRequestSlashPowerThreshold); err != nil {
+		return sdkerrors.Wrap(err, "valset request slash power threshold")
+	}
+	if err := validateDowntimeOverlapPolicy(p.DowntimeOverlapPolicy); err != nil {
+		return sdkerrors.Wrap(err, "downtime overlap policy")
+	}
+	if err := validateEscalateBatchSigningPenalties(p.EscalateBatchSigningPenalties); err != nil {
+		return sdkerrors.Wrap(err, "escalate batch signing penalties")
+	}
+	if err := validateUpgradeGuardDepositThresholds(p.UpgradeGuardDepositThresholds); err != nil {
+		return sdkerrors.Wrap(err, "upgrade guard deposit thresholds")
+	}
+	if err := validateUpgradeGuardBlocksUpgrades(p.UpgradeGuardBlocksUpgrades); err != nil {
+		return sdkerrors.Wrap(err, "upgrade guard blocks upgrades")
+	}
+	if err := validateAttestationCatchUpLag(p.AttestationCatchUpLag); err != nil {
+		return sdkerrors.Wrap(err, "attestation catch up lag")
+	}
+	if err := validateRelayerFeeShare(p.RelayerFeeShare); err != nil {
+		return sdkerrors.Wrap(err, "relayer fee share")
+	}
+	if err := validateSyntheticDelegationModules(p.SyntheticDelegationModules); err != nil {
+		return sdkerrors.Wrap(err, "synthetic delegation modules")
+	}
+	if err := validateMaxValsetPowerShare(p.MaxValsetPowerShare); err != nil {
+		return sdkerrors.Wrap(err, "max valset power share")
+	}
+	if err := validateMinBridgeValidators(p.MinBridgeValidators); err != nil {
+		return sdkerrors.Wrap(err, "min bridge validators")
+	}
+	if err := validateClaimHashVersion(p.ClaimHashVersion); err != nil {
+		return sdkerrors.Wrap(err, "claim hash version")
+	}
+	if err := validateClaimHashVersionEthereumHeight(p.ClaimHashVersionEthereumHeight); err != nil {
+		return sdkerrors.Wrap(err, "claim hash version ethereum height")
+	}
+	if err := validatePausedTokens(p.DepositPausedTokens); err != nil {
+		return sdkerrors.Wrap(err, "deposit paused tokens")
+	}
+	if err := validatePausedTokens(p.WithdrawalPausedTokens); err != nil {
+		return sdkerrors.Wrap(err, "withdrawal paused tokens")
+	}
+	if err := validateInvalidReceiverPolicy(p.InvalidReceiverPolicy); err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver policy")
+	}
+	if err := validateExpeditedVotingPeriod(p.ExpeditedVotingPeriod); err != nil {
+		return sdkerrors.Wrap(err, "expedited voting period")
+	}
+	if err := validateExpeditedQuorum(p.ExpeditedQuorum); err != nil {
+		return sdkerrors.Wrap(err, "expedited quorum")
+	}
+	if err := validateExecutedBatchRetention(p.ExecutedBatchRetention); err != nil {
+		return sdkerrors.Wrap(err, "executed batch retention")
+	}
+	if err := validateWethContract(p.WethContract); err != nil {
+		return sdkerrors.Wrap(err, "weth contract")
+	}
+	if err := validateTokenSupplyCaps(p.TokenSupplyCaps); err != nil {
+		return sdkerrors.Wrap(err, "token supply caps")
+	}
+	if err := validateSupplyCapPolicy(p.SupplyCapPolicy); err != nil {
+		return sdkerrors.Wrap(err, "supply cap policy")
+	}
+	if err := validateCriticalParamChangeDelay(p.CriticalParamChangeDelay); err != nil {
+		return sdkerrors.Wrap(err, "critical param change delay")
+	}
+	if err := validateBlockModuleAccountReceivers(p.BlockModuleAccountReceivers); err != nil {
+		return sdkerrors.Wrap(err, "block module account receivers")
+	}
+	if err := validateAllowedReceiverModules(p.AllowedReceiverModules); err != nil {
+		return sdkerrors.Wrap(err, "allowed receiver modules")
+	}
+	if err := validateContractReceivers(p.ContractReceivers); err != nil {
+		return sdkerrors.Wrap(err, "contract receivers")
+	}
+	if err := validateContractReceiverPolicy(p.ContractReceiverPolicy); err != nil {
+		return sdkerrors.Wrap(err, "contract receiver policy")
+	}
+	if err := validateFeeBurnShare(p.FeeBurnShare); err != nil {
+		return sdkerrors.Wrap(err, "fee burn share")
+	}
+	if err := validateFeeBurnDenoms(p.FeeBurnDenoms); err != nil {
+		return sdkerrors.Wrap(err, "fee burn denoms")
+	}
+	if err := validateUsageEpochLength(p.UsageEpochLength); err != nil {
+		return sdkerrors.Wrap(err, "usage epoch length")
+	}
+	if err := validateUsageEpochsRetained(p.UsageEpochsRetained); err != nil {
+		return sdkerrors.Wrap(err, "usage epochs retained")
+	}
+	if err := validateIbcAutoForwardChannels(p.IbcAutoForwardChannels); err != nil {
+		return sdkerrors.Wrap(err, "ibc auto forward channels")
+	}
+	if err := validateIbcAutoForwardTimeout(p.IbcAutoForwardTimeout); err != nil {
+		return sdkerrors.Wrap(err, "ibc auto forward timeout")
+	}
+	if err := validateLogicCallMaxGasLimit(p.LogicCallMaxGasLimit); err != nil {
+		return sdkerrors.Wrap(err, "logic call max gas limit")
+	}
+	if err := validateLogicCallMaxPayloadSize(p.LogicCallMaxPayloadSize); err != nil {
+		return sdkerrors.Wrap(err, "logic call max payload size")
+	}
+	if err := validateMinChainFeeBasisPoints(p.MinChainFeeBasisPoints); err != nil {
+		return sdkerrors.Wrap(err, "min chain fee basis points")
+	}
 	if err := validateErc20ToDenomPermanentSwap(p.Erc20ToDenomPermanentSwap); err != nil {
 		return sdkerrors.Wrap(err, "Erc20ToDenomPermanentSwap")
 	}
@@ -242,7 +627,45 @@ func ParamKeyTable() paramtypes.KeyTable {
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
-		Erc20ToDenomPermanentSwap: ERC20ToDenom{},
+		BatchRelayLatencySla:             0,
+		BridgeFeeExchangeRates:           []BridgeFeeExchangeRate{},
+		MaxOutgoingBatchesPerToken:       0,
+		ValsetRequestSlashPowerThreshold: sdk.Dec{},
+		DowntimeOverlapPolicy:            DOWNTIME_OVERLAP_POLICY_SLASH_BOTH,
+		EscalateBatchSigningPenalties:    false,
+		UpgradeGuardDepositThresholds:    []ERC20Token{},
+		UpgradeGuardBlocksUpgrades:       false,
+		AttestationCatchUpLag:            0,
+		RelayerFeeShare:                  sdk.Dec{},
+		SyntheticDelegationModules:       []string{},
+		MaxValsetPowerShare:              sdk.Dec{},
+		MinBridgeValidators:              0,
+		ClaimHashVersion:                 0,
+		ClaimHashVersionEthereumHeight:   0,
+		DepositPausedTokens:              []string{},
+		WithdrawalPausedTokens:           []string{},
+		InvalidReceiverPolicy:            INVALID_RECEIVER_POLICY_COMMUNITY_POOL,
+		ExpeditedVotingPeriod:            0,
+		ExpeditedQuorum:                  sdk.Dec{},
+		ExecutedBatchRetention:           0,
+		WethContract:                     "",
+		TokenSupplyCaps:                  []ERC20Token{},
+		SupplyCapPolicy:                  SUPPLY_CAP_POLICY_HOLD,
+		CriticalParamChangeDelay:         0,
+		BlockModuleAccountReceivers:      false,
+		AllowedReceiverModules:           []string{},
+		ContractReceivers:                []string{},
+		ContractReceiverPolicy:           CONTRACT_RECEIVER_POLICY_ALLOW,
+		FeeBurnShare:                     sdk.Dec{},
+		FeeBurnDenoms:                    []string{},
+		UsageEpochLength:                 0,
+		UsageEpochsRetained:              0,
+		IbcAutoForwardChannels:           []IbcAutoForwardChannel{},
+		IbcAutoForwardTimeout:            0,
+		LogicCallMaxGasLimit:             0,
+		LogicCallMaxPayloadSize:          0,
+		MinChainFeeBasisPoints:           0,
+		Erc20ToDenomPermanentSwap:        ERC20ToDenom{},
 	})
 }
 
@@ -267,6 +690,44 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreValsetRewardAmount, &p.ValsetReward, validateValsetRewardAmount),
 		paramtypes.NewParamSetPair(ParamStoreBridgeActive, &p.BridgeActive, validateBridgeActive),
 		paramtypes.NewParamSetPair(ParamStoreEthereumBlacklist, &p.EthereumBlacklist, validateEthereumBlacklistAddresses),
+		paramtypes.NewParamSetPair(ParamStoreBatchRelayLatencySLA, &p.BatchRelayLatencySla, validateBatchRelayLatencySLA),
+		paramtypes.NewParamSetPair(ParamStoreBridgeFeeExchangeRates, &p.BridgeFeeExchangeRates, validateBridgeFeeExchangeRates),
+		paramtypes.NewParamSetPair(ParamStoreMaxOutgoingBatchesPerToken, &p.MaxOutgoingBatchesPerToken, validateMaxOutgoingBatchesPerToken),
+		paramtypes.NewParamSetPair(ParamStoreValsetRequestSlashPowerThreshold, &p.ValsetRequestSlashPowerThreshold, validateValsetRequestSlashPowerThreshold),
+		paramtypes.NewParamSetPair(ParamStoreDowntimeOverlapPolicy, &p.DowntimeOverlapPolicy, validateDowntimeOverlapPolicy),
+		paramtypes.NewParamSetPair(ParamStoreEscalateBatchSigningPenalties, &p.EscalateBatchSigningPenalties, validateEscalateBatchSigningPenalties),
+		paramtypes.NewParamSetPair(ParamStoreUpgradeGuardDepositThresholds, &p.UpgradeGuardDepositThresholds, validateUpgradeGuardDepositThresholds),
+		paramtypes.NewParamSetPair(ParamStoreUpgradeGuardBlocksUpgrades, &p.UpgradeGuardBlocksUpgrades, validateUpgradeGuardBlocksUpgrades),
+		paramtypes.NewParamSetPair(ParamStoreAttestationCatchUpLag, &p.AttestationCatchUpLag, validateAttestationCatchUpLag),
+		paramtypes.NewParamSetPair(ParamStoreRelayerFeeShare, &p.RelayerFeeShare, validateRelayerFeeShare),
+		paramtypes.NewParamSetPair(ParamStoreSyntheticDelegationModules, &p.SyntheticDelegationModules, validateSyntheticDelegationModules),
+		paramtypes.NewParamSetPair(ParamStoreMaxValsetPowerShare, &p.MaxValsetPowerShare, validateMaxValsetPowerShare),
+		paramtypes.NewParamSetPair(ParamStoreMinBridgeValidators, &p.MinBridgeValidators, validateMinBridgeValidators),
+		paramtypes.NewParamSetPair(ParamStoreClaimHashVersion, &p.ClaimHashVersion, validateClaimHashVersion),
+		paramtypes.NewParamSetPair(ParamStoreClaimHashVersionEthereumHeight, &p.ClaimHashVersionEthereumHeight, validateClaimHashVersionEthereumHeight),
+		paramtypes.NewParamSetPair(ParamStoreDepositPausedTokens, &p.DepositPausedTokens, validatePausedTokens),
+		paramtypes.NewParamSetPair(ParamStoreWithdrawalPausedTokens, &p.WithdrawalPausedTokens, validatePausedTokens),
+		paramtypes.NewParamSetPair(ParamStoreInvalidReceiverPolicy, &p.InvalidReceiverPolicy, validateInvalidReceiverPolicy),
+		paramtypes.NewParamSetPair(ParamStoreExpeditedVotingPeriod, &p.ExpeditedVotingPeriod, validateExpeditedVotingPeriod),
+		paramtypes.NewParamSetPair(ParamStoreExpeditedQuorum, &p.ExpeditedQuorum, validateExpeditedQuorum),
+		paramtypes.NewParamSetPair(ParamStoreExecutedBatchRetention, &p.ExecutedBatchRetention, validateExecutedBatchRetention),
+		paramtypes.NewParamSetPair(ParamStoreWethContract, &p.WethContract, validateWethContract),
+		paramtypes.NewParamSetPair(ParamStoreTokenSupplyCaps, &p.TokenSupplyCaps, validateTokenSupplyCaps),
+		paramtypes.NewParamSetPair(ParamStoreSupplyCapPolicy, &p.SupplyCapPolicy, validateSupplyCapPolicy),
+		paramtypes.NewParamSetPair(ParamStoreCriticalParamChangeDelay, &p.CriticalParamChangeDelay, validateCriticalParamChangeDelay),
+		paramtypes.NewParamSetPair(ParamStoreBlockModuleAccountReceivers, &p.BlockModuleAccountReceivers, validateBlockModuleAccountReceivers),
+		paramtypes.NewParamSetPair(ParamStoreAllowedReceiverModules, &p.AllowedReceiverModules, validateAllowedReceiverModules),
+		paramtypes.NewParamSetPair(ParamStoreContractReceivers, &p.ContractReceivers, validateContractReceivers),
+		paramtypes.NewParamSetPair(ParamStoreContractReceiverPolicy, &p.ContractReceiverPolicy, validateContractReceiverPolicy),
+		paramtypes.NewParamSetPair(ParamStoreFeeBurnShare, &p.FeeBurnShare, validateFeeBurnShare),
+		paramtypes.NewParamSetPair(ParamStoreFeeBurnDenoms, &p.FeeBurnDenoms, validateFeeBurnDenoms),
+		paramtypes.NewParamSetPair(ParamStoreUsageEpochLength, &p.UsageEpochLength, validateUsageEpochLength),
+		paramtypes.NewParamSetPair(ParamStoreUsageEpochsRetained, &p.UsageEpochsRetained, validateUsageEpochsRetained),
+		paramtypes.NewParamSetPair(ParamStoreIbcAutoForwardChannels, &p.IbcAutoForwardChannels, validateIbcAutoForwardChannels),
+		paramtypes.NewParamSetPair(ParamStoreIbcAutoForwardTimeout, &p.IbcAutoForwardTimeout, validateIbcAutoForwardTimeout),
+		paramtypes.NewParamSetPair(ParamStoreLogicCallMaxGasLimit, &p.LogicCallMaxGasLimit, validateLogicCallMaxGasLimit),
+		paramtypes.NewParamSetPair(ParamStoreLogicCallMaxPayloadSize, &p.LogicCallMaxPayloadSize, validateLogicCallMaxPayloadSize),
+		paramtypes.NewParamSetPair(ParamStoreMinChainFeeBasisPoints, &p.MinChainFeeBasisPoints, validateMinChainFeeBasisPoints),
 		paramtypes.NewParamSetPair(ParamStoreErc20ToDenomPermanentSwap, &p.Erc20ToDenomPermanentSwap, validateErc20ToDenomPermanentSwap),
 	}
 }
@@ -443,6 +904,456 @@ func validateEthereumBlacklistAddresses(i interface{}) error {
 	return nil
 }
 
+func validateBatchRelayLatencySLA(i interface{}) error {
+	// zero disables the latency warning
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxOutgoingBatchesPerToken(i interface{}) error {
+	// zero disables the limit
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateValsetRequestSlashPowerThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// one disables the valset requests as no validator can hold more than the whole power
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("valset request slash power threshold must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateDowntimeOverlapPolicy(i interface{}) error {
+	v, ok := i.(DowntimeOverlapPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := DowntimeOverlapPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown downtime overlap policy: %d", v)
+	}
+	return nil
+}
+
+func validateEscalateBatchSigningPenalties(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUpgradeGuardDepositThresholds(i interface{}) error {
+	thresholds, ok := i.([]ERC20Token)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(thresholds))
+	for _, threshold := range thresholds {
+		if err := threshold.ValidateBasic(); err != nil {
+			return err
+		}
+		if threshold.Amount.IsNil() || !threshold.Amount.IsPositive() {
+			return fmt.Errorf("threshold for %s must be positive", threshold.Contract)
+		}
+		contract := strings.ToLower(threshold.Contract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate threshold for %s", threshold.Contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateUpgradeGuardBlocksUpgrades(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAttestationCatchUpLag(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRelayerFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("relayer fee share must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateSyntheticDelegationModules(i interface{}) error {
+	modules, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(modules))
+	for _, module := range modules {
+		if strings.TrimSpace(module) == "" {
+			return fmt.Errorf("module name cannot be blank")
+		}
+		if seen[module] {
+			return fmt.Errorf("duplicate module %s", module)
+		}
+		seen[module] = true
+	}
+	return nil
+}
+
+func validateMaxValsetPowerShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// one disables the cap as no validator can hold more than the whole power
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max valset power share must be above 0 and at most 1: %s", v)
+	}
+	return nil
+}
+
+func validateMinBridgeValidators(i interface{}) error {
+	// zero disables the guard
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateClaimHashVersion(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 || v > ClaimEncodingVersion {
+		return fmt.Errorf("claim hash version must be between 1 and %d: %d", ClaimEncodingVersion, v)
+	}
+	return nil
+}
+
+func validateClaimHashVersionEthereumHeight(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePausedTokens(i interface{}) error {
+	tokens, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		contract, err := NewEthAddress(token)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "token %s", token)
+		}
+		if seen[contract.GetAddress()] {
+			return fmt.Errorf("duplicate token %s", token)
+		}
+		seen[contract.GetAddress()] = true
+	}
+	return nil
+}
+
+func validateInvalidReceiverPolicy(i interface{}) error {
+	v, ok := i.(InvalidReceiverPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := InvalidReceiverPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown invalid receiver policy: %d", v)
+	}
+	return nil
+}
+
+func validateExpeditedVotingPeriod(i interface{}) error {
+	// zero disables the expedited track
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateExpeditedQuorum(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited quorum must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateExecutedBatchRetention(i interface{}) error {
+	// zero archives executed batches at the end of the block their execution was observed in
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateWethContract(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// empty while the Gravity contract does not wrap native ETH
+	if v == "" {
+		return nil
+	}
+	if err := ValidateEthAddress(v); err != nil {
+		return err
+	}
+	if v == ZeroAddressString {
+		return fmt.Errorf("the zero address stands for native ETH")
+	}
+	return nil
+}
+
+func validateTokenSupplyCaps(i interface{}) error {
+	caps, ok := i.([]ERC20Token)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(caps))
+	for _, supplyCap := range caps {
+		if err := supplyCap.ValidateBasic(); err != nil {
+			return err
+		}
+		// a zero cap holds every deposit of the token
+		if supplyCap.Amount.IsNil() || supplyCap.Amount.IsNegative() {
+			return fmt.Errorf("cap for %s must not be negative", supplyCap.Contract)
+		}
+		contract := strings.ToLower(supplyCap.Contract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate cap for %s", supplyCap.Contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateSupplyCapPolicy(i interface{}) error {
+	v, ok := i.(SupplyCapPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := SupplyCapPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown supply cap policy: %d", v)
+	}
+	return nil
+}
+
+func validateCriticalParamChangeDelay(i interface{}) error {
+	// zero applies the changes of critical params right away
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBlockModuleAccountReceivers(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAllowedReceiverModules(i interface{}) error {
+	modules, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(modules))
+	for _, module := range modules {
+		if strings.TrimSpace(module) == "" {
+			return fmt.Errorf("module name cannot be blank")
+		}
+		if seen[module] {
+			return fmt.Errorf("duplicate module %s", module)
+		}
+		seen[module] = true
+	}
+	return nil
+}
+
+func validateContractReceivers(i interface{}) error {
+	receivers, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(receivers))
+	for _, receiver := range receivers {
+		contract, err := NewEthAddress(receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "receiver %s", receiver)
+		}
+		if seen[contract.GetAddress()] {
+			return fmt.Errorf("duplicate receiver %s", receiver)
+		}
+		seen[contract.GetAddress()] = true
+	}
+	return nil
+}
+
+func validateContractReceiverPolicy(i interface{}) error {
+	v, ok := i.(ContractReceiverPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := ContractReceiverPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown contract receiver policy: %d", v)
+	}
+	return nil
+}
+
+func validateFeeBurnShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn share must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateFeeBurnDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+func validateUsageEpochLength(i interface{}) error {
+	// zero records no bridge usage
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUsageEpochsRetained(i interface{}) error {
+	// zero keeps the bridge usage of every epoch
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateIbcAutoForwardChannels(i interface{}) error {
+	channels, ok := i.([]IbcAutoForwardChannel)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		if channel.Bech32Prefix == "" || strings.ToLower(channel.Bech32Prefix) != channel.Bech32Prefix {
+			return fmt.Errorf("invalid bech32 prefix %q", channel.Bech32Prefix)
+		}
+		if err := host.ChannelIdentifierValidator(channel.SourceChannel); err != nil {
+			return sdkerrors.Wrapf(err, "channel of %s", channel.Bech32Prefix)
+		}
+		if seen[channel.Bech32Prefix] {
+			return fmt.Errorf("duplicate channel for %s", channel.Bech32Prefix)
+		}
+		seen[channel.Bech32Prefix] = true
+	}
+	return nil
+}
+
+func validateIbcAutoForwardTimeout(i interface{}) error {
+	// zero disables the forwarding
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateLogicCallMaxGasLimit(i interface{}) error {
+	// zero leaves the gas limit of the logic calls uncapped
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateLogicCallMaxPayloadSize(i interface{}) error {
+	// zero leaves the payload size of the logic calls uncapped
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinChainFeeBasisPoints(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > 10000 {
+		return fmt.Errorf("min chain fee basis points must be at most 10000: %d", v)
+	}
+	return nil
+}
+
+func validateBridgeFeeExchangeRates(i interface{}) error {
+	rates, ok := i.([]BridgeFeeExchangeRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(rates))
+	for _, rate := range rates {
+		if err := sdk.ValidateDenom(rate.FeeDenom); err != nil {
+			return sdkerrors.Wrap(err, "fee denom")
+		}
+		if err := sdk.ValidateDenom(rate.TokenDenom); err != nil {
+			return sdkerrors.Wrap(err, "token denom")
+		}
+		if rate.FeeDenom == rate.TokenDenom {
+			return fmt.Errorf("fee denom %s is the token denom", rate.FeeDenom)
+		}
+		if rate.Rate.IsNil() || !rate.Rate.IsPositive() {
+			return fmt.Errorf("rate for %s to %s must be positive", rate.FeeDenom, rate.TokenDenom)
+		}
+		pair := rate.FeeDenom + "/" + rate.TokenDenom
+		if seen[pair] {
+			return fmt.Errorf("duplicate rate for %s to %s", rate.FeeDenom, rate.TokenDenom)
+		}
+		seen[pair] = true
+	}
+	return nil
+}
+
 func validateErc20ToDenomPermanentSwap(i interface{}) error {
 	if _, ok := i.(ERC20ToDenom); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
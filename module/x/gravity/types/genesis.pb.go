@@ -46,6 +46,225 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // disagrees with the rest. Normally this would require a chain halt, manual genesis editing and restar to resolve
 // with this feature a governance proposal can be used instead
 //
+// batch_relay_latency_sla
+//
+// The number of blocks a batch that has collected enough signatures to be relayed may remain unexecuted
+// before a warning event is emitted. This is an early warning that relayers have stopped, zero disables it.
+//
+// bridge_fee_exchange_rates
+//
+// The denoms, other than the one being sent, that a SendToEth bridge fee may be paid in. Such a fee is
+// exchanged with the community pool at the fixed governance rate for the denom being sent, so that the
+// fee on Ethereum is always paid in the bridged token.
+//
+// max_outgoing_batches_per_token
+//
+// The number of unexecuted batches a token may have before no new batch is created for it, this bounds the
+// ordering work on Ethereum and the number of batches validators must confirm. Zero disables the limit.
+//
+// valset_request_slash_power_threshold
+//
+// The share of the latest valset power a validator must hold for its slashing, which is always followed by jailing
+// or tombstoning, to request a new valset in the same block. This shrinks the window in which the key of a removed
+// validator remains in the Ethereum checkpoint. A threshold of one disables these requests.
+//
+// downtime_overlap_policy
+//
+// Whether validators which were offline for Tendermint consensus, and are already penalised for it by the slashing
+// module, are also slashed for the bridge signatures they missed in the same signing window.
+//
+// escalate_batch_signing_penalties
+//
+// Whether a validator missing batch signatures is penalised progressively instead of being slashed right away: its
+// first miss only emits a warning event, its second jails it and its third and later ones slash and jail it. Signing
+// a batch resets the count of misses.
+//
+// upgrade_guard_deposit_thresholds
+//
+// The amount from which a deposit of an ERC20 token is high value. When a software upgrade proposal passes while such
+// a deposit is seen on Ethereum but not yet observed, a warning event is emitted, the attestation would otherwise
+// straddle the upgrade. Tokens without a threshold are never high value.
+//
+// upgrade_guard_blocks_upgrades
+//
+// Whether a software upgrade proposal passing while high value deposits are pending fails instead of scheduling the
+// upgrade, it can then be resubmitted once the deposits are observed.
+//
+// attestation_catch_up_lag
+//
+// The number of event nonces the last observed event nonce may lag the highest claimed event nonce by before the
+// attestations are processed in catch up mode, e.g. after a long halt: only the attestations at the next event nonce
+// are read and a bounded number of event nonces is observed every block. Zero disables catch up mode.
+//
+// relayer_fee_share
+//
+// The share of the relay fees collected on Cosmos by an executed batch paid to its relayer, the rest goes to the
+// community pool. The share of a validator is allocated to it through the distribution module, splitting it between
+// its commission and its delegators. A relayer the chain can not map to a Cosmos account leaves all the fees to the
+// community pool.
+//
+// synthetic_delegation_modules
+//
+// The names of the module accounts delegating on behalf of liquid staking wrappers. The tokens they delegate are not
+// counted in the valset power of a validator, so that wrapped stake does not gain bridge control governance did not
+// intend. A validator left without power is excluded from the valsets.
+//
+// max_valset_power_share
+//
+// The largest share of the normalized power of a valset a single validator may hold in the Ethereum checkpoint, the
+// excess is redistributed over the other members in proportion to their power. This keeps a single validator far from
+// the signature threshold of the contract. When there are too few members to meet the cap they share the power
+// equally. A share of one disables the cap.
+//
+// min_bridge_validators
+//
+// The number of bonded validators which must have registered their delegate keys before valsets are created and the
+// bridge is active, so that a bootstrapping network does not produce a checkpoint a single validator controls. Until
+// then the bridge behaves as if bridge_active was false. Zero disables the guard.
+//
+// claim_hash_version
+//
+// The version of the canonical claim encoding claims are hashed with to key their attestations. Claims of Ethereum
+// events before claim_hash_version_ethereum_height are hashed with the previous version, so a new version is rolled out
+// by a parameter change setting both without splitting the votes of the events in flight. The height should be above
+// the last observed Ethereum height when the change passes.
+//
+// claim_hash_version_ethereum_height
+//
+// The Ethereum block height from which the events are hashed with claim_hash_version.
+//
+// deposit_paused_tokens
+//
+// The ERC20 contracts whose deposits are held, so that governance can stop a single compromised asset without halting
+// the bridge. Their SendToCosmos events are observed in order with the other events, but the observed deposits
+// are credited to the held deposits account instead of their receivers, and are released once the token is removed
+// from the list.
+//
+// withdrawal_paused_tokens
+//
+// The ERC20 contracts whose withdrawals are rejected. No transfer of them enters the pool and no batch of them is
+// created, the transfers already in the pool wait there and can still be canceled.
+//
+// invalid_receiver_policy
+//
+// What happens to an observed deposit whose Cosmos receiver can not be decoded or can not receive funds: it is sent
+// to the community pool, held until governance refunds it, or refunded to its Ethereum sender right away with the
+// refund bridge fee deducted from the amount. A deposit the refund policy can not refund is held instead.
+//
+// expedited_voting_period
+//
+// The number of seconds after which a bridge emergency proposal in its voting period, an UnhaltBridgeProposal, an
+// EmergencyValsetProposal or a parameter change only setting bridge_active or the paused tokens, is tallied with
+// expedited_quorum every block. The first time it passes that tally an expedited_quorum_reached event is emitted,
+// the proposal keeps voting until the end of its regular voting period as the gov module of this SDK version can not
+// end it early. Zero disables the expedited track.
+//
+// expedited_quorum
+//
+// The quorum an expedited proposal must reach to pass the expedited tally, the gov quorum applies if it is higher.
+//
+// executed_batch_retention
+//
+// The number of blocks an executed batch stays in the executed batches after its execution was observed, before it
+// is moved compressed into the batch archive. The archive is never pruned, so that the executed batches can still be
+// audited while the batches iterated every block stay few.
+//
+// weth_contract
+//
+// The WETH contract the Gravity contract wraps native ETH deposits into. Deposits of native ETH, reported with the
+// zero address as token contract, and of WETH are credited as gravity-eth vouchers, which are sent back to Ethereum
+// as WETH. Empty until the contract wraps ETH, native ETH deposits are then still credited as gravity-eth but can not
+// be sent back until it is set. It should be set while no transfer of the WETH contract is pending, as the escrow of
+// the transfers is looked up by the denom of their token.
+//
+// token_supply_caps
+//
+// The maximum amount of outstanding vouchers of an Ethereum originated token, their supply but the vouchers held in
+// the held deposits account, to roll out a newly listed asset progressively. A deposit which would take the vouchers
+// over the cap is handled as supply_cap_policy decides. Native ETH is capped by a cap on the zero address or on the
+// weth_contract. Tokens without a cap are not limited.
+//
+// supply_cap_policy
+//
+// What happens to a deposit which would exceed the supply cap of its token: it is held until it fits under the cap,
+// as the cap is raised or vouchers are sent back to Ethereum, or refunded to its Ethereum sender right away with the
+// refund bridge fee deducted from the amount. A deposit the refund policy can not refund is held instead.
+//
+// critical_param_change_delay
+//
+// The number of blocks a passed parameter change waits before it applies when it sets a critical param: the signed
+// windows, the slash fractions, the bridge contract address or this delay. The change is kept as a pending param
+// change, queried with PendingParamChanges, so that orchestrators can adapt their configuration before the value
+// flips. Zero applies the changes right away.
+//
+// block_module_account_receivers
+//
+// Whether an observed deposit whose Cosmos receiver is a module account is handled as a deposit to an invalid
+// receiver, following the invalid_receiver_policy, rather than minted into an account which can not spend it. The
+// module accounts the bank module blocks are invalid receivers regardless.
+//
+// allowed_receiver_modules
+//
+// The names of the modules whose accounts still receive deposits while block_module_account_receivers is set.
+//
+// contract_receivers
+//
+// The Ethereum contracts known to lose or reject the ERC20 transfers of a batch, such as contracts without a way to
+// move tokens out or multisigs of another chain, handled as contract_receiver_policy decides when a withdrawal is sent
+// to them.
+//
+// contract_receiver_policy
+//
+// What happens to a withdrawal to one of the contract_receivers: it enters the pool, it enters the pool with an event
+// warning its sender, or it is rejected. Withdrawals already in the pool are not affected.
+//
+// fee_burn_share
+//
+// The share of the fees collected on the bridge operations which is burned, like the base fee of EIP-1559, so that
+// bridge usage reduces the supply of the native token. It applies to the relay fees of executed batches, before the
+// relayer share is taken, and to the bridge fees exchanged with the community pool, in the fee_burn_denoms only.
+// The total burned is kept in the state and returned by the BurnedFees query. Zero burns nothing.
+//
+// fee_burn_denoms
+//
+// The denoms of the collected fees fee_burn_share burns, usually the native token. Fees in other denoms, such as
+// the gravity vouchers whose ERC20s stay locked on Ethereum, are never burned.
+//
+// usage_epoch_length
+//
+// The number of blocks of an epoch of the bridge usage, the volume every account sent to and received from Ethereum
+// in the epoch, which an incentive program can distribute rewards by. Epoch 1 starts at height 0, a change of the
+// length renumbers the epochs from the current height on. Zero records no usage.
+//
+// usage_epochs_retained
+//
+// The number of epochs, the current one included, whose bridge usage is kept in the state. Zero keeps every epoch.
+//
+// ibc_auto_forward_channels
+//
+// The IBC channels the deposits to the accounts of other chains are forwarded over, by bech32 prefix, so that an
+// Ethereum user can deposit to any IBC connected chain in one hop. A deposit to a prefix without a channel is
+// credited to the local account with the same address bytes, as before.
+//
+// ibc_auto_forward_timeout
+//
+// The number of seconds after which an IBC transfer of a forwarded deposit times out, refunding the local account.
+// Zero disables the forwarding.
+//
+// logic_call_max_gas_limit
+//
+// The highest gas limit a logic call can be scheduled with, so that relayers are never asked to execute a logic call
+// that can not fit in an Ethereum block. Zero leaves the gas limit uncapped.
+//
+// logic_call_max_payload_size
+//
+// The size in bytes of the largest payload a logic call can be scheduled with. Zero leaves the payload size uncapped.
+//
+// min_chain_fee_basis_points
+//
+// The chain fee every MsgSendToEth pays at least, in basis points of its amount and in the same denom, sent to the fee
+// collector to be distributed to the stakers. Zero lets transfers leave without a chain fee.
+//
 // bridge_active
 //
 // This boolean flag can be used by governance to temporarily halt the bridge due to a vulnerability or other issue
@@ -76,7 +295,45 @@ type Params struct {
 	BridgeActive                 bool                                   `protobuf:"varint,18,opt,name=bridge_active,json=bridgeActive,proto3" json:"bridge_active,omitempty"`
 	// addresses on this blacklist are forbidden from depositing or withdrawing
 	// from Ethereum to the bridge
-	EthereumBlacklist []string `protobuf:"bytes,19,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
+	EthereumBlacklist                []string                               `protobuf:"bytes,19,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
+	BatchRelayLatencySla             uint64                                 `protobuf:"varint,20,opt,name=batch_relay_latency_sla,json=batchRelayLatencySla,proto3" json:"batch_relay_latency_sla,omitempty"`
+	BridgeFeeExchangeRates           []BridgeFeeExchangeRate                `protobuf:"bytes,21,rep,name=bridge_fee_exchange_rates,json=bridgeFeeExchangeRates,proto3" json:"bridge_fee_exchange_rates"`
+	MaxOutgoingBatchesPerToken       uint64                                 `protobuf:"varint,22,opt,name=max_outgoing_batches_per_token,json=maxOutgoingBatchesPerToken,proto3" json:"max_outgoing_batches_per_token,omitempty"`
+	ValsetRequestSlashPowerThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=valset_request_slash_power_threshold,json=valsetRequestSlashPowerThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset_request_slash_power_threshold"`
+	DowntimeOverlapPolicy            DowntimeOverlapPolicy                  `protobuf:"varint,24,opt,name=downtime_overlap_policy,json=downtimeOverlapPolicy,proto3,enum=gravity.v1.DowntimeOverlapPolicy" json:"downtime_overlap_policy,omitempty"`
+	EscalateBatchSigningPenalties    bool                                   `protobuf:"varint,25,opt,name=escalate_batch_signing_penalties,json=escalateBatchSigningPenalties,proto3" json:"escalate_batch_signing_penalties,omitempty"`
+	UpgradeGuardDepositThresholds    []ERC20Token                           `protobuf:"bytes,26,rep,name=upgrade_guard_deposit_thresholds,json=upgradeGuardDepositThresholds,proto3" json:"upgrade_guard_deposit_thresholds"`
+	UpgradeGuardBlocksUpgrades       bool                                   `protobuf:"varint,27,opt,name=upgrade_guard_blocks_upgrades,json=upgradeGuardBlocksUpgrades,proto3" json:"upgrade_guard_blocks_upgrades,omitempty"`
+	AttestationCatchUpLag            uint64                                 `protobuf:"varint,28,opt,name=attestation_catch_up_lag,json=attestationCatchUpLag,proto3" json:"attestation_catch_up_lag,omitempty"`
+	RelayerFeeShare                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=relayer_fee_share,json=relayerFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"relayer_fee_share"`
+	SyntheticDelegationModules       []string                               `protobuf:"bytes,30,rep,name=synthetic_delegation_modules,json=syntheticDelegationModules,proto3" json:"synthetic_delegation_modules,omitempty"`
+	MaxValsetPowerShare              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,31,opt,name=max_valset_power_share,json=maxValsetPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_valset_power_share"`
+	MinBridgeValidators              uint64                                 `protobuf:"varint,32,opt,name=min_bridge_validators,json=minBridgeValidators,proto3" json:"min_bridge_validators,omitempty"`
+	ClaimHashVersion                 uint64                                 `protobuf:"varint,33,opt,name=claim_hash_version,json=claimHashVersion,proto3" json:"claim_hash_version,omitempty"`
+	ClaimHashVersionEthereumHeight   uint64                                 `protobuf:"varint,34,opt,name=claim_hash_version_ethereum_height,json=claimHashVersionEthereumHeight,proto3" json:"claim_hash_version_ethereum_height,omitempty"`
+	DepositPausedTokens              []string                               `protobuf:"bytes,35,rep,name=deposit_paused_tokens,json=depositPausedTokens,proto3" json:"deposit_paused_tokens,omitempty"`
+	WithdrawalPausedTokens           []string                               `protobuf:"bytes,36,rep,name=withdrawal_paused_tokens,json=withdrawalPausedTokens,proto3" json:"withdrawal_paused_tokens,omitempty"`
+	InvalidReceiverPolicy            InvalidReceiverPolicy                  `protobuf:"varint,37,opt,name=invalid_receiver_policy,json=invalidReceiverPolicy,proto3,enum=gravity.v1.InvalidReceiverPolicy" json:"invalid_receiver_policy,omitempty"`
+	ExpeditedVotingPeriod            uint64                                 `protobuf:"varint,38,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3" json:"expedited_voting_period,omitempty"`
+	ExpeditedQuorum                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,39,opt,name=expedited_quorum,json=expeditedQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_quorum"`
+	ExecutedBatchRetention           uint64                                 `protobuf:"varint,40,opt,name=executed_batch_retention,json=executedBatchRetention,proto3" json:"executed_batch_retention,omitempty"`
+	WethContract                     string                                 `protobuf:"bytes,41,opt,name=weth_contract,json=wethContract,proto3" json:"weth_contract,omitempty"`
+	TokenSupplyCaps                  []ERC20Token                           `protobuf:"bytes,42,rep,name=token_supply_caps,json=tokenSupplyCaps,proto3" json:"token_supply_caps"`
+	SupplyCapPolicy                  SupplyCapPolicy                        `protobuf:"varint,43,opt,name=supply_cap_policy,json=supplyCapPolicy,proto3,enum=gravity.v1.SupplyCapPolicy" json:"supply_cap_policy,omitempty"`
+	CriticalParamChangeDelay         uint64                                 `protobuf:"varint,44,opt,name=critical_param_change_delay,json=criticalParamChangeDelay,proto3" json:"critical_param_change_delay,omitempty"`
+	BlockModuleAccountReceivers      bool                                   `protobuf:"varint,45,opt,name=block_module_account_receivers,json=blockModuleAccountReceivers,proto3" json:"block_module_account_receivers,omitempty"`
+	AllowedReceiverModules           []string                               `protobuf:"bytes,46,rep,name=allowed_receiver_modules,json=allowedReceiverModules,proto3" json:"allowed_receiver_modules,omitempty"`
+	ContractReceivers                []string                               `protobuf:"bytes,47,rep,name=contract_receivers,json=contractReceivers,proto3" json:"contract_receivers,omitempty"`
+	ContractReceiverPolicy           ContractReceiverPolicy                 `protobuf:"varint,48,opt,name=contract_receiver_policy,json=contractReceiverPolicy,proto3,enum=gravity.v1.ContractReceiverPolicy" json:"contract_receiver_policy,omitempty"`
+	FeeBurnShare                     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,49,opt,name=fee_burn_share,json=feeBurnShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_share"`
+	FeeBurnDenoms                    []string                               `protobuf:"bytes,51,rep,name=fee_burn_denoms,json=feeBurnDenoms,proto3" json:"fee_burn_denoms,omitempty"`
+	UsageEpochLength                 uint64                                 `protobuf:"varint,52,opt,name=usage_epoch_length,json=usageEpochLength,proto3" json:"usage_epoch_length,omitempty"`
+	UsageEpochsRetained              uint64                                 `protobuf:"varint,53,opt,name=usage_epochs_retained,json=usageEpochsRetained,proto3" json:"usage_epochs_retained,omitempty"`
+	IbcAutoForwardChannels           []IbcAutoForwardChannel                `protobuf:"bytes,54,rep,name=ibc_auto_forward_channels,json=ibcAutoForwardChannels,proto3" json:"ibc_auto_forward_channels"`
+	IbcAutoForwardTimeout            uint64                                 `protobuf:"varint,55,opt,name=ibc_auto_forward_timeout,json=ibcAutoForwardTimeout,proto3" json:"ibc_auto_forward_timeout,omitempty"`
+	LogicCallMaxGasLimit             uint64                                 `protobuf:"varint,56,opt,name=logic_call_max_gas_limit,json=logicCallMaxGasLimit,proto3" json:"logic_call_max_gas_limit,omitempty"`
+	LogicCallMaxPayloadSize          uint64                                 `protobuf:"varint,57,opt,name=logic_call_max_payload_size,json=logicCallMaxPayloadSize,proto3" json:"logic_call_max_payload_size,omitempty"`
+	MinChainFeeBasisPoints           uint64                                 `protobuf:"varint,58,opt,name=min_chain_fee_basis_points,json=minChainFeeBasisPoints,proto3" json:"min_chain_fee_basis_points,omitempty"`
 	// the pair of eth token and denom to automatically swap once the erc20 token is bridged.
 	Erc20ToDenomPermanentSwap ERC20ToDenom `protobuf:"bytes,50,opt,name=erc20_to_denom_permanent_swap,json=erc20ToDenomPermanentSwap,proto3" json:"erc20_to_denom_permanent_swap"`
 }
@@ -212,6 +469,237 @@ func (m *Params) GetEthereumBlacklist() []string {
 	return nil
 }
 
+func (m *Params) GetBatchRelayLatencySla() uint64 {
+	if m != nil {
+		return m.BatchRelayLatencySla
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeFeeExchangeRates() []BridgeFeeExchangeRate {
+	if m != nil {
+		return m.BridgeFeeExchangeRates
+	}
+	return nil
+}
+
+func (m *Params) GetMaxOutgoingBatchesPerToken() uint64 {
+	if m != nil {
+		return m.MaxOutgoingBatchesPerToken
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeOverlapPolicy() DowntimeOverlapPolicy {
+	if m != nil {
+		return m.DowntimeOverlapPolicy
+	}
+	return DOWNTIME_OVERLAP_POLICY_SLASH_BOTH
+}
+
+func (m *Params) GetEscalateBatchSigningPenalties() bool {
+	if m != nil {
+		return m.EscalateBatchSigningPenalties
+	}
+	return false
+}
+
+func (m *Params) GetUpgradeGuardDepositThresholds() []ERC20Token {
+	if m != nil {
+		return m.UpgradeGuardDepositThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetUpgradeGuardBlocksUpgrades() bool {
+	if m != nil {
+		return m.UpgradeGuardBlocksUpgrades
+	}
+	return false
+}
+
+func (m *Params) GetAttestationCatchUpLag() uint64 {
+	if m != nil {
+		return m.AttestationCatchUpLag
+	}
+	return 0
+}
+
+func (m *Params) GetSyntheticDelegationModules() []string {
+	if m != nil {
+		return m.SyntheticDelegationModules
+	}
+	return nil
+}
+
+func (m *Params) GetMinBridgeValidators() uint64 {
+	if m != nil {
+		return m.MinBridgeValidators
+	}
+	return 0
+}
+
+func (m *Params) GetClaimHashVersion() uint64 {
+	if m != nil {
+		return m.ClaimHashVersion
+	}
+	return 0
+}
+
+func (m *Params) GetClaimHashVersionEthereumHeight() uint64 {
+	if m != nil {
+		return m.ClaimHashVersionEthereumHeight
+	}
+	return 0
+}
+
+func (m *Params) GetDepositPausedTokens() []string {
+	if m != nil {
+		return m.DepositPausedTokens
+	}
+	return nil
+}
+
+func (m *Params) GetWithdrawalPausedTokens() []string {
+	if m != nil {
+		return m.WithdrawalPausedTokens
+	}
+	return nil
+}
+
+func (m *Params) GetInvalidReceiverPolicy() InvalidReceiverPolicy {
+	if m != nil {
+		return m.InvalidReceiverPolicy
+	}
+	return INVALID_RECEIVER_POLICY_COMMUNITY_POOL
+}
+
+func (m *Params) GetExpeditedVotingPeriod() uint64 {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetExecutedBatchRetention() uint64 {
+	if m != nil {
+		return m.ExecutedBatchRetention
+	}
+	return 0
+}
+
+func (m *Params) GetWethContract() string {
+	if m != nil {
+		return m.WethContract
+	}
+	return ""
+}
+
+func (m *Params) GetTokenSupplyCaps() []ERC20Token {
+	if m != nil {
+		return m.TokenSupplyCaps
+	}
+	return nil
+}
+
+func (m *Params) GetSupplyCapPolicy() SupplyCapPolicy {
+	if m != nil {
+		return m.SupplyCapPolicy
+	}
+	return SUPPLY_CAP_POLICY_HOLD
+}
+
+func (m *Params) GetCriticalParamChangeDelay() uint64 {
+	if m != nil {
+		return m.CriticalParamChangeDelay
+	}
+	return 0
+}
+
+func (m *Params) GetBlockModuleAccountReceivers() bool {
+	if m != nil {
+		return m.BlockModuleAccountReceivers
+	}
+	return false
+}
+
+func (m *Params) GetAllowedReceiverModules() []string {
+	if m != nil {
+		return m.AllowedReceiverModules
+	}
+	return nil
+}
+
+func (m *Params) GetContractReceivers() []string {
+	if m != nil {
+		return m.ContractReceivers
+	}
+	return nil
+}
+
+func (m *Params) GetContractReceiverPolicy() ContractReceiverPolicy {
+	if m != nil {
+		return m.ContractReceiverPolicy
+	}
+	return CONTRACT_RECEIVER_POLICY_ALLOW
+}
+
+func (m *Params) GetFeeBurnDenoms() []string {
+	if m != nil {
+		return m.FeeBurnDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetUsageEpochLength() uint64 {
+	if m != nil {
+		return m.UsageEpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetUsageEpochsRetained() uint64 {
+	if m != nil {
+		return m.UsageEpochsRetained
+	}
+	return 0
+}
+
+func (m *Params) GetIbcAutoForwardChannels() []IbcAutoForwardChannel {
+	if m != nil {
+		return m.IbcAutoForwardChannels
+	}
+	return nil
+}
+
+func (m *Params) GetIbcAutoForwardTimeout() uint64 {
+	if m != nil {
+		return m.IbcAutoForwardTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetLogicCallMaxGasLimit() uint64 {
+	if m != nil {
+		return m.LogicCallMaxGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetLogicCallMaxPayloadSize() uint64 {
+	if m != nil {
+		return m.LogicCallMaxPayloadSize
+	}
+	return 0
+}
+
+func (m *Params) GetMinChainFeeBasisPoints() uint64 {
+	if m != nil {
+		return m.MinChainFeeBasisPoints
+	}
+	return 0
+}
+
 func (m *Params) GetErc20ToDenomPermanentSwap() ERC20ToDenom {
 	if m != nil {
 		return m.Erc20ToDenomPermanentSwap
@@ -221,18 +709,35 @@ func (m *Params) GetErc20ToDenomPermanentSwap() ERC20ToDenom {
 
 // GenesisState struct, containing all persistant data required by the Gravity module
 type GenesisState struct {
-	Params             *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	GravityNonces      GravityNonces               `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
-	Valsets            []Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
-	ValsetConfirms     []MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
-	Batches            []OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
-	BatchConfirms      []MsgConfirmBatch           `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls         []OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
-	LogicCallConfirms  []MsgConfirmLogicCall       `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations       []Attestation               `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys       []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
-	Erc20ToDenoms      []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
-	UnbatchedTransfers []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	Params                 *Params                                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	GravityNonces          GravityNonces                            `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
+	Valsets                []Valset                                 `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
+	ValsetConfirms         []MsgValsetConfirm                       `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
+	Batches                []OutgoingTxBatch                        `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
+	BatchConfirms          []MsgConfirmBatch                        `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls             []OutgoingLogicCall                      `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
+	LogicCallConfirms      []MsgConfirmLogicCall                    `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations           []Attestation                            `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys           []MsgSetOrchestratorAddress              `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
+	Erc20ToDenoms          []ERC20ToDenom                           `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
+	UnbatchedTransfers     []OutgoingTransferTx                     `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	LogicCallDeposits      []LogicCallDeposit                       `protobuf:"bytes,13,rep,name=logic_call_deposits,json=logicCallDeposits,proto3" json:"logic_call_deposits"`
+	ScheduledTransfers     []ScheduledOutgoingTransferTx            `protobuf:"bytes,14,rep,name=scheduled_transfers,json=scheduledTransfers,proto3" json:"scheduled_transfers"`
+	RecurringSends         []RecurringSendToEth                     `protobuf:"bytes,15,rep,name=recurring_sends,json=recurringSends,proto3" json:"recurring_sends"`
+	HeldDeposits           []HeldDeposit                            `protobuf:"bytes,16,rep,name=held_deposits,json=heldDeposits,proto3" json:"held_deposits"`
+	ForkAttestations       []ForkAttestation                        `protobuf:"bytes,17,rep,name=fork_attestations,json=forkAttestations,proto3" json:"fork_attestations"`
+	ObservedBlockHashes    []ObservedBlockHash                      `protobuf:"bytes,18,rep,name=observed_block_hashes,json=observedBlockHashes,proto3" json:"observed_block_hashes"`
+	SelfBridgeLimits       []SelfBridgeLimit                        `protobuf:"bytes,19,rep,name=self_bridge_limits,json=selfBridgeLimits,proto3" json:"self_bridge_limits"`
+	ProposalMetadata       []GravityProposalMetadata                `protobuf:"bytes,20,rep,name=proposal_metadata,json=proposalMetadata,proto3" json:"proposal_metadata"`
+	ExecutedBatches        []ExecutedBatch                          `protobuf:"bytes,21,rep,name=executed_batches,json=executedBatches,proto3" json:"executed_batches"`
+	ArchivedBatches        []ExecutedBatch                          `protobuf:"bytes,22,rep,name=archived_batches,json=archivedBatches,proto3" json:"archived_batches"`
+	PendingParamChanges    []PendingParamChange                     `protobuf:"bytes,23,rep,name=pending_param_changes,json=pendingParamChanges,proto3" json:"pending_param_changes"`
+	BridgeBinding          *BridgeBinding                           `protobuf:"bytes,24,opt,name=bridge_binding,json=bridgeBinding,proto3" json:"bridge_binding,omitempty"`
+	Erc20Provenances       []ERC20Provenance                        `protobuf:"bytes,25,rep,name=erc20_provenances,json=erc20Provenances,proto3" json:"erc20_provenances"`
+	BurnedFees             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,26,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
+	BridgeUsage            []BridgeUsage                            `protobuf:"bytes,27,rep,name=bridge_usage,json=bridgeUsage,proto3" json:"bridge_usage"`
+	PendingIbcAutoForwards []PendingIbcAutoForward                  `protobuf:"bytes,28,rep,name=pending_ibc_auto_forwards,json=pendingIbcAutoForwards,proto3" json:"pending_ibc_auto_forwards"`
+	ValsetRelayPackages    []ValsetRelayPackage                     `protobuf:"bytes,29,rep,name=valset_relay_packages,json=valsetRelayPackages,proto3" json:"valset_relay_packages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -352,26 +857,148 @@ func (m *GenesisState) GetUnbatchedTransfers() []OutgoingTransferTx {
 	return nil
 }
 
-// GravityCounters contains the many noces and counters required to maintain the bridge state in the genesis
-type GravityNonces struct {
-	// the nonce of the last generated validator set
-	LatestValsetNonce uint64 `protobuf:"varint,1,opt,name=latest_valset_nonce,json=latestValsetNonce,proto3" json:"latest_valset_nonce,omitempty"`
-	// the last observed Gravity.sol contract event nonce
-	LastObservedNonce uint64 `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	// the last valset nonce we have slashed, to prevent double slashing
-	LastSlashedValsetNonce uint64 `protobuf:"varint,3,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
-	// the last batch Cosmos chain block that batch slashing has completed for
-	// there is an individual batch nonce for each token type so this removes
-	// the need to store them all
-	LastSlashedBatchBlock uint64 `protobuf:"varint,4,opt,name=last_slashed_batch_block,json=lastSlashedBatchBlock,proto3" json:"last_slashed_batch_block,omitempty"`
-	// the last cosmos block that logic call slashing has completed for
-	LastSlashedLogicCallBlock uint64 `protobuf:"varint,5,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
-	// the last transaction id from the Gravity TX pool, this prevents ID
-	// duplication during chain upgrades
-	LastTxPoolId uint64 `protobuf:"varint,6,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
-	// the last batch id from the Gravity batch pool, this prevents ID duplication
-	// during chain upgrades
+func (m *GenesisState) GetLogicCallDeposits() []LogicCallDeposit {
+	if m != nil {
+		return m.LogicCallDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledTransfers() []ScheduledOutgoingTransferTx {
+	if m != nil {
+		return m.ScheduledTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecurringSends() []RecurringSendToEth {
+	if m != nil {
+		return m.RecurringSends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeldDeposits() []HeldDeposit {
+	if m != nil {
+		return m.HeldDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForkAttestations() []ForkAttestation {
+	if m != nil {
+		return m.ForkAttestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObservedBlockHashes() []ObservedBlockHash {
+	if m != nil {
+		return m.ObservedBlockHashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSelfBridgeLimits() []SelfBridgeLimit {
+	if m != nil {
+		return m.SelfBridgeLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalMetadata() []GravityProposalMetadata {
+	if m != nil {
+		return m.ProposalMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutedBatches() []ExecutedBatch {
+	if m != nil {
+		return m.ExecutedBatches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedBatches() []ExecutedBatch {
+	if m != nil {
+		return m.ArchivedBatches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingParamChanges() []PendingParamChange {
+	if m != nil {
+		return m.PendingParamChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeBinding() *BridgeBinding {
+	if m != nil {
+		return m.BridgeBinding
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20Provenances() []ERC20Provenance {
+	if m != nil {
+		return m.Erc20Provenances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeUsage() []BridgeUsage {
+	if m != nil {
+		return m.BridgeUsage
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingIbcAutoForwards() []PendingIbcAutoForward {
+	if m != nil {
+		return m.PendingIbcAutoForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValsetRelayPackages() []ValsetRelayPackage {
+	if m != nil {
+		return m.ValsetRelayPackages
+	}
+	return nil
+}
+
+// GravityCounters contains the many noces and counters required to maintain the bridge state in the genesis
+type GravityNonces struct {
+	// the nonce of the last generated validator set
+	LatestValsetNonce uint64 `protobuf:"varint,1,opt,name=latest_valset_nonce,json=latestValsetNonce,proto3" json:"latest_valset_nonce,omitempty"`
+	// the last observed Gravity.sol contract event nonce
+	LastObservedNonce uint64 `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	// the last valset nonce we have slashed, to prevent double slashing
+	LastSlashedValsetNonce uint64 `protobuf:"varint,3,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
+	// the last batch Cosmos chain block that batch slashing has completed for
+	// there is an individual batch nonce for each token type so this removes
+	// the need to store them all
+	LastSlashedBatchBlock uint64 `protobuf:"varint,4,opt,name=last_slashed_batch_block,json=lastSlashedBatchBlock,proto3" json:"last_slashed_batch_block,omitempty"`
+	// the last cosmos block that logic call slashing has completed for
+	LastSlashedLogicCallBlock uint64 `protobuf:"varint,5,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
+	// the last transaction id from the Gravity TX pool, this prevents ID
+	// duplication during chain upgrades
+	LastTxPoolId uint64 `protobuf:"varint,6,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
+	// the last batch id from the Gravity batch pool, this prevents ID duplication
+	// during chain upgrades
 	LastBatchId uint64 `protobuf:"varint,7,opt,name=last_batch_id,json=lastBatchId,proto3" json:"last_batch_id,omitempty"`
+	// the last recurring send to eth id, this prevents ID duplication during
+	// chain upgrades
+	LastRecurringSendId uint64 `protobuf:"varint,8,opt,name=last_recurring_send_id,json=lastRecurringSendId,proto3" json:"last_recurring_send_id,omitempty"`
 }
 
 func (m *GravityNonces) Reset()         { *m = GravityNonces{} }
@@ -456,6 +1083,13 @@ func (m *GravityNonces) GetLastBatchId() uint64 {
 	return 0
 }
 
+func (m *GravityNonces) GetLastRecurringSendId() uint64 {
+	if m != nil {
+		return m.LastRecurringSendId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
@@ -465,83 +1099,176 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6e, 0x1b, 0x37,
-	0x13, 0xb7, 0x62, 0x45, 0x8e, 0x28, 0xc9, 0x8e, 0x69, 0x3b, 0xa1, 0x92, 0x58, 0x11, 0xfc, 0x21,
-	0x81, 0xf0, 0xa1, 0x91, 0x6c, 0x15, 0x68, 0x91, 0x16, 0x05, 0x6a, 0xcb, 0x4e, 0x62, 0xa4, 0x69,
-	0x0c, 0xc9, 0x6d, 0x81, 0x5e, 0x18, 0x6a, 0x97, 0x5e, 0x2d, 0xbc, 0x5a, 0x0a, 0x4b, 0x4a, 0xb6,
-	0x6f, 0x7d, 0x84, 0x3e, 0x56, 0x8e, 0x39, 0x16, 0x6d, 0x11, 0x14, 0xf6, 0xb1, 0x97, 0x3e, 0x42,
-	0xc1, 0x21, 0x77, 0x45, 0x29, 0x06, 0x0a, 0xf8, 0x24, 0x61, 0x7e, 0x7f, 0x66, 0x30, 0x3b, 0x1c,
-	0x12, 0x91, 0x20, 0x61, 0x93, 0x50, 0x5d, 0xb4, 0x26, 0x3b, 0xad, 0x80, 0xc7, 0x5c, 0x86, 0xb2,
-	0x39, 0x4a, 0x84, 0x12, 0x18, 0x59, 0xa4, 0x39, 0xd9, 0x79, 0xb0, 0x1e, 0x88, 0x40, 0x40, 0xb8,
-	0xa5, 0xff, 0x19, 0xc6, 0x83, 0x7b, 0x8e, 0x56, 0x5d, 0x8c, 0xb8, 0x55, 0x3e, 0xd8, 0x70, 0xe2,
-	0x43, 0x19, 0xc8, 0x6b, 0xe8, 0x7d, 0xa6, 0xbc, 0x81, 0x8d, 0x3f, 0x72, 0xe2, 0x4c, 0x29, 0x2e,
-	0x15, 0x53, 0xa1, 0x88, 0x2d, 0x5a, 0xf3, 0x84, 0x1c, 0x0a, 0xd9, 0xea, 0x33, 0xc9, 0x5b, 0x93,
-	0x9d, 0x3e, 0x57, 0x6c, 0xa7, 0xe5, 0x89, 0xd0, 0xe2, 0x5b, 0x7f, 0x17, 0x51, 0xe1, 0x88, 0x25,
-	0x6c, 0x28, 0xf1, 0x26, 0x4a, 0x6b, 0xa6, 0xa1, 0x4f, 0x72, 0xf5, 0x5c, 0xa3, 0xd8, 0x2d, 0xda,
-	0xc8, 0xa1, 0x8f, 0xb7, 0xd1, 0xba, 0x27, 0x62, 0x95, 0x30, 0x4f, 0x51, 0x29, 0xc6, 0x89, 0xc7,
-	0xe9, 0x80, 0xc9, 0x01, 0xb9, 0x05, 0x44, 0x9c, 0x62, 0x3d, 0x80, 0x5e, 0x31, 0x39, 0xc0, 0x5f,
-	0xa0, 0xfb, 0xfd, 0x24, 0xf4, 0x03, 0x4e, 0xb9, 0x1a, 0xf0, 0x84, 0x8f, 0x87, 0x94, 0xf9, 0x7e,
-	0xc2, 0xa5, 0x24, 0x79, 0x10, 0x6d, 0x18, 0xf8, 0xc0, 0xa2, 0xbb, 0x06, 0xc4, 0x4f, 0xd1, 0x8a,
-	0xd5, 0x79, 0x03, 0x16, 0xc6, 0xba, 0x9a, 0xdb, 0xf5, 0x5c, 0x23, 0xdf, 0xad, 0x98, 0x70, 0x47,
-	0x47, 0x0f, 0x7d, 0xdc, 0x46, 0x1b, 0x32, 0x0c, 0x62, 0xee, 0xd3, 0x09, 0x8b, 0x24, 0x57, 0x92,
-	0x9e, 0x85, 0xb1, 0x2f, 0xce, 0x48, 0x01, 0xd8, 0x6b, 0x06, 0xfc, 0xd1, 0x60, 0x3f, 0x01, 0xe4,
-	0x68, 0xa0, 0x87, 0x3c, 0xd3, 0x2c, 0xb9, 0x9a, 0x3d, 0x83, 0x59, 0xcd, 0x73, 0x54, 0xb5, 0x9a,
-	0x48, 0x04, 0xa1, 0x47, 0x3d, 0x16, 0x45, 0x99, 0xee, 0x0e, 0xe8, 0xee, 0x19, 0xc2, 0x77, 0x1a,
-	0xef, 0x68, 0xd8, 0x4a, 0xb7, 0xd1, 0xba, 0x62, 0x49, 0xc0, 0x95, 0x49, 0x47, 0x55, 0x38, 0xe4,
-	0x62, 0xac, 0x48, 0x11, 0x54, 0xd8, 0x60, 0x90, 0xed, 0xd8, 0x20, 0xf8, 0x33, 0x84, 0xd9, 0x84,
-	0x27, 0x2c, 0xe0, 0xb4, 0x1f, 0x09, 0xef, 0x14, 0x24, 0x04, 0x01, 0xff, 0xae, 0x45, 0xf6, 0x34,
-	0xa0, 0x05, 0xf8, 0x1b, 0xf4, 0x30, 0x65, 0x67, 0x3d, 0x76, 0x64, 0x25, 0x90, 0x11, 0x4b, 0x49,
-	0xfb, 0x3c, 0x95, 0xf7, 0xd1, 0x86, 0x8c, 0x98, 0x1c, 0xd0, 0x13, 0xfd, 0xe9, 0x42, 0x11, 0xdb,
-	0x4e, 0x92, 0x72, 0x3d, 0xd7, 0x28, 0xef, 0x35, 0xdf, 0x7f, 0x7c, 0xbc, 0xf0, 0xfb, 0xc7, 0xc7,
-	0x4f, 0x83, 0x50, 0x0d, 0xc6, 0xfd, 0xa6, 0x27, 0x86, 0x2d, 0x3b, 0x4f, 0xe6, 0xe7, 0x99, 0xf4,
-	0x4f, 0xed, 0xec, 0xee, 0x73, 0xaf, 0xbb, 0x06, 0x66, 0x2f, 0xac, 0x97, 0x69, 0x3c, 0x7e, 0x87,
-	0xd6, 0xe7, 0x72, 0x40, 0x2b, 0x48, 0xe5, 0x46, 0x29, 0xf0, 0x4c, 0x0a, 0xe8, 0x1c, 0x0e, 0x51,
-	0x75, 0x2e, 0xc3, 0xf4, 0x3b, 0x91, 0xe5, 0x1b, 0xa5, 0xb9, 0x37, 0x93, 0x26, 0xfb, 0xac, 0xb8,
-	0x83, 0x6a, 0xe3, 0xb8, 0x2f, 0x62, 0x9f, 0x02, 0x21, 0x8c, 0x83, 0xf9, 0xd9, 0x5b, 0x81, 0x96,
-	0x3f, 0x34, 0xac, 0x9e, 0x25, 0xcd, 0xce, 0xe0, 0x04, 0xd5, 0x3f, 0xe9, 0x88, 0xaf, 0xbf, 0x1f,
-	0xd5, 0x53, 0xc4, 0xd4, 0x38, 0xe1, 0xe4, 0xee, 0x8d, 0xca, 0x7e, 0x34, 0xd7, 0x1d, 0xff, 0x40,
-	0x0d, 0x7a, 0xa9, 0x27, 0xde, 0x47, 0x15, 0x53, 0x2c, 0x4d, 0xf8, 0x19, 0x4b, 0x7c, 0xb2, 0x5a,
-	0xcf, 0x35, 0x4a, 0xed, 0x6a, 0xd3, 0x78, 0x35, 0xf5, 0x8e, 0x68, 0xda, 0x1d, 0xd1, 0xec, 0x88,
-	0x30, 0xde, 0xcb, 0xeb, 0xfc, 0xdd, 0xb2, 0x51, 0x75, 0x41, 0x84, 0xff, 0x87, 0xec, 0x31, 0xa4,
-	0x3a, 0xcb, 0x84, 0x13, 0x5c, 0xcf, 0x35, 0xee, 0x74, 0xcb, 0x26, 0xb8, 0x0b, 0x31, 0xfc, 0x0c,
-	0x61, 0x67, 0x1e, 0x99, 0x77, 0x1a, 0x85, 0x52, 0x91, 0xb5, 0xfa, 0x62, 0xa3, 0xd8, 0x5d, 0xe5,
-	0xd9, 0x1c, 0x5a, 0x00, 0xbf, 0x43, 0x9b, 0x3c, 0xf1, 0xda, 0xdb, 0x54, 0x09, 0xea, 0xf3, 0x58,
-	0x0c, 0xe9, 0x88, 0x27, 0x43, 0x16, 0xf3, 0x58, 0x51, 0x79, 0xc6, 0x46, 0xa4, 0x0d, 0x95, 0x92,
-	0xe6, 0x74, 0xa9, 0x36, 0x0f, 0xba, 0x9d, 0xf6, 0xf6, 0xb1, 0xd8, 0xd7, 0x74, 0x5b, 0x68, 0x15,
-	0x4c, 0x6c, 0xec, 0x28, 0x75, 0xe8, 0x9d, 0xb1, 0xd1, 0x57, 0xf9, 0x5f, 0xfe, 0xac, 0x2f, 0x6c,
-	0xfd, 0x51, 0x40, 0xe5, 0x97, 0x66, 0x4d, 0xf7, 0x14, 0x53, 0x1c, 0xff, 0x1f, 0x15, 0x46, 0xb0,
-	0xfd, 0x60, 0xdf, 0x95, 0xda, 0xd8, 0xcd, 0x60, 0xf6, 0x62, 0xd7, 0x32, 0xf0, 0x0b, 0xb4, 0x9c,
-	0xee, 0xc7, 0x58, 0xc4, 0x1e, 0x97, 0xb0, 0xfa, 0x74, 0xff, 0x1c, 0xcd, 0x4b, 0xf3, 0xf7, 0x7b,
-	0x20, 0xd8, 0xb2, 0x2a, 0x81, 0x1b, 0xc4, 0x6d, 0xb4, 0x64, 0x67, 0x86, 0x2c, 0xd6, 0x17, 0xe7,
-	0x93, 0x9a, 0x51, 0xb1, 0xca, 0x94, 0x88, 0x5f, 0xa3, 0x15, 0xfb, 0xe9, 0x3c, 0x11, 0x9f, 0x84,
-	0xc9, 0x50, 0xaf, 0x50, 0xad, 0x7d, 0xe4, 0x6a, 0xdf, 0x48, 0x3b, 0x69, 0x1d, 0x43, 0xb2, 0x2e,
-	0xcb, 0x13, 0x37, 0x28, 0xf1, 0xd7, 0x68, 0xc9, 0x2e, 0x3f, 0x72, 0x1b, 0x4c, 0x1e, 0xba, 0x26,
-	0x6f, 0xc7, 0x2a, 0x10, 0x61, 0x1c, 0x1c, 0x9f, 0xc3, 0xe9, 0x4a, 0x2b, 0xb1, 0x0a, 0xfc, 0x0a,
-	0x2d, 0x9b, 0x55, 0x96, 0x15, 0x52, 0xf8, 0xd4, 0xe3, 0x8d, 0x0c, 0xd2, 0x12, 0x1c, 0x8f, 0x0a,
-	0x08, 0xb3, 0x32, 0xf6, 0x51, 0xc9, 0xd9, 0xa7, 0x64, 0x09, 0x6c, 0x36, 0xaf, 0x2b, 0x25, 0x3b,
-	0x7f, 0xd6, 0x08, 0x45, 0xd9, 0x9e, 0xc5, 0x3f, 0xa0, 0xb5, 0xa9, 0xcb, 0xb4, 0xa8, 0x3b, 0xe0,
-	0xf6, 0xf8, 0xfa, 0xa2, 0xe6, 0xfd, 0x56, 0x33, 0xbf, 0xac, 0xb8, 0x5d, 0x54, 0x76, 0x2e, 0x53,
-	0x49, 0x8a, 0xe0, 0x77, 0xdf, 0xf5, 0xdb, 0x9d, 0xe2, 0xe9, 0x41, 0x71, 0x25, 0xf8, 0x08, 0x55,
-	0x7c, 0x1e, 0xf1, 0x80, 0x29, 0x4e, 0x4f, 0xf9, 0x85, 0x24, 0x08, 0x3c, 0x9e, 0xcc, 0xd5, 0xd4,
-	0xe3, 0xea, 0x6d, 0xa2, 0x5b, 0xab, 0x12, 0xa6, 0x44, 0x62, 0x2f, 0xc1, 0xd4, 0x31, 0x75, 0x78,
-	0xcd, 0x2f, 0xf4, 0x04, 0xae, 0xcc, 0x1e, 0x13, 0x49, 0x4a, 0xe0, 0xf9, 0x5f, 0x07, 0xa3, 0xe2,
-	0x1e, 0x0c, 0xe8, 0xd9, 0x38, 0x36, 0x1f, 0xd4, 0xa7, 0x2a, 0x61, 0xb1, 0x3c, 0xe1, 0x89, 0x24,
-	0x65, 0xf0, 0xaa, 0x5d, 0x3b, 0x0c, 0x96, 0x74, 0x7c, 0x6e, 0x1d, 0x71, 0x66, 0x90, 0x42, 0x72,
-	0xeb, 0x9f, 0x5b, 0xa8, 0x32, 0x33, 0xff, 0xb8, 0x89, 0xd6, 0x22, 0xa6, 0x5b, 0x62, 0xb7, 0xa4,
-	0x39, 0x38, 0x70, 0xd6, 0xf2, 0xdd, 0x55, 0x03, 0x99, 0x89, 0x05, 0x81, 0xe1, 0x4b, 0x45, 0x45,
-	0x5f, 0xf2, 0x64, 0xc2, 0x7d, 0xcb, 0xbf, 0x95, 0xf2, 0xa5, 0x7a, 0x6b, 0x11, 0xc3, 0x7f, 0x8e,
-	0xaa, 0xc0, 0x87, 0xb5, 0x97, 0xbd, 0x03, 0xac, 0x6a, 0xd1, 0xdc, 0xcc, 0x9a, 0xd0, 0x33, 0xb8,
-	0x9b, 0xea, 0x4b, 0x44, 0x66, 0xa4, 0x66, 0xa8, 0xe1, 0xee, 0x84, 0xd7, 0x49, 0xbe, 0xbb, 0xe1,
-	0x28, 0xcd, 0x18, 0x6b, 0x10, 0x7f, 0x8b, 0x36, 0x67, 0x84, 0xce, 0xf4, 0x19, 0xb5, 0x79, 0xab,
-	0x54, 0x1d, 0xf5, 0x74, 0xde, 0xc0, 0xe1, 0x09, 0x5a, 0x01, 0x07, 0x75, 0x4e, 0x47, 0x42, 0x44,
-	0xfa, 0x7d, 0x63, 0x5e, 0x2c, 0x65, 0x1d, 0x3e, 0x3e, 0x3f, 0x12, 0x22, 0x3a, 0xf4, 0xf1, 0x16,
-	0xaa, 0x00, 0xcd, 0x54, 0x16, 0xfa, 0xf6, 0x89, 0x52, 0xd2, 0x41, 0xa8, 0xe7, 0xd0, 0xdf, 0xa3,
-	0xef, 0x2f, 0x6b, 0xb9, 0x0f, 0x97, 0xb5, 0xdc, 0x5f, 0x97, 0xb5, 0xdc, 0xaf, 0x57, 0xb5, 0x85,
-	0x0f, 0x57, 0xb5, 0x85, 0xdf, 0xae, 0x6a, 0x0b, 0x3f, 0x1f, 0x38, 0x57, 0x86, 0x88, 0xc5, 0xf0,
-	0x02, 0xde, 0x7b, 0x9e, 0x88, 0xd2, 0x9b, 0xc3, 0x7e, 0xe5, 0x67, 0x66, 0x6f, 0xb7, 0x86, 0xc2,
-	0x1f, 0x47, 0xbc, 0x75, 0xde, 0x4a, 0x9f, 0x93, 0x70, 0xab, 0xf4, 0x0b, 0x20, 0xfb, 0xfc, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x49, 0x3e, 0x2b, 0xe9, 0x0a, 0x00, 0x00,
+	// 2689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0xdd, 0x72, 0x1c, 0xb7,
+	0xb1, 0x16, 0x4d, 0x59, 0xb2, 0xc0, 0x3f, 0x11, 0x14, 0x49, 0x90, 0x14, 0xa9, 0x35, 0x6d, 0xcb,
+	0x3c, 0x3e, 0x16, 0x29, 0x51, 0xc7, 0xbf, 0xe7, 0x9c, 0x2a, 0xf3, 0x57, 0xa2, 0x2d, 0x5a, 0x9b,
+	0x25, 0x25, 0xc7, 0xae, 0x54, 0x60, 0xec, 0x4c, 0x73, 0x77, 0x8a, 0xb3, 0x83, 0x31, 0x80, 0x59,
+	0x92, 0xbe, 0x48, 0xe5, 0x11, 0xf2, 0x12, 0xb9, 0xc9, 0x93, 0xb8, 0x72, 0xe5, 0xcb, 0x54, 0x2a,
+	0xe5, 0xa4, 0xec, 0xaa, 0x3c, 0x47, 0x0a, 0x0d, 0x60, 0x76, 0x76, 0x97, 0x49, 0xa9, 0x78, 0x25,
+	0xaa, 0xbf, 0xaf, 0x1b, 0xbd, 0xdd, 0x8d, 0x46, 0x03, 0x43, 0x58, 0x4b, 0x89, 0x6e, 0x62, 0x2e,
+	0x36, 0xba, 0x8f, 0x36, 0x5a, 0x90, 0x81, 0x4e, 0xf4, 0x7a, 0xae, 0xa4, 0x91, 0x94, 0x78, 0x64,
+	0xbd, 0xfb, 0x68, 0xf1, 0x4e, 0x4b, 0xb6, 0x24, 0x8a, 0x37, 0xec, 0x5f, 0x8e, 0xb1, 0x38, 0x57,
+	0xd1, 0x35, 0x17, 0x39, 0x78, 0xcd, 0xc5, 0xd9, 0x8a, 0xbc, 0xa3, 0x5b, 0xfa, 0x12, 0x7a, 0x53,
+	0x98, 0xa8, 0xed, 0xe5, 0x77, 0x2b, 0x72, 0x61, 0x0c, 0x68, 0x23, 0x4c, 0x22, 0x33, 0x8f, 0xae,
+	0x44, 0x52, 0x77, 0xa4, 0xde, 0x68, 0x0a, 0x0d, 0x1b, 0xdd, 0x47, 0x4d, 0x30, 0xe2, 0xd1, 0x46,
+	0x24, 0x13, 0x8f, 0xaf, 0xfe, 0x79, 0x85, 0xdc, 0xa8, 0x0b, 0x25, 0x3a, 0x9a, 0x2e, 0x93, 0xe0,
+	0x33, 0x4f, 0x62, 0x36, 0x52, 0x1b, 0x59, 0xbb, 0xd5, 0xb8, 0xe5, 0x25, 0x07, 0x31, 0x7d, 0x48,
+	0xee, 0x44, 0x32, 0x33, 0x4a, 0x44, 0x86, 0x6b, 0x59, 0xa8, 0x08, 0x78, 0x5b, 0xe8, 0x36, 0x7b,
+	0x0d, 0x89, 0x34, 0x60, 0x47, 0x08, 0x3d, 0x15, 0xba, 0x4d, 0x3f, 0x24, 0xf3, 0x4d, 0x95, 0xc4,
+	0x2d, 0xe0, 0x60, 0xda, 0xa0, 0xa0, 0xe8, 0x70, 0x11, 0xc7, 0x0a, 0xb4, 0x66, 0xd7, 0x51, 0x69,
+	0xd6, 0xc1, 0x7b, 0x1e, 0xdd, 0x72, 0x20, 0xbd, 0x4f, 0xa6, 0xbc, 0x5e, 0xd4, 0x16, 0x49, 0x66,
+	0xbd, 0x79, 0xbd, 0x36, 0xb2, 0x76, 0xbd, 0x31, 0xe1, 0xc4, 0x3b, 0x56, 0x7a, 0x10, 0xd3, 0x4d,
+	0x32, 0xab, 0x93, 0x56, 0x06, 0x31, 0xef, 0x8a, 0x54, 0x83, 0xd1, 0xfc, 0x2c, 0xc9, 0x62, 0x79,
+	0xc6, 0x6e, 0x20, 0x7b, 0xc6, 0x81, 0x2f, 0x1d, 0xf6, 0x15, 0x42, 0x15, 0x1d, 0x8c, 0x21, 0x94,
+	0x3a, 0x37, 0xab, 0x3a, 0xdb, 0x0e, 0xf3, 0x3a, 0x9f, 0x90, 0x05, 0xaf, 0x93, 0xca, 0x56, 0x12,
+	0xf1, 0x48, 0xa4, 0x69, 0xa9, 0xf7, 0x06, 0xea, 0xcd, 0x39, 0xc2, 0x33, 0x8b, 0xef, 0x58, 0xd8,
+	0xab, 0x3e, 0x24, 0x77, 0x8c, 0x50, 0x2d, 0x30, 0x6e, 0x39, 0x6e, 0x92, 0x0e, 0xc8, 0xc2, 0xb0,
+	0x5b, 0xa8, 0x45, 0x1d, 0x86, 0xab, 0x1d, 0x3b, 0x84, 0xbe, 0x4f, 0xa8, 0xe8, 0x82, 0x12, 0x2d,
+	0xe0, 0xcd, 0x54, 0x46, 0xa7, 0xa8, 0xc2, 0x08, 0xf2, 0x6f, 0x7b, 0x64, 0xdb, 0x02, 0x56, 0x81,
+	0xfe, 0x3f, 0x59, 0x0a, 0xec, 0x32, 0xc6, 0x15, 0xb5, 0x31, 0x54, 0x63, 0x9e, 0x12, 0xe2, 0xdc,
+	0x53, 0x6f, 0x92, 0x59, 0x9d, 0x0a, 0xdd, 0xe6, 0x27, 0x36, 0x75, 0x89, 0xcc, 0x7c, 0x24, 0xd9,
+	0x78, 0x6d, 0x64, 0x6d, 0x7c, 0x7b, 0xfd, 0x87, 0x9f, 0xee, 0x5d, 0xfb, 0xeb, 0x4f, 0xf7, 0xee,
+	0xb7, 0x12, 0xd3, 0x2e, 0x9a, 0xeb, 0x91, 0xec, 0x6c, 0xf8, 0x7a, 0x72, 0xff, 0x3c, 0xd0, 0xf1,
+	0xa9, 0xaf, 0xdd, 0x5d, 0x88, 0x1a, 0x33, 0x68, 0x6c, 0xdf, 0xdb, 0x72, 0x81, 0xa7, 0xdf, 0x92,
+	0x3b, 0x03, 0x6b, 0x60, 0x28, 0xd8, 0xc4, 0x95, 0x96, 0xa0, 0x7d, 0x4b, 0x60, 0xe4, 0x68, 0x42,
+	0x16, 0x06, 0x56, 0xe8, 0xe5, 0x89, 0x4d, 0x5e, 0x69, 0x99, 0xb9, 0xbe, 0x65, 0xca, 0xb4, 0xd2,
+	0x1d, 0xb2, 0x52, 0x64, 0x4d, 0x99, 0xc5, 0x1c, 0x09, 0x49, 0xd6, 0x1a, 0xac, 0xbd, 0x29, 0x0c,
+	0xf9, 0x92, 0x63, 0x1d, 0x79, 0x52, 0x7f, 0x0d, 0x76, 0x49, 0x6d, 0x28, 0x22, 0xb1, 0xcd, 0x1f,
+	0xb7, 0x55, 0x24, 0x4c, 0xa1, 0x80, 0xdd, 0xbe, 0x92, 0xdb, 0x77, 0x07, 0xa2, 0x13, 0xef, 0x99,
+	0xf6, 0x51, 0xb0, 0x49, 0x77, 0xc9, 0x84, 0x73, 0x96, 0x2b, 0x38, 0x13, 0x2a, 0x66, 0xd3, 0xb5,
+	0x91, 0xb5, 0xb1, 0xcd, 0x85, 0x75, 0x67, 0x6b, 0xdd, 0xf6, 0x88, 0x75, 0xdf, 0x23, 0xd6, 0x77,
+	0x64, 0x92, 0x6d, 0x5f, 0xb7, 0xeb, 0x37, 0xc6, 0x9d, 0x56, 0x03, 0x95, 0xe8, 0x5b, 0xc4, 0x6f,
+	0x43, 0x6e, 0x57, 0xe9, 0x02, 0xa3, 0xb5, 0x91, 0xb5, 0x37, 0x1a, 0xe3, 0x4e, 0xb8, 0x85, 0x32,
+	0xfa, 0x80, 0xd0, 0x4a, 0x3d, 0x8a, 0xe8, 0x34, 0x4d, 0xb4, 0x61, 0x33, 0xb5, 0xd1, 0xb5, 0x5b,
+	0x8d, 0x69, 0x28, 0xeb, 0xd0, 0x03, 0xf4, 0x03, 0x32, 0xef, 0xf6, 0x87, 0x82, 0x54, 0x5c, 0xf0,
+	0x54, 0x18, 0xc8, 0xa2, 0x0b, 0x1b, 0x63, 0x76, 0x07, 0xe3, 0x79, 0x07, 0xe1, 0x86, 0x45, 0x9f,
+	0x39, 0xf0, 0x28, 0x15, 0xb4, 0x49, 0x16, 0xbc, 0x2b, 0x27, 0x00, 0x1c, 0xce, 0xa3, 0xb6, 0xc8,
+	0x5a, 0xc0, 0x95, 0x30, 0xa0, 0xd9, 0x6c, 0x6d, 0x74, 0x6d, 0x6c, 0xf3, 0xcd, 0xf5, 0x5e, 0x1f,
+	0x5e, 0xdf, 0x46, 0xf2, 0x3e, 0xc0, 0x9e, 0xa7, 0x36, 0x84, 0x01, 0xff, 0x23, 0xe7, 0x9a, 0x97,
+	0x81, 0x9a, 0x6e, 0x93, 0x95, 0x8e, 0x38, 0xe7, 0xb2, 0x30, 0x2d, 0x69, 0xd3, 0x1d, 0xda, 0x46,
+	0x0e, 0x8a, 0x1b, 0x79, 0x0a, 0x19, 0x9b, 0x43, 0x0f, 0x17, 0x3b, 0xe2, 0xfc, 0xb9, 0x27, 0xf9,
+	0xf6, 0x51, 0x07, 0x75, 0x6c, 0x19, 0xf4, 0x77, 0xe4, 0xed, 0x32, 0xf0, 0xdf, 0x15, 0xa0, 0x8d,
+	0xab, 0x1e, 0x9e, 0xcb, 0x33, 0x6b, 0xa5, 0xad, 0x40, 0xb7, 0x65, 0x1a, 0xb3, 0xf9, 0x2b, 0x25,
+	0xbd, 0x16, 0xd2, 0x83, 0xa6, 0xb1, 0xe4, 0xea, 0xd6, 0xf0, 0x71, 0xb0, 0x4b, 0xbf, 0x26, 0xf3,
+	0xb1, 0x3c, 0xcb, 0x6c, 0x4b, 0xe0, 0xb2, 0x0b, 0x2a, 0x15, 0x39, 0xcf, 0x65, 0x9a, 0x44, 0x17,
+	0x8c, 0xd5, 0x46, 0xd6, 0x26, 0xfb, 0xa3, 0xb4, 0xeb, 0xa9, 0xcf, 0x1d, 0xb3, 0x8e, 0xc4, 0xc6,
+	0x6c, 0x7c, 0x99, 0x98, 0x3e, 0x21, 0x35, 0xd0, 0x91, 0xb0, 0x19, 0xf3, 0x2d, 0xce, 0xd6, 0xb0,
+	0x0d, 0x54, 0x0e, 0x99, 0x48, 0x4d, 0x02, 0x9a, 0x2d, 0x60, 0x81, 0x2c, 0x07, 0x1e, 0x46, 0xe7,
+	0xc8, 0xb1, 0xea, 0x81, 0x44, 0x81, 0xd4, 0x8a, 0xbc, 0xa5, 0x44, 0x0c, 0xbc, 0x55, 0x08, 0x15,
+	0xf3, 0x18, 0x72, 0xa9, 0x13, 0xd3, 0x0b, 0x8f, 0x66, 0x8b, 0x98, 0xd2, 0xb9, 0xaa, 0xb3, 0x7b,
+	0x8d, 0x9d, 0xcd, 0x87, 0x18, 0x65, 0x9f, 0xc7, 0x65, 0x6f, 0xe5, 0x89, 0x35, 0xb2, 0xeb, 0x6c,
+	0x94, 0x91, 0xd0, 0x74, 0x8b, 0x2c, 0xf7, 0x2f, 0x83, 0xdd, 0x52, 0x73, 0x2f, 0xd4, 0x6c, 0x09,
+	0x9d, 0x5d, 0xac, 0x5a, 0xc1, 0x7e, 0xa9, 0x5f, 0x78, 0x06, 0xfd, 0x88, 0xb0, 0xca, 0x39, 0xcb,
+	0x23, 0xfc, 0xd5, 0x45, 0xce, 0x53, 0xd1, 0x62, 0x77, 0xb1, 0x16, 0x66, 0x2b, 0xf8, 0x8e, 0x85,
+	0x5f, 0xe4, 0xcf, 0x44, 0x8b, 0x7e, 0x43, 0xa6, 0xb1, 0xbe, 0x41, 0x61, 0xbd, 0xea, 0xb6, 0x50,
+	0xc0, 0x96, 0xaf, 0x94, 0xf3, 0x29, 0x6f, 0x68, 0x1f, 0xe0, 0xc8, 0x9a, 0xa1, 0x9f, 0x91, 0xbb,
+	0xfa, 0x22, 0x33, 0x6d, 0x30, 0x49, 0xc4, 0x63, 0x48, 0xa1, 0xe5, 0xbc, 0xeb, 0xc8, 0xb8, 0x48,
+	0x41, 0xb3, 0x15, 0xdc, 0x7a, 0x8b, 0x25, 0x67, 0xb7, 0xa4, 0x1c, 0x3a, 0x06, 0x8d, 0xc8, 0x9c,
+	0x2d, 0x74, 0x5f, 0xa8, 0xae, 0x34, 0x9d, 0x8b, 0xf7, 0xae, 0x76, 0x18, 0x74, 0xc4, 0xb9, 0xeb,
+	0x7b, 0x58, 0x8d, 0xce, 0xcd, 0x4d, 0x32, 0xdb, 0x49, 0x32, 0xee, 0x77, 0x6d, 0x57, 0xa4, 0x49,
+	0x2c, 0x8c, 0x54, 0x9a, 0xd5, 0xdc, 0xf1, 0xdb, 0x49, 0x32, 0xb7, 0x49, 0x5f, 0x96, 0x90, 0x3d,
+	0x11, 0xa3, 0x54, 0x24, 0x1d, 0x1c, 0x37, 0x78, 0x17, 0x94, 0x4e, 0x64, 0xc6, 0xde, 0x74, 0x27,
+	0x22, 0x22, 0x76, 0xda, 0x78, 0xe9, 0xe4, 0xf4, 0x73, 0xb2, 0x3a, 0xcc, 0xee, 0x1d, 0x8e, 0x6d,
+	0x48, 0x5a, 0x6d, 0xc3, 0x56, 0x51, 0x7b, 0x65, 0x50, 0x3b, 0x9c, 0x90, 0x4f, 0x91, 0x65, 0xbd,
+	0x0d, 0x55, 0x98, 0x8b, 0x42, 0x43, 0xec, 0x76, 0xbc, 0x66, 0x6f, 0x61, 0x34, 0x67, 0x3c, 0x58,
+	0x47, 0x0c, 0x8b, 0x50, 0xd3, 0x8f, 0x09, 0x3b, 0x4b, 0x4c, 0x3b, 0x56, 0xe2, 0x4c, 0xa4, 0x03,
+	0x6a, 0x6f, 0xa3, 0xda, 0x5c, 0x0f, 0xef, 0xd3, 0xfc, 0x9a, 0xcc, 0x27, 0x19, 0x86, 0x84, 0x2b,
+	0x88, 0x20, 0xe9, 0x82, 0x0a, 0xbb, 0xf4, 0x9d, 0xe1, 0x5d, 0x7a, 0xe0, 0xa8, 0x0d, 0xcf, 0x0c,
+	0xbb, 0x34, 0xb9, 0x4c, 0x6c, 0x27, 0x31, 0x38, 0xcf, 0x21, 0x4e, 0x8c, 0x1d, 0x96, 0xa4, 0x71,
+	0xfb, 0x53, 0x25, 0x32, 0x66, 0xf7, 0x5d, 0xc5, 0x96, 0xf0, 0x4b, 0x44, 0xeb, 0x08, 0xd2, 0xaf,
+	0xc9, 0xed, 0x9e, 0xde, 0x77, 0x85, 0x54, 0x45, 0x87, 0xbd, 0x7b, 0xb5, 0x82, 0x2d, 0xed, 0xfc,
+	0x0a, 0xcd, 0xd8, 0x38, 0xc1, 0x39, 0x44, 0x85, 0x09, 0xa3, 0x18, 0x57, 0x60, 0x20, 0xb3, 0x15,
+	0xc9, 0xd6, 0xdc, 0x4c, 0x15, 0xf0, 0x6d, 0xd7, 0xfb, 0x3d, 0x6a, 0x0f, 0xa0, 0x33, 0x7b, 0x58,
+	0x86, 0x89, 0x93, 0xfd, 0x17, 0x0e, 0x93, 0xe3, 0x56, 0xb8, 0xe3, 0x65, 0xf4, 0x29, 0x99, 0xc6,
+	0xa0, 0x73, 0x5d, 0xe4, 0x79, 0x7a, 0xc1, 0x23, 0x91, 0x6b, 0xf6, 0xde, 0x2b, 0xf4, 0x8f, 0x29,
+	0x54, 0x3b, 0x42, 0xad, 0x1d, 0x91, 0x6b, 0xfa, 0x84, 0x4c, 0xf7, 0x6c, 0x84, 0x84, 0xfc, 0x37,
+	0x26, 0x64, 0xa9, 0x6a, 0xa9, 0x54, 0xf1, 0xa9, 0x98, 0xd2, 0xfd, 0x02, 0x3b, 0xab, 0x45, 0x2a,
+	0x31, 0x49, 0x84, 0x75, 0xa1, 0x44, 0x87, 0xfb, 0xf3, 0x2a, 0xb6, 0x7b, 0x99, 0xbd, 0xef, 0x66,
+	0xb5, 0x40, 0xc1, 0xa1, 0x7c, 0x07, 0x09, 0xbb, 0x16, 0xb7, 0xa3, 0x87, 0x9b, 0xec, 0xdc, 0x96,
+	0xe6, 0x22, 0x8a, 0x64, 0x91, 0x99, 0xb2, 0x56, 0x34, 0x7b, 0x80, 0xad, 0x6b, 0x09, 0x59, 0x6e,
+	0x57, 0x6f, 0x39, 0x4e, 0xa8, 0x06, 0xac, 0x4e, 0x91, 0xa6, 0xf2, 0x0c, 0x2a, 0x35, 0x16, 0x5a,
+	0xc4, 0xba, 0xab, 0x4e, 0x8f, 0x07, 0x9d, 0xd0, 0x1e, 0x1e, 0x90, 0x72, 0xc4, 0xaf, 0x2c, 0xb9,
+	0xe1, 0x4e, 0xf4, 0x80, 0xf4, 0x16, 0xfa, 0x0d, 0x61, 0x43, 0xf4, 0x10, 0xbc, 0x87, 0x18, 0xbc,
+	0xd5, 0x6a, 0xf0, 0x76, 0x06, 0x0c, 0xf8, 0x18, 0xce, 0x45, 0x97, 0xca, 0xe9, 0x31, 0x99, 0xb4,
+	0x1d, 0xb4, 0x59, 0xa8, 0xcc, 0xf7, 0xa8, 0x47, 0x57, 0xaa, 0xca, 0xf1, 0x13, 0x80, 0xed, 0x42,
+	0x65, 0xae, 0x39, 0xdd, 0x27, 0x53, 0xa5, 0xd5, 0x18, 0x32, 0xd9, 0xd1, 0xec, 0x31, 0xfe, 0xbe,
+	0x09, 0x4f, 0xdb, 0x45, 0xa1, 0x6d, 0x48, 0x85, 0xc6, 0x91, 0x3b, 0x97, 0x51, 0x9b, 0xa7, 0x90,
+	0xb5, 0x4c, 0x9b, 0xfd, 0x8f, 0x6b, 0x48, 0x88, 0xec, 0x59, 0xe0, 0x19, 0xca, 0x6d, 0x13, 0xa9,
+	0xb0, 0xb5, 0x2d, 0x73, 0x91, 0x64, 0x10, 0xb3, 0x0f, 0x5c, 0xcb, 0xeb, 0x29, 0xe8, 0x86, 0x87,
+	0xec, 0x60, 0x93, 0x34, 0x23, 0x2e, 0x0a, 0x23, 0xf9, 0x89, 0x54, 0x76, 0xee, 0xc2, 0x62, 0xc9,
+	0x20, 0xd5, 0xec, 0xc3, 0xe1, 0xc1, 0xe6, 0xa0, 0x19, 0x6d, 0x15, 0x46, 0xee, 0x3b, 0xea, 0x8e,
+	0x63, 0x86, 0xc1, 0x26, 0xb9, 0x0c, 0xc4, 0x63, 0x6c, 0x68, 0x8d, 0x70, 0x3d, 0xf9, 0xc8, 0x35,
+	0x85, 0x7e, 0xcd, 0x70, 0x43, 0xf9, 0x90, 0xb0, 0xde, 0x7c, 0xcd, 0xed, 0x99, 0xd1, 0x12, 0x9a,
+	0xa7, 0x49, 0x27, 0x31, 0xec, 0x63, 0x37, 0xad, 0xa5, 0x61, 0x60, 0x3e, 0x14, 0xe7, 0x4f, 0x84,
+	0x7e, 0x66, 0x31, 0xfa, 0x7f, 0x64, 0x69, 0x40, 0x2f, 0x17, 0x17, 0xa9, 0x14, 0x31, 0xd7, 0xc9,
+	0xf7, 0xc0, 0x3e, 0x41, 0xd5, 0xf9, 0xaa, 0x6a, 0xdd, 0xe1, 0x47, 0xc9, 0xf7, 0x40, 0x3f, 0x25,
+	0x8b, 0xf6, 0xe4, 0x70, 0x37, 0x42, 0x4c, 0x93, 0xd0, 0x89, 0xe6, 0xb9, 0x4c, 0x32, 0xa3, 0xd9,
+	0xa7, 0xae, 0x63, 0x74, 0x92, 0x0c, 0x2f, 0x87, 0xfb, 0x00, 0xdb, 0x16, 0xae, 0x23, 0x4a, 0xbf,
+	0x25, 0xcb, 0xa0, 0xa2, 0xcd, 0x87, 0xdc, 0x48, 0x97, 0x58, 0xdb, 0xfc, 0x3a, 0x22, 0x83, 0xcc,
+	0x70, 0x7d, 0x26, 0x72, 0xb6, 0x89, 0x83, 0x30, 0xbb, 0xa4, 0x31, 0x60, 0xca, 0x7d, 0x24, 0x17,
+	0xd0, 0x88, 0x97, 0xd5, 0x83, 0x85, 0xa3, 0x33, 0x91, 0x7f, 0x7a, 0xfd, 0xf7, 0x7f, 0xab, 0x5d,
+	0x5b, 0xfd, 0xe7, 0x34, 0x19, 0x7f, 0xe2, 0x5e, 0x01, 0x8e, 0x8c, 0x30, 0x40, 0xdf, 0x23, 0x37,
+	0x70, 0xa7, 0x6b, 0xbc, 0x4e, 0x8f, 0x6d, 0xd2, 0xea, 0x0a, 0xee, 0xda, 0xdd, 0xf0, 0x0c, 0xba,
+	0x4f, 0x26, 0x3d, 0xc8, 0x33, 0x99, 0x45, 0xa0, 0xd9, 0x6b, 0x7e, 0x3c, 0xaf, 0xe8, 0x3c, 0x71,
+	0x7f, 0x7e, 0x89, 0x04, 0xef, 0xd6, 0x44, 0xab, 0x2a, 0xa4, 0x9b, 0xe4, 0xa6, 0xbf, 0x92, 0xb0,
+	0xd1, 0xda, 0xe8, 0xe0, 0xa2, 0xee, 0x44, 0xf6, 0x9a, 0x81, 0x48, 0xbf, 0x20, 0x53, 0xee, 0x4f,
+	0xdb, 0x54, 0x4f, 0x12, 0xd5, 0xb1, 0x37, 0x74, 0xab, 0x7b, 0xb7, 0xaa, 0x7b, 0xa8, 0xfd, 0x45,
+	0x66, 0xc7, 0x91, 0xbc, 0x95, 0xc9, 0x6e, 0x55, 0xa8, 0xe9, 0xff, 0x92, 0x9b, 0x7e, 0x48, 0x66,
+	0xaf, 0xa3, 0x91, 0xbe, 0x36, 0x19, 0x66, 0xe4, 0xe3, 0x73, 0x6c, 0xeb, 0xc1, 0x13, 0xaf, 0x41,
+	0x9f, 0x92, 0x49, 0xfc, 0xb3, 0xe7, 0xc8, 0x8d, 0x61, 0x1b, 0x87, 0xba, 0x15, 0x5c, 0xa8, 0xd8,
+	0x98, 0x40, 0xc5, 0xd2, 0x8d, 0x5d, 0x32, 0x56, 0xb9, 0xae, 0xb3, 0x9b, 0x68, 0x66, 0xf9, 0x32,
+	0x57, 0xca, 0xeb, 0x9d, 0x37, 0x44, 0xca, 0x1a, 0xd4, 0xf4, 0x05, 0x99, 0xa9, 0x14, 0x6d, 0xe9,
+	0xd4, 0x1b, 0x68, 0xed, 0xde, 0xe5, 0x4e, 0x0d, 0xda, 0x9b, 0x2e, 0xed, 0x95, 0xce, 0x6d, 0x91,
+	0xf1, 0xca, 0x8c, 0xa8, 0xd9, 0x2d, 0xb4, 0x37, 0x5f, 0xb5, 0xb7, 0xd5, 0xc3, 0xc3, 0x3d, 0xac,
+	0xaa, 0x42, 0xeb, 0x64, 0xc2, 0xcf, 0x79, 0xc0, 0x4f, 0xe1, 0x42, 0x33, 0x82, 0x36, 0xde, 0x19,
+	0xf0, 0xe9, 0x08, 0xcc, 0x73, 0x65, 0x43, 0x6b, 0x94, 0x30, 0x52, 0xf9, 0x37, 0x96, 0x60, 0x31,
+	0x58, 0xf8, 0x02, 0x2e, 0x6c, 0x05, 0x4e, 0xf5, 0x6f, 0x13, 0xcd, 0xc6, 0x6a, 0xa3, 0xaf, 0xb0,
+	0x31, 0x26, 0xaa, 0x1b, 0x03, 0x63, 0x56, 0x64, 0x2e, 0xa1, 0x31, 0x37, 0x4a, 0x64, 0xfa, 0xc4,
+	0x9e, 0x15, 0xe3, 0x68, 0x6b, 0xe5, 0xd2, 0x62, 0xf0, 0xa4, 0xe3, 0x73, 0x6f, 0x91, 0x96, 0x06,
+	0x02, 0xa4, 0x69, 0xa3, 0x2f, 0x15, 0x7e, 0xf6, 0xd2, 0x6c, 0x62, 0xb8, 0x50, 0xcb, 0x04, 0xf8,
+	0xf9, 0x7f, 0x28, 0x0f, 0x5e, 0xae, 0xe9, 0x6f, 0xc9, 0x8c, 0xb6, 0xab, 0x14, 0x69, 0x9f, 0xab,
+	0x93, 0x68, 0xf3, 0xdd, 0xbe, 0xe3, 0x3d, 0xd0, 0xfe, 0xbd, 0xcf, 0xa5, 0xa5, 0x9e, 0xcf, 0x87,
+	0x64, 0x4a, 0x41, 0x54, 0x28, 0x65, 0x27, 0x2e, 0x0d, 0x59, 0xac, 0xd9, 0xd4, 0x70, 0x18, 0x1a,
+	0x81, 0x72, 0x04, 0x59, 0x7c, 0x2c, 0xf7, 0x4c, 0x28, 0xe9, 0x49, 0x55, 0x45, 0xec, 0x65, 0x74,
+	0xa2, 0x0d, 0x69, 0xdc, 0xfb, 0xf1, 0xb7, 0x87, 0xeb, 0xe6, 0x29, 0xa4, 0x71, 0xff, 0xef, 0x1e,
+	0x6f, 0xf7, 0x44, 0x9a, 0x7e, 0x49, 0xa6, 0x4f, 0xa4, 0x3a, 0xe5, 0x7d, 0xf5, 0x37, 0x3d, 0xbc,
+	0xc9, 0xf6, 0xa5, 0x3a, 0x1d, 0xae, 0xc1, 0xdb, 0x27, 0xfd, 0x62, 0x4d, 0xbf, 0x22, 0xb3, 0xb2,
+	0xa9, 0x41, 0x75, 0xc1, 0x5f, 0xa6, 0x70, 0xf2, 0x06, 0xcd, 0xe8, 0x25, 0x3b, 0xce, 0x13, 0xf1,
+	0x46, 0x65, 0xe7, 0x6e, 0x6f, 0x75, 0x46, 0x0e, 0x02, 0xa0, 0xe9, 0x73, 0x42, 0x35, 0xa4, 0x27,
+	0xe1, 0xb2, 0x80, 0x07, 0x8c, 0x66, 0x33, 0xc3, 0x9e, 0x1e, 0x41, 0x7a, 0xe2, 0x6e, 0x0d, 0x78,
+	0xd0, 0x04, 0x4f, 0x75, 0xbf, 0x58, 0xd3, 0x97, 0x64, 0x3a, 0x57, 0x32, 0x97, 0x5a, 0xa4, 0xbc,
+	0x03, 0x46, 0xc4, 0xc2, 0xd8, 0xf7, 0x05, 0x6b, 0xef, 0xad, 0x4b, 0x9a, 0x6c, 0xdd, 0x73, 0x0f,
+	0x3d, 0x35, 0xd8, 0xcd, 0x07, 0xe4, 0xf4, 0x73, 0x72, 0x3b, 0x8c, 0xaa, 0xe1, 0x79, 0xc0, 0xbf,
+	0x3e, 0xf4, 0xf5, 0xee, 0xbd, 0xea, 0x38, 0x1b, 0xa6, 0xcd, 0xbe, 0x19, 0x17, 0xb4, 0xb5, 0x25,
+	0x54, 0xd4, 0x4e, 0xba, 0x15, 0x5b, 0x73, 0xaf, 0x68, 0x2b, 0x28, 0x06, 0x5b, 0xbf, 0x26, 0xb3,
+	0x39, 0x64, 0x31, 0x0e, 0xfb, 0x95, 0x79, 0x53, 0xb3, 0xf9, 0xe1, 0x12, 0xac, 0x3b, 0x62, 0x65,
+	0xea, 0x0c, 0xa9, 0xc9, 0x87, 0x10, 0x4d, 0x3f, 0x23, 0x93, 0x3e, 0x2b, 0xcd, 0x04, 0x51, 0x7c,
+	0x47, 0x18, 0xf0, 0xd1, 0xc5, 0x7e, 0xdb, 0x11, 0xc2, 0xdb, 0xad, 0xff, 0xaf, 0xad, 0x42, 0xd7,
+	0x6b, 0x72, 0x25, 0xbb, 0x90, 0x09, 0x3c, 0xf0, 0x16, 0x86, 0x73, 0x8b, 0xdd, 0xa6, 0x5e, 0x72,
+	0x42, 0x0e, 0x50, 0xb7, 0x27, 0xd6, 0x34, 0x25, 0x63, 0x76, 0x6e, 0x83, 0xd8, 0xce, 0x06, 0xe1,
+	0xa5, 0xe0, 0x3f, 0xbc, 0x6c, 0x3d, 0xb4, 0x76, 0xfe, 0xf4, 0xf7, 0x7b, 0x6b, 0xaf, 0x30, 0x29,
+	0x5a, 0x05, 0xdd, 0x20, 0xce, 0xfe, 0x3e, 0xe0, 0xef, 0xf7, 0xcf, 0x5d, 0x1c, 0xa7, 0x37, 0xb6,
+	0x34, 0xbc, 0x0d, 0xdd, 0xaf, 0x7f, 0x61, 0x61, 0xef, 0xf4, 0x58, 0xb3, 0x27, 0xb2, 0x13, 0x5e,
+	0xc8, 0xcd, 0xe0, 0x14, 0xa6, 0xd9, 0xdd, 0xe1, 0x09, 0xcf, 0xe7, 0xa7, 0x7f, 0xd0, 0x0b, 0x13,
+	0x5e, 0x7e, 0x19, 0x88, 0xf9, 0x2f, 0x9f, 0x9d, 0xec, 0xb3, 0x5a, 0x2e, 0xa2, 0x53, 0x61, 0xf3,
+	0xbf, 0x3c, 0x9c, 0xff, 0x97, 0xfe, 0x0d, 0x29, 0x15, 0x17, 0x75, 0x47, 0x0b, 0xf9, 0xef, 0x0e,
+	0x21, 0x7a, 0xf5, 0x8f, 0xa3, 0x64, 0xa2, 0x6f, 0x14, 0xa1, 0xeb, 0x64, 0x26, 0x15, 0x06, 0xb4,
+	0x09, 0x0f, 0x08, 0x38, 0xc3, 0xe0, 0xd8, 0x73, 0xbd, 0x31, 0xed, 0x20, 0xb7, 0x06, 0x2a, 0x38,
+	0xbe, 0x36, 0xbc, 0x6c, 0x1d, 0x8e, 0xff, 0x5a, 0xe0, 0x6b, 0x13, 0x7a, 0x85, 0xe3, 0x7f, 0x42,
+	0x16, 0x52, 0x11, 0x1e, 0xce, 0xca, 0x17, 0x7f, 0xaf, 0x35, 0xea, 0xa6, 0xbf, 0x54, 0xf8, 0xe7,
+	0xaf, 0xf0, 0xe8, 0xef, 0x54, 0x3f, 0x22, 0xac, 0x4f, 0xd5, 0xcd, 0x17, 0xd8, 0xaa, 0xf0, 0x3b,
+	0xc4, 0xf5, 0xc6, 0x6c, 0x45, 0xd3, 0xed, 0x28, 0x0b, 0xd2, 0xcf, 0xc8, 0x72, 0x9f, 0x62, 0xe5,
+	0xf4, 0x71, 0xda, 0xee, 0xab, 0xc4, 0x42, 0x45, 0xbb, 0x77, 0xf4, 0xa3, 0x85, 0x77, 0xc8, 0x14,
+	0x5a, 0x30, 0xe7, 0x3c, 0x97, 0x32, 0xb5, 0x5f, 0x32, 0xdc, 0xb7, 0x89, 0x71, 0x2b, 0x3e, 0x3e,
+	0xaf, 0x4b, 0x99, 0x1e, 0xc4, 0x74, 0x95, 0x4c, 0x20, 0xcd, 0x79, 0x96, 0xc4, 0xfe, 0x63, 0xc4,
+	0x98, 0x15, 0xa2, 0x3f, 0x07, 0x31, 0x7d, 0x4c, 0xf0, 0xf7, 0xf1, 0xfe, 0xe3, 0xc4, 0x92, 0xdd,
+	0x17, 0x08, 0x0c, 0x67, 0xdf, 0x41, 0x72, 0x10, 0x6f, 0x1f, 0xfe, 0xf0, 0xf3, 0xca, 0xc8, 0x8f,
+	0x3f, 0xaf, 0x8c, 0xfc, 0xe3, 0xe7, 0x95, 0x91, 0x3f, 0xfc, 0xb2, 0x72, 0xed, 0xc7, 0x5f, 0x56,
+	0xae, 0xfd, 0xe5, 0x97, 0x95, 0x6b, 0xdf, 0x3c, 0xae, 0xd4, 0xbd, 0xcc, 0x64, 0xe7, 0x02, 0x3f,
+	0x07, 0x45, 0x32, 0xdd, 0x10, 0x2a, 0xda, 0x70, 0x57, 0xc2, 0x8d, 0xf3, 0x8d, 0xf0, 0x6d, 0x09,
+	0x37, 0x42, 0xf3, 0x06, 0x92, 0x1e, 0xff, 0x6b, 0x00, 0xfd, 0xc7, 0x9f, 0xc2, 0xf6, 0x1a, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -564,6 +1291,75 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinChainFeeBasisPoints != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinChainFeeBasisPoints))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.LogicCallMaxPayloadSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LogicCallMaxPayloadSize))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.LogicCallMaxGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LogicCallMaxGasLimit))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.IbcAutoForwardTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IbcAutoForwardTimeout))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.IbcAutoForwardChannels) > 0 {
+		for iNdEx := len(m.IbcAutoForwardChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcAutoForwardChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.UsageEpochsRetained != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UsageEpochsRetained))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.UsageEpochLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UsageEpochLength))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.FeeBurnDenoms) > 0 {
+		for iNdEx := len(m.FeeBurnDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeBurnDenoms[iNdEx])
+			copy(dAtA[i:], m.FeeBurnDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeBurnDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	{
 		size, err := m.Erc20ToDenomPermanentSwap.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -576,92 +1372,391 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0x3
 	i--
 	dAtA[i] = 0x92
-	if len(m.EthereumBlacklist) > 0 {
-		for iNdEx := len(m.EthereumBlacklist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EthereumBlacklist[iNdEx])
-			copy(dAtA[i:], m.EthereumBlacklist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumBlacklist[iNdEx])))
+	{
+		size := m.FeeBurnShare.Size()
+		i -= size
+		if _, err := m.FeeBurnShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x8a
+	if m.ContractReceiverPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractReceiverPolicy))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ContractReceivers) > 0 {
+		for iNdEx := len(m.ContractReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractReceivers[iNdEx])
+			copy(dAtA[i:], m.ContractReceivers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractReceivers[iNdEx])))
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xfa
 		}
 	}
-	if m.BridgeActive {
+	if len(m.AllowedReceiverModules) > 0 {
+		for iNdEx := len(m.AllowedReceiverModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedReceiverModules[iNdEx])
+			copy(dAtA[i:], m.AllowedReceiverModules[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedReceiverModules[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.BlockModuleAccountReceivers {
 		i--
-		if m.BridgeActive {
+		if m.BlockModuleAccountReceivers {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0xe8
 	}
-	{
-		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.CriticalParamChangeDelay != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CriticalParamChangeDelay))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.SupplyCapPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SupplyCapPolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.TokenSupplyCaps) > 0 {
+		for iNdEx := len(m.TokenSupplyCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenSupplyCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
+	if len(m.WethContract) > 0 {
+		i -= len(m.WethContract)
+		copy(dAtA[i:], m.WethContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WethContract)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.ExecutedBatchRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutedBatchRetention))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
 	{
-		size := m.SlashFractionBadEthSignature.Size()
+		size := m.ExpeditedQuorum.Size()
 		i -= size
-		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ExpeditedQuorum.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x2
 	i--
-	dAtA[i] = 0x82
-	if m.UnbondSlashingValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+	dAtA[i] = 0xba
+	if m.ExpeditedVotingPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExpeditedVotingPeriod))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
 	}
-	{
-		size := m.SlashFractionLogicCall.Size()
-		i -= size
-		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.InvalidReceiverPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InvalidReceiverPolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.WithdrawalPausedTokens) > 0 {
+		for iNdEx := len(m.WithdrawalPausedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WithdrawalPausedTokens[iNdEx])
+			copy(dAtA[i:], m.WithdrawalPausedTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawalPausedTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x72
+	if len(m.DepositPausedTokens) > 0 {
+		for iNdEx := len(m.DepositPausedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DepositPausedTokens[iNdEx])
+			copy(dAtA[i:], m.DepositPausedTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DepositPausedTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.ClaimHashVersionEthereumHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ClaimHashVersionEthereumHeight))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ClaimHashVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ClaimHashVersion))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MinBridgeValidators != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinBridgeValidators))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
 	{
-		size := m.SlashFractionBatch.Size()
+		size := m.MaxValsetPowerShare.Size()
 		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxValsetPowerShare.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x6a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	if len(m.SyntheticDelegationModules) > 0 {
+		for iNdEx := len(m.SyntheticDelegationModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SyntheticDelegationModules[iNdEx])
+			copy(dAtA[i:], m.SyntheticDelegationModules[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SyntheticDelegationModules[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
 	{
-		size := m.SlashFractionValset.Size()
+		size := m.RelayerFeeShare.Size()
 		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RelayerFeeShare.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x62
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.AttestationCatchUpLag != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationCatchUpLag))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.UpgradeGuardBlocksUpgrades {
+		i--
+		if m.UpgradeGuardBlocksUpgrades {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.UpgradeGuardDepositThresholds) > 0 {
+		for iNdEx := len(m.UpgradeGuardDepositThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeGuardDepositThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if m.EscalateBatchSigningPenalties {
+		i--
+		if m.EscalateBatchSigningPenalties {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.DowntimeOverlapPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DowntimeOverlapPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.ValsetRequestSlashPowerThreshold.Size()
+		i -= size
+		if _, err := m.ValsetRequestSlashPowerThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.MaxOutgoingBatchesPerToken != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOutgoingBatchesPerToken))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.BridgeFeeExchangeRates) > 0 {
+		for iNdEx := len(m.BridgeFeeExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFeeExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.BatchRelayLatencySla != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchRelayLatencySla))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.EthereumBlacklist) > 0 {
+		for iNdEx := len(m.EthereumBlacklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EthereumBlacklist[iNdEx])
+			copy(dAtA[i:], m.EthereumBlacklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumBlacklist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.BridgeActive {
+		i--
+		if m.BridgeActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.SlashFractionBadEthSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.UnbondSlashingValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.SlashFractionLogicCall.Size()
+		i -= size
+		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x58
 	}
 	if m.AverageBlockTime != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
@@ -737,10 +1832,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbatchedTransfers) > 0 {
-		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ValsetRelayPackages) > 0 {
+		for iNdEx := len(m.ValsetRelayPackages) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ValsetRelayPackages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -748,13 +1843,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingIbcAutoForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -762,13 +1859,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BridgeUsage) > 0 {
+		for iNdEx := len(m.BridgeUsage) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BridgeUsage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -776,13 +1875,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -790,13 +1891,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Erc20Provenances) > 0 {
+		for iNdEx := len(m.Erc20Provenances) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Erc20Provenances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -804,13 +1907,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.LogicCalls) > 0 {
-		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+	if m.BridgeBinding != nil {
+		{
+			size, err := m.BridgeBinding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.PendingParamChanges) > 0 {
+		for iNdEx := len(m.PendingParamChanges) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingParamChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -818,13 +1937,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.BatchConfirms) > 0 {
-		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ArchivedBatches) > 0 {
+		for iNdEx := len(m.ArchivedBatches) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ArchivedBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -832,13 +1953,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ExecutedBatches) > 0 {
+		for iNdEx := len(m.ExecutedBatches) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ExecutedBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -846,13 +1969,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ProposalMetadata) > 0 {
+		for iNdEx := len(m.ProposalMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ProposalMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -860,13 +1985,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.Valsets) > 0 {
-		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SelfBridgeLimits) > 0 {
+		for iNdEx := len(m.SelfBridgeLimits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SelfBridgeLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -874,35 +2001,267 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.GravityNonces.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ObservedBlockHashes) > 0 {
+		for iNdEx := len(m.ObservedBlockHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservedBlockHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
+	if len(m.ForkAttestations) > 0 {
+		for iNdEx := len(m.ForkAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForkAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.HeldDeposits) > 0 {
+		for iNdEx := len(m.HeldDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RecurringSends) > 0 {
+		for iNdEx := len(m.RecurringSends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringSends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ScheduledTransfers) > 0 {
+		for iNdEx := len(m.ScheduledTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.LogicCallDeposits) > 0 {
+		for iNdEx := len(m.LogicCallDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.GravityNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -922,6 +2281,11 @@ func (m *GravityNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastRecurringSendId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRecurringSendId))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.LastBatchId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchId))
 		i--
@@ -1032,39 +2396,179 @@ func (m *Params) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	l = m.Erc20ToDenomPermanentSwap.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.BatchRelayLatencySla != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchRelayLatencySla))
 	}
-	l = m.GravityNonces.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
+	if len(m.BridgeFeeExchangeRates) > 0 {
+		for _, e := range m.BridgeFeeExchangeRates {
 			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for _, e := range m.ValsetConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.MaxOutgoingBatchesPerToken != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxOutgoingBatchesPerToken))
 	}
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
+	l = m.ValsetRequestSlashPowerThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.DowntimeOverlapPolicy != 0 {
+		n += 2 + sovGenesis(uint64(m.DowntimeOverlapPolicy))
+	}
+	if m.EscalateBatchSigningPenalties {
+		n += 3
+	}
+	if len(m.UpgradeGuardDepositThresholds) > 0 {
+		for _, e := range m.UpgradeGuardDepositThresholds {
 			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.UpgradeGuardBlocksUpgrades {
+		n += 3
+	}
+	if m.AttestationCatchUpLag != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationCatchUpLag))
+	}
+	l = m.RelayerFeeShare.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.SyntheticDelegationModules) > 0 {
+		for _, s := range m.SyntheticDelegationModules {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MaxValsetPowerShare.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.MinBridgeValidators != 0 {
+		n += 2 + sovGenesis(uint64(m.MinBridgeValidators))
+	}
+	if m.ClaimHashVersion != 0 {
+		n += 2 + sovGenesis(uint64(m.ClaimHashVersion))
+	}
+	if m.ClaimHashVersionEthereumHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.ClaimHashVersionEthereumHeight))
+	}
+	if len(m.DepositPausedTokens) > 0 {
+		for _, s := range m.DepositPausedTokens {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawalPausedTokens) > 0 {
+		for _, s := range m.WithdrawalPausedTokens {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.InvalidReceiverPolicy != 0 {
+		n += 2 + sovGenesis(uint64(m.InvalidReceiverPolicy))
+	}
+	if m.ExpeditedVotingPeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.ExpeditedVotingPeriod))
+	}
+	l = m.ExpeditedQuorum.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ExecutedBatchRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.ExecutedBatchRetention))
+	}
+	l = len(m.WethContract)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TokenSupplyCaps) > 0 {
+		for _, e := range m.TokenSupplyCaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SupplyCapPolicy != 0 {
+		n += 2 + sovGenesis(uint64(m.SupplyCapPolicy))
+	}
+	if m.CriticalParamChangeDelay != 0 {
+		n += 2 + sovGenesis(uint64(m.CriticalParamChangeDelay))
+	}
+	if m.BlockModuleAccountReceivers {
+		n += 3
+	}
+	if len(m.AllowedReceiverModules) > 0 {
+		for _, s := range m.AllowedReceiverModules {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractReceivers) > 0 {
+		for _, s := range m.ContractReceivers {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ContractReceiverPolicy != 0 {
+		n += 2 + sovGenesis(uint64(m.ContractReceiverPolicy))
+	}
+	l = m.FeeBurnShare.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.Erc20ToDenomPermanentSwap.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.FeeBurnDenoms) > 0 {
+		for _, s := range m.FeeBurnDenoms {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.UsageEpochLength != 0 {
+		n += 2 + sovGenesis(uint64(m.UsageEpochLength))
+	}
+	if m.UsageEpochsRetained != 0 {
+		n += 2 + sovGenesis(uint64(m.UsageEpochsRetained))
+	}
+	if len(m.IbcAutoForwardChannels) > 0 {
+		for _, e := range m.IbcAutoForwardChannels {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.IbcAutoForwardTimeout != 0 {
+		n += 2 + sovGenesis(uint64(m.IbcAutoForwardTimeout))
+	}
+	if m.LogicCallMaxGasLimit != 0 {
+		n += 2 + sovGenesis(uint64(m.LogicCallMaxGasLimit))
+	}
+	if m.LogicCallMaxPayloadSize != 0 {
+		n += 2 + sovGenesis(uint64(m.LogicCallMaxPayloadSize))
+	}
+	if m.MinChainFeeBasisPoints != 0 {
+		n += 2 + sovGenesis(uint64(m.MinChainFeeBasisPoints))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.GravityNonces.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
 	if len(m.BatchConfirms) > 0 {
@@ -1109,46 +2613,1643 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.LogicCallDeposits) > 0 {
+		for _, e := range m.LogicCallDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledTransfers) > 0 {
+		for _, e := range m.ScheduledTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecurringSends) > 0 {
+		for _, e := range m.RecurringSends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeldDeposits) > 0 {
+		for _, e := range m.HeldDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForkAttestations) > 0 {
+		for _, e := range m.ForkAttestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservedBlockHashes) > 0 {
+		for _, e := range m.ObservedBlockHashes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SelfBridgeLimits) > 0 {
+		for _, e := range m.SelfBridgeLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalMetadata) > 0 {
+		for _, e := range m.ProposalMetadata {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedBatches) > 0 {
+		for _, e := range m.ExecutedBatches {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedBatches) > 0 {
+		for _, e := range m.ArchivedBatches {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingParamChanges) > 0 {
+		for _, e := range m.PendingParamChanges {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgeBinding != nil {
+		l = m.BridgeBinding.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Erc20Provenances) > 0 {
+		for _, e := range m.Erc20Provenances {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeUsage) > 0 {
+		for _, e := range m.BridgeUsage {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for _, e := range m.PendingIbcAutoForwards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetRelayPackages) > 0 {
+		for _, e := range m.ValsetRelayPackages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GravityNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestValsetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestValsetNonce))
+	}
+	if m.LastObservedNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedValsetNonce))
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedBatchBlock))
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	}
+	if m.LastTxPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
+	}
+	if m.LastBatchId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBatchId))
+	}
+	if m.LastRecurringSendId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRecurringSendId))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			}
+			m.TargetBatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeActive = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRelayLatencySla", wireType)
+			}
+			m.BatchRelayLatencySla = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchRelayLatencySla |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFeeExchangeRates = append(m.BridgeFeeExchangeRates, BridgeFeeExchangeRate{})
+			if err := m.BridgeFeeExchangeRates[len(m.BridgeFeeExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutgoingBatchesPerToken", wireType)
+			}
+			m.MaxOutgoingBatchesPerToken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutgoingBatchesPerToken |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetRequestSlashPowerThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetRequestSlashPowerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOverlapPolicy", wireType)
+			}
+			m.DowntimeOverlapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeOverlapPolicy |= DowntimeOverlapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscalateBatchSigningPenalties", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EscalateBatchSigningPenalties = bool(v != 0)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeGuardDepositThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeGuardDepositThresholds = append(m.UpgradeGuardDepositThresholds, ERC20Token{})
+			if err := m.UpgradeGuardDepositThresholds[len(m.UpgradeGuardDepositThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeGuardBlocksUpgrades", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpgradeGuardBlocksUpgrades = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationCatchUpLag", wireType)
+			}
+			m.AttestationCatchUpLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationCatchUpLag |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticDelegationModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyntheticDelegationModules = append(m.SyntheticDelegationModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValsetPowerShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValsetPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBridgeValidators", wireType)
+			}
+			m.MinBridgeValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBridgeValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHashVersion", wireType)
+			}
+			m.ClaimHashVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimHashVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHashVersionEthereumHeight", wireType)
+			}
+			m.ClaimHashVersionEthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimHashVersionEthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPausedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositPausedTokens = append(m.DepositPausedTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalPausedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalPausedTokens = append(m.WithdrawalPausedTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReceiverPolicy", wireType)
+			}
+			m.InvalidReceiverPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidReceiverPolicy |= InvalidReceiverPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			m.ExpeditedVotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpeditedVotingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedQuorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatchRetention", wireType)
+			}
+			m.ExecutedBatchRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedBatchRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WethContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WethContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSupplyCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSupplyCaps = append(m.TokenSupplyCaps, ERC20Token{})
+			if err := m.TokenSupplyCaps[len(m.TokenSupplyCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCapPolicy", wireType)
+			}
+			m.SupplyCapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyCapPolicy |= SupplyCapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalParamChangeDelay", wireType)
+			}
+			m.CriticalParamChangeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CriticalParamChangeDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockModuleAccountReceivers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockModuleAccountReceivers = bool(v != 0)
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedReceiverModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedReceiverModules = append(m.AllowedReceiverModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractReceivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractReceivers = append(m.ContractReceivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractReceiverPolicy", wireType)
+			}
+			m.ContractReceiverPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractReceiverPolicy |= ContractReceiverPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenomPermanentSwap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20ToDenomPermanentSwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeBurnDenoms = append(m.FeeBurnDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageEpochLength", wireType)
+			}
+			m.UsageEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageEpochsRetained", wireType)
+			}
+			m.UsageEpochsRetained = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageEpochsRetained |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAutoForwardChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcAutoForwardChannels = append(m.IbcAutoForwardChannels, IbcAutoForwardChannel{})
+			if err := m.IbcAutoForwardChannels[len(m.IbcAutoForwardChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAutoForwardTimeout", wireType)
+			}
+			m.IbcAutoForwardTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcAutoForwardTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallMaxGasLimit", wireType)
+			}
+			m.LogicCallMaxGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogicCallMaxGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallMaxPayloadSize", wireType)
+			}
+			m.LogicCallMaxPayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogicCallMaxPayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinChainFeeBasisPoints", wireType)
+			}
+			m.MinChainFeeBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinChainFeeBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GravityNonces) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LatestValsetNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LatestValsetNonce))
-	}
-	if m.LastObservedNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
-	}
-	if m.LastSlashedValsetNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedValsetNonce))
-	}
-	if m.LastSlashedBatchBlock != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedBatchBlock))
-	}
-	if m.LastSlashedLogicCallBlock != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
-	}
-	if m.LastTxPoolId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
-	}
-	if m.LastBatchId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastBatchId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1171,17 +4272,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1191,29 +4292,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1223,29 +4328,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1255,29 +4361,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1287,54 +4395,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedValsetsWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SignedBatchesWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			m.SignedLogicCallsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1344,16 +4429,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.TargetBatchTimeout = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1363,16 +4463,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1382,16 +4497,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1401,16 +4531,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1420,30 +4565,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1453,30 +4599,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1486,30 +4633,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
 			}
-			m.UnbondSlashingValsetsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1519,16 +4667,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallDeposits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1538,28 +4701,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogicCallDeposits = append(m.LogicCallDeposits, LogicCallDeposit{})
+			if err := m.LogicCallDeposits[len(m.LogicCallDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1586,15 +4750,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledTransfers = append(m.ScheduledTransfers, ScheduledOutgoingTransferTx{})
+			if err := m.ScheduledTransfers[len(m.ScheduledTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringSends", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1604,17 +4769,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeActive = bool(v != 0)
-		case 19:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringSends = append(m.RecurringSends, RecurringSendToEth{})
+			if err := m.RecurringSends[len(m.RecurringSends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldDeposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1624,27 +4803,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			m.HeldDeposits = append(m.HeldDeposits, HeldDeposit{})
+			if err := m.HeldDeposits[len(m.HeldDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 50:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenomPermanentSwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkAttestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1671,63 +4852,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20ToDenomPermanentSwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForkAttestations = append(m.ForkAttestations, ForkAttestation{})
+			if err := m.ForkAttestations[len(m.ForkAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedBlockHashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1754,16 +4886,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ObservedBlockHashes = append(m.ObservedBlockHashes, ObservedBlockHash{})
+			if err := m.ObservedBlockHashes[len(m.ObservedBlockHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBridgeLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1790,13 +4920,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SelfBridgeLimits = append(m.SelfBridgeLimits, SelfBridgeLimit{})
+			if err := m.SelfBridgeLimits[len(m.SelfBridgeLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1823,14 +4954,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProposalMetadata = append(m.ProposalMetadata, GravityProposalMetadata{})
+			if err := m.ProposalMetadata[len(m.ProposalMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1857,14 +4988,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExecutedBatches = append(m.ExecutedBatches, ExecutedBatch{})
+			if err := m.ExecutedBatches[len(m.ExecutedBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedBatches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1891,14 +5022,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ArchivedBatches = append(m.ArchivedBatches, ExecutedBatch{})
+			if err := m.ArchivedBatches[len(m.ArchivedBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingParamChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1925,14 +5056,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingParamChanges = append(m.PendingParamChanges, PendingParamChange{})
+			if err := m.PendingParamChanges[len(m.PendingParamChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeBinding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1959,14 +5090,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BridgeBinding == nil {
+				m.BridgeBinding = &BridgeBinding{}
+			}
+			if err := m.BridgeBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Provenances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1993,14 +5126,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20Provenances = append(m.Erc20Provenances, ERC20Provenance{})
+			if err := m.Erc20Provenances[len(m.Erc20Provenances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2027,14 +5160,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeUsage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2061,14 +5194,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeUsage = append(m.BridgeUsage, BridgeUsage{})
+			if err := m.BridgeUsage[len(m.BridgeUsage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2095,14 +5228,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, PendingIbcAutoForward{})
+			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetRelayPackages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2129,8 +5262,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValsetRelayPackages = append(m.ValsetRelayPackages, ValsetRelayPackage{})
+			if err := m.ValsetRelayPackages[len(m.ValsetRelayPackages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2317,6 +5450,25 @@ func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecurringSendId", wireType)
+			}
+			m.LastRecurringSendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecurringSendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -46,6 +46,7 @@ func TestGenesisStateValidate(t *testing.T) {
 			DelegateKeys:       []MsgSetOrchestratorAddress{},
 			Erc20ToDenoms:      []ERC20ToDenom{},
 			UnbatchedTransfers: []OutgoingTransferTx{},
+			LogicCallDeposits:  []LogicCallDeposit{},
 		}, expErr: true},
 		"invalid params": {src: &GenesisState{
 			Params: &Params{
@@ -84,6 +85,7 @@ func TestGenesisStateValidate(t *testing.T) {
 			DelegateKeys:       []MsgSetOrchestratorAddress{},
 			Erc20ToDenoms:      []ERC20ToDenom{},
 			UnbatchedTransfers: []OutgoingTransferTx{},
+			LogicCallDeposits:  []LogicCallDeposit{},
 		}, expErr: true},
 	}
 	for msg, spec := range specs {
@@ -2,16 +2,25 @@ package types
 
 import (
 	fmt "fmt"
+	"math"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
 )
 
 const (
 	ProposalTypeUnhaltBridge = "UnhaltBridge"
 	ProposalTypeAirdrop      = "Airdrop"
 	ProposalTypeIBCMetadata  = "IBCMetadata"
+
+	ProposalTypeRecoverStrandedFunds = "RecoverStrandedFunds"
+	ProposalTypeEmergencyValset      = "EmergencyValset"
+	ProposalTypeReleaseHeldDeposits  = "ReleaseHeldDeposits"
+	ProposalTypeRefundHeldDeposits   = "RefundHeldDeposits"
 )
 
 func (p *UnhaltBridgeProposal) GetTitle() string { return p.Title }
@@ -29,6 +38,9 @@ func (p *UnhaltBridgeProposal) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
+	if p.TargetNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "target nonce must be positive")
+	}
 	return nil
 }
 
@@ -87,6 +99,47 @@ func (p AirdropProposal) String() string {
 	return b.String()
 }
 
+// NewIBCMetadataProposal builds the proposal setting the metadata of the IBC token with the given denom trace, whose
+// display unit is the lowercase symbol with the given decimals. The base unit is the IBC denom, aliased by the denom
+// on the chain of origin, as the metadata is only accepted by HandleIBCMetadataProposal when it is indexed by it
+func NewIBCMetadataProposal(trace ibctransfertypes.DenomTrace, name string, symbol string, decimals uint32) (*IBCMetadataProposal, error) {
+	if err := trace.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(err, "denom trace")
+	}
+	if trace.Path == "" {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "%s is not an IBC token", trace.BaseDenom)
+	}
+	ibcDenom := trace.IBCDenom()
+	display := strings.ToLower(symbol)
+	units := []*banktypes.DenomUnit{{Denom: ibcDenom, Exponent: 0, Aliases: []string{trace.BaseDenom}}}
+	if decimals == 0 {
+		display = ibcDenom
+	} else {
+		units = append(units, &banktypes.DenomUnit{Denom: display, Exponent: decimals, Aliases: []string{}})
+	}
+
+	p := &IBCMetadataProposal{
+		Title:       fmt.Sprintf("Set the metadata of %s", symbol),
+		Description: fmt.Sprintf("Sets the metadata of %s, the IBC token %s, so that its ERC20 can be deployed", ibcDenom, trace.GetFullDenomPath()),
+		Metadata: banktypes.Metadata{
+			Description: fmt.Sprintf("%s transferred over IBC from %s", name, trace.GetFullDenomPath()),
+			DenomUnits:  units,
+			Base:        ibcDenom,
+			Display:     display,
+			Name:        name,
+			Symbol:      symbol,
+		},
+		IbcDenom: ibcDenom,
+	}
+	if err := p.Metadata.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(err, "metadata")
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 func (p *IBCMetadataProposal) GetTitle() string { return p.Title }
 
 func (p *IBCMetadataProposal) GetDescription() string { return p.Description }
@@ -126,3 +179,162 @@ func (p IBCMetadataProposal) String() string {
 `, p.Title, p.Description, p.Metadata.Name, p.Metadata.Symbol, p.Metadata.Display, decimals, p.Metadata.Description))
 	return b.String()
 }
+
+func (p *RecoverStrandedFundsProposal) GetTitle() string { return p.Title }
+
+func (p *RecoverStrandedFundsProposal) GetDescription() string { return p.Description }
+
+func (p *RecoverStrandedFundsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RecoverStrandedFundsProposal) ProposalType() string {
+	return ProposalTypeRecoverStrandedFunds
+}
+
+func (p *RecoverStrandedFundsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if !p.Amount.IsValid() || p.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, p.Amount.String())
+	}
+	return nil
+}
+
+func (p RecoverStrandedFundsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Recover Stranded Funds Proposal:
+  Title:          %s
+  Description:    %s
+  Amount:         %s
+`, p.Title, p.Description, p.Amount))
+	return b.String()
+}
+
+func (p *EmergencyValsetProposal) GetTitle() string { return p.Title }
+
+func (p *EmergencyValsetProposal) GetDescription() string { return p.Description }
+
+func (p *EmergencyValsetProposal) ProposalRoute() string { return RouterKey }
+
+func (p *EmergencyValsetProposal) ProposalType() string {
+	return ProposalTypeEmergencyValset
+}
+
+// ValidateBasic checks the members form a valset the Gravity contract can be updated to and later updated from: valid,
+// distinct and non zero Ethereum addresses whose powers sum to more than the contract power threshold out of 2^32
+func (p *EmergencyValsetProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	members, err := BridgeValidators(p.Members).ToInternal()
+	if err != nil {
+		return sdkerrors.Wrap(err, "members")
+	}
+	if err := members.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "members")
+	}
+	totalPower := uint64(0)
+	for i, member := range *members {
+		if member.EthereumAddress == ZeroAddress() {
+			return sdkerrors.Wrapf(ErrInvalid, "member %d has the zero address", i)
+		}
+		if member.Power > math.MaxUint32 {
+			return sdkerrors.Wrapf(ErrInvalid, "member %d power %d exceeds 2^32", i, member.Power)
+		}
+		totalPower += member.Power
+	}
+	if totalPower > math.MaxUint32 {
+		return sdkerrors.Wrapf(ErrInvalid, "total power %d exceeds 2^32", totalPower)
+	}
+	if totalPower <= EthereumSignaturePowerThreshold {
+		return sdkerrors.Wrapf(ErrInvalid, "total power %d does not exceed the contract threshold %d",
+			totalPower, EthereumSignaturePowerThreshold)
+	}
+	return nil
+}
+
+func (p EmergencyValsetProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Emergency Valset Proposal:
+  Title:          %s
+  Description:    %s
+  Members:
+`, p.Title, p.Description))
+	for _, member := range p.Members {
+		b.WriteString(fmt.Sprintf("    %s: %d\n", member.EthereumAddress, member.Power))
+	}
+	return b.String()
+}
+
+func (p *ReleaseHeldDepositsProposal) GetTitle() string { return p.Title }
+
+func (p *ReleaseHeldDepositsProposal) GetDescription() string { return p.Description }
+
+func (p *ReleaseHeldDepositsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ReleaseHeldDepositsProposal) ProposalType() string {
+	return ProposalTypeReleaseHeldDeposits
+}
+
+func (p *ReleaseHeldDepositsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return validateHeldDepositNonces(p.EventNonces)
+}
+
+func (p ReleaseHeldDepositsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Release Held Deposits Proposal:
+  Title:          %s
+  Description:    %s
+  Event Nonces:   %v
+`, p.Title, p.Description, p.EventNonces))
+	return b.String()
+}
+
+func (p *RefundHeldDepositsProposal) GetTitle() string { return p.Title }
+
+func (p *RefundHeldDepositsProposal) GetDescription() string { return p.Description }
+
+func (p *RefundHeldDepositsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RefundHeldDepositsProposal) ProposalType() string {
+	return ProposalTypeRefundHeldDeposits
+}
+
+func (p *RefundHeldDepositsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return validateHeldDepositNonces(p.EventNonces)
+}
+
+func (p RefundHeldDepositsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Refund Held Deposits Proposal:
+  Title:          %s
+  Description:    %s
+  Event Nonces:   %v
+`, p.Title, p.Description, p.EventNonces))
+	return b.String()
+}
+
+// validateHeldDepositNonces checks the event nonces of a held deposits proposal are non empty and distinct
+func validateHeldDepositNonces(nonces []uint64) error {
+	if len(nonces) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "event nonces")
+	}
+	seen := make(map[uint64]bool, len(nonces))
+	for _, nonce := range nonces {
+		if seen[nonce] {
+			return sdkerrors.Wrapf(ErrDuplicate, "event nonce %d", nonce)
+		}
+		seen[nonce] = true
+	}
+	return nil
+}
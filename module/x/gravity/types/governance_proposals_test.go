@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIBCMetadataProposal(t *testing.T) {
+	trace := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	ibcDenom := trace.IBCDenom()
+
+	p, err := NewIBCMetadataProposal(trace, "Cosmos Hub Atom", "ATOM", 6)
+	require.NoError(t, err)
+	assert.Equal(t, ibcDenom, p.IbcDenom)
+	assert.Equal(t, ibcDenom, p.Metadata.Base)
+	assert.Equal(t, "atom", p.Metadata.Display)
+	require.Len(t, p.Metadata.DenomUnits, 2)
+	assert.Equal(t, []string{"uatom"}, p.Metadata.DenomUnits[0].Aliases)
+	assert.Equal(t, uint32(6), p.Metadata.DenomUnits[1].Exponent)
+
+	// without decimals the base unit is displayed
+	p, err = NewIBCMetadataProposal(trace, "Cosmos Hub Atom", "ATOM", 0)
+	require.NoError(t, err)
+	assert.Equal(t, ibcDenom, p.Metadata.Display)
+	require.Len(t, p.Metadata.DenomUnits, 1)
+
+	// native tokens have no IBC denom
+	_, err = NewIBCMetadataProposal(ibctransfertypes.DenomTrace{BaseDenom: "stake"}, "Stake", "STAKE", 6)
+	require.Error(t, err)
+	_, err = NewIBCMetadataProposal(trace, "", "ATOM", 6)
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// msgsProtoFile is the registered name of the proto file declaring the Msg service
+const msgsProtoFile = "gravity/v1/msgs.proto"
+
+// MsgDescriptors returns the descriptors of the gravity Msg types in the order of the Msg service. They are read from
+// the registered descriptor of msgs.proto, so they always match the generated types
+func MsgDescriptors() ([]MsgDescriptor, error) {
+	file, err := registeredFileDescriptor(msgsProtoFile)
+	if err != nil {
+		return nil, err
+	}
+	messages := make(map[string]*descriptorpb.DescriptorProto, len(file.MessageType))
+	for _, message := range file.MessageType {
+		messages["."+file.GetPackage()+"."+message.GetName()] = message
+	}
+
+	var descriptors []MsgDescriptor
+	for _, service := range file.Service {
+		if service.GetName() != "Msg" {
+			continue
+		}
+		for _, method := range service.Method {
+			message, found := messages[method.GetInputType()]
+			if !found {
+				return nil, sdkerrors.Wrapf(ErrInvalid, "unknown input type %s of %s", method.GetInputType(), method.GetName())
+			}
+			descriptor := MsgDescriptor{
+				TypeUrl: "/" + strings.TrimPrefix(method.GetInputType(), "."),
+				Fields:  make([]FieldDescriptor, len(message.Field)),
+			}
+			for i, field := range message.Field {
+				descriptor.Fields[i] = FieldDescriptor{
+					Name:     field.GetName(),
+					Type:     fieldType(field),
+					Repeated: field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED,
+					Rules:    fieldRules(field),
+				}
+			}
+			descriptors = append(descriptors, descriptor)
+		}
+	}
+	return descriptors, nil
+}
+
+// registeredFileDescriptor decodes the gzipped descriptor of a proto file registered by the generated code
+func registeredFileDescriptor(name string) (*descriptorpb.FileDescriptorProto, error) {
+	gz := gogoproto.FileDescriptor(name)
+	if gz == nil {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "proto file %s is not registered", name)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "decompressing file descriptor")
+	}
+	bz, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "decompressing file descriptor")
+	}
+	var file descriptorpb.FileDescriptorProto
+	if err := protov2.Unmarshal(bz, &file); err != nil {
+		return nil, sdkerrors.Wrap(err, "decoding file descriptor")
+	}
+	return &file, nil
+}
+
+// fieldType returns the scalar type of a field, e.g. uint64, or the full name of its message or enum type
+func fieldType(field *descriptorpb.FieldDescriptorProto) string {
+	if field.GetTypeName() != "" {
+		return strings.TrimPrefix(field.GetTypeName(), ".")
+	}
+	return strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+}
+
+// fieldRules returns the rules of the validation option of a field. The option is only registered with the gogo
+// registry, so it is left among the unknown fields of the decoded options and is read from there
+func fieldRules(field *descriptorpb.FieldDescriptorProto) []string {
+	if field.Options == nil {
+		return nil
+	}
+	unknown := field.Options.ProtoReflect().GetUnknown()
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return nil
+		}
+		unknown = unknown[n:]
+		if num == protowire.Number(E_Validation.Field) && typ == protowire.BytesType {
+			value, m := protowire.ConsumeBytes(unknown)
+			if m < 0 || len(value) == 0 {
+				return nil
+			}
+			return strings.Split(string(value), ",")
+		}
+		m := protowire.ConsumeFieldValue(num, typ, unknown)
+		if m < 0 {
+			return nil
+		}
+		unknown = unknown[m:]
+	}
+	return nil
+}
@@ -0,0 +1,728 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gravity/v1/introspection.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgDescriptor describes a gravity Msg type
+// TYPE_URL:
+// the type URL of the Msg packed in a transaction, e.g. /gravity.v1.MsgSendToEth
+// FIELDS:
+// the fields of the Msg in declaration order
+type MsgDescriptor struct {
+	TypeUrl string            `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Fields  []FieldDescriptor `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields"`
+}
+
+func (m *MsgDescriptor) Reset()         { *m = MsgDescriptor{} }
+func (m *MsgDescriptor) String() string { return proto.CompactTextString(m) }
+func (*MsgDescriptor) ProtoMessage()    {}
+func (*MsgDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af59f4642c0f7eb4, []int{0}
+}
+func (m *MsgDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDescriptor.Merge(m, src)
+}
+func (m *MsgDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDescriptor proto.InternalMessageInfo
+
+func (m *MsgDescriptor) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MsgDescriptor) GetFields() []FieldDescriptor {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// FieldDescriptor describes a field of a gravity Msg
+// TYPE:
+// the protobuf scalar type of the field, e.g. uint64, or the full name of its
+// message or enum type, e.g. cosmos.base.v1beta1.Coin
+// RULES:
+// the validation rules of the field, see the validation field option
+type FieldDescriptor struct {
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Repeated bool     `protobuf:"varint,3,opt,name=repeated,proto3" json:"repeated,omitempty"`
+	Rules    []string `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (m *FieldDescriptor) Reset()         { *m = FieldDescriptor{} }
+func (m *FieldDescriptor) String() string { return proto.CompactTextString(m) }
+func (*FieldDescriptor) ProtoMessage()    {}
+func (*FieldDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af59f4642c0f7eb4, []int{1}
+}
+func (m *FieldDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldDescriptor.Merge(m, src)
+}
+func (m *FieldDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldDescriptor proto.InternalMessageInfo
+
+func (m *FieldDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FieldDescriptor) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *FieldDescriptor) GetRepeated() bool {
+	if m != nil {
+		return m.Repeated
+	}
+	return false
+}
+
+func (m *FieldDescriptor) GetRules() []string {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+var E_Validation = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         51001,
+	Name:          "gravity.v1.validation",
+	Tag:           "bytes,51001,opt,name=validation",
+	Filename:      "gravity/v1/introspection.proto",
+}
+
+func init() {
+	proto.RegisterType((*MsgDescriptor)(nil), "gravity.v1.MsgDescriptor")
+	proto.RegisterType((*FieldDescriptor)(nil), "gravity.v1.FieldDescriptor")
+	proto.RegisterExtension(E_Validation)
+}
+
+func init() { proto.RegisterFile("gravity/v1/introspection.proto", fileDescriptor_af59f4642c0f7eb4) }
+
+var fileDescriptor_af59f4642c0f7eb4 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x4d, 0x9a, 0x7e, 0xfd, 0xda, 0x11, 0x11, 0x86, 0x2e, 0x62, 0xc5, 0x18, 0xba, 0xca, 0x2a,
+	0x43, 0xed, 0x4a, 0x37, 0x42, 0x11, 0x77, 0x45, 0x08, 0xb8, 0x71, 0x23, 0x69, 0x32, 0x1d, 0x07,
+	0x27, 0xb9, 0x61, 0x66, 0x12, 0xec, 0x4b, 0x88, 0xaf, 0xe3, 0x1b, 0x74, 0xd9, 0xa5, 0x2b, 0x91,
+	0xf6, 0x45, 0x64, 0x92, 0xfe, 0x88, 0xbb, 0x7b, 0xce, 0x3d, 0xf7, 0x9e, 0x3b, 0x67, 0x90, 0xc7,
+	0x64, 0x5c, 0x71, 0xbd, 0x20, 0xd5, 0x88, 0xf0, 0x5c, 0x4b, 0x50, 0x05, 0x4d, 0x34, 0x87, 0x3c,
+	0x2c, 0x24, 0x68, 0xc0, 0x68, 0xdb, 0x0f, 0xab, 0xd1, 0xa0, 0xcf, 0x80, 0x41, 0x4d, 0x13, 0x53,
+	0x35, 0x8a, 0x81, 0xcf, 0x00, 0x98, 0xa0, 0xa4, 0x46, 0xb3, 0x72, 0x4e, 0x52, 0xaa, 0x12, 0xc9,
+	0x0b, 0x0d, 0xb2, 0x51, 0x0c, 0x29, 0x3a, 0x9e, 0x2a, 0x76, 0xbb, 0xa7, 0xf1, 0x29, 0xea, 0xea,
+	0x45, 0x41, 0x9f, 0x4a, 0x29, 0x5c, 0xdb, 0xb7, 0x83, 0x5e, 0xf4, 0xdf, 0xe0, 0x07, 0x29, 0xf0,
+	0x15, 0xea, 0xcc, 0x39, 0x15, 0xa9, 0x72, 0x5b, 0xbe, 0x13, 0x1c, 0x5d, 0x9e, 0x85, 0x87, 0x03,
+	0xc2, 0x3b, 0xd3, 0x39, 0xec, 0x99, 0xb4, 0x97, 0x5f, 0x17, 0x56, 0xb4, 0x1d, 0x18, 0xbe, 0xa0,
+	0x93, 0x3f, 0x02, 0x8c, 0x51, 0x3b, 0x8f, 0x33, 0xba, 0x35, 0xa9, 0x6b, 0xc3, 0x19, 0x33, 0xb7,
+	0xd5, 0x70, 0xa6, 0xc6, 0x03, 0xd4, 0x95, 0xb4, 0xa0, 0xb1, 0xa6, 0xa9, 0xeb, 0xf8, 0x76, 0xd0,
+	0x8d, 0xf6, 0x18, 0xf7, 0xd1, 0x3f, 0x59, 0x0a, 0xaa, 0xdc, 0xb6, 0xef, 0x04, 0xbd, 0xa8, 0x01,
+	0xd7, 0x37, 0x08, 0x55, 0xb1, 0xe0, 0x69, 0x6c, 0xb2, 0xc2, 0xe7, 0x61, 0x13, 0x42, 0xb8, 0x0b,
+	0xa1, 0x39, 0xf5, 0xbe, 0x30, 0x5d, 0xe5, 0x7e, 0xbc, 0x39, 0xb5, 0xd9, 0xaf, 0x91, 0xc9, 0x74,
+	0xb9, 0xf6, 0xec, 0xd5, 0xda, 0xb3, 0xbf, 0xd7, 0x9e, 0xfd, 0xbe, 0xf1, 0xac, 0xd5, 0xc6, 0xb3,
+	0x3e, 0x37, 0x9e, 0xf5, 0x38, 0x66, 0x5c, 0x3f, 0x97, 0xb3, 0x30, 0x81, 0x8c, 0x40, 0x0e, 0xd9,
+	0xa2, 0x5e, 0x9a, 0x80, 0x20, 0xb1, 0x4c, 0x48, 0x06, 0x69, 0x29, 0x28, 0x79, 0x25, 0xbb, 0x8f,
+	0x33, 0x0f, 0x50, 0xb3, 0x4e, 0x2d, 0x1a, 0xff, 0x0c, 0x00, 0xe2, 0xef, 0x4d, 0x8b, 0xd0, 0x01,
+	0x00, 0x00,
+}
+
+func (m *MsgDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIntrospection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintIntrospection(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Rules[iNdEx])
+			copy(dAtA[i:], m.Rules[iNdEx])
+			i = encodeVarintIntrospection(dAtA, i, uint64(len(m.Rules[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Repeated {
+		i--
+		if m.Repeated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintIntrospection(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintIntrospection(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIntrospection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIntrospection(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovIntrospection(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovIntrospection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovIntrospection(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovIntrospection(uint64(l))
+	}
+	if m.Repeated {
+		n += 2
+	}
+	if len(m.Rules) > 0 {
+		for _, s := range m.Rules {
+			l = len(s)
+			n += 1 + l + sovIntrospection(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIntrospection(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIntrospection(x uint64) (n int) {
+	return sovIntrospection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntrospection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntrospection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntrospection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, FieldDescriptor{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntrospection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntrospection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntrospection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntrospection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntrospection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repeated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntrospection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntrospection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntrospection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIntrospection(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIntrospection
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntrospection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntrospection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIntrospection
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIntrospection
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIntrospection
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIntrospection        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIntrospection          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIntrospection = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests that every Msg of the service is described with the validation rules of its fields
+func TestMsgDescriptors(t *testing.T) {
+	descriptors, err := MsgDescriptors()
+	require.NoError(t, err)
+	require.Len(t, descriptors, len(_Msg_serviceDesc.Methods))
+
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	byTypeURL := make(map[string]MsgDescriptor)
+	for _, descriptor := range descriptors {
+		var msg sdk.Msg
+		require.NoError(t, registry.UnpackAny(&codectypes.Any{TypeUrl: descriptor.TypeUrl}, &msg), descriptor.TypeUrl)
+		byTypeURL[descriptor.TypeUrl] = descriptor
+	}
+
+	sendToEth := byTypeURL["/gravity.v1.MsgSendToEth"]
+	require.Equal(t, []FieldDescriptor{
+		{Name: "sender", Type: "string", Rules: []string{"account_address"}},
+		{Name: "eth_dest", Type: "string", Rules: []string{"eth_address"}},
+		{Name: "amount", Type: "cosmos.base.v1beta1.Coin", Rules: []string{"positive_coin"}},
+		{Name: "bridge_fee", Type: "cosmos.base.v1beta1.Coin", Rules: []string{"coin"}},
+		{Name: "relay_fee", Type: "cosmos.base.v1beta1.Coin", Rules: []string{"optional", "positive_coin"}},
+		{Name: "execute_after_height", Type: "uint64"},
+		{Name: "chain_fee", Type: "cosmos.base.v1beta1.Coin", Rules: []string{"optional", "coin"}},
+	}, sendToEth.Fields)
+
+	valsetClaim := byTypeURL["/gravity.v1.MsgValsetUpdatedClaim"]
+	require.Equal(t, FieldDescriptor{Name: "members", Type: "gravity.v1.BridgeValidator", Repeated: true}, valsetClaim.Fields[3])
+}
@@ -19,8 +19,29 @@ const (
 
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
+
+	// UnbatchedPoolAccountName is the module account escrowing the amount and bridge fee of the
+	// transactions in the outgoing tx pool
+	UnbatchedPoolAccountName = ModuleName + "_unbatched_pool"
+
+	// BatchesAccountName is the module account escrowing the amount and bridge fee of the transactions
+	// in batches which are not observed executed yet
+	BatchesAccountName = ModuleName + "_batches"
+
+	// FeesAccountName is the module account escrowing the relay fees of outgoing transactions and the
+	// logic call deposits until they are paid out or refunded
+	FeesAccountName = ModuleName + "_fees"
+
+	// HeldDepositsAccountName is the module account holding the amount of the observed deposits which were held
+	// instead of credited to their receivers, until they are released or refunded
+	HeldDepositsAccountName = ModuleName + "_held_deposits"
 )
 
+// SubPoolAccountNames are the module accounts escrowing funds for a single purpose each, so that their
+// balances can be checked exactly against the state they back. The ModuleName account mints and burns
+// vouchers and holds the Cosmos originated tokens locked against their ERC20s on Ethereum
+var SubPoolAccountNames = []string{UnbatchedPoolAccountName, BatchesAccountName, FeesAccountName, HeldDepositsAccountName}
+
 var (
 	// EthAddressByValidatorKey indexes cosmos validator account addresses
 	// i.e. gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm
@@ -37,14 +58,6 @@ var (
 	// i.e gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm
 	ValsetConfirmKey = "ValsetConfirmKey"
 
-	// OracleClaimKey Claim details by nonce and validator address
-	// i.e. gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm
-	// A claim is named more intuitively than an Attestation, it is literally
-	// a validator making a claim to have seen something happen. Claims are
-	// attached to attestations which can be thought of as 'the event' that
-	// will eventually be executed.
-	OracleClaimKey = "OracleClaimKey"
-
 	// OracleAttestationKey attestation details by nonce and validator address
 	// i.e. gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm
 	// An attestation can be thought of as the 'event to be executed' while
@@ -83,6 +96,9 @@ var (
 	// KeyLastOutgoingBatchID indexes the lastBatchID
 	KeyLastOutgoingBatchID = SequenceKeyPrefix + "lastBatchId"
 
+	// KeyLastRecurringSendToEthID indexes the lastRecurringSendID
+	KeyLastRecurringSendToEthID = SequenceKeyPrefix + "lastRecurringSendId"
+
 	// KeyOrchestratorAddress indexes the validator keys for an orchestrator
 	KeyOrchestratorAddress = "KeyOrchestratorAddress"
 
@@ -92,6 +108,9 @@ var (
 	// KeyOutgoingLogicConfirm indexes the outgoing logic confirms
 	KeyOutgoingLogicConfirm = "KeyOutgoingLogicConfirm"
 
+	// KeyLogicCallDeposit indexes the sponsor deposits of outgoing logic calls
+	KeyLogicCallDeposit = "KeyLogicCallDeposit"
+
 	// LastObservedEthereumBlockHeightKey indexes the latest Ethereum block height
 	LastObservedEthereumBlockHeightKey = "LastObservedEthereumBlockHeightKey"
 
@@ -101,6 +120,9 @@ var (
 	// ERC20ToDenomKey prefixes the index of Cosmos originated assets ERC20s to denoms
 	ERC20ToDenomKey = "ERC20ToDenomKey"
 
+	// ERC20DeployedRejectionKey indexes the rejected ERC20 deployments by event nonce
+	ERC20DeployedRejectionKey = "ERC20DeployedRejectionKey"
+
 	// LastSlashedValsetNonce indexes the latest slashed valset nonce
 	LastSlashedValsetNonce = "LastSlashedValsetNonce"
 
@@ -116,6 +138,9 @@ var (
 	// LastUnBondingBlockHeight indexes the last validator unbonding block height
 	LastUnBondingBlockHeight = "LastUnBondingBlockHeight"
 
+	// LastSlashValsetRequestBlockHeight indexes the last block height a slash requested a new valset
+	LastSlashValsetRequestBlockHeight = "LastSlashValsetRequestBlockHeight"
+
 	// LastObservedValsetNonceKey indexes the latest observed valset nonce
 	// HERE THERE BE DRAGONS, do not use this value as an up to date validator set
 	// on Ethereum it will always lag significantly and may be totally wrong at some
@@ -124,6 +149,92 @@ var (
 
 	// PastEthSignatureCheckpointKey indexes eth signature checkpoints that have existed
 	PastEthSignatureCheckpointKey = "PastEthSignatureCheckpointKey"
+
+	// BatchRelayLatencyKey indexes the batch relay latency statistics by token contract
+	BatchRelayLatencyKey = "BatchRelayLatencyKey"
+
+	// BatchRelayLatencySLAWarnedKey indexes batches for which a latency warning was already emitted
+	BatchRelayLatencySLAWarnedKey = "BatchRelayLatencySLAWarnedKey"
+
+	// KeyBridgeJailedValidator indexes the jailing height of validators jailed for missing bridge signatures
+	KeyBridgeJailedValidator = "KeyBridgeJailedValidator"
+
+	// KeyMissedBatchSignatures indexes the number of batch signatures missed by validators since they last signed one
+	KeyMissedBatchSignatures = "KeyMissedBatchSignatures"
+
+	// PendingEmergencyValsetKey indexes the emergency valset waiting for its timelock to pass
+	PendingEmergencyValsetKey = "PendingEmergencyValsetKey"
+
+	// LastEmergencyValsetNonceKey indexes the nonce of the last emergency valset stored
+	LastEmergencyValsetNonceKey = "LastEmergencyValsetNonceKey"
+
+	// ScheduledOutgoingTXKey indexes the transfers waiting for their execute after height to enter the pool
+	ScheduledOutgoingTXKey = "ScheduledOutgoingTXKey"
+
+	// RecurringSendToEthKey indexes the recurring sends to Ethereum by the height of their next transfer
+	RecurringSendToEthKey = "RecurringSendToEthKey"
+
+	// PoolEntryHeightKey indexes the height at which transfers first entered the pool by their id
+	PoolEntryHeightKey = "PoolEntryHeightKey"
+
+	// BridgeFeeTiersKey indexes the bridge fee tiers by token contract
+	BridgeFeeTiersKey = "BridgeFeeTiersKey"
+
+	// HeldDepositKey indexes the held deposits by event nonce
+	HeldDepositKey = "HeldDepositKey"
+
+	// ForkAttestationKey indexes the fork attestations by Ethereum height and block hashes
+	ForkAttestationKey = "ForkAttestationKey"
+
+	// ObservedBlockHashKey indexes the Ethereum block hashes of the most recent observed events by event nonce
+	ObservedBlockHashKey = "ObservedBlockHashKey"
+
+	// BridgeCheckpointKey indexes the bridge checkpoint written at the end of every block
+	BridgeCheckpointKey = "BridgeCheckpointKey"
+
+	// SelfBridgeLimitKey indexes the limits accounts set on the coins they send to Ethereum by address
+	SelfBridgeLimitKey = "SelfBridgeLimitKey"
+
+	// GravityProposalMetadataKey indexes the metadata of the proposals submitted with MsgSubmitGravityProposal by
+	// proposal id
+	GravityProposalMetadataKey = "GravityProposalMetadataKey"
+
+	// ExecutedBatchKey indexes the batches whose execution was observed by executed height, token contract and nonce
+	// until they are archived
+	ExecutedBatchKey = "ExecutedBatchKey"
+
+	// ArchivedBatchKey indexes the compressed executed batches past the retention window by token contract and nonce
+	ArchivedBatchKey = "ArchivedBatchKey"
+
+	// PendingParamChangeKey indexes the changes of critical params waiting for their apply height by apply height and
+	// param key
+	PendingParamChangeKey = "PendingParamChangeKey"
+
+	// BridgeBindingKey is the Gravity.sol deployment the chain is bound to
+	BridgeBindingKey = "BridgeBindingKey"
+
+	// ERC20ProvenanceKey indexes the first observed deposits of Ethereum originated ERC20s by token contract
+	ERC20ProvenanceKey = "ERC20ProvenanceKey"
+
+	// BurnedFeesKey indexes the total of the collected bridge fees burned by denom
+	BurnedFeesKey = "BurnedFeesKey"
+
+	// BridgeUsageKey indexes the volume the accounts bridged by epoch and address
+	BridgeUsageKey = "BridgeUsageKey"
+
+	// AttestationApplyQueueKey indexes the attestations which have the votes to be observed by event nonce, waiting
+	// for the attestations at the event nonces before them to be observed
+	AttestationApplyQueueKey = "AttestationApplyQueueKey"
+
+	// PendingIbcAutoForwardKey indexes the deposits waiting to be forwarded over IBC by event nonce
+	PendingIbcAutoForwardKey = "PendingIbcAutoForwardKey"
+
+	// ValsetRelayPackageKey indexes the relay packages of the relayable valsets by valset nonce
+	ValsetRelayPackageKey = "ValsetRelayPackageKey"
+
+	// ExpeditedQuorumReachedKey indexes the bridge emergency proposals in their voting period which passed the
+	// expedited tally by proposal id
+	ExpeditedQuorumReachedKey = "ExpeditedQuorumReachedKey"
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -171,52 +282,29 @@ func GetValsetConfirmKey(nonce uint64, validator sdk.AccAddress) string {
 	return ValsetConfirmKey + ConvertByteArrToString(UInt64Bytes(nonce)) + string(validator.Bytes())
 }
 
-// GetClaimKey returns the following key format
-// prefix type               cosmos-validator-address                       nonce                             attestation-details-hash
-// [0x0][0 0 0 1][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
-// The Claim hash identifies a unique event, for example it would have a event nonce, a sender and a receiver. Or an event nonce and a batch nonce. But
-// the Claim is stored indexed with the claimer key to make sure that it is unique.
-func GetClaimKey(details EthereumClaim) string {
-	var detailsHash []byte
-	if details != nil {
-		var err error
-		detailsHash, err = details.ClaimHash()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "unable to compute claim hash"))
-		}
-	} else {
-		panic("No claim without details!")
-	}
-	claimTypeLen := len([]byte{byte(details.GetType())})
-	nonceBz := UInt64Bytes(details.GetEventNonce())
-	if err := sdk.VerifyAddressFormat(details.GetClaimer()); err != nil {
-		panic(sdkerrors.Wrap(err, "invalid claimer address"))
-	}
-
-	addrLen := len(details.GetClaimer())
-
-	key := make([]byte, len(OracleClaimKey)+claimTypeLen+addrLen+len(nonceBz)+len(detailsHash))
-	copy(key[0:], OracleClaimKey)
-	copy(key[len(OracleClaimKey):], []byte{byte(details.GetType())})
-	// TODO this is the delegate address, should be stored by the valaddress
-	copy(key[len(OracleClaimKey)+claimTypeLen:], details.GetClaimer())
-	copy(key[len(OracleClaimKey)+claimTypeLen+addrLen:], nonceBz)
-	copy(key[len(OracleClaimKey)+claimTypeLen+addrLen+len(nonceBz):], detailsHash)
-	return ConvertByteArrToString(key)
-}
-
 // GetAttestationKey returns the following key format
-// prefix     nonce                             claim-details-hash
-// [0x5][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+// prefix     nonce                             claim-hash-version      claim-details-hash
+// [0x5][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
 // An attestation is an event multiple people are voting on, this function needs the claim
 // details because each Attestation is aggregating all claims of a specific event, lets say
 // validator X and validator y where making different claims about the same event nonce
 // Note that the claim hash does NOT include the claimer address and only identifies an event
-func GetAttestationKey(eventNonce uint64, claimHash []byte) string {
-	key := make([]byte, len(OracleAttestationKey)+len(UInt64Bytes(0))+len(claimHash))
+func GetAttestationKey(eventNonce uint64, claimHashVersion uint64, claimHash []byte) string {
+	key := make([]byte, len(OracleAttestationKey)+2*len(UInt64Bytes(0))+len(claimHash))
+	copy(key[0:], OracleAttestationKey)
+	copy(key[len(OracleAttestationKey):], UInt64Bytes(eventNonce))
+	copy(key[len(OracleAttestationKey)+len(UInt64Bytes(0)):], UInt64Bytes(claimHashVersion))
+	copy(key[len(OracleAttestationKey)+2*len(UInt64Bytes(0)):], claimHash)
+	return ConvertByteArrToString(key)
+}
+
+// GetAttestationNoncePrefix returns the prefix of the keys of the attestations at an event nonce
+// prefix     nonce
+// [0x5][0 0 0 0 0 0 0 1]
+func GetAttestationNoncePrefix(eventNonce uint64) string {
+	key := make([]byte, len(OracleAttestationKey)+len(UInt64Bytes(0)))
 	copy(key[0:], OracleAttestationKey)
 	copy(key[len(OracleAttestationKey):], UInt64Bytes(eventNonce))
-	copy(key[len(OracleAttestationKey)+len(UInt64Bytes(0)):], claimHash)
 	return ConvertByteArrToString(key)
 }
 
@@ -250,6 +338,14 @@ func GetOutgoingTxBatchKey(tokenContract EthAddress, nonce uint64) string {
 	return OutgoingTXBatchKey + tokenContract.GetAddress() + string(UInt64Bytes(nonce))
 }
 
+// GetOutgoingTxBatchContractPrefix returns the following key format
+// prefix     eth-contract-address
+// [0xa][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// This prefix is used for iterating over the batches of a given contract
+func GetOutgoingTxBatchContractPrefix(tokenContract EthAddress) string {
+	return OutgoingTXBatchKey + tokenContract.GetAddress()
+}
+
 // GetBatchConfirmKey returns the following key format
 // prefix           eth-contract-address                BatchNonce                       Validator-address
 // [0xe1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
@@ -283,6 +379,13 @@ func GetERC20ToDenomKey(erc20 EthAddress) string {
 	return ERC20ToDenomKey + erc20.GetAddress()
 }
 
+// GetERC20DeployedRejectionKey returns the following key format
+// prefix     event-nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetERC20DeployedRejectionKey(eventNonce uint64) string {
+	return ERC20DeployedRejectionKey + string(UInt64Bytes(eventNonce))
+}
+
 func GetOutgoingLogicCallKey(invalidationId []byte, invalidationNonce uint64) string {
 	a := KeyOutgoingLogicCall + string(invalidationId)
 	return a + string(UInt64Bytes(invalidationNonce))
@@ -297,6 +400,13 @@ func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validat
 	return interm + string(validator.Bytes())
 }
 
+// GetLogicCallDepositKey returns the following key format
+// prefix     invalidation-id     invalidation-nonce
+// [0x0][ invalidation id bytes ][0 0 0 0 0 0 0 1]
+func GetLogicCallDepositKey(invalidationId []byte, invalidationNonce uint64) string {
+	return KeyLogicCallDeposit + string(invalidationId) + string(UInt64Bytes(invalidationNonce))
+}
+
 // GetPastEthSignatureCheckpointKey returns the following key format
 // prefix    checkpoint
 // [0x0][ checkpoint bytes ]
@@ -304,6 +414,120 @@ func GetPastEthSignatureCheckpointKey(checkpoint []byte) string {
 	return PastEthSignatureCheckpointKey + ConvertByteArrToString(checkpoint)
 }
 
+// GetBatchRelayLatencyKey returns the following key format
+// prefix     eth-contract-address
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBatchRelayLatencyKey(tokenContract EthAddress) string {
+	return BatchRelayLatencyKey + tokenContract.GetAddress()
+}
+
+// GetBatchRelayLatencySLAWarnedKey returns the following key format
+// prefix     eth-contract-address                       nonce
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchRelayLatencySLAWarnedKey(tokenContract EthAddress, nonce uint64) string {
+	return BatchRelayLatencySLAWarnedKey + tokenContract.GetAddress() + string(UInt64Bytes(nonce))
+}
+
+// GetPoolEntryHeightKey returns the following key format
+// prefix     id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetPoolEntryHeightKey(id uint64) string {
+	return PoolEntryHeightKey + string(UInt64Bytes(id))
+}
+
+// GetBridgeFeeTiersKey returns the following key format
+// prefix     eth-contract-address
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBridgeFeeTiersKey(tokenContract EthAddress) string {
+	return BridgeFeeTiersKey + tokenContract.GetAddress()
+}
+
+// GetBridgeJailedValidatorKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetBridgeJailedValidatorKey(validator sdk.ValAddress) string {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return KeyBridgeJailedValidator + string(validator.Bytes())
+}
+
+// GetMissedBatchSignaturesKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetMissedBatchSignaturesKey(validator sdk.ValAddress) string {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return KeyMissedBatchSignatures + string(validator.Bytes())
+}
+
+// GetScheduledOutgoingTxKey returns the following key format
+// prefix     execute-after-height    id
+// [0x0][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetScheduledOutgoingTxKey(executeAfterHeight uint64, id uint64) string {
+	return ScheduledOutgoingTXKey + string(UInt64Bytes(executeAfterHeight)) + string(UInt64Bytes(id))
+}
+
+// GetRecurringSendToEthKey returns the following key format
+// prefix     next-height    id
+// [0x0][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetRecurringSendToEthKey(nextHeight uint64, id uint64) string {
+	return RecurringSendToEthKey + string(UInt64Bytes(nextHeight)) + string(UInt64Bytes(id))
+}
+
+// GetHeldDepositKey returns the following key format
+// prefix     nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetHeldDepositKey(eventNonce uint64) string {
+	return HeldDepositKey + string(UInt64Bytes(eventNonce))
+}
+
+// GetForkAttestationKey returns the following key format
+// prefix     ethereum-height    observed-block-hash      conflicting-block-hash
+// [0x0][0 0 0 0 0 0 0 1][0x1c9e...e5b0][0x8a4f...07d2]
+func GetForkAttestationKey(ethereumHeight uint64, observedBlockHash string, conflictingBlockHash string) string {
+	return ForkAttestationKey + string(UInt64Bytes(ethereumHeight)) + strings.ToLower(observedBlockHash) + strings.ToLower(conflictingBlockHash)
+}
+
+// GetObservedBlockHashKey returns the following key format
+// prefix     event-nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetObservedBlockHashKey(eventNonce uint64) string {
+	return ObservedBlockHashKey + string(UInt64Bytes(eventNonce))
+}
+
+// GetSelfBridgeLimitKey returns the following key format
+// prefix     address
+// [0x0][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetSelfBridgeLimitKey(address sdk.AccAddress) string {
+	if err := sdk.VerifyAddressFormat(address); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid address"))
+	}
+	return SelfBridgeLimitKey + string(address.Bytes())
+}
+
+// GetGravityProposalMetadataKey returns the following key format
+// prefix     proposal-id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetGravityProposalMetadataKey(proposalID uint64) string {
+	return GravityProposalMetadataKey + string(UInt64Bytes(proposalID))
+}
+
+// GetExecutedBatchKey returns the following key format
+// prefix     executed-height     eth-contract-address                        nonce
+// [0x0][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetExecutedBatchKey(executedHeight uint64, tokenContract EthAddress, nonce uint64) string {
+	return ExecutedBatchKey + string(UInt64Bytes(executedHeight)) + tokenContract.GetAddress() + string(UInt64Bytes(nonce))
+}
+
+// GetArchivedBatchKey returns the following key format
+// prefix     eth-contract-address                        nonce
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetArchivedBatchKey(tokenContract EthAddress, nonce uint64) string {
+	return ArchivedBatchKey + tokenContract.GetAddress() + string(UInt64Bytes(nonce))
+}
+
 func ConvertByteArrToString(value []byte) string {
 	var ret strings.Builder
 	for i := 0; i < len(value); i++ {
@@ -311,3 +535,62 @@ func ConvertByteArrToString(value []byte) string {
 	}
 	return ret.String()
 }
+
+// GetPendingParamChangeKey returns the following key format
+// prefix     apply-height      param-key
+// [0x0][0 0 0 0 0 0 0 1][SignedValsetsWindow]
+func GetPendingParamChangeKey(applyHeight uint64, paramKey string) string {
+	return PendingParamChangeKey + string(UInt64Bytes(applyHeight)) + paramKey
+}
+
+// GetERC20ProvenanceKey returns the following key format
+// prefix     eth-contract-address
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetERC20ProvenanceKey(tokenContract EthAddress) string {
+	return ERC20ProvenanceKey + tokenContract.GetAddress()
+}
+
+// GetBurnedFeesKey returns the following key format
+// prefix  denom
+// [0x0][anom]
+func GetBurnedFeesKey(denom string) string {
+	return BurnedFeesKey + denom
+}
+
+// GetBridgeUsageKey returns the following key format
+// prefix     epoch              address
+// [0x0][0 0 0 0 0 0 0 1][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetBridgeUsageKey(epoch uint64, address sdk.AccAddress) string {
+	if err := sdk.VerifyAddressFormat(address); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid address"))
+	}
+	return BridgeUsageKey + string(UInt64Bytes(epoch)) + string(address.Bytes())
+}
+
+// GetAttestationApplyQueueKey returns the following key format
+// prefix     nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetAttestationApplyQueueKey(eventNonce uint64) string {
+	return AttestationApplyQueueKey + string(UInt64Bytes(eventNonce))
+}
+
+// GetPendingIbcAutoForwardKey returns the following key format
+// prefix     nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetPendingIbcAutoForwardKey(eventNonce uint64) string {
+	return PendingIbcAutoForwardKey + string(UInt64Bytes(eventNonce))
+}
+
+// GetValsetRelayPackageKey returns the following key format
+// prefix     nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetValsetRelayPackageKey(nonce uint64) string {
+	return ValsetRelayPackageKey + string(UInt64Bytes(nonce))
+}
+
+// GetExpeditedQuorumReachedKey returns the following key format
+// prefix     proposal-id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetExpeditedQuorumReachedKey(proposalID uint64) string {
+	return ExpeditedQuorumReachedKey + string(UInt64Bytes(proposalID))
+}
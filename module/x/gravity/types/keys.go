@@ -0,0 +1,275 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Sizes of the fixed size key segments
+const (
+	uint64KeySize     = 8
+	ethAddressKeySize = 42
+	hashKeySize       = 32
+	blockHashKeySize  = 66
+)
+
+// KeySegment is a field of the keys under a prefix of the gravity store
+// SIZE:
+// the byte length of the segment, zero for a variable length segment. A key has at most one variable length segment,
+// the segments after it are then parsed from the end of the key
+// RUNE_ENCODED:
+// the segment was written with ConvertByteArrToString, which encodes every byte as a UTF-8 rune so that the bytes
+// above 0x7f take two bytes in the key
+type KeySegment struct {
+	Name        string
+	Size        int
+	RuneEncoded bool
+}
+
+// KeyLayout describes a prefix of the gravity store and the segments of the keys under it, a layout without segments
+// is a single key
+type KeyLayout struct {
+	Name        string
+	Prefix      string
+	Segments    []KeySegment
+	Description string
+}
+
+func fixedKeySegment(name string, size int) KeySegment {
+	return KeySegment{Name: name, Size: size, RuneEncoded: false}
+}
+
+func runeKeySegment(name string, size int) KeySegment {
+	return KeySegment{Name: name, Size: size, RuneEncoded: true}
+}
+
+func variableKeySegment(name string) KeySegment {
+	return KeySegment{Name: name, Size: 0, RuneEncoded: false}
+}
+
+func keyLayout(name string, prefix string, description string, segments ...KeySegment) KeyLayout {
+	return KeyLayout{Name: name, Prefix: prefix, Segments: segments, Description: description}
+}
+
+// KeyLayouts registers every prefix of the gravity store with the layout of its keys. No prefix may be a prefix of
+// another one, so a new prefix has to be registered here for the key tests to check it against the existing ones
+var KeyLayouts = []KeyLayout{
+	keyLayout("EthAddressByValidatorKey", EthAddressByValidatorKey, "Ethereum address of a validator",
+		variableKeySegment("validator-address")),
+	keyLayout("ValidatorByEthAddressKey", ValidatorByEthAddressKey, "validator of an Ethereum address",
+		fixedKeySegment("eth-address", ethAddressKeySize)),
+	keyLayout("ValsetRequestKey", ValsetRequestKey, "valset",
+		fixedKeySegment("nonce", uint64KeySize)),
+	keyLayout("ValsetConfirmKey", ValsetConfirmKey, "valset confirmation",
+		runeKeySegment("nonce", uint64KeySize), variableKeySegment("validator-address")),
+	keyLayout("OracleAttestationKey", OracleAttestationKey, "attestation",
+		runeKeySegment("event-nonce", uint64KeySize), runeKeySegment("claim-hash-version", uint64KeySize),
+		runeKeySegment("claim-hash", hashKeySize)),
+	keyLayout("OutgoingTXPoolKey", OutgoingTXPoolKey, "unbatched transfer",
+		runeKeySegment("fee-contract", ethAddressKeySize), runeKeySegment("fee-amount", hashKeySize),
+		runeKeySegment("id", uint64KeySize)),
+	keyLayout("DenomiatorPrefix", DenomiatorPrefix, "reserved, not written"),
+	keyLayout("OutgoingTXBatchKey", OutgoingTXBatchKey, "outgoing batch",
+		fixedKeySegment("token-contract", ethAddressKeySize), fixedKeySegment("nonce", uint64KeySize)),
+	keyLayout("BatchConfirmKey", BatchConfirmKey, "batch confirmation",
+		fixedKeySegment("token-contract", ethAddressKeySize), fixedKeySegment("nonce", uint64KeySize),
+		variableKeySegment("validator-address")),
+	keyLayout("SecondIndexNonceByClaimKey", SecondIndexNonceByClaimKey, "reserved, not written"),
+	keyLayout("LastEventNonceByValidatorKey", LastEventNonceByValidatorKey, "last event nonce of a validator",
+		variableKeySegment("validator-address")),
+	keyLayout("LastObservedEventNonceKey", LastObservedEventNonceKey, "last observed event nonce"),
+	keyLayout("SequenceKeyPrefix", SequenceKeyPrefix, "last id of a sequence, e.g. lastTxPoolId",
+		variableKeySegment("sequence")),
+	keyLayout("KeyOrchestratorAddress", KeyOrchestratorAddress, "validator of an orchestrator",
+		variableKeySegment("orchestrator-address")),
+	keyLayout("KeyOutgoingLogicCall", KeyOutgoingLogicCall, "outgoing logic call",
+		variableKeySegment("invalidation-id"), fixedKeySegment("invalidation-nonce", uint64KeySize)),
+	keyLayout("KeyOutgoingLogicConfirm", KeyOutgoingLogicConfirm, "logic call confirmation",
+		variableKeySegment("invalidation-id"), fixedKeySegment("invalidation-nonce", uint64KeySize),
+		fixedKeySegment("validator-address", 20)),
+	keyLayout("KeyLogicCallDeposit", KeyLogicCallDeposit, "logic call deposit",
+		variableKeySegment("invalidation-id"), fixedKeySegment("invalidation-nonce", uint64KeySize)),
+	keyLayout("LastObservedEthereumBlockHeightKey", LastObservedEthereumBlockHeightKey, "last observed Ethereum height"),
+	keyLayout("DenomToERC20Key", DenomToERC20Key, "ERC20 of a cosmos originated denom",
+		variableKeySegment("denom")),
+	keyLayout("ERC20ToDenomKey", ERC20ToDenomKey, "cosmos originated denom of an ERC20",
+		fixedKeySegment("erc20", ethAddressKeySize)),
+	keyLayout("ERC20DeployedRejectionKey", ERC20DeployedRejectionKey, "rejected ERC20 deployment",
+		fixedKeySegment("event-nonce", uint64KeySize)),
+	keyLayout("LastSlashedValsetNonce", LastSlashedValsetNonce, "last slashed valset nonce"),
+	keyLayout("LatestValsetNonce", LatestValsetNonce, "latest valset nonce"),
+	keyLayout("LastSlashedBatchBlock", LastSlashedBatchBlock, "last slashed batch height"),
+	keyLayout("LastSlashedLogicCallBlock", LastSlashedLogicCallBlock, "last slashed logic call height"),
+	keyLayout("LastUnBondingBlockHeight", LastUnBondingBlockHeight, "last validator unbonding height"),
+	keyLayout("LastSlashValsetRequestBlockHeight", LastSlashValsetRequestBlockHeight, "last slashing valset request height"),
+	keyLayout("LastObservedValsetKey", LastObservedValsetKey, "last observed valset"),
+	keyLayout("PastEthSignatureCheckpointKey", PastEthSignatureCheckpointKey, "past signed checkpoint",
+		runeKeySegment("checkpoint", hashKeySize)),
+	keyLayout("BatchRelayLatencyKey", BatchRelayLatencyKey, "batch relay latency of a token",
+		fixedKeySegment("token-contract", ethAddressKeySize)),
+	keyLayout("BatchRelayLatencySLAWarnedKey", BatchRelayLatencySLAWarnedKey, "batch with a relay latency warning",
+		fixedKeySegment("token-contract", ethAddressKeySize), fixedKeySegment("nonce", uint64KeySize)),
+	keyLayout("KeyBridgeJailedValidator", KeyBridgeJailedValidator, "jailing height of a bridge jailed validator",
+		variableKeySegment("validator-address")),
+	keyLayout("KeyMissedBatchSignatures", KeyMissedBatchSignatures, "batch signatures missed by a validator",
+		variableKeySegment("validator-address")),
+	keyLayout("PendingEmergencyValsetKey", PendingEmergencyValsetKey, "emergency valset waiting for its timelock"),
+	keyLayout("LastEmergencyValsetNonceKey", LastEmergencyValsetNonceKey, "last emergency valset nonce"),
+	keyLayout("ScheduledOutgoingTXKey", ScheduledOutgoingTXKey, "scheduled transfer",
+		fixedKeySegment("execute-after-height", uint64KeySize), fixedKeySegment("id", uint64KeySize)),
+	keyLayout("RecurringSendToEthKey", RecurringSendToEthKey, "recurring send",
+		fixedKeySegment("next-height", uint64KeySize), fixedKeySegment("id", uint64KeySize)),
+	keyLayout("PoolEntryHeightKey", PoolEntryHeightKey, "pool entry height of a transfer",
+		fixedKeySegment("id", uint64KeySize)),
+	keyLayout("BridgeFeeTiersKey", BridgeFeeTiersKey, "bridge fee tiers of a token",
+		fixedKeySegment("token-contract", ethAddressKeySize)),
+	keyLayout("HeldDepositKey", HeldDepositKey, "held deposit",
+		fixedKeySegment("event-nonce", uint64KeySize)),
+	keyLayout("ForkAttestationKey", ForkAttestationKey, "fork attestation",
+		fixedKeySegment("ethereum-height", uint64KeySize), fixedKeySegment("observed-block-hash", blockHashKeySize),
+		fixedKeySegment("conflicting-block-hash", blockHashKeySize)),
+	keyLayout("ObservedBlockHashKey", ObservedBlockHashKey, "observed Ethereum block hash",
+		fixedKeySegment("event-nonce", uint64KeySize)),
+	keyLayout("BridgeCheckpointKey", BridgeCheckpointKey, "bridge checkpoint"),
+	keyLayout("SelfBridgeLimitKey", SelfBridgeLimitKey, "self bridge limit of an account",
+		variableKeySegment("address")),
+	keyLayout("GravityProposalMetadataKey", GravityProposalMetadataKey, "metadata of a gravity proposal",
+		fixedKeySegment("proposal-id", uint64KeySize)),
+	keyLayout("ExecutedBatchKey", ExecutedBatchKey, "executed batch until it is archived",
+		fixedKeySegment("executed-height", uint64KeySize), fixedKeySegment("token-contract", ethAddressKeySize),
+		fixedKeySegment("nonce", uint64KeySize)),
+	keyLayout("ArchivedBatchKey", ArchivedBatchKey, "compressed archived batch",
+		fixedKeySegment("token-contract", ethAddressKeySize), fixedKeySegment("nonce", uint64KeySize)),
+	keyLayout("PendingParamChangeKey", PendingParamChangeKey, "critical param change waiting for its apply height",
+		fixedKeySegment("apply-height", uint64KeySize), variableKeySegment("param-key")),
+	keyLayout("BridgeBindingKey", BridgeBindingKey, "bound Gravity.sol deployment"),
+	keyLayout("ERC20ProvenanceKey", ERC20ProvenanceKey, "first observed deposit of an Ethereum originated ERC20",
+		fixedKeySegment("token-contract", ethAddressKeySize)),
+	keyLayout("BurnedFeesKey", BurnedFeesKey, "total of the collected bridge fees burned in a denom",
+		variableKeySegment("denom")),
+	keyLayout("BridgeUsageKey", BridgeUsageKey, "volume an account bridged in an epoch",
+		fixedKeySegment("epoch", uint64KeySize), variableKeySegment("address")),
+	keyLayout("AttestationApplyQueueKey", AttestationApplyQueueKey, "attestation waiting for the event nonces before it",
+		fixedKeySegment("event-nonce", uint64KeySize)),
+	keyLayout("PendingIbcAutoForwardKey", PendingIbcAutoForwardKey, "deposit waiting to be forwarded over IBC",
+		fixedKeySegment("event-nonce", uint64KeySize)),
+	keyLayout("ValsetRelayPackageKey", ValsetRelayPackageKey, "signatures of a relayable valset",
+		fixedKeySegment("nonce", uint64KeySize)),
+	keyLayout("ExpeditedQuorumReachedKey", ExpeditedQuorumReachedKey, "emergency proposal which passed the expedited tally",
+		fixedKeySegment("proposal-id", uint64KeySize)),
+}
+
+// BuildKey builds a key of the layout from the raw bytes of its segments
+func (l KeyLayout) BuildKey(segments ...[]byte) (string, error) {
+	if len(segments) != len(l.Segments) {
+		return "", sdkerrors.Wrapf(ErrInvalid, "%s keys have %d segments, got %d", l.Name, len(l.Segments), len(segments))
+	}
+	var key strings.Builder
+	key.WriteString(l.Prefix)
+	for i, segment := range l.Segments {
+		value := segments[i]
+		if segment.Size != 0 && len(value) != segment.Size {
+			return "", sdkerrors.Wrapf(ErrInvalid, "%s %s is %d bytes, got %d", l.Name, segment.Name, segment.Size, len(value))
+		}
+		if segment.RuneEncoded {
+			key.WriteString(ConvertByteArrToString(value))
+		} else {
+			key.Write(value)
+		}
+	}
+	return key.String(), nil
+}
+
+// ParseKey splits a key of the layout into the raw bytes of its segments
+func (l KeyLayout) ParseKey(key []byte) ([][]byte, error) {
+	if !strings.HasPrefix(string(key), l.Prefix) {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "key does not start with the %s prefix", l.Name)
+	}
+	rest := key[len(l.Prefix):]
+	segments := make([][]byte, len(l.Segments))
+	for i, segment := range l.Segments {
+		if segment.Size == 0 {
+			// the fixed size segments after the variable one are parsed from the end of the key
+			trailing := 0
+			for _, after := range l.Segments[i+1:] {
+				trailing += after.Size
+			}
+			if len(rest) < trailing {
+				return nil, sdkerrors.Wrapf(ErrInvalid, "%s key too short for its %s", l.Name, segment.Name)
+			}
+			segments[i], rest = rest[:len(rest)-trailing], rest[len(rest)-trailing:]
+			continue
+		}
+		value, consumed, err := readKeySegment(rest, segment)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s %s", l.Name, segment.Name)
+		}
+		segments[i], rest = value, rest[consumed:]
+	}
+	if len(rest) != 0 {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "%s key has %d trailing bytes", l.Name, len(rest))
+	}
+	return segments, nil
+}
+
+// readKeySegment reads a fixed size segment at the start of rest, returning its raw bytes and the number of key bytes
+// it took
+func readKeySegment(rest []byte, segment KeySegment) ([]byte, int, error) {
+	if !segment.RuneEncoded {
+		if len(rest) < segment.Size {
+			return nil, 0, sdkerrors.Wrap(ErrInvalid, "key too short")
+		}
+		return rest[:segment.Size], segment.Size, nil
+	}
+	value := make([]byte, 0, segment.Size)
+	consumed := 0
+	for len(value) < segment.Size {
+		r, size := utf8.DecodeRune(rest[consumed:])
+		if r == utf8.RuneError || r > 0xff {
+			return nil, 0, sdkerrors.Wrap(ErrInvalid, "invalid rune encoded byte")
+		}
+		value = append(value, byte(r))
+		consumed += size
+	}
+	return value, consumed, nil
+}
+
+// ParseKey finds the layout of a key of the gravity store and splits the key into its segments
+func ParseKey(key []byte) (KeyLayout, [][]byte, error) {
+	for _, layout := range KeyLayouts {
+		if !strings.HasPrefix(string(key), layout.Prefix) {
+			continue
+		}
+		segments, err := layout.ParseKey(key)
+		return layout, segments, err
+	}
+	return KeyLayout{}, nil, sdkerrors.Wrap(ErrUnknown, "key prefix")
+}
+
+// KeyLayoutsMarkdown renders the key layouts as the markdown table of the state spec
+func KeyLayoutsMarkdown() string {
+	var doc strings.Builder
+	doc.WriteString("| Prefix | Segments | Stores |\n")
+	doc.WriteString("| ------ | -------- | ------ |\n")
+	for _, layout := range KeyLayouts {
+		segments := make([]string, 0, len(layout.Segments))
+		for _, segment := range layout.Segments {
+			size := "variable"
+			if segment.Size != 0 {
+				size = fmt.Sprintf("%d bytes", segment.Size)
+			}
+			if segment.RuneEncoded {
+				size += ", rune encoded"
+			}
+			segments = append(segments, fmt.Sprintf("`%s` (%s)", segment.Name, size))
+		}
+		if len(segments) == 0 {
+			segments = append(segments, "single key")
+		}
+		fmt.Fprintf(&doc, "| `%s` | %s | %s |\n", layout.Prefix, strings.Join(segments, " + "), layout.Description)
+	}
+	return doc.String()
+}
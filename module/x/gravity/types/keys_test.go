@@ -0,0 +1,164 @@
+package types
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var updateKeyLayouts = flag.Bool("update-key-layouts", false, "rewrite the key layouts of the state spec")
+
+const (
+	keyLayoutsSpec  = "../spec/02_state.md"
+	keyLayoutsBegin = "<!-- key layouts begin: generated by KeyLayoutsMarkdown, run go test -run TestKeyLayoutsSpec -update-key-layouts -->\n"
+	keyLayoutsEnd   = "<!-- key layouts end -->\n"
+)
+
+// Tests that every store prefix declared in key.go is registered in KeyLayouts, reading the declarations from the
+// source so that a new prefix can not be forgotten
+func TestKeyLayoutsCoverKeyPrefixes(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "key.go", nil, 0)
+	require.NoError(t, err)
+
+	registered := make(map[string]bool, len(KeyLayouts))
+	for _, layout := range KeyLayouts {
+		registered[layout.Name] = true
+	}
+	var declared []string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			for i, name := range value.Names {
+				// sequence keys are single keys under SequenceKeyPrefix, other values are not store prefixes
+				if lit, ok := value.Values[i].(*ast.BasicLit); !ok || lit.Kind != token.STRING {
+					continue
+				}
+				declared = append(declared, name.Name)
+				require.True(t, registered[name.Name], "%s is not registered in KeyLayouts", name.Name)
+			}
+		}
+	}
+	require.Len(t, KeyLayouts, len(declared))
+}
+
+// Tests that no prefix is a prefix of another, so that iterating one never reaches the keys of another
+func TestKeyPrefixesDoNotCollide(t *testing.T) {
+	for i, layout := range KeyLayouts {
+		require.NotEmpty(t, layout.Prefix, layout.Name)
+		for j, other := range KeyLayouts {
+			if i == j {
+				continue
+			}
+			require.NotEqual(t, layout.Name, other.Name)
+			require.False(t, strings.HasPrefix(other.Prefix, layout.Prefix), "%s is a prefix of %s", layout.Name, other.Name)
+		}
+
+		variable := 0
+		for k, segment := range layout.Segments {
+			if segment.Size != 0 {
+				continue
+			}
+			variable++
+			for _, after := range layout.Segments[k+1:] {
+				require.False(t, after.RuneEncoded, "%s has a rune encoded segment after its variable one", layout.Name)
+			}
+		}
+		require.LessOrEqual(t, variable, 1, "%s has more than one variable segment", layout.Name)
+	}
+}
+
+// Tests that a key generated for every layout, with bytes above 0x7f in every segment, is parsed back into the same
+// layout and segments
+func TestKeyLayoutsRoundTrip(t *testing.T) {
+	for _, layout := range KeyLayouts {
+		segments := make([][]byte, len(layout.Segments))
+		for i, segment := range layout.Segments {
+			size := segment.Size
+			if size == 0 {
+				size = 13
+			}
+			segments[i] = bytes.Repeat([]byte{0xf0 - byte(i)}, size)
+		}
+		key, err := layout.BuildKey(segments...)
+		require.NoError(t, err, layout.Name)
+
+		parsed, parsedSegments, err := ParseKey([]byte(key))
+		require.NoError(t, err, layout.Name)
+		require.Equal(t, layout.Name, parsed.Name)
+		require.Equal(t, segments, parsedSegments, layout.Name)
+	}
+
+	_, _, err := ParseKey([]byte("UnregisteredKey"))
+	require.Error(t, err)
+	_, err = KeyLayouts[0].BuildKey()
+	require.Error(t, err)
+}
+
+// Tests that the keys written by the key getters follow their registered layouts
+func TestKeyGettersFollowLayouts(t *testing.T) {
+	contract, err := NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	validator := sdk.AccAddress(bytes.Repeat([]byte{0x9a}, 20))
+	fee, err := NewInternalERC20Token(sdk.NewInt(1000), contract.GetAddress())
+	require.NoError(t, err)
+	claimHash := bytes.Repeat([]byte{0xc1}, 32)
+	invalidationID := []byte{0xde, 0xad, 0xbe, 0xef}
+	blockHash := "0x" + strings.Repeat("ab", 32)
+	nonce := uint64(0xff80)
+
+	testCases := []struct {
+		layout   string
+		key      string
+		segments [][]byte
+	}{
+		{"ValsetConfirmKey", GetValsetConfirmKey(nonce, validator), [][]byte{UInt64Bytes(nonce), validator}},
+		{"OracleAttestationKey", GetAttestationKey(nonce, 2, claimHash), [][]byte{UInt64Bytes(nonce), UInt64Bytes(2), claimHash}},
+		{"OutgoingTXPoolKey", GetOutgoingTxPoolKey(*fee, nonce), [][]byte{[]byte(contract.GetAddress()), fee.Amount.BigInt().FillBytes(make([]byte, 32)), UInt64Bytes(nonce)}},
+		{"OutgoingTXBatchKey", GetOutgoingTxBatchKey(*contract, nonce), [][]byte{[]byte(contract.GetAddress()), UInt64Bytes(nonce)}},
+		{"BatchConfirmKey", GetBatchConfirmKey(*contract, nonce, validator), [][]byte{[]byte(contract.GetAddress()), UInt64Bytes(nonce), validator}},
+		{"KeyOutgoingLogicConfirm", GetLogicConfirmKey(invalidationID, nonce, validator), [][]byte{invalidationID, UInt64Bytes(nonce), validator}},
+		{"PastEthSignatureCheckpointKey", GetPastEthSignatureCheckpointKey(claimHash), [][]byte{claimHash}},
+		{"ForkAttestationKey", GetForkAttestationKey(nonce, blockHash, blockHash), [][]byte{UInt64Bytes(nonce), []byte(blockHash), []byte(blockHash)}},
+		{"ExecutedBatchKey", GetExecutedBatchKey(nonce, *contract, nonce), [][]byte{UInt64Bytes(nonce), []byte(contract.GetAddress()), UInt64Bytes(nonce)}},
+		{"SequenceKeyPrefix", KeyLastTXPoolID, [][]byte{[]byte("lastTxPoolId")}},
+		{"BridgeCheckpointKey", BridgeCheckpointKey, [][]byte{}},
+	}
+	for _, tc := range testCases {
+		layout, segments, err := ParseKey([]byte(tc.key))
+		require.NoError(t, err, tc.layout)
+		require.Equal(t, tc.layout, layout.Name)
+		require.Equal(t, tc.segments, segments, tc.layout)
+
+		key, err := layout.BuildKey(segments...)
+		require.NoError(t, err, tc.layout)
+		require.Equal(t, tc.key, key, tc.layout)
+	}
+}
+
+// Tests that the key layouts of the state spec are the ones generated from KeyLayouts
+func TestKeyLayoutsSpec(t *testing.T) {
+	spec, err := os.ReadFile(keyLayoutsSpec)
+	require.NoError(t, err)
+	begin := strings.Index(string(spec), keyLayoutsBegin)
+	end := strings.Index(string(spec), keyLayoutsEnd)
+	require.True(t, begin >= 0 && end > begin, "key layout markers not found in %s", keyLayoutsSpec)
+
+	generated := string(spec[:begin]) + keyLayoutsBegin + KeyLayoutsMarkdown() + string(spec[end:])
+	if *updateKeyLayouts {
+		require.NoError(t, os.WriteFile(keyLayoutsSpec, []byte(generated), 0o600))
+		return
+	}
+	require.Equal(t, generated, string(spec), "key layouts of %s are outdated, run go test -run TestKeyLayoutsSpec -update-key-layouts", keyLayoutsSpec)
+}
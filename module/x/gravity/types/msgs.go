@@ -3,10 +3,14 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"regexp"
+	"strings"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/tendermint/tendermint/crypto/tmhash"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 //nolint: exhaustivestruct
@@ -24,6 +28,15 @@ var (
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgUnjailValidator{}
+	_ sdk.Msg = &MsgCreateRecurringSendToEth{}
+	_ sdk.Msg = &MsgCancelRecurringSendToEth{}
+	_ sdk.Msg = &MsgForkDetectedClaim{}
+	_ sdk.Msg = &MsgSetSelfBridgeLimit{}
+	_ sdk.Msg = &MsgExecuteIbcAutoForwards{}
+	_ sdk.Msg = &MsgSubmitGravityProposal{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitGravityProposal{}
 )
 
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
@@ -98,7 +111,7 @@ func (msg *MsgValsetConfirm) ValidateBasic() (err error) {
 	if err := ValidateEthAddress(msg.EthAddress); err != nil {
 		return sdkerrors.Wrap(err, "ethereum address")
 	}
-	return nil
+	return validateConfirmSignature(msg.Signature)
 }
 
 // GetSignBytes encodes the message for signing
@@ -139,11 +152,8 @@ func (msg MsgSendToEth) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
 	}
 
-	// fee and send must be of the same denom
-	if msg.Amount.Denom != msg.BridgeFee.Denom {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins,
-			fmt.Sprintf("fee and amount must be the same type %s != %s", msg.Amount.Denom, msg.BridgeFee.Denom))
-	}
+	// a fee in a denom other than the amount must have a governance exchange rate,
+	// this is checked against the params when the message is handled
 
 	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
@@ -151,6 +161,14 @@ func (msg MsgSendToEth) ValidateBasic() error {
 	if !msg.BridgeFee.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
 	}
+	if msg.RelayFee != nil {
+		if err := ValidateRelayFee(*msg.RelayFee); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "relay fee")
+		}
+	}
+	if msg.ChainFee != nil && (!msg.ChainFee.IsValid() || msg.ChainFee.Denom != msg.Amount.Denom) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "chain fee")
+	}
 	if err := ValidateEthAddress(msg.EthDest); err != nil {
 		return sdkerrors.Wrap(err, "ethereum address")
 	}
@@ -227,11 +245,7 @@ func (msg MsgConfirmBatch) ValidateBasic() error {
 	if err := ValidateEthAddress(msg.TokenContract); err != nil {
 		return sdkerrors.Wrap(err, "token contract")
 	}
-	_, err := hex.DecodeString(msg.Signature)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Could not decode hex string %s", msg.Signature)
-	}
-	return nil
+	return validateConfirmSignature(msg.Signature)
 }
 
 // GetSignBytes encodes the message for signing
@@ -262,11 +276,10 @@ func (msg MsgConfirmLogicCall) ValidateBasic() error {
 	if err := ValidateEthAddress(msg.EthSigner); err != nil {
 		return sdkerrors.Wrap(err, "eth signer")
 	}
-	_, err := hex.DecodeString(msg.Signature)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Could not decode hex string %s", msg.Signature)
+	if err := validateConfirmSignature(msg.Signature); err != nil {
+		return err
 	}
-	_, err = hex.DecodeString(msg.InvalidationId)
+	_, err := hex.DecodeString(msg.InvalidationId)
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Could not decode hex string %s", msg.InvalidationId)
 	}
@@ -299,6 +312,12 @@ type EthereumClaim interface {
 	// when we go to create a new batch we set the timeout some number of batches out from the last
 	// known height plus projected block progress since then.
 	GetBlockHeight() uint64
+	// The hash of the Ethereum block the claimed event occurred in, it is part of the claim hash from claim hash
+	// version 2 on and may be empty for orchestrators that do not report it
+	GetBlockHash() string
+	// The Gravity.sol contract the claimed event was read from, claims of another contract than the bridge binding
+	// are rejected. It is not part of the claim hash and may be empty for orchestrators that do not report it
+	GetBridgeContract() string
 	// the delegate address of the claimer, for MsgDepositClaim and MsgWithdrawClaim
 	// this is sent in as the sdk.AccAddress of the delegated key. it is up to the user
 	// to disambiguate this into a sdk.ValAddress
@@ -308,8 +327,11 @@ type EthereumClaim interface {
 	ValidateBasic() error
 	// The claim hash of this claim. This is used to store these claims and also used to check if two different
 	// validators claims agree. Therefore it's extremely important that this include all elements of the claim
-	// with the exception of the orchestrator who sent it in, which will be used as a different part of the index
-	ClaimHash() ([]byte, error)
+	// with the exception of the orchestrator who sent it in, which will be used as a different part of the index, and
+	// of the bridge contract, which is checked against the bridge binding before the claim is attested.
+	// It is the SHA256 hash of CanonicalClaimBytes in the claim hash version of the attestation, so it can be computed
+	// outside of this module
+	ClaimHash(version uint64) ([]byte, error)
 }
 
 //nolint: exhaustivestruct
@@ -333,6 +355,9 @@ func (msg *MsgSendToCosmosClaim) ValidateBasic() error {
 	if err := ValidateEthAddress(msg.TokenContract); err != nil {
 		return sdkerrors.Wrap(err, "erc20 token")
 	}
+	if err := ValidateUint256(msg.Amount); err != nil {
+		return sdkerrors.Wrap(err, "amount")
+	}
 	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "orchestrator")
 	}
@@ -347,6 +372,12 @@ func (msg *MsgSendToCosmosClaim) ValidateBasic() error {
 	if msg.EventNonce == 0 {
 		return fmt.Errorf("nonce == 0")
 	}
+	if err := validateClaimBlockHash(msg.BlockHash); err != nil {
+		return err
+	}
+	if err := validateClaimBridgeContract(msg.BridgeContract); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -394,9 +425,8 @@ const (
 // could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
-func (msg *MsgSendToCosmosClaim) ClaimHash() ([]byte, error) {
-	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s", msg.EventNonce, msg.BlockHeight, msg.TokenContract, msg.Amount.String(), msg.EthereumSender, msg.CosmosReceiver)
-	return tmhash.Sum([]byte(path)), nil
+func (msg *MsgSendToCosmosClaim) ClaimHash(version uint64) ([]byte, error) {
+	return hashClaim(msg, version)
 }
 
 // GetType returns the claim type
@@ -418,13 +448,24 @@ func (e *MsgBatchSendToEthClaim) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
 	}
+	if e.Relayer != "" {
+		if err := ValidateEthAddress(e.Relayer); err != nil {
+			return sdkerrors.Wrap(err, "relayer")
+		}
+	}
+	if err := validateClaimBlockHash(e.BlockHash); err != nil {
+		return err
+	}
+	if err := validateClaimBridgeContract(e.BridgeContract); err != nil {
+		return err
+	}
 	return nil
 }
 
 // Hash implements WithdrawBatch.Hash
-func (msg *MsgBatchSendToEthClaim) ClaimHash() ([]byte, error) {
-	path := fmt.Sprintf("%s/%d/%d/%s", msg.TokenContract, msg.BatchNonce, msg.EventNonce, msg.TokenContract)
-	return tmhash.Sum([]byte(path)), nil
+// the relayer is part of the hash lowercased, so claims of the same relay agree regardless of the address checksum
+func (msg *MsgBatchSendToEthClaim) ClaimHash(version uint64) ([]byte, error) {
+	return hashClaim(msg, version)
 }
 
 // GetSignBytes encodes the message for signing
@@ -480,6 +521,12 @@ func (e *MsgERC20DeployedClaim) ValidateBasic() error {
 	if e.EventNonce == 0 {
 		return fmt.Errorf("nonce == 0")
 	}
+	if err := validateClaimBlockHash(e.BlockHash); err != nil {
+		return err
+	}
+	if err := validateClaimBridgeContract(e.BridgeContract); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -519,9 +566,8 @@ func (msg MsgERC20DeployedClaim) Route() string { return RouterKey }
 // could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
-func (b *MsgERC20DeployedClaim) ClaimHash() ([]byte, error) {
-	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s/%d", b.EventNonce, b.BlockHeight, b.CosmosDenom, b.TokenContract, b.Name, b.Symbol, b.Decimals)
-	return tmhash.Sum([]byte(path)), nil
+func (b *MsgERC20DeployedClaim) ClaimHash(version uint64) ([]byte, error) {
+	return hashClaim(b, version)
 }
 
 // EthereumClaim implementation for MsgLogicCallExecutedClaim
@@ -540,6 +586,12 @@ func (e *MsgLogicCallExecutedClaim) ValidateBasic() error {
 	if e.EventNonce == 0 {
 		return fmt.Errorf("nonce == 0")
 	}
+	if err := validateClaimBlockHash(e.BlockHash); err != nil {
+		return err
+	}
+	if err := validateClaimBridgeContract(e.BridgeContract); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -579,9 +631,8 @@ func (msg MsgLogicCallExecutedClaim) Route() string { return RouterKey }
 // could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
-func (b *MsgLogicCallExecutedClaim) ClaimHash() ([]byte, error) {
-	path := fmt.Sprintf("%d,%d,%s/%d/", b.EventNonce, b.BlockHeight, b.InvalidationId, b.InvalidationNonce)
-	return tmhash.Sum([]byte(path)), nil
+func (b *MsgLogicCallExecutedClaim) ClaimHash(version uint64) ([]byte, error) {
+	return hashClaim(b, version)
 }
 
 // EthereumClaim implementation for MsgValsetUpdatedClaim
@@ -605,6 +656,12 @@ func (e *MsgValsetUpdatedClaim) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
+	// a valset without reward may leave the reward amount unset
+	if !e.RewardAmount.IsNil() {
+		if err := ValidateUint256(e.RewardAmount); err != nil {
+			return sdkerrors.Wrap(err, "reward amount")
+		}
+	}
 
 	for _, member := range e.Members {
 		err := ValidateEthAddress(member.EthereumAddress)
@@ -613,6 +670,12 @@ func (e *MsgValsetUpdatedClaim) ValidateBasic() error {
 		}
 	}
 
+	if err := validateClaimBlockHash(e.BlockHash); err != nil {
+		return err
+	}
+	if err := validateClaimBridgeContract(e.BridgeContract); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -652,15 +715,8 @@ func (msg MsgValsetUpdatedClaim) Route() string { return RouterKey }
 // could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
-func (b *MsgValsetUpdatedClaim) ClaimHash() ([]byte, error) {
-	var members BridgeValidators = b.Members
-	internalMembers, err := members.ToInternal()
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "invalid members")
-	}
-	internalMembers.Sort()
-	path := fmt.Sprintf("%d/%d/%d/%x/%s/%s", b.EventNonce, b.ValsetNonce, b.BlockHeight, internalMembers.ToExternal(), b.RewardAmount.String(), b.RewardToken)
-	return tmhash.Sum([]byte(path)), nil
+func (b *MsgValsetUpdatedClaim) ClaimHash(version uint64) ([]byte, error) {
+	return hashClaim(b, version)
 }
 
 // NewMsgCancelSendToEth returns a new msgSetOrchestratorAddress
@@ -700,6 +756,372 @@ func (msg *MsgCancelSendToEth) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgUnjailValidator returns a new MsgUnjailValidator
+func NewMsgUnjailValidator(val sdk.ValAddress) *MsgUnjailValidator {
+	return &MsgUnjailValidator{
+		Validator: val.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgUnjailValidator) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgUnjailValidator) Type() string { return "unjail_validator" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgUnjailValidator) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUnjailValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required, the operator account of the validator
+func (msg *MsgUnjailValidator) GetSigners() []sdk.AccAddress {
+	val, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(val)}
+}
+
+// NewMsgCreateRecurringSendToEth returns a new MsgCreateRecurringSendToEth
+func NewMsgCreateRecurringSendToEth(
+	sender sdk.AccAddress, dest EthAddress, amount sdk.Coin, fee sdk.Coin, interval uint64, count uint64,
+) *MsgCreateRecurringSendToEth {
+	return &MsgCreateRecurringSendToEth{
+		Sender:    sender.String(),
+		EthDest:   dest.GetAddress(),
+		Amount:    amount,
+		BridgeFee: fee,
+		Interval:  interval,
+		Count:     count,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgCreateRecurringSendToEth) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgCreateRecurringSendToEth) Type() string { return "create_recurring_send_to_eth" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgCreateRecurringSendToEth) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if err := ValidateEthAddress(msg.EthDest); err != nil {
+		return sdkerrors.Wrap(err, "ethereum address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	// the escrow pays every bridge fee upfront, so it can not be exchanged from another denom
+	if !msg.BridgeFee.IsValid() || msg.BridgeFee.Denom != msg.Amount.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee must be in the denom of the amount")
+	}
+	if msg.Interval == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "interval must be positive")
+	}
+	if msg.Count == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "count must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateRecurringSendToEth) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgCreateRecurringSendToEth) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgCancelRecurringSendToEth returns a new MsgCancelRecurringSendToEth
+func NewMsgCancelRecurringSendToEth(sender sdk.AccAddress, id uint64) *MsgCancelRecurringSendToEth {
+	return &MsgCancelRecurringSendToEth{
+		Sender: sender.String(),
+		Id:     id,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgCancelRecurringSendToEth) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgCancelRecurringSendToEth) Type() string { return "cancel_recurring_send_to_eth" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgCancelRecurringSendToEth) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelRecurringSendToEth) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgCancelRecurringSendToEth) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MsgForkDetectedClaim
+// ======================================================
+
+// GetType returns the type of the claim
+func (msg *MsgForkDetectedClaim) GetType() ClaimType {
+	return CLAIM_TYPE_FORK_DETECTED
+}
+
+// Route should return the name of the module
+func (msg *MsgForkDetectedClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgForkDetectedClaim) Type() string { return "fork_detected_claim" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgForkDetectedClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	if msg.EthereumHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum height == 0")
+	}
+	if err := ValidateEthBlockHash(msg.ObservedBlockHash); err != nil {
+		return sdkerrors.Wrap(err, "observed block hash")
+	}
+	if err := ValidateEthBlockHash(msg.ConflictingBlockHash); err != nil {
+		return sdkerrors.Wrap(err, "conflicting block hash")
+	}
+	if strings.EqualFold(msg.ObservedBlockHash, msg.ConflictingBlockHash) {
+		return sdkerrors.Wrap(ErrInvalid, "the observed and conflicting block hashes are the same")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgForkDetectedClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgForkDetectedClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MsgSetSelfBridgeLimit
+// ======================================================
+
+func NewMsgSetSelfBridgeLimit(sender sdk.AccAddress, limit sdk.Coins) *MsgSetSelfBridgeLimit {
+	return &MsgSetSelfBridgeLimit{
+		Sender: sender.String(),
+		Limit:  limit,
+	}
+}
+
+func (msg *MsgSetSelfBridgeLimit) Route() string { return RouterKey }
+
+func (msg *MsgSetSelfBridgeLimit) Type() string { return "set_self_bridge_limit" }
+
+func (msg *MsgSetSelfBridgeLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if err := msg.Limit.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+func (msg *MsgSetSelfBridgeLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSetSelfBridgeLimit) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MsgSubmitGravityProposal
+// ======================================================
+
+// MaxGravityProposalMetadataLen is the maximum length of the metadata of a MsgSubmitGravityProposal, the gov v1 default
+const MaxGravityProposalMetadataLen = 255
+
+func NewMsgSubmitGravityProposal(
+	proposer sdk.AccAddress, content govtypes.Content, initialDeposit sdk.Coins, metadata string,
+) (*MsgSubmitGravityProposal, error) {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", content)
+	}
+	contentAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitGravityProposal{
+		Proposer:       proposer.String(),
+		Content:        contentAny,
+		InitialDeposit: initialDeposit,
+		Metadata:       metadata,
+	}, nil
+}
+
+// GetContent returns the proposal content, or nil if it was not unpacked
+func (msg *MsgSubmitGravityProposal) GetContent() govtypes.Content {
+	content, ok := msg.Content.GetCachedValue().(govtypes.Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
+
+func (msg *MsgSubmitGravityProposal) Route() string { return RouterKey }
+
+func (msg *MsgSubmitGravityProposal) Type() string { return "submit_gravity_proposal" }
+
+func (msg *MsgSubmitGravityProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Proposer)
+	}
+	content := msg.GetContent()
+	if content == nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "missing content")
+	}
+	if content.ProposalRoute() != RouterKey {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidProposalType, "%s is not a gravity proposal", content.ProposalType())
+	}
+	if err := content.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := msg.InitialDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if len(msg.Metadata) > MaxGravityProposalMetadataLen {
+		return sdkerrors.Wrapf(ErrInvalid, "metadata is longer than %d", MaxGravityProposalMetadataLen)
+	}
+	return nil
+}
+
+func (msg *MsgSubmitGravityProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSubmitGravityProposal) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// UnpackInterfaces unpacks the proposal content
+func (msg *MsgSubmitGravityProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var content govtypes.Content
+	return unpacker.UnpackAny(msg.Content, &content)
+}
+
+// MsgExecuteIbcAutoForwards
+// ======================================================
+
+func NewMsgExecuteIbcAutoForwards(executor sdk.AccAddress, forwardsToClear uint64) *MsgExecuteIbcAutoForwards {
+	return &MsgExecuteIbcAutoForwards{
+		ForwardsToClear: forwardsToClear,
+		Executor:        executor.String(),
+	}
+}
+
+func (msg *MsgExecuteIbcAutoForwards) Route() string { return RouterKey }
+
+func (msg *MsgExecuteIbcAutoForwards) Type() string { return "execute_ibc_auto_forwards" }
+
+func (msg *MsgExecuteIbcAutoForwards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Executor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Executor)
+	}
+	if msg.ForwardsToClear == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "forwards to clear must be positive")
+	}
+	return nil
+}
+
+func (msg *MsgExecuteIbcAutoForwards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgExecuteIbcAutoForwards) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateEthBlockHash checks that hash is a 0x prefixed hex encoded 32 byte Ethereum block hash
+func ValidateEthBlockHash(hash string) error {
+	if !regexp.MustCompile("^0x[0-9a-fA-F]{64}$").MatchString(hash) {
+		return sdkerrors.Wrapf(ErrInvalid, "block hash(%s) doesn't pass regex", hash)
+	}
+	return nil
+}
+
+// validateClaimBlockHash checks the block hash of a claim, which may be left empty
+func validateClaimBlockHash(hash string) error {
+	if hash == "" {
+		return nil
+	}
+	return sdkerrors.Wrap(ValidateEthBlockHash(hash), "block hash")
+}
+
+// validateClaimBridgeContract checks the bridge contract of a claim, which may be left empty
+func validateClaimBridgeContract(contract string) error {
+	if contract == "" {
+		return nil
+	}
+	return sdkerrors.Wrap(ValidateEthAddress(contract), "bridge contract")
+}
+
+// validateConfirmSignature checks that a hex encoded confirm signature is well formed, this
+// is only a pre-check the signature is verified against the signed checkpoint and the
+// validator's registered Ethereum key in the msg handler
+func validateConfirmSignature(signature string) error {
+	sigBytes, err := hex.DecodeString(signature)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Could not decode hex string %s", signature)
+	}
+	if err := ValidateEthereumSignatureFormat(sigBytes); err != nil {
+		return sdkerrors.Wrap(err, "signature")
+	}
+	return nil
+}
+
 // MsgSubmitBadSignatureEvidence
 // ======================================================
 
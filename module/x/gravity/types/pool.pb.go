@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -69,10 +70,17 @@ func (m *IDSet) GetIds() []uint64 {
 	return nil
 }
 
+// BatchFees are the fees of the next batch of a token, of at most OutgoingTxBatchSize transactions, and of the whole
+// unbatched pool of the token
 type BatchFees struct {
 	Token     string                                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	TotalFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees"`
 	TxCount   uint64                                 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// the relay fees, in every denom they were paid in, of the same transactions
+	RelayFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=relay_fees,json=relayFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"relay_fees"`
+	// the fees and the count of every unbatched transaction of the token, the ones past the batch size included
+	PoolTotalFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=pool_total_fees,json=poolTotalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_total_fees"`
+	PoolTxCount   uint64                                 `protobuf:"varint,6,opt,name=pool_tx_count,json=poolTxCount,proto3" json:"pool_tx_count,omitempty"`
 }
 
 func (m *BatchFees) Reset()         { *m = BatchFees{} }
@@ -122,6 +130,20 @@ func (m *BatchFees) GetTxCount() uint64 {
 	return 0
 }
 
+func (m *BatchFees) GetRelayFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RelayFees
+	}
+	return nil
+}
+
+func (m *BatchFees) GetPoolTxCount() uint64 {
+	if m != nil {
+		return m.PoolTxCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
 	proto.RegisterType((*BatchFees)(nil), "gravity.v1.BatchFees")
@@ -130,25 +152,31 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/pool.proto", fileDescriptor_18d107f7cfc31f22) }
 
 var fileDescriptor_18d107f7cfc31f22 = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x50, 0xbb, 0x6e, 0x83, 0x40,
-	0x10, 0xe4, 0x62, 0x3b, 0x89, 0xaf, 0x8a, 0x90, 0x23, 0xe1, 0x14, 0x67, 0xcb, 0x45, 0xe4, 0xc6,
-	0x9c, 0xac, 0xfc, 0x01, 0x79, 0x48, 0x2e, 0xd2, 0x90, 0x2e, 0x0d, 0xe2, 0x71, 0xc1, 0xc8, 0xc0,
-	0x22, 0x6e, 0x41, 0xf0, 0x0d, 0x69, 0xf2, 0x59, 0x2e, 0x5d, 0x46, 0x29, 0xac, 0x08, 0x7e, 0x24,
-	0xe2, 0x8c, 0xa5, 0x54, 0x37, 0x33, 0xb7, 0xbb, 0xb3, 0x3b, 0xf4, 0x36, 0xcc, 0xdd, 0x32, 0xc2,
-	0x9a, 0x97, 0x6b, 0x9e, 0x01, 0xc4, 0x66, 0x96, 0x03, 0x82, 0x4e, 0x7b, 0xd9, 0x2c, 0xd7, 0x77,
-	0x93, 0x10, 0x42, 0x50, 0x32, 0xef, 0xd0, 0xa9, 0x62, 0x31, 0xa5, 0xa3, 0xcd, 0xd3, 0x9b, 0x40,
-	0xfd, 0x86, 0x0e, 0xa2, 0x40, 0x1a, 0x64, 0x3e, 0x58, 0x0e, 0xed, 0x0e, 0x2e, 0x3e, 0x09, 0x1d,
-	0x5b, 0x2e, 0xfa, 0xdb, 0x17, 0x21, 0xa4, 0x3e, 0xa1, 0x23, 0x84, 0x9d, 0x48, 0x0d, 0x32, 0x27,
-	0xcb, 0xb1, 0x7d, 0x22, 0xfa, 0x2b, 0xa5, 0x08, 0xe8, 0xc6, 0xce, 0x87, 0x10, 0xd2, 0xb8, 0xe8,
-	0xbe, 0x2c, 0x73, 0x7f, 0x9c, 0x69, 0x3f, 0xc7, 0xd9, 0x7d, 0x18, 0xe1, 0xb6, 0xf0, 0x4c, 0x1f,
-	0x12, 0xee, 0x83, 0x4c, 0x40, 0xf6, 0xcf, 0x4a, 0x06, 0x3b, 0x8e, 0x75, 0x26, 0xa4, 0xb9, 0x49,
-	0xd1, 0x1e, 0xab, 0x09, 0xca, 0x64, 0x4a, 0xaf, 0xb1, 0x72, 0x7c, 0x28, 0x52, 0x34, 0x06, 0x73,
-	0xb2, 0x1c, 0xda, 0x57, 0x58, 0x3d, 0x76, 0xd4, 0x72, 0xf6, 0x0d, 0x23, 0x87, 0x86, 0x91, 0xdf,
-	0x86, 0x91, 0xaf, 0x96, 0x69, 0x87, 0x96, 0x69, 0xdf, 0x2d, 0xd3, 0xde, 0x9f, 0xff, 0xf9, 0x40,
-	0x0a, 0x49, 0xad, 0x2e, 0xf3, 0x21, 0x3e, 0xdb, 0xf5, 0x21, 0xac, 0xbc, 0x3c, 0x0a, 0x42, 0xc1,
-	0x13, 0x08, 0x8a, 0x58, 0xf0, 0x8a, 0x9f, 0x33, 0x53, 0xab, 0x78, 0x97, 0xaa, 0xed, 0xe1, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0xfb, 0xab, 0xf0, 0x92, 0x4b, 0x01, 0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xb1, 0xae, 0xda, 0x30,
+	0x14, 0x86, 0x13, 0x02, 0xb4, 0x31, 0x42, 0xad, 0x22, 0x2a, 0x05, 0x86, 0x10, 0x31, 0x54, 0x59,
+	0x6a, 0x37, 0xe5, 0x0d, 0x42, 0x55, 0x89, 0x81, 0x25, 0xad, 0x3a, 0x74, 0x41, 0x49, 0x70, 0x43,
+	0x4a, 0x92, 0x83, 0x62, 0x13, 0x91, 0xb7, 0xe8, 0x73, 0xf4, 0x49, 0x18, 0x19, 0xab, 0x0e, 0xb4,
+	0x82, 0x47, 0xe8, 0x0b, 0x5c, 0xd9, 0x86, 0x2b, 0xc6, 0xab, 0x3b, 0xf9, 0x9c, 0xe3, 0xe3, 0xff,
+	0xfc, 0x9f, 0x6d, 0xf4, 0x26, 0xad, 0xa2, 0x3a, 0xe3, 0x0d, 0xa9, 0x7d, 0xb2, 0x05, 0xc8, 0xf1,
+	0xb6, 0x02, 0x0e, 0x16, 0xba, 0x96, 0x71, 0xed, 0x8f, 0x9c, 0x04, 0x58, 0x01, 0x8c, 0xc4, 0x11,
+	0xa3, 0xa4, 0xf6, 0x63, 0xca, 0x23, 0x9f, 0x24, 0x90, 0x95, 0xaa, 0x77, 0x34, 0x48, 0x21, 0x05,
+	0x19, 0x12, 0x11, 0xa9, 0xea, 0x64, 0x88, 0x3a, 0xf3, 0x8f, 0x9f, 0x29, 0xb7, 0x5e, 0x23, 0x23,
+	0x5b, 0x31, 0x5b, 0x77, 0x0d, 0xaf, 0x1d, 0x8a, 0x70, 0xf2, 0xbf, 0x85, 0xcc, 0x20, 0xe2, 0xc9,
+	0xfa, 0x13, 0xa5, 0xcc, 0x1a, 0xa0, 0x0e, 0x87, 0x0d, 0x2d, 0x6d, 0xdd, 0xd5, 0x3d, 0x33, 0x54,
+	0x89, 0xb5, 0x40, 0x88, 0x03, 0x8f, 0xf2, 0xe5, 0x77, 0x4a, 0x99, 0xdd, 0x12, 0x5b, 0x01, 0x3e,
+	0x9c, 0xc6, 0xda, 0x9f, 0xd3, 0xf8, 0x6d, 0x9a, 0xf1, 0xf5, 0x2e, 0xc6, 0x09, 0x14, 0xe4, 0xea,
+	0x4d, 0x2d, 0xef, 0xd8, 0x6a, 0x43, 0x78, 0xb3, 0xa5, 0x0c, 0xcf, 0x4b, 0x1e, 0x9a, 0x52, 0x41,
+	0x0e, 0x19, 0xa2, 0x97, 0x7c, 0xbf, 0x4c, 0x60, 0x57, 0x72, 0xdb, 0x70, 0x75, 0xaf, 0x1d, 0xbe,
+	0xe0, 0xfb, 0x99, 0x48, 0xad, 0x1f, 0x08, 0x55, 0x34, 0x8f, 0x1a, 0x35, 0xa9, 0xed, 0x1a, 0x5e,
+	0xef, 0xc3, 0x10, 0x2b, 0x41, 0x2c, 0x98, 0xf1, 0x95, 0x19, 0xcf, 0x20, 0x2b, 0x83, 0xf7, 0xc2,
+	0xc4, 0xaf, 0xbf, 0x63, 0xef, 0x09, 0x26, 0xc4, 0x01, 0x16, 0x9a, 0x52, 0x5e, 0xda, 0xf8, 0x8a,
+	0x5e, 0x89, 0x4b, 0x5e, 0xde, 0xa1, 0x75, 0x9e, 0x85, 0xd6, 0x17, 0x32, 0x5f, 0x1e, 0xf1, 0x26,
+	0xa8, 0xaf, 0x74, 0x6f, 0x8c, 0x5d, 0xc9, 0xd8, 0x93, 0x5d, 0x8a, 0x33, 0x58, 0x1c, 0xce, 0x8e,
+	0x7e, 0x3c, 0x3b, 0xfa, 0xbf, 0xb3, 0xa3, 0xff, 0xbc, 0x38, 0xda, 0xf1, 0xe2, 0x68, 0xbf, 0x2f,
+	0x8e, 0xf6, 0x6d, 0x7a, 0x37, 0x14, 0x4a, 0x28, 0x1a, 0xf9, 0x82, 0x09, 0xe4, 0x24, 0xaa, 0x12,
+	0x52, 0xc0, 0x6a, 0x97, 0x53, 0xb2, 0x27, 0xb7, 0x9f, 0x22, 0x5d, 0xc4, 0x5d, 0xd9, 0x34, 0x7d,
+	0x18, 0x00, 0xac, 0x50, 0x8e, 0x09, 0x41, 0x02, 0x00, 0x00,
 }
 
 func (m *IDSet) Marshal() (dAtA []byte, err error) {
@@ -212,6 +240,35 @@ func (m *BatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PoolTxCount != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolTxCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.PoolTotalFees.Size()
+		i -= size
+		if _, err := m.PoolTotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RelayFees) > 0 {
+		for iNdEx := len(m.RelayFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.TxCount != 0 {
 		i = encodeVarintPool(dAtA, i, uint64(m.TxCount))
 		i--
@@ -279,6 +336,17 @@ func (m *BatchFees) Size() (n int) {
 	if m.TxCount != 0 {
 		n += 1 + sovPool(uint64(m.TxCount))
 	}
+	if len(m.RelayFees) > 0 {
+		for _, e := range m.RelayFees {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	l = m.PoolTotalFees.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.PoolTxCount != 0 {
+		n += 1 + sovPool(uint64(m.PoolTxCount))
+	}
 	return n
 }
 
@@ -528,6 +596,93 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayFees = append(m.RelayFees, types.Coin{})
+			if err := m.RelayFees[len(m.RelayFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolTotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTxCount", wireType)
+			}
+			m.PoolTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// StateProofPath is the ABCI query path returning the value of a key of the gravity store with its proof
+const StateProofPath = "/store/" + StoreKey + "/key"
+
+// StateProofKey returns the key in the gravity store of the state entry the request identifies
+func (m QueryStateProofKeyRequest) StateProofKey() ([]byte, error) {
+	switch m.Entry {
+	case STATE_PROOF_ENTRY_ATTESTATION:
+		if len(m.ClaimHash) == 0 {
+			return nil, sdkerrors.Wrap(ErrInvalid, "empty claim hash")
+		}
+		return []byte(GetAttestationKey(m.Nonce, m.ClaimHashVersion, m.ClaimHash)), nil
+	case STATE_PROOF_ENTRY_BATCH:
+		contract, err := NewEthAddress(m.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract")
+		}
+		return []byte(GetOutgoingTxBatchKey(*contract, m.Nonce)), nil
+	case STATE_PROOF_ENTRY_DENOM_TO_ERC20:
+		if m.Denom == "" {
+			return nil, sdkerrors.Wrap(ErrInvalid, "empty denom")
+		}
+		return []byte(GetDenomToERC20Key(m.Denom)), nil
+	case STATE_PROOF_ENTRY_ERC20_TO_DENOM:
+		contract, err := NewEthAddress(m.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract")
+		}
+		return []byte(GetERC20ToDenomKey(*contract)), nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalid, "unknown state proof entry %d", m.Entry)
+	}
+}
+
+// VerifyStateProof verifies the ICS23 proof of a gravity state entry against appHash, the app hash of the header at
+// the height after the height of the proof. A proof without value proves that the key is absent
+func VerifyStateProof(appHash []byte, proof StateProof) error {
+	if proof.StoreName != StoreKey {
+		return sdkerrors.Wrapf(ErrInvalid, "proof of store %s", proof.StoreName)
+	}
+	if proof.Proof == nil || len(proof.Proof.Ops) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "empty proof")
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(proof.StoreName), merkle.KeyEncodingURL).
+		AppendKey(proof.Key, merkle.KeyEncodingHex).
+		String()
+	runtime := rootmulti.DefaultProofRuntime()
+	if len(proof.Value) == 0 {
+		return sdkerrors.Wrap(runtime.VerifyAbsence(proof.Proof, appHash, keyPath), "absence proof")
+	}
+	return sdkerrors.Wrap(runtime.VerifyValue(proof.Proof, appHash, keyPath, proof.Value), "value proof")
+}
@@ -198,3 +198,74 @@ func shuffled(v InternalBridgeValidators) InternalBridgeValidators {
 	})
 	return v
 }
+
+func TestFormatDecimalAmount(t *testing.T) {
+	specs := []struct {
+		amount   int64
+		decimals uint32
+		exp      string
+	}{
+		{amount: 1500000, decimals: 6, exp: "1.5"},
+		{amount: 1000000, decimals: 6, exp: "1"},
+		{amount: 1, decimals: 6, exp: "0.000001"},
+		{amount: 0, decimals: 18, exp: "0"},
+		{amount: 123, decimals: 0, exp: "123"},
+		{amount: -25, decimals: 1, exp: "-2.5"},
+	}
+	for _, spec := range specs {
+		assert.Equal(t, spec.exp, FormatDecimalAmount(sdk.NewInt(spec.amount), spec.decimals))
+	}
+}
+
+// Tests the uint256 bounds of the amounts of Ethereum events at their edges, including a negative amount decoded
+// from a malleable encoding
+func TestUint256Amounts(t *testing.T) {
+	maxUint256, ok := sdk.NewIntFromString("115792089237316195423570985008687907853269984665640564039457584007913129639935")
+	require.True(t, ok)
+	var negative sdk.Int
+	require.NoError(t, negative.Unmarshal([]byte("-1")))
+
+	assert.NoError(t, ValidateUint256(sdk.ZeroInt()))
+	assert.NoError(t, ValidateUint256(maxUint256))
+	assert.Error(t, ValidateUint256(negative))
+	assert.Error(t, ValidateUint256(sdk.Int{}))
+
+	sum, err := AddUint256(maxUint256.SubRaw(1), sdk.OneInt())
+	require.NoError(t, err)
+	assert.Equal(t, maxUint256, sum)
+	_, err = AddUint256(maxUint256, sdk.OneInt())
+	assert.ErrorIs(t, err, ErrUint256Overflow)
+	_, err = AddUint256(maxUint256, negative)
+	assert.Error(t, err)
+
+	contract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	claim := MsgSendToCosmosClaim{
+		EventNonce:     1,
+		TokenContract:  contract,
+		Amount:         maxUint256,
+		EthereumSender: contract,
+		Orchestrator:   sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String(),
+	}
+	assert.NoError(t, claim.ValidateBasic())
+	claim.Amount = negative
+	assert.Error(t, claim.ValidateBasic())
+	claim.Amount = sdk.Int{}
+	assert.Error(t, claim.ValidateBasic())
+
+	tokenContract, err := NewEthAddress(contract)
+	require.NoError(t, err)
+	half := maxUint256.QuoRaw(2)
+	tx := func(amount sdk.Int) *InternalOutgoingTransferTx {
+		return &InternalOutgoingTransferTx{
+			Id:          1,
+			Sender:      sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)),
+			DestAddress: tokenContract,
+			Erc20Token:  &InternalERC20Token{Amount: amount, Contract: *tokenContract},
+			Erc20Fee:    &InternalERC20Token{Amount: sdk.OneInt(), Contract: *tokenContract},
+		}
+	}
+	batch := InternalOutgoingTxBatch{BatchNonce: 1, TokenContract: *tokenContract, Transactions: []*InternalOutgoingTransferTx{tx(half)}}
+	assert.NoError(t, batch.ValidateBasic())
+	batch.Transactions = append(batch.Transactions, tx(half))
+	assert.ErrorIs(t, batch.ValidateBasic(), ErrUint256Overflow)
+}
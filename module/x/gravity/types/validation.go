@@ -143,6 +143,17 @@ func (b InternalBridgeValidators) TotalPower() (out uint64) {
 	return
 }
 
+// PowerOfSigners returns the total power of the validators whose Ethereum addresses
+// are present in signers, addresses are compared case insensitively
+func (b InternalBridgeValidators) PowerOfSigners(signers map[string]struct{}) (out uint64) {
+	for _, v := range b {
+		if _, ok := signers[strings.ToLower(v.EthereumAddress.GetAddress())]; ok {
+			out += v.Power
+		}
+	}
+	return
+}
+
 // HasDuplicates returns true if there are duplicates in the set
 func (b InternalBridgeValidators) HasDuplicates() bool {
 	m := make(map[string]struct{}, len(b))
@@ -193,7 +204,7 @@ func NewValset(nonce, height uint64, members InternalBridgeValidators, rewardAmo
 	for _, val := range members {
 		mem = append(mem, val.ToExternal())
 	}
-	vs := Valset{Nonce: uint64(nonce), Members: mem, Height: height, RewardAmount: rewardAmount, RewardToken: rewardToken.GetAddress()}
+	vs := Valset{Nonce: uint64(nonce), Members: mem, Height: height, RewardAmount: rewardAmount, RewardToken: rewardToken.GetAddress(), RelayableSinceHeight: 0}
 	return &vs,
 		nil
 }
@@ -263,11 +274,12 @@ func (v *Valset) WithoutEmptyMembers() *Valset {
 		return nil
 	}
 	r := Valset{
-		Nonce:        v.Nonce,
-		Members:      make([]BridgeValidator, 0, len(v.Members)),
-		Height:       0,
-		RewardAmount: sdk.Int{},
-		RewardToken:  "",
+		Nonce:                v.Nonce,
+		Members:              make([]BridgeValidator, 0, len(v.Members)),
+		Height:               0,
+		RewardAmount:         sdk.Int{},
+		RewardToken:          "",
+		RelayableSinceHeight: 0,
 	}
 	for i := range v.Members {
 		if _, err := v.Members[i].ToInternal(); err == nil {